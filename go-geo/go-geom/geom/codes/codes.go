@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrInconsistentLayout = errors.New("inconsistent layout: input and target layouts don't match")
 	ErrUnsupportedLayout  = errors.New("this method does not support the provided layout")
+	ErrNotImplemented     = errors.New("feature not implemented")
 )
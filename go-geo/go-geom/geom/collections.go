@@ -1,39 +1,349 @@
 package geom
 
+import (
+	"math"
+
+	"github.com/fredbi/go-geom/geom/codes"
+)
+
 type (
 	// Collection of geometries, which may be manipulated as a geometry itself.
 	//
 	// Collection is Sortable.
+	//
+	// Most operators on a collection are applied to each of its geometries and yield
+	// a collection.
 	Collection      []T
 	LineCollection  []Line
 	PointCollection []Point
 	RingCollection  []Ring
 
+	// LineStringCollection is a MultiLineString geometry
+	LineStringCollection []LineString
+
 	// PolygonCollection is a MultiPolygon geometry
 	PolygonCollection []Polygon
 )
 
-func (c Collection) Area() LineString { return nil }
-func (c Collection) Layout() Layout   { return XY }
-func (c Collection) IsEmpty() bool    { return len(c) == 0 }
-func (c Collection) Clone() T         { return nil }
+var (
+	_ T = Collection{}
+	_ T = LineCollection{}
+	_ T = PointCollection{}
+	_ T = RingCollection{}
+	_ T = LineStringCollection{}
+	_ T = PolygonCollection{}
+)
 
-// Round all coordinates that define T, defaults to 6 decimals
-func (c Collection) Round(...RoundingOption) {}
+// sridder is implemented by geometries which know about their SRID
+type sridder interface {
+	SRID() uint32
+}
 
-// Bounds returns the bounding box covering T
-func (c Collection) Bounds() *Bounds { return nil }
-func (c Collection) Equals(T) bool   { return false }
+// Layout yields the layout of the first geometry of the collection, NoLayout if the collection is empty
+func (c Collection) Layout() Layout {
+	if len(c) == 0 {
+		return NoLayout
+	}
+	return c[0].Layout()
+}
 
-// Centroid yields the centroid of any geometry
-func (c Collection) Centroid() Point {
+// SRID yields the SRID of the first geometry of the collection, 0 if it is not known
+func (c Collection) SRID() uint32 {
+	if len(c) == 0 {
+		return 0
+	}
+	if s, ok := c[0].(sridder); ok {
+		return s.SRID()
+	}
+	return 0
+}
+
+// IsEmpty tells if the collection holds no geometry, or only empty ones
+func (c Collection) IsEmpty() bool {
+	for _, g := range c {
+		if !g.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Collection) Clone() T {
+	return c.apply(func(g T) T { return g.Clone() })
+}
+
+// Equals another collection, holding equal geometries in the same order
+func (c Collection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(Collection)
+	if !ok || len(o) != len(c) {
+		return false
+	}
+	for i, g := range c {
+		if !g.Equals(o[i], opts...) {
+			return false
+		}
+	}
+	return true
+}
+
+// Round all coordinates that define the collection
+func (c Collection) Round(opts ...RoundingOption) {
+	for _, g := range c {
+		g.Round(opts...)
+	}
+}
+
+// Bounds returns the bounding box covering all geometries, nil if the collection is empty
+func (c Collection) Bounds() Bounds {
+	if len(c) == 0 {
+		return nil
+	}
+	b := c[0].Bounds()
+	for _, g := range c[1:] {
+		b = b.Extends(g)
+	}
+	return b
+}
+
+// FlatCoords yields the coordinates of all geometries
+func (c Collection) FlatCoords() [][]float64 {
+	var coords [][]float64
+	for _, g := range c {
+		coords = append(coords, g.FlatCoords()...)
+	}
+	return coords
+}
+
+// SetFlatCoords sets the coordinates of all geometries, which keep their number of coordinates
+func (c Collection) SetFlatCoords(coords [][]float64) error {
+	if len(coords) != len(c.FlatCoords()) {
+		return codes.ErrInconsistentLayout
+	}
+	for _, g := range c {
+		n := len(g.FlatCoords())
+		if err := g.SetFlatCoords(coords[:n]); err != nil {
+			return err
+		}
+		coords = coords[n:]
+	}
 	return nil
 }
 
-func (c Collection) SRID() int   { return 0 }
-func (c Collection) SetSRID(int) {}
+// Centroid yields the mean of all vertices of the collection, nil if the collection is empty
+func (c Collection) Centroid() Point {
+	vertices := c.Vertices()
+	if len(vertices) == 0 {
+		return nil
+	}
+	mean := make([]float64, len(vertices[0].Coords()))
+	for _, v := range vertices {
+		for i, x := range v.Coords() {
+			mean[i] += x
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(vertices))
+	}
+	return vertices[0].Clone().(Point).WithCoords(mean, func(error) {})
+}
+
+func (c Collection) Vertices() []Point {
+	var vertices []Point
+	for _, g := range c {
+		vertices = append(vertices, g.Vertices()...)
+	}
+	return vertices
+}
+
+func (c Collection) Edges() []Line {
+	var edges []Line
+	for _, g := range c {
+		edges = append(edges, g.Edges()...)
+	}
+	return edges
+}
+
+// Sort the geometries of the collection
+func (c Collection) Sort(strats ...SortStrategy) {
+	for _, s := range strats {
+		s.sortMany(c...)
+	}
+}
+
+func (c Collection) Clusterize(s ClusteringStrategy) T { return s.clusterize(c) }
+
+func (c Collection) Area() float64       { return c.sum(func(g T) float64 { return g.Area() }) }
+func (c Collection) SignedArea() float64 { return c.sum(func(g T) float64 { return g.SignedArea() }) }
+func (c Collection) Length() float64     { return c.sum(func(g T) float64 { return g.Length() }) }
+func (c Collection) Volume() float64     { return c.sum(func(g T) float64 { return g.Volume() }) }
+func (c Collection) SignedVolume() float64 {
+	return c.sum(func(g T) float64 { return g.SignedVolume() })
+}
+
+// DistanceTo yields the shortest distance from the geometries of the collection
+func (c Collection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	d := math.Inf(1)
+	for _, g := range c {
+		d = math.Min(d, g.DistanceTo(other, strats...))
+	}
+	return d
+}
+
+// Angle is not defined for a collection
+func (c Collection) Angle(T) float64 { panic(codes.ErrNotImplemented) }
+
+func (c Collection) ProjectOn(s ProjectionStrategy) T {
+	return c.apply(func(g T) T { return g.ProjectOn(s) })
+}
+
+// ConvexHull is not supported by collections
+func (c Collection) ConvexHull() T { panic(codes.ErrNotImplemented) }
+
+func (c Collection) Simplify(strats ...SimplificationStrategy) T {
+	return c.apply(func(g T) T { return g.Simplify(strats...) })
+}
+
+func (c Collection) Clip(other T) T     { return c.apply(func(g T) T { return g.Clip(other) }) }
+func (c Collection) Buffer(d float64) T { return c.apply(func(g T) T { return g.Buffer(d) }) }
+func (c Collection) Rotate(a float64) T { return c.apply(func(g T) T { return g.Rotate(a) }) }
+func (c Collection) Translate(v Line) T { return c.apply(func(g T) T { return g.Translate(v) }) }
+func (c Collection) Scale(f float64) T  { return c.apply(func(g T) T { return g.Scale(f) }) }
+func (c Collection) Affine(a, f float64, v Line) T {
+	return c.apply(func(g T) T { return g.Affine(a, f, v) })
+}
+
+func (c Collection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return c.apply(func(g T) T { return g.Symmetrical(other, strats...) })
+}
+
+// Tesselate all geometries of the collection
+func (c Collection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	var tiles PolygonCollection
+	for _, g := range c {
+		tiles = append(tiles, g.Tesselate(t, opts...)...)
+	}
+	return tiles
+}
+
+func (c Collection) Interior() T { return c.apply(func(g T) T { return g.Interior() }) }
+func (c Collection) Border() T   { return c.apply(func(g T) T { return g.Border() }) }
+
+// Intersects tells if any geometry of the collection intersects another geometry
+func (c Collection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.any(func(g T) bool { return g.Intersects(other, opts...) })
+}
+
+// PointClosestTo yields the closest point to another geometry, among the geometries of the collection
+func (c Collection) PointClosestTo(other T) Point {
+	var closest Point
+	d := math.Inf(1)
+	for _, g := range c {
+		p := g.PointClosestTo(other)
+		if p == nil {
+			continue
+		}
+		if dp := p.DistanceTo(other); dp < d {
+			closest, d = p, dp
+		}
+	}
+	return closest
+}
+
+// ShortestLineTo yields the shortest line to another geometry, among the geometries of the collection
+func (c Collection) ShortestLineTo(other T) Line {
+	var shortest Line
+	d := math.Inf(1)
+	for _, g := range c {
+		l := g.ShortestLineTo(other)
+		if l == nil {
+			continue
+		}
+		if dl := l.Length(); dl < d {
+			shortest, d = l, dl
+		}
+	}
+	return shortest
+}
+
+// IsInside tells if all geometries of the collection are inside another geometry
+func (c Collection) IsInside(other T, opts ...TopologyOption) bool {
+	return !c.any(func(g T) bool { return !g.IsInside(other, opts...) })
+}
+
+// IsOutside tells if all geometries of the collection are outside another geometry
+func (c Collection) IsOutside(other T, opts ...TopologyOption) bool {
+	return !c.any(func(g T) bool { return !g.IsOutside(other, opts...) })
+}
 
-func (pc PointCollection) LineString() LineString   { return nil }
-func (pc PointCollection) Vertices() []Point        { return nil }
-func (pc PointCollection) Edges() LineString        { return nil }
-func (pc PointCollection) AsLineString() LineString { return nil }
+// IsOn tells if another geometry lies on the border of any geometry of the collection
+func (c Collection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.any(func(g T) bool { return g.IsOn(other, opts...) })
+}
+
+func (c Collection) Intersection(other T, opts ...TopologyOption) T {
+	return c.apply(func(g T) T { return g.Intersection(other, opts...) })
+}
+
+func (c Collection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return c.apply(func(g T) T { return g.IntersectionWith(others, opts...) })
+}
+
+// Union of the collection with another geometry, which is added to the collection (not simplified)
+func (c Collection) Union(other T, _ ...TopologyOption) T {
+	return append(c.Clone().(Collection), other)
+}
+
+// UnionWith adds other geometries to the collection (not simplified)
+func (c Collection) UnionWith(others []T, _ ...TopologyOption) T {
+	return append(c.Clone().(Collection), others...)
+}
+
+// Features yields the features of each geometry of the collection, as a []interface{}
+func (c Collection) Features() interface{} {
+	features := make([]interface{}, len(c))
+	for i, g := range c {
+		features[i] = g.Features()
+	}
+	return features
+}
+
+// SetFeatures sets the features of each geometry of the collection.
+//
+// When given a []interface{} of the length of the collection, each geometry gets its own features.
+// Otherwise, all geometries get the same features.
+func (c Collection) SetFeatures(features interface{}) {
+	if all, ok := features.([]interface{}); ok && len(all) == len(c) {
+		for i, g := range c {
+			g.SetFeatures(all[i])
+		}
+		return
+	}
+	for _, g := range c {
+		g.SetFeatures(features)
+	}
+}
+
+func (c Collection) apply(op func(T) T) Collection {
+	result := make(Collection, len(c))
+	for i, g := range c {
+		result[i] = op(g)
+	}
+	return result
+}
+
+func (c Collection) sum(measure func(T) float64) float64 {
+	var s float64
+	for _, g := range c {
+		s += measure(g)
+	}
+	return s
+}
+
+func (c Collection) any(predicate func(T) bool) bool {
+	for _, g := range c {
+		if predicate(g) {
+			return true
+		}
+	}
+	return false
+}
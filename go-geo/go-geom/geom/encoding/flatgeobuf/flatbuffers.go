@@ -0,0 +1,422 @@
+package flatgeobuf
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// This file implements the minimal subset of the FlatBuffers binary format needed by FlatGeobuf:
+// size-prefixed root tables, scalar fields, strings, vectors of scalars and vectors of tables.
+//
+// Unlike the reference builders, objects are laid out front to back: a table is preceded by
+// its vtable and followed by the objects it refers to. All offsets and alignments are
+// computed relative to the start of the size prefix.
+
+// fbObject is an object to serialize: *fbTable, fbVector, fbString or fbTables.
+type fbObject interface{}
+
+// fbField is a field of a table, either an inline scalar or a reference to another object.
+type fbField struct {
+	id     int
+	scalar []byte
+	ref    fbObject
+}
+
+func (f fbField) size() int {
+	if f.ref != nil {
+		return 4
+	}
+	return len(f.scalar)
+}
+
+// fbTable is a table under construction.
+type fbTable struct {
+	fields []fbField
+}
+
+func (t *fbTable) addUint8(id int, v uint8) {
+	t.fields = append(t.fields, fbField{id: id, scalar: []byte{v}})
+}
+
+func (t *fbTable) addBool(id int, v bool) {
+	if v {
+		t.addUint8(id, 1)
+		return
+	}
+	t.addUint8(id, 0)
+}
+
+func (t *fbTable) addUint16(id int, v uint16) {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, v)
+	t.fields = append(t.fields, fbField{id: id, scalar: b})
+}
+
+func (t *fbTable) addInt32(id int, v int32) {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	t.fields = append(t.fields, fbField{id: id, scalar: b})
+}
+
+func (t *fbTable) addUint64(id int, v uint64) {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	t.fields = append(t.fields, fbField{id: id, scalar: b})
+}
+
+func (t *fbTable) addRef(id int, obj fbObject) {
+	t.fields = append(t.fields, fbField{id: id, ref: obj})
+}
+
+func (t *fbTable) addString(id int, s string) {
+	if s != "" {
+		t.addRef(id, fbString(s))
+	}
+}
+
+// fbVector is a vector of scalars, already encoded in little endian.
+type fbVector struct {
+	elemSize int
+	data     []byte
+}
+
+func float64Vector(values []float64) fbVector {
+	data := make([]byte, 8*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(v))
+	}
+	return fbVector{elemSize: 8, data: data}
+}
+
+func uint32Vector(values []uint32) fbVector {
+	data := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(data[4*i:], v)
+	}
+	return fbVector{elemSize: 4, data: data}
+}
+
+func bytesVector(values []byte) fbVector {
+	return fbVector{elemSize: 1, data: values}
+}
+
+// fbString is a string.
+type fbString string
+
+// fbTables is a vector of tables.
+type fbTables []*fbTable
+
+// fbBuilder serializes a root table into a size-prefixed buffer.
+type fbBuilder struct {
+	buf []byte
+}
+
+// finishSizePrefixed yields the size-prefixed buffer of a root table.
+func finishSizePrefixed(root *fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 8, 256)}
+	pos := b.write(root)
+	binary.LittleEndian.PutUint32(b.buf[4:], uint32(pos-4))
+	binary.LittleEndian.PutUint32(b.buf[0:], uint32(len(b.buf)-4))
+	return b.buf
+}
+
+func (b *fbBuilder) pad(align int) {
+	for len(b.buf)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+// padBefore pads the buffer so that the position after the next n bytes is aligned.
+func (b *fbBuilder) padBefore(n, align int) {
+	for (len(b.buf)+n)%align != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+func (b *fbBuilder) patchOffset(at, target int) {
+	binary.LittleEndian.PutUint32(b.buf[at:], uint32(target-at))
+}
+
+// write serializes an object and yields its position.
+func (b *fbBuilder) write(obj fbObject) int {
+	switch o := obj.(type) {
+	case *fbTable:
+		return b.writeTable(o)
+	case fbVector:
+		align := o.elemSize
+		if align < 4 {
+			align = 4
+		}
+		b.padBefore(4, align)
+		pos := len(b.buf)
+		b.buf = appendUint32(b.buf, uint32(len(o.data)/o.elemSize))
+		b.buf = append(b.buf, o.data...)
+		return pos
+	case fbString:
+		b.pad(4)
+		pos := len(b.buf)
+		b.buf = appendUint32(b.buf, uint32(len(o)))
+		b.buf = append(b.buf, o...)
+		b.buf = append(b.buf, 0)
+		return pos
+	case fbTables:
+		b.pad(4)
+		pos := len(b.buf)
+		b.buf = appendUint32(b.buf, uint32(len(o)))
+		at := len(b.buf)
+		b.buf = append(b.buf, make([]byte, 4*len(o))...)
+		for i, table := range o {
+			b.patchOffset(at+4*i, b.writeTable(table))
+		}
+		return pos
+	default:
+		panic("flatgeobuf: unsupported flatbuffers object")
+	}
+}
+
+func (b *fbBuilder) writeTable(t *fbTable) int {
+	// fields are laid out by decreasing size, so that they are naturally aligned
+	fields := make([]fbField, len(t.fields))
+	copy(fields, t.fields)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].size() > fields[j].size() })
+
+	numSlots := 0
+	for _, f := range fields {
+		if f.id+1 > numSlots {
+			numSlots = f.id + 1
+		}
+	}
+
+	b.pad(2)
+	vtablePos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4+2*numSlots)...)
+
+	// the table starts with the offset to its vtable: 8-byte fields come right after
+	b.pad(8)
+	tablePos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 4)...)
+	if len(fields) > 0 && fields[0].size() == 8 {
+		b.buf = append(b.buf, make([]byte, 4)...)
+	}
+
+	refs := make(map[int]fbObject)
+	for _, f := range fields {
+		fieldPos := len(b.buf)
+		binary.LittleEndian.PutUint16(b.buf[vtablePos+4+2*f.id:], uint16(fieldPos-tablePos))
+		if f.ref != nil {
+			refs[fieldPos] = f.ref
+			b.buf = append(b.buf, make([]byte, 4)...)
+			continue
+		}
+		b.buf = append(b.buf, f.scalar...)
+	}
+
+	binary.LittleEndian.PutUint16(b.buf[vtablePos:], uint16(4+2*numSlots))
+	binary.LittleEndian.PutUint16(b.buf[vtablePos+2:], uint16(len(b.buf)-tablePos))
+	binary.LittleEndian.PutUint32(b.buf[tablePos:], uint32(int32(tablePos-vtablePos)))
+
+	positions := make([]int, 0, len(refs))
+	for fieldPos := range refs {
+		positions = append(positions, fieldPos)
+	}
+	sort.Ints(positions)
+	for _, fieldPos := range positions {
+		b.patchOffset(fieldPos, b.write(refs[fieldPos]))
+	}
+	return tablePos
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+// errMalformed is raised by table readers on out of bounds accesses, and recovered by decoders.
+type errMalformed struct{}
+
+// fbTableReader reads the fields of a table.
+type fbTableReader struct {
+	buf     []byte
+	pos     int
+	vtable  int
+	vtLen   int
+	tableSz int
+}
+
+func (b fbTableReader) check(pos, n int) {
+	if pos < 0 || n < 0 || pos+n > len(b.buf) || pos+n < pos {
+		panic(errMalformed{})
+	}
+}
+
+func (b fbTableReader) uint16At(pos int) int {
+	b.check(pos, 2)
+	return int(binary.LittleEndian.Uint16(b.buf[pos:]))
+}
+
+func (b fbTableReader) uint32At(pos int) uint32 {
+	b.check(pos, 4)
+	return binary.LittleEndian.Uint32(b.buf[pos:])
+}
+
+// rootTable reads the root table of a size-prefixed buffer.
+func rootTable(buf []byte) fbTableReader {
+	r := fbTableReader{buf: buf}
+	return r.tableAt(4 + int(r.uint32At(4)))
+}
+
+func (b fbTableReader) tableAt(pos int) fbTableReader {
+	vtable := pos - int(int32(b.uint32At(pos)))
+	t := fbTableReader{buf: b.buf, pos: pos, vtable: vtable}
+	t.vtLen = t.uint16At(vtable)
+	t.tableSz = t.uint16At(vtable + 2)
+	t.check(vtable, t.vtLen)
+	t.check(pos, t.tableSz)
+	return t
+}
+
+// field yields the position of a field, or 0 when the field is absent.
+func (b fbTableReader) field(id int) int {
+	slot := 4 + 2*id
+	if slot+2 > b.vtLen {
+		return 0
+	}
+	offset := b.uint16At(b.vtable + slot)
+	if offset == 0 {
+		return 0
+	}
+	return b.pos + offset
+}
+
+func (b fbTableReader) uint8(id int, def uint8) uint8 {
+	pos := b.field(id)
+	if pos == 0 {
+		return def
+	}
+	b.check(pos, 1)
+	return b.buf[pos]
+}
+
+func (b fbTableReader) bool(id int, def bool) bool {
+	var d uint8
+	if def {
+		d = 1
+	}
+	return b.uint8(id, d) != 0
+}
+
+func (b fbTableReader) uint16(id int, def uint16) uint16 {
+	pos := b.field(id)
+	if pos == 0 {
+		return def
+	}
+	return uint16(b.uint16At(pos))
+}
+
+func (b fbTableReader) int32(id int, def int32) int32 {
+	pos := b.field(id)
+	if pos == 0 {
+		return def
+	}
+	return int32(b.uint32At(pos))
+}
+
+func (b fbTableReader) uint64(id int, def uint64) uint64 {
+	pos := b.field(id)
+	if pos == 0 {
+		return def
+	}
+	b.check(pos, 8)
+	return binary.LittleEndian.Uint64(b.buf[pos:])
+}
+
+// deref follows the offset stored in a field.
+func (b fbTableReader) deref(id int) int {
+	pos := b.field(id)
+	if pos == 0 {
+		return 0
+	}
+	return pos + int(b.uint32At(pos))
+}
+
+// vector yields the position of the elements of a vector, and its length.
+func (b fbTableReader) vector(id, elemSize int) (int, int) {
+	pos := b.deref(id)
+	if pos == 0 {
+		return 0, 0
+	}
+	n := int(b.uint32At(pos))
+	b.check(pos+4, n*elemSize)
+	return pos + 4, n
+}
+
+func (b fbTableReader) string(id int) string {
+	pos, n := b.vector(id, 1)
+	if pos == 0 {
+		return ""
+	}
+	return string(b.buf[pos : pos+n])
+}
+
+func (b fbTableReader) bytes(id int) []byte {
+	pos, n := b.vector(id, 1)
+	if pos == 0 {
+		return nil
+	}
+	return b.buf[pos : pos+n]
+}
+
+func (b fbTableReader) float64s(id int) []float64 {
+	pos, n := b.vector(id, 8)
+	if pos == 0 {
+		return nil
+	}
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.LittleEndian.Uint64(b.buf[pos+8*i:]))
+	}
+	return values
+}
+
+func (b fbTableReader) uint32s(id int) []uint32 {
+	pos, n := b.vector(id, 4)
+	if pos == 0 {
+		return nil
+	}
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(b.buf[pos+4*i:])
+	}
+	return values
+}
+
+func (b fbTableReader) table(id int) (fbTableReader, bool) {
+	pos := b.deref(id)
+	if pos == 0 {
+		return fbTableReader{}, false
+	}
+	return b.tableAt(pos), true
+}
+
+func (b fbTableReader) tables(id int) []fbTableReader {
+	pos, n := b.vector(id, 4)
+	if pos == 0 {
+		return nil
+	}
+	tables := make([]fbTableReader, n)
+	for i := range tables {
+		at := pos + 4*i
+		tables[i] = b.tableAt(at + int(b.uint32At(at)))
+	}
+	return tables
+}
+
+// recoverMalformed converts an out of bounds access into an error.
+func recoverMalformed(err *error, what string) {
+	if r := recover(); r != nil {
+		if _, ok := r.(errMalformed); !ok {
+			panic(r)
+		}
+		*err = ErrMalformed(what)
+	}
+}
@@ -0,0 +1,286 @@
+// Package flatgeobuf implements FlatGeobuf encoding and decoding.
+//
+// A FlatGeobuf file is made of a header describing the dataset, an optional packed Hilbert
+// R-tree indexing the bounding boxes of the features, and the features themselves.
+//
+// The Reader streams all the features of a file. The IndexedReader uses the spatial index to
+// only read the features overlapping a bounding box, with random accesses through an
+// io.ReaderAt: a local file or a remote one read with HTTP range requests (see HTTPReaderAt).
+//
+// This package works with github.com/twpayne/go-geom geometries, and features are
+// github.com/fredbi/go-geom/geom/encoding/geojson/twpayne features, so they may be fed
+// directly to a vector tile layer.
+//
+// See https://flatgeobuf.org for the specification.
+package flatgeobuf
+
+import (
+	"fmt"
+)
+
+// magicBytes start a FlatGeobuf file: the fourth byte is the major version of the specification.
+var magicBytes = []byte{'f', 'g', 'b', 3, 'f', 'g', 'b', 0}
+
+const (
+	// DefaultIndexNodeSize is the default number of children of the nodes of the spatial index.
+	DefaultIndexNodeSize = 16
+
+	// maxHeaderSize guards against corrupted header sizes.
+	maxHeaderSize = 10 * 1024 * 1024
+
+	// maxFeatureSize guards against corrupted feature sizes.
+	maxFeatureSize = 1024 * 1024 * 1024
+)
+
+// ErrNotFlatGeobuf is returned when the input does not start with the FlatGeobuf magic bytes.
+type ErrNotFlatGeobuf []byte
+
+func (e ErrNotFlatGeobuf) Error() string {
+	return fmt.Sprintf("flatgeobuf: invalid magic bytes %x", []byte(e))
+}
+
+// ErrMalformed is returned when some part of a file cannot be decoded.
+type ErrMalformed string
+
+func (e ErrMalformed) Error() string {
+	return fmt.Sprintf("flatgeobuf: malformed %s", string(e))
+}
+
+// ErrUnsupportedGeometryType is returned for geometry types that cannot be encoded or decoded.
+type ErrUnsupportedGeometryType string
+
+func (e ErrUnsupportedGeometryType) Error() string {
+	return fmt.Sprintf("flatgeobuf: unsupported geometry type: %s", string(e))
+}
+
+// GeometryType is the type of the geometries of a dataset or of a feature.
+type GeometryType uint8
+
+// Geometry types supported by this package.
+//
+// Curves, surfaces, TINs and triangles are part of the specification but not supported.
+const (
+	Unknown GeometryType = iota
+	Point
+	LineString
+	Polygon
+	MultiPoint
+	MultiLineString
+	MultiPolygon
+	GeometryCollection
+)
+
+var geometryTypeNames = map[GeometryType]string{
+	Unknown:            "Unknown",
+	Point:              "Point",
+	LineString:         "LineString",
+	Polygon:            "Polygon",
+	MultiPoint:         "MultiPoint",
+	MultiLineString:    "MultiLineString",
+	MultiPolygon:       "MultiPolygon",
+	GeometryCollection: "GeometryCollection",
+}
+
+func (t GeometryType) String() string {
+	if name, ok := geometryTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("GeometryType(%d)", uint8(t))
+}
+
+// ColumnType is the type of the values of a column.
+type ColumnType uint8
+
+// Column types.
+const (
+	ColumnByte ColumnType = iota
+	ColumnUByte
+	ColumnBool
+	ColumnShort
+	ColumnUShort
+	ColumnInt
+	ColumnUInt
+	ColumnLong
+	ColumnULong
+	ColumnFloat
+	ColumnDouble
+	ColumnString
+	ColumnJSON
+	ColumnDateTime
+	ColumnBinary
+)
+
+// Header describes a dataset.
+type Header struct {
+	Name string
+	// Envelope is the bounding box of the dataset: min x, min y, max x, max y.
+	Envelope     []float64
+	GeometryType GeometryType
+	HasZ         bool
+	HasM         bool
+	Columns      []*Column
+	// FeaturesCount is the number of features, or 0 when unknown.
+	FeaturesCount uint64
+	// IndexNodeSize is the number of children of the nodes of the spatial index, or 0 without index.
+	IndexNodeSize uint16
+	CRS           *CRS
+	Title         string
+	Description   string
+	Metadata      string
+}
+
+// Column describes the properties of the features.
+type Column struct {
+	Name        string
+	Type        ColumnType
+	Title       string
+	Description string
+	Nullable    bool
+	Metadata    string
+}
+
+// CRS describes the coordinate reference system of a dataset.
+type CRS struct {
+	// Org is the authority of the code, usually EPSG.
+	Org         string
+	Code        int32
+	Name        string
+	Description string
+	WKT         string
+	CodeString  string
+}
+
+// Field ids of the header tables.
+const (
+	headerName = iota
+	headerEnvelope
+	headerGeometryType
+	headerHasZ
+	headerHasM
+	headerHasT
+	headerHasTM
+	headerColumns
+	headerFeaturesCount
+	headerIndexNodeSize
+	headerCRS
+	headerTitle
+	headerDescription
+	headerMetadata
+)
+
+const (
+	columnName = iota
+	columnType
+	columnTitle
+	columnDescription
+	columnWidth
+	columnPrecision
+	columnScale
+	columnNullable
+	columnUnique
+	columnPrimaryKey
+	columnMetadata
+)
+
+const (
+	crsOrg = iota
+	crsCode
+	crsName
+	crsDescription
+	crsWKT
+	crsCodeString
+)
+
+// hasIndex tells if the features are preceded by a spatial index.
+func (h *Header) hasIndex() bool {
+	return h.IndexNodeSize > 0 && h.FeaturesCount > 0
+}
+
+func (h *Header) encode() []byte {
+	t := &fbTable{}
+	t.addString(headerName, h.Name)
+	if len(h.Envelope) > 0 {
+		t.addRef(headerEnvelope, float64Vector(h.Envelope))
+	}
+	t.addUint8(headerGeometryType, uint8(h.GeometryType))
+	t.addBool(headerHasZ, h.HasZ)
+	t.addBool(headerHasM, h.HasM)
+	if len(h.Columns) > 0 {
+		columns := make(fbTables, len(h.Columns))
+		for i, c := range h.Columns {
+			columns[i] = c.encode()
+		}
+		t.addRef(headerColumns, columns)
+	}
+	t.addUint64(headerFeaturesCount, h.FeaturesCount)
+	t.addUint16(headerIndexNodeSize, h.IndexNodeSize)
+	if h.CRS != nil {
+		t.addRef(headerCRS, h.CRS.encode())
+	}
+	t.addString(headerTitle, h.Title)
+	t.addString(headerDescription, h.Description)
+	t.addString(headerMetadata, h.Metadata)
+	return finishSizePrefixed(t)
+}
+
+func (c *Column) encode() *fbTable {
+	t := &fbTable{}
+	t.addRef(columnName, fbString(c.Name))
+	t.addUint8(columnType, uint8(c.Type))
+	t.addString(columnTitle, c.Title)
+	t.addString(columnDescription, c.Description)
+	t.addBool(columnNullable, c.Nullable)
+	t.addString(columnMetadata, c.Metadata)
+	return t
+}
+
+func (c *CRS) encode() *fbTable {
+	t := &fbTable{}
+	t.addString(crsOrg, c.Org)
+	t.addInt32(crsCode, c.Code)
+	t.addString(crsName, c.Name)
+	t.addString(crsDescription, c.Description)
+	t.addString(crsWKT, c.WKT)
+	t.addString(crsCodeString, c.CodeString)
+	return t
+}
+
+// decodeHeader decodes a size-prefixed header.
+func decodeHeader(buf []byte) (h *Header, err error) {
+	defer recoverMalformed(&err, "header")
+
+	t := rootTable(buf)
+	h = &Header{
+		Name:          t.string(headerName),
+		Envelope:      t.float64s(headerEnvelope),
+		GeometryType:  GeometryType(t.uint8(headerGeometryType, 0)),
+		HasZ:          t.bool(headerHasZ, false),
+		HasM:          t.bool(headerHasM, false),
+		FeaturesCount: t.uint64(headerFeaturesCount, 0),
+		IndexNodeSize: t.uint16(headerIndexNodeSize, DefaultIndexNodeSize),
+		Title:         t.string(headerTitle),
+		Description:   t.string(headerDescription),
+		Metadata:      t.string(headerMetadata),
+	}
+	for _, ct := range t.tables(headerColumns) {
+		h.Columns = append(h.Columns, &Column{
+			Name:        ct.string(columnName),
+			Type:        ColumnType(ct.uint8(columnType, 0)),
+			Title:       ct.string(columnTitle),
+			Description: ct.string(columnDescription),
+			Nullable:    ct.bool(columnNullable, true),
+			Metadata:    ct.string(columnMetadata),
+		})
+	}
+	if ct, ok := t.table(headerCRS); ok {
+		h.CRS = &CRS{
+			Org:         ct.string(crsOrg),
+			Code:        ct.int32(crsCode, 0),
+			Name:        ct.string(crsName),
+			Description: ct.string(crsDescription),
+			WKT:         ct.string(crsWKT),
+			CodeString:  ct.string(crsCodeString),
+		}
+	}
+	return h, nil
+}
@@ -0,0 +1,238 @@
+package flatgeobuf
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	geojson "github.com/fredbi/go-geom/geom/encoding/geojson/twpayne"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func writeFeatures(t *testing.T, features []*geojson.Feature, opts ...WriterOption) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, opts...)
+	for _, f := range features {
+		require.NoError(t, w.Write(f))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func readFeatures(t *testing.T, data []byte) (*Header, []*geojson.Feature) {
+	r, err := NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	var features []*geojson.Feature
+	for {
+		f, err := r.Read()
+		if err == io.EOF {
+			return r.Header(), features
+		}
+		require.NoError(t, err)
+		features = append(features, f)
+	}
+}
+
+func TestRoundTripGeometries(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		g    geom.T
+		typ  GeometryType
+	}{
+		{
+			name: "point",
+			g:    geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			typ:  Point,
+		},
+		{
+			name: "point xyzm",
+			g:    geom.NewPointFlat(geom.XYZM, []float64{1, 2, 3, 4}),
+			typ:  Point,
+		},
+		{
+			name: "linestring xyz",
+			g:    geom.NewLineStringFlat(geom.XYZ, []float64{1, 2, 3, 4, 5, 6}),
+			typ:  LineString,
+		},
+		{
+			name: "polygon with a hole",
+			g: geom.NewPolygonFlat(geom.XY, []float64{
+				0, 0, 10, 0, 10, 10, 0, 10, 0, 0,
+				2, 2, 2, 4, 4, 4, 4, 2, 2, 2,
+			}, []int{10, 20}),
+			typ: Polygon,
+		},
+		{
+			name: "multipoint",
+			g:    geom.NewMultiPointFlat(geom.XY, []float64{1, 2, 3, 4}),
+			typ:  MultiPoint,
+		},
+		{
+			name: "multilinestring",
+			g:    geom.NewMultiLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []int{4, 10}),
+			typ:  MultiLineString,
+		},
+		{
+			name: "multipolygon",
+			g: geom.NewMultiPolygonFlat(geom.XYM, []float64{
+				0, 0, 1, 1, 0, 1, 1, 1, 1, 0, 0, 1,
+				5, 5, 2, 6, 5, 2, 6, 6, 2, 5, 5, 2,
+			}, [][]int{{12}, {24}}),
+			typ: MultiPolygon,
+		},
+		{
+			name: "geometry collection",
+			g: geom.NewGeometryCollection().MustPush(
+				geom.NewPointFlat(geom.XY, []float64{1, 2}),
+				geom.NewLineStringFlat(geom.XY, []float64{3, 4, 5, 6}),
+			),
+			typ: GeometryCollection,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := writeFeatures(t, []*geojson.Feature{{Geometry: tc.g}})
+			header, features := readFeatures(t, data)
+
+			assert.Equal(t, tc.typ, header.GeometryType)
+			assert.Equal(t, uint64(1), header.FeaturesCount)
+			require.Len(t, features, 1)
+			assert.Equal(t, tc.g, features[0].Geometry)
+		})
+	}
+}
+
+func TestRoundTripMixedTypes(t *testing.T) {
+	input := []*geojson.Feature{
+		{Geometry: geom.NewPointFlat(geom.XY, []float64{1, 2})},
+		{Geometry: nil},
+		{Geometry: geom.NewLineStringFlat(geom.XYZ, []float64{3, 4, 5, 6, 7, 8})},
+	}
+	header, features := readFeatures(t, writeFeatures(t, input, WithIndexNodeSize(0)))
+
+	assert.Equal(t, Unknown, header.GeometryType)
+	assert.True(t, header.HasZ)
+	assert.Equal(t, []float64{1, 2, 6, 7}, header.Envelope)
+	require.Len(t, features, 3)
+	// the dataset layout applies to all geometries
+	assert.Equal(t, geom.NewPointFlat(geom.XYZ, []float64{1, 2, 0}), features[0].Geometry)
+	assert.Nil(t, features[1].Geometry)
+	assert.Equal(t, input[2].Geometry, features[2].Geometry)
+}
+
+func TestRoundTripProperties(t *testing.T) {
+	input := []*geojson.Feature{
+		{
+			Geometry: geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			Properties: map[string]interface{}{
+				"name":   "a",
+				"count":  int64(42),
+				"ratio":  0.5,
+				"valid":  true,
+				"small":  int16(-3),
+				"tags":   []interface{}{"x", "y"},
+				"blob":   []byte{1, 2, 3},
+				"absent": nil,
+			},
+		},
+		{
+			Geometry: geom.NewPointFlat(geom.XY, []float64{3, 4}),
+			Properties: map[string]interface{}{
+				"name":  "b",
+				"extra": float32(1.5),
+			},
+		},
+	}
+	header, features := readFeatures(t, writeFeatures(t, input))
+
+	names := make([]string, len(header.Columns))
+	for i, c := range header.Columns {
+		names[i] = c.Name
+	}
+	assert.Equal(t, []string{"blob", "count", "name", "ratio", "small", "tags", "valid", "extra"}, names)
+	assert.Equal(t, ColumnJSON, header.Columns[5].Type)
+
+	require.Len(t, features, 2)
+	byName := make(map[string]map[string]interface{})
+	for _, f := range features {
+		byName[f.Properties["name"].(string)] = f.Properties
+	}
+	assert.Equal(t, map[string]interface{}{
+		"name":  "a",
+		"count": int64(42),
+		"ratio": 0.5,
+		"valid": true,
+		"small": int16(-3),
+		"tags":  []interface{}{"x", "y"},
+		"blob":  []byte{1, 2, 3},
+	}, byName["a"])
+	assert.Equal(t, map[string]interface{}{"name": "b", "extra": float32(1.5)}, byName["b"])
+}
+
+func TestDeclaredColumns(t *testing.T) {
+	input := []*geojson.Feature{{
+		Geometry:   geom.NewPointFlat(geom.XY, []float64{1, 2}),
+		Properties: map[string]interface{}{"id": 7.0, "ignored": "x"},
+	}}
+	_, features := readFeatures(t, writeFeatures(t, input, WithColumns(&Column{Name: "id", Type: ColumnInt})))
+	require.Len(t, features, 1)
+	assert.Equal(t, map[string]interface{}{"id": int32(7)}, features[0].Properties)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, WithColumns(&Column{Name: "id", Type: ColumnInt}))
+	require.NoError(t, w.Write(&geojson.Feature{Properties: map[string]interface{}{"id": "seven"}}))
+	assert.IsType(t, ErrPropertyType{}, w.Close())
+}
+
+func TestHeader(t *testing.T) {
+	data := writeFeatures(t, nil,
+		WithName("places"),
+		WithTitle("Places"),
+		WithDescription("Some places"),
+		WithCRS(&CRS{Org: "EPSG", Code: 4326}),
+	)
+	assert.Equal(t, magicBytes, data[:len(magicBytes)])
+
+	header, features := readFeatures(t, data)
+	assert.Empty(t, features)
+	assert.Equal(t, &Header{
+		Name:        "places",
+		Title:       "Places",
+		Description: "Some places",
+		CRS:         &CRS{Org: "EPSG", Code: 4326},
+	}, header)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("not a flatgeobuf file")))
+	assert.IsType(t, ErrNotFlatGeobuf(nil), err)
+
+	data := writeFeatures(t, []*geojson.Feature{{Geometry: geom.NewPointFlat(geom.XY, []float64{1, 2})}})
+	for _, size := range []int{len(data) - 1, len(magicBytes) + 6} {
+		r, err := NewReader(bytes.NewReader(data[:size]))
+		if err != nil {
+			continue
+		}
+		_, err = r.Read()
+		assert.Error(t, err)
+	}
+
+	// corrupted offsets are reported, not panicking
+	corrupted := append([]byte(nil), data...)
+	for i := len(corrupted) - 40; i < len(corrupted); i++ {
+		corrupted[i] = 0xff
+	}
+	r, err := NewReader(bytes.NewReader(corrupted))
+	require.NoError(t, err)
+	_, err = r.Read()
+	assert.Error(t, err)
+}
+
+func TestWriterClosed(t *testing.T) {
+	w := NewWriter(ioutil.Discard)
+	require.NoError(t, w.Close())
+	assert.Equal(t, ErrClosed, w.Write(&geojson.Feature{}))
+	assert.Equal(t, ErrClosed, w.Close())
+}
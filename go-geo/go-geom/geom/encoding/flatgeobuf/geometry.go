@@ -0,0 +1,242 @@
+package flatgeobuf
+
+import (
+	"fmt"
+
+	"github.com/twpayne/go-geom"
+)
+
+// Field ids of the geometry table.
+const (
+	geometryEnds = iota
+	geometryXY
+	geometryZ
+	geometryM
+	geometryT
+	geometryTM
+	geometryType
+	geometryParts
+)
+
+// layoutOf yields the layout of the geometries of a dataset.
+func layoutOf(hasZ, hasM bool) geom.Layout {
+	switch {
+	case hasZ && hasM:
+		return geom.XYZM
+	case hasZ:
+		return geom.XYZ
+	case hasM:
+		return geom.XYM
+	default:
+		return geom.XY
+	}
+}
+
+// geometryTypeOf yields the FlatGeobuf type of a geometry.
+func geometryTypeOf(g geom.T) (GeometryType, error) {
+	switch g.(type) {
+	case *geom.Point:
+		return Point, nil
+	case *geom.LineString:
+		return LineString, nil
+	case *geom.Polygon:
+		return Polygon, nil
+	case *geom.MultiPoint:
+		return MultiPoint, nil
+	case *geom.MultiLineString:
+		return MultiLineString, nil
+	case *geom.MultiPolygon:
+		return MultiPolygon, nil
+	case *geom.GeometryCollection:
+		return GeometryCollection, nil
+	default:
+		return Unknown, ErrUnsupportedGeometryType(fmt.Sprintf("%T", g))
+	}
+}
+
+// encodeGeometry encodes a geometry with the layout of the dataset.
+//
+// Missing ordinates are encoded as zeros, extra ordinates are dropped.
+func encodeGeometry(g geom.T, layout geom.Layout) (*fbTable, error) {
+	typ, err := geometryTypeOf(g)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &fbTable{}
+	t.addUint8(geometryType, uint8(typ))
+
+	switch g := g.(type) {
+	case *geom.MultiPolygon:
+		parts := make(fbTables, g.NumPolygons())
+		for i := range parts {
+			if parts[i], err = encodeGeometry(g.Polygon(i), layout); err != nil {
+				return nil, err
+			}
+		}
+		t.addRef(geometryParts, parts)
+		return t, nil
+	case *geom.GeometryCollection:
+		parts := make(fbTables, g.NumGeoms())
+		for i := range parts {
+			if parts[i], err = encodeGeometry(g.Geom(i), layout); err != nil {
+				return nil, err
+			}
+		}
+		t.addRef(geometryParts, parts)
+		return t, nil
+	}
+
+	stride := g.Stride()
+	if stride == 0 {
+		return t, nil
+	}
+	flatCoords := g.FlatCoords()
+	if typ == Point && g.Empty() {
+		return t, nil
+	}
+
+	n := len(flatCoords) / stride
+	xy := make([]float64, 0, 2*n)
+	for i := 0; i < n; i++ {
+		xy = append(xy, flatCoords[i*stride], flatCoords[i*stride+1])
+	}
+	t.addRef(geometryXY, float64Vector(xy))
+	if layout.ZIndex() >= 0 {
+		t.addRef(geometryZ, float64Vector(ordinates(flatCoords, stride, g.Layout().ZIndex())))
+	}
+	if layout.MIndex() >= 0 {
+		t.addRef(geometryM, float64Vector(ordinates(flatCoords, stride, g.Layout().MIndex())))
+	}
+
+	if typ == Polygon || typ == MultiLineString {
+		if ends := g.Ends(); len(ends) > 1 {
+			fgbEnds := make([]uint32, len(ends))
+			for i, end := range ends {
+				fgbEnds[i] = uint32(end / stride)
+			}
+			t.addRef(geometryEnds, uint32Vector(fgbEnds))
+		}
+	}
+	return t, nil
+}
+
+// ordinates extracts the ordinates at some index, or zeros when the index is missing.
+func ordinates(flatCoords []float64, stride, index int) []float64 {
+	values := make([]float64, len(flatCoords)/stride)
+	if index < 0 {
+		return values
+	}
+	for i := range values {
+		values[i] = flatCoords[i*stride+index]
+	}
+	return values
+}
+
+// decodeGeometry decodes a geometry table.
+//
+// The type of the geometry is the type of the dataset, unless the dataset has mixed types.
+func decodeGeometry(t fbTableReader, typ GeometryType, layout geom.Layout) (geom.T, error) {
+	if typ == Unknown || typ == GeometryCollection {
+		typ = GeometryType(t.uint8(geometryType, uint8(typ)))
+	}
+
+	switch typ {
+	case MultiPolygon:
+		mp := geom.NewMultiPolygon(layout)
+		for _, part := range t.tables(geometryParts) {
+			g, err := decodeGeometry(part, Polygon, layout)
+			if err != nil {
+				return nil, err
+			}
+			if err := mp.Push(g.(*geom.Polygon)); err != nil {
+				return nil, err
+			}
+		}
+		return mp, nil
+	case GeometryCollection:
+		gc := geom.NewGeometryCollection()
+		for _, part := range t.tables(geometryParts) {
+			g, err := decodeGeometry(part, Unknown, layout)
+			if err != nil {
+				return nil, err
+			}
+			if err := gc.Push(g); err != nil {
+				return nil, err
+			}
+		}
+		return gc, nil
+	}
+
+	xy := t.float64s(geometryXY)
+	z := t.float64s(geometryZ)
+	m := t.float64s(geometryM)
+	n := len(xy) / 2
+	stride := layout.Stride()
+	zIndex, mIndex := layout.ZIndex(), layout.MIndex()
+	if (zIndex >= 0 && len(z) != n) || (mIndex >= 0 && len(m) != n) {
+		return nil, ErrMalformed("geometry ordinates")
+	}
+
+	flatCoords := make([]float64, n*stride)
+	for i := 0; i < n; i++ {
+		flatCoords[i*stride] = xy[2*i]
+		flatCoords[i*stride+1] = xy[2*i+1]
+		if zIndex >= 0 {
+			flatCoords[i*stride+zIndex] = z[i]
+		}
+		if mIndex >= 0 {
+			flatCoords[i*stride+mIndex] = m[i]
+		}
+	}
+
+	ends, err := decodeEnds(t.uint32s(geometryEnds), n, stride)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case Point:
+		if n == 0 {
+			return geom.NewPointEmpty(layout), nil
+		}
+		if n != 1 {
+			return nil, ErrMalformed("point")
+		}
+		return geom.NewPointFlat(layout, flatCoords), nil
+	case LineString:
+		return geom.NewLineStringFlat(layout, flatCoords), nil
+	case Polygon:
+		return geom.NewPolygonFlat(layout, flatCoords, ends), nil
+	case MultiPoint:
+		return geom.NewMultiPointFlat(layout, flatCoords), nil
+	case MultiLineString:
+		return geom.NewMultiLineStringFlat(layout, flatCoords, ends), nil
+	default:
+		return nil, ErrUnsupportedGeometryType(typ.String())
+	}
+}
+
+// decodeEnds converts the ends of the parts of a geometry, counted in positions,
+// to ends of flat coordinates. Without ends, a geometry has a single part.
+func decodeEnds(fgbEnds []uint32, n, stride int) ([]int, error) {
+	if len(fgbEnds) == 0 {
+		if n == 0 {
+			return nil, nil
+		}
+		return []int{n * stride}, nil
+	}
+	ends := make([]int, len(fgbEnds))
+	previous := 0
+	for i, end := range fgbEnds {
+		if int(end) < previous || int(end) > n {
+			return nil, ErrMalformed("geometry ends")
+		}
+		previous = int(end)
+		ends[i] = int(end) * stride
+	}
+	if previous != n {
+		return nil, ErrMalformed("geometry ends")
+	}
+	return ends, nil
+}
@@ -0,0 +1,67 @@
+package flatgeobuf
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrRangeNotSatisfied is returned when a server does not honor an HTTP range request.
+type ErrRangeNotSatisfied struct {
+	URL    string
+	Status int
+}
+
+func (e ErrRangeNotSatisfied) Error() string {
+	return fmt.Sprintf("flatgeobuf: range request to %s failed with status %d", e.URL, e.Status)
+}
+
+// HTTPReaderAt reads a remote file with HTTP range requests.
+//
+// It is meant to be used with an IndexedReader, to search a FlatGeobuf file served over HTTP
+// without downloading it.
+type HTTPReaderAt struct {
+	client *http.Client
+	url    string
+}
+
+// NewHTTPReaderAt yields a reader of the file at some URL.
+// A nil client stands for http.DefaultClient.
+func NewHTTPReaderAt(client *http.Client, url string) *HTTPReaderAt {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPReaderAt{client: client, url: url}
+}
+
+// ReadAt reads len(p) bytes at some offset with a single range request.
+func (h *HTTPReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	req, err := http.NewRequest(http.MethodGet, h.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		return 0, io.EOF
+	default:
+		return 0, ErrRangeNotSatisfied{URL: h.url, Status: resp.StatusCode}
+	}
+
+	n, err := io.ReadFull(resp.Body, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
@@ -0,0 +1,272 @@
+package flatgeobuf
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+)
+
+// nodeItemSize is the size of a node of the spatial index: a bounding box and an offset.
+const nodeItemSize = 40
+
+// nodeItem is a node of the packed Hilbert R-tree.
+//
+// The offset of a leaf is the position of its feature in the features section, the offset
+// of other nodes is the index of their first child.
+type nodeItem struct {
+	minX, minY, maxX, maxY float64
+	offset                 uint64
+}
+
+func emptyNodeItem() nodeItem {
+	return nodeItem{
+		minX: math.Inf(1), minY: math.Inf(1),
+		maxX: math.Inf(-1), maxY: math.Inf(-1),
+	}
+}
+
+func (n *nodeItem) expand(other nodeItem) {
+	n.minX = math.Min(n.minX, other.minX)
+	n.minY = math.Min(n.minY, other.minY)
+	n.maxX = math.Max(n.maxX, other.maxX)
+	n.maxY = math.Max(n.maxY, other.maxY)
+}
+
+func (n nodeItem) intersects(other nodeItem) bool {
+	return n.maxX >= other.minX && n.maxY >= other.minY && n.minX <= other.maxX && n.minY <= other.maxY
+}
+
+func (n nodeItem) width() float64 {
+	return n.maxX - n.minX
+}
+
+func (n nodeItem) height() float64 {
+	return n.maxY - n.minY
+}
+
+func (n nodeItem) appendTo(buf []byte) []byte {
+	var b [nodeItemSize]byte
+	binary.LittleEndian.PutUint64(b[0:], math.Float64bits(n.minX))
+	binary.LittleEndian.PutUint64(b[8:], math.Float64bits(n.minY))
+	binary.LittleEndian.PutUint64(b[16:], math.Float64bits(n.maxX))
+	binary.LittleEndian.PutUint64(b[24:], math.Float64bits(n.maxY))
+	binary.LittleEndian.PutUint64(b[32:], n.offset)
+	return append(buf, b[:]...)
+}
+
+func readNodeItem(b []byte) nodeItem {
+	return nodeItem{
+		minX:   math.Float64frombits(binary.LittleEndian.Uint64(b[0:])),
+		minY:   math.Float64frombits(binary.LittleEndian.Uint64(b[8:])),
+		maxX:   math.Float64frombits(binary.LittleEndian.Uint64(b[16:])),
+		maxY:   math.Float64frombits(binary.LittleEndian.Uint64(b[24:])),
+		offset: binary.LittleEndian.Uint64(b[32:]),
+	}
+}
+
+// levelBounds yields the ranges of node indices of each level of the tree, leaves first.
+//
+// Levels are stored root first, so the leaves are the last nodes of the index.
+func levelBounds(numItems uint64, nodeSize uint16) [][2]uint64 {
+	if nodeSize < 2 {
+		nodeSize = 2
+	}
+	n := numItems
+	numNodes := n
+	levelNumNodes := []uint64{n}
+	for n > 1 {
+		n = (n + uint64(nodeSize) - 1) / uint64(nodeSize)
+		numNodes += n
+		levelNumNodes = append(levelNumNodes, n)
+	}
+
+	bounds := make([][2]uint64, len(levelNumNodes))
+	n = numNodes
+	for i, size := range levelNumNodes {
+		bounds[i] = [2]uint64{n - size, n}
+		n -= size
+	}
+	return bounds
+}
+
+// indexSize yields the size in bytes of the index of some features.
+func indexSize(numItems uint64, nodeSize uint16) int64 {
+	if numItems == 0 || nodeSize == 0 {
+		return 0
+	}
+	bounds := levelBounds(numItems, nodeSize)
+	return int64(bounds[0][1]) * nodeItemSize
+}
+
+// buildIndex builds the index of some leaves, sorted in Hilbert order.
+func buildIndex(leaves []nodeItem, nodeSize uint16) []byte {
+	bounds := levelBounds(uint64(len(leaves)), nodeSize)
+	numNodes := bounds[0][1]
+	nodes := make([]nodeItem, numNodes)
+	copy(nodes[bounds[0][0]:], leaves)
+
+	for i := 0; i < len(bounds)-1; i++ {
+		pos, end := bounds[i][0], bounds[i][1]
+		parent := bounds[i+1][0]
+		for pos < end {
+			node := emptyNodeItem()
+			node.offset = pos
+			for j := uint16(0); j < nodeSize && pos < end; j++ {
+				node.expand(nodes[pos])
+				pos++
+			}
+			nodes[parent] = node
+			parent++
+		}
+	}
+
+	buf := make([]byte, 0, len(nodes)*nodeItemSize)
+	for _, node := range nodes {
+		buf = node.appendTo(buf)
+	}
+	return buf
+}
+
+// hilbertSort sorts leaves by the Hilbert value of the center of their bounding box,
+// within the extent of all the leaves. It yields the permutation applied.
+func hilbertSort(leaves []nodeItem, extent nodeItem) []int {
+	values := make([]uint32, len(leaves))
+	order := make([]int, len(leaves))
+	const max = 1<<16 - 1
+	for i, leaf := range leaves {
+		order[i] = i
+		if leaf.minX > leaf.maxX {
+			// features without geometry come last
+			continue
+		}
+		var x, y uint32
+		if w := extent.width(); w > 0 {
+			x = uint32(max * ((leaf.minX+leaf.maxX)/2 - extent.minX) / w)
+		}
+		if h := extent.height(); h > 0 {
+			y = uint32(max * ((leaf.minY+leaf.maxY)/2 - extent.minY) / h)
+		}
+		values[i] = hilbert(x, y)
+	}
+	// like the reference implementations, features are sorted by decreasing Hilbert value
+	sort.SliceStable(order, func(i, j int) bool { return values[order[i]] > values[order[j]] })
+
+	sorted := make([]nodeItem, len(leaves))
+	for i, k := range order {
+		sorted[i] = leaves[k]
+	}
+	copy(leaves, sorted)
+	return order
+}
+
+// hilbert yields the position of (x, y) along a Hilbert curve filling a 2^16 x 2^16 square.
+//
+// See https://github.com/rawrunprotected/hilbert_curves (public domain).
+func hilbert(x, y uint32) uint32 {
+	a := x ^ y
+	b := 0xFFFF ^ a
+	c := 0xFFFF ^ (x | y)
+	d := x & (y ^ 0xFFFF)
+
+	A := a | (b >> 1)
+	B := (a >> 1) ^ a
+	C := ((c >> 1) ^ (b & (d >> 1))) ^ c
+	D := ((a & (c >> 1)) ^ (d >> 1)) ^ d
+
+	a, b, c, d = A, B, C, D
+	A = (a & (a >> 2)) ^ (b & (b >> 2))
+	B = (a & (b >> 2)) ^ (b & ((a ^ b) >> 2))
+	C ^= (a & (c >> 2)) ^ (b & (d >> 2))
+	D ^= (b & (c >> 2)) ^ ((a ^ b) & (d >> 2))
+
+	a, b, c, d = A, B, C, D
+	A = (a & (a >> 4)) ^ (b & (b >> 4))
+	B = (a & (b >> 4)) ^ (b & ((a ^ b) >> 4))
+	C ^= (a & (c >> 4)) ^ (b & (d >> 4))
+	D ^= (b & (c >> 4)) ^ ((a ^ b) & (d >> 4))
+
+	a, b, c, d = A, B, C, D
+	C ^= (a & (c >> 8)) ^ (b & (d >> 8))
+	D ^= (b & (c >> 8)) ^ ((a ^ b) & (d >> 8))
+
+	a = C ^ (C >> 1)
+	b = D ^ (D >> 1)
+
+	i0 := x ^ y
+	i1 := b | (0xFFFF ^ (i0 | a))
+
+	return (interleave(i1) << 1) | interleave(i0)
+}
+
+// interleave spreads the 16 lower bits of x over the even bits.
+func interleave(x uint32) uint32 {
+	x = (x | (x << 8)) & 0x00FF00FF
+	x = (x | (x << 4)) & 0x0F0F0F0F
+	x = (x | (x << 2)) & 0x33333333
+	x = (x | (x << 1)) & 0x55555555
+	return x
+}
+
+// maxGapNodes is the number of unwanted nodes that may be read between two wanted nodes,
+// rather than issuing a separate read.
+const maxGapNodes = 256
+
+// searchIndex yields the offsets of the features whose bounding box intersects a box,
+// reading the nodes of an index located at some position of r.
+//
+// Nodes are read one level at a time, by runs of nearby nodes, so that searching a remote
+// index costs a few range requests.
+func searchIndex(r io.ReaderAt, indexOffset int64, numItems uint64, nodeSize uint16, box nodeItem) ([]uint64, error) {
+	bounds := levelBounds(numItems, nodeSize)
+	var offsets []uint64
+
+	current := []uint64{0}
+	for level := len(bounds) - 1; len(current) > 0; level-- {
+		childrenEnd := func(index uint64) uint64 {
+			end := index + uint64(nodeSize)
+			if end > bounds[level][1] {
+				end = bounds[level][1]
+			}
+			return end
+		}
+
+		var next []uint64
+		for i := 0; i < len(current); {
+			start, end := current[i], childrenEnd(current[i])
+			j := i + 1
+			for ; j < len(current) && current[j] <= end+maxGapNodes; j++ {
+				if e := childrenEnd(current[j]); e > end {
+					end = e
+				}
+			}
+
+			buf := make([]byte, (end-start)*nodeItemSize)
+			if err := readFullAt(r, buf, indexOffset+int64(start)*nodeItemSize); err != nil {
+				return nil, err
+			}
+
+			for _, index := range current[i:j] {
+				for pos := index; pos < childrenEnd(index); pos++ {
+					node := readNodeItem(buf[(pos-start)*nodeItemSize:])
+					if !box.intersects(node) {
+						continue
+					}
+					if level == 0 {
+						offsets = append(offsets, node.offset)
+						continue
+					}
+					if node.offset < bounds[level-1][0] || node.offset >= bounds[level-1][1] {
+						return nil, ErrMalformed("index")
+					}
+					next = append(next, node.offset)
+				}
+			}
+			i = j
+		}
+		current = next
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets, nil
+}
@@ -0,0 +1,148 @@
+package flatgeobuf
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	geojson "github.com/fredbi/go-geom/geom/encoding/geojson/twpayne"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestLevelBounds(t *testing.T) {
+	assert.Equal(t, [][2]uint64{{8, 108}, {1, 8}, {0, 1}}, levelBounds(100, 16))
+	assert.Equal(t, [][2]uint64{{0, 1}}, levelBounds(1, 16))
+	assert.Equal(t, int64(108*nodeItemSize), indexSize(100, 16))
+}
+
+func TestHilbert(t *testing.T) {
+	// the 16 first positions of the curve fill the 4x4 cells at the origin, moving to an adjacent cell each time
+	cells := make(map[uint32][2]int)
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 4; y++ {
+			cells[hilbert(uint32(x), uint32(y))] = [2]int{x, y}
+		}
+	}
+	for i := uint32(1); i < 16; i++ {
+		previous, ok := cells[i-1]
+		require.True(t, ok)
+		current, ok := cells[i]
+		require.True(t, ok)
+		dx, dy := current[0]-previous[0], current[1]-previous[1]
+		assert.Equal(t, 1, dx*dx+dy*dy)
+	}
+}
+
+func randomFeatures(n int) []*geojson.Feature {
+	rnd := rand.New(rand.NewSource(1))
+	features := make([]*geojson.Feature, n)
+	for i := range features {
+		x, y := rnd.Float64()*360-180, rnd.Float64()*180-90
+		var g geom.T = geom.NewPointFlat(geom.XY, []float64{x, y})
+		if i%3 == 0 {
+			g = geom.NewLineStringFlat(geom.XY, []float64{x, y, x + rnd.Float64(), y + rnd.Float64()})
+		}
+		features[i] = &geojson.Feature{
+			Geometry:   g,
+			Properties: map[string]interface{}{"i": int64(i)},
+		}
+	}
+	return features
+}
+
+func bruteForce(features []*geojson.Feature, bounds *geom.Bounds) []int64 {
+	var ids []int64
+	for _, f := range features {
+		if f.Geometry.Bounds().Overlaps(geom.XY, bounds) {
+			ids = append(ids, f.Properties["i"].(int64))
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func idsOf(features []*geojson.Feature) []int64 {
+	var ids []int64
+	for _, f := range features {
+		ids = append(ids, f.Properties["i"].(int64))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	input := randomFeatures(1000)
+	queries := []*geom.Bounds{
+		geom.NewBounds(geom.XY).Set(-10, -10, 10, 10),
+		geom.NewBounds(geom.XY).Set(100, 20, 180, 90),
+		geom.NewBounds(geom.XY).Set(-180, -90, 180, 90),
+		geom.NewBounds(geom.XY).Set(500, 500, 600, 600),
+	}
+
+	for _, nodeSize := range []uint16{0, 2, 16} {
+		data := writeFeatures(t, input, WithIndexNodeSize(nodeSize))
+		r, err := NewIndexedReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, nodeSize, r.Header().IndexNodeSize)
+
+		for _, bounds := range queries {
+			t.Run(fmt.Sprintf("node size %d, %v-%v", nodeSize, bounds.Min(0), bounds.Max(0)), func(t *testing.T) {
+				features, err := r.Search(bounds)
+				require.NoError(t, err)
+				assert.Equal(t, bruteForce(input, bounds), idsOf(features))
+			})
+		}
+	}
+}
+
+func TestStreamIndexedFile(t *testing.T) {
+	input := randomFeatures(100)
+	_, features := readFeatures(t, writeFeatures(t, input))
+	assert.Equal(t, idsOf(input), idsOf(features))
+}
+
+func TestSearchOverHTTP(t *testing.T) {
+	input := randomFeatures(10000)
+	data := writeFeatures(t, input)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.ServeContent(w, r, "data.fgb", time.Time{}, bytes.NewReader(data))
+	}))
+	defer server.Close()
+
+	r, err := NewIndexedReader(NewHTTPReaderAt(server.Client(), server.URL))
+	require.NoError(t, err)
+
+	bounds := geom.NewBounds(geom.XY).Set(10, 10, 12, 12)
+	features, err := r.Search(bounds)
+	require.NoError(t, err)
+	assert.NotEmpty(t, features)
+	assert.Equal(t, bruteForce(input, bounds), idsOf(features))
+	// header, a few index levels and a few runs of features
+	assert.Less(t, int(atomic.LoadInt32(&requests)), 20)
+}
+
+func TestSearchFuncStops(t *testing.T) {
+	data := writeFeatures(t, randomFeatures(100))
+	r, err := NewIndexedReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	stop := fmt.Errorf("stop")
+	n := 0
+	err = r.SearchFunc(geom.NewBounds(geom.XY).Set(-180, -90, 180, 90), func(*geojson.Feature) error {
+		n++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,319 @@
+package flatgeobuf
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// ErrPropertyType is returned when a property cannot be encoded with the type of its column.
+type ErrPropertyType struct {
+	Column string
+	Type   ColumnType
+	Value  interface{}
+}
+
+func (e ErrPropertyType) Error() string {
+	return fmt.Sprintf("flatgeobuf: cannot encode %T as column %q of type %d", e.Value, e.Column, e.Type)
+}
+
+// columnTypeOf infers the column type of a property value.
+func columnTypeOf(v interface{}) (ColumnType, bool) {
+	switch v.(type) {
+	case bool:
+		return ColumnBool, true
+	case int8:
+		return ColumnByte, true
+	case uint8:
+		return ColumnUByte, true
+	case int16:
+		return ColumnShort, true
+	case uint16:
+		return ColumnUShort, true
+	case int32:
+		return ColumnInt, true
+	case uint32:
+		return ColumnUInt, true
+	case int, int64:
+		return ColumnLong, true
+	case uint, uint64:
+		return ColumnULong, true
+	case float32:
+		return ColumnFloat, true
+	case float64:
+		return ColumnDouble, true
+	case string:
+		return ColumnString, true
+	case time.Time:
+		return ColumnDateTime, true
+	case []byte:
+		return ColumnBinary, true
+	case json.RawMessage:
+		return ColumnJSON, true
+	case nil:
+		return 0, false
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return ColumnJSON, true
+	default:
+		return 0, false
+	}
+}
+
+// encodeProperties encodes the properties of a feature, in the order of the columns.
+//
+// Properties without a column are ignored.
+func encodeProperties(properties map[string]interface{}, columns []*Column) ([]byte, error) {
+	var buf []byte
+	for i, c := range columns {
+		v, ok := properties[c.Name]
+		if !ok || v == nil {
+			continue
+		}
+		buf = append(buf, byte(i), byte(i>>8))
+		var err error
+		if buf, err = appendValue(buf, c, v); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func appendValue(buf []byte, c *Column, v interface{}) ([]byte, error) {
+	fail := ErrPropertyType{Column: c.Name, Type: c.Type, Value: v}
+
+	switch c.Type {
+	case ColumnBool:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fail
+		}
+		if b {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case ColumnByte, ColumnShort, ColumnInt, ColumnLong:
+		i, ok := toInt64(v)
+		if !ok {
+			return nil, fail
+		}
+		switch c.Type {
+		case ColumnByte:
+			return append(buf, byte(int8(i))), nil
+		case ColumnShort:
+			return appendUint(buf, uint64(int16(i)), 2), nil
+		case ColumnInt:
+			return appendUint(buf, uint64(int32(i)), 4), nil
+		default:
+			return appendUint(buf, uint64(i), 8), nil
+		}
+	case ColumnUByte, ColumnUShort, ColumnUInt, ColumnULong:
+		i, ok := toInt64(v)
+		if !ok {
+			u, isUint := v.(uint64)
+			if !isUint {
+				return nil, fail
+			}
+			i = int64(u)
+		}
+		switch c.Type {
+		case ColumnUByte:
+			return append(buf, byte(i)), nil
+		case ColumnUShort:
+			return appendUint(buf, uint64(i), 2), nil
+		case ColumnUInt:
+			return appendUint(buf, uint64(i), 4), nil
+		default:
+			return appendUint(buf, uint64(i), 8), nil
+		}
+	case ColumnFloat, ColumnDouble:
+		f, ok := toFloat64(v)
+		if !ok {
+			return nil, fail
+		}
+		if c.Type == ColumnFloat {
+			return appendUint(buf, uint64(math.Float32bits(float32(f))), 4), nil
+		}
+		return appendUint(buf, math.Float64bits(f), 8), nil
+	case ColumnString:
+		s, ok := v.(string)
+		if !ok {
+			s = fmt.Sprint(v)
+		}
+		return appendBytes(buf, []byte(s)), nil
+	case ColumnDateTime:
+		switch t := v.(type) {
+		case time.Time:
+			return appendBytes(buf, []byte(t.Format(time.RFC3339Nano))), nil
+		case string:
+			return appendBytes(buf, []byte(t)), nil
+		default:
+			return nil, fail
+		}
+	case ColumnBinary:
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, fail
+		}
+		return appendBytes(buf, b), nil
+	case ColumnJSON:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(buf, data), nil
+	default:
+		return nil, fail
+	}
+}
+
+func appendUint(buf []byte, v uint64, size int) []byte {
+	for i := 0; i < size; i++ {
+		buf = append(buf, byte(v>>(8*i)))
+	}
+	return buf
+}
+
+func appendBytes(buf, data []byte) []byte {
+	return append(appendUint32(buf, uint32(len(data))), data...)
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint:
+		return int64(n), true
+	case uint8:
+		return int64(n), true
+	case uint16:
+		return int64(n), true
+	case uint32:
+		return int64(n), true
+	case float64:
+		if n == math.Trunc(n) {
+			return int64(n), true
+		}
+	case float32:
+		if n == float32(math.Trunc(float64(n))) {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	i, ok := toInt64(v)
+	return float64(i), ok
+}
+
+// decodeProperties decodes the properties of a feature.
+//
+// Integers and floats are decoded with the Go type matching their column type, date-times
+// as strings, JSON values as generic JSON values.
+func decodeProperties(buf []byte, columns []*Column) (map[string]interface{}, error) {
+	properties := make(map[string]interface{}, len(columns))
+	malformed := ErrMalformed("properties")
+
+	for pos := 0; pos < len(buf); {
+		if pos+2 > len(buf) {
+			return nil, malformed
+		}
+		i := int(binary.LittleEndian.Uint16(buf[pos:]))
+		pos += 2
+		if i >= len(columns) {
+			return nil, malformed
+		}
+		c := columns[i]
+
+		size := columnSize(c.Type)
+		if size == 0 {
+			if pos+4 > len(buf) {
+				return nil, malformed
+			}
+			size = int(binary.LittleEndian.Uint32(buf[pos:]))
+			pos += 4
+		}
+		if size < 0 || pos+size > len(buf) {
+			return nil, malformed
+		}
+		data := buf[pos : pos+size]
+		pos += size
+
+		properties[c.Name] = decodeValue(c.Type, data)
+	}
+	return properties, nil
+}
+
+// columnSize yields the size of fixed-size values, or 0 for values prefixed by their size.
+func columnSize(t ColumnType) int {
+	switch t {
+	case ColumnByte, ColumnUByte, ColumnBool:
+		return 1
+	case ColumnShort, ColumnUShort:
+		return 2
+	case ColumnInt, ColumnUInt, ColumnFloat:
+		return 4
+	case ColumnLong, ColumnULong, ColumnDouble:
+		return 8
+	default:
+		return 0
+	}
+}
+
+func decodeValue(t ColumnType, data []byte) interface{} {
+	switch t {
+	case ColumnByte:
+		return int8(data[0])
+	case ColumnUByte:
+		return data[0]
+	case ColumnBool:
+		return data[0] != 0
+	case ColumnShort:
+		return int16(binary.LittleEndian.Uint16(data))
+	case ColumnUShort:
+		return binary.LittleEndian.Uint16(data)
+	case ColumnInt:
+		return int32(binary.LittleEndian.Uint32(data))
+	case ColumnUInt:
+		return binary.LittleEndian.Uint32(data)
+	case ColumnLong:
+		return int64(binary.LittleEndian.Uint64(data))
+	case ColumnULong:
+		return binary.LittleEndian.Uint64(data)
+	case ColumnFloat:
+		return math.Float32frombits(binary.LittleEndian.Uint32(data))
+	case ColumnDouble:
+		return math.Float64frombits(binary.LittleEndian.Uint64(data))
+	case ColumnJSON:
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return string(data)
+		}
+		return v
+	case ColumnBinary:
+		return append([]byte(nil), data...)
+	default:
+		return string(data)
+	}
+}
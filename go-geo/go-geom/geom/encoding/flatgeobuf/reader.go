@@ -0,0 +1,264 @@
+package flatgeobuf
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	geojson "github.com/fredbi/go-geom/geom/encoding/geojson/twpayne"
+	"github.com/twpayne/go-geom"
+)
+
+// maxGapBytes is the number of unwanted bytes that may be read between two wanted features,
+// rather than issuing a separate read.
+const maxGapBytes = 64 * 1024
+
+// readHeader reads the magic bytes and the header of a file.
+func readHeader(r io.Reader) (*Header, int64, error) {
+	prefix := make([]byte, len(magicBytes)+4)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, 0, err
+	}
+	// the last byte is the patch version of the specification
+	if !bytes.Equal(prefix[:len(magicBytes)-1], magicBytes[:len(magicBytes)-1]) {
+		return nil, 0, ErrNotFlatGeobuf(prefix[:len(magicBytes)])
+	}
+
+	size := binary.LittleEndian.Uint32(prefix[len(magicBytes):])
+	if size < 8 || size > maxHeaderSize {
+		return nil, 0, ErrMalformed("header size")
+	}
+	buf := make([]byte, 4+int(size))
+	copy(buf, prefix[len(magicBytes):])
+	if _, err := io.ReadFull(r, buf[4:]); err != nil {
+		return nil, 0, err
+	}
+
+	header, err := decodeHeader(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	return header, int64(len(prefix)) + int64(size), nil
+}
+
+// decodeFeature decodes a size-prefixed feature.
+func decodeFeature(buf []byte, header *Header, layout geom.Layout) (f *geojson.Feature, err error) {
+	defer recoverMalformed(&err, "feature")
+
+	t := rootTable(buf)
+	f = &geojson.Feature{}
+	if gt, ok := t.table(featureGeometry); ok {
+		if f.Geometry, err = decodeGeometry(gt, header.GeometryType, layout); err != nil {
+			return nil, err
+		}
+	}
+
+	columns := header.Columns
+	if len(t.tables(featureColumns)) > 0 {
+		columns = nil
+		for _, ct := range t.tables(featureColumns) {
+			columns = append(columns, &Column{Name: ct.string(columnName), Type: ColumnType(ct.uint8(columnType, 0))})
+		}
+	}
+	if properties := t.bytes(featureProperties); len(properties) > 0 {
+		if f.Properties, err = decodeProperties(properties, columns); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Reader reads all the features of a FlatGeobuf file, in the order of the file.
+type Reader struct {
+	r      *bufio.Reader
+	header *Header
+	layout geom.Layout
+}
+
+// NewReader reads the header of a file, and skips its spatial index.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header, _, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	if header.hasIndex() {
+		if _, err := io.CopyN(ioutil.Discard, br, indexSize(header.FeaturesCount, header.IndexNodeSize)); err != nil {
+			return nil, err
+		}
+	}
+	return &Reader{r: br, header: header, layout: layoutOf(header.HasZ, header.HasM)}, nil
+}
+
+// Header yields the header of the file.
+func (r *Reader) Header() *Header {
+	return r.header
+}
+
+// Read reads the next feature. It returns io.EOF after the last feature.
+func (r *Reader) Read() (*geojson.Feature, error) {
+	prefix := make([]byte, 4)
+	if _, err := io.ReadFull(r.r, prefix); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, ErrMalformed("feature size")
+		}
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(prefix)
+	if size > maxFeatureSize {
+		return nil, ErrMalformed("feature size")
+	}
+	buf := make([]byte, 4+int(size))
+	copy(buf, prefix)
+	if _, err := io.ReadFull(r.r, buf[4:]); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return decodeFeature(buf, r.header, r.layout)
+}
+
+// IndexedReader reads the features of a FlatGeobuf file overlapping a bounding box.
+//
+// The file is read with random accesses, e.g. from a local file or from a remote file
+// with HTTP range requests: only the header, the relevant nodes of the spatial index and
+// the matching features are read.
+type IndexedReader struct {
+	r              io.ReaderAt
+	header         *Header
+	layout         geom.Layout
+	indexOffset    int64
+	featuresOffset int64
+}
+
+// NewIndexedReader reads the header of a file.
+func NewIndexedReader(r io.ReaderAt) (*IndexedReader, error) {
+	header, size, err := readHeader(io.NewSectionReader(r, 0, maxHeaderSize+int64(len(magicBytes))+4))
+	if err != nil {
+		return nil, err
+	}
+	ir := &IndexedReader{
+		r:              r,
+		header:         header,
+		layout:         layoutOf(header.HasZ, header.HasM),
+		indexOffset:    size,
+		featuresOffset: size,
+	}
+	if header.hasIndex() {
+		ir.featuresOffset += indexSize(header.FeaturesCount, header.IndexNodeSize)
+	}
+	return ir, nil
+}
+
+// Header yields the header of the file.
+func (r *IndexedReader) Header() *Header {
+	return r.header
+}
+
+// Search yields the features whose bounding box overlaps some bounds, in the order of the file.
+func (r *IndexedReader) Search(bounds *geom.Bounds) ([]*geojson.Feature, error) {
+	var features []*geojson.Feature
+	err := r.SearchFunc(bounds, func(f *geojson.Feature) error {
+		features = append(features, f)
+		return nil
+	})
+	return features, err
+}
+
+// SearchFunc calls fn with each feature whose bounding box overlaps some bounds, in the order
+// of the file. It stops at the first error returned by fn.
+//
+// Without a spatial index, all the features are read and filtered.
+func (r *IndexedReader) SearchFunc(bounds *geom.Bounds, fn func(*geojson.Feature) error) error {
+	box := nodeItem{minX: bounds.Min(0), minY: bounds.Min(1), maxX: bounds.Max(0), maxY: bounds.Max(1)}
+
+	if !r.header.hasIndex() {
+		return r.scan(box, fn)
+	}
+
+	offsets, err := searchIndex(r.r, r.indexOffset, r.header.FeaturesCount, r.header.IndexNodeSize, box)
+	if err != nil {
+		return err
+	}
+
+	// nearby features are fetched with a single read, the size of the last one is found afterwards
+	for i := 0; i < len(offsets); {
+		j := i + 1
+		for j < len(offsets) && offsets[j]-offsets[j-1] <= maxGapBytes {
+			j++
+		}
+		start, last := offsets[i], offsets[j-1]
+		buf := make([]byte, last-start+4)
+		if err := readFullAt(r.r, buf, r.featuresOffset+int64(start)); err != nil {
+			return err
+		}
+		lastSize := binary.LittleEndian.Uint32(buf[last-start:])
+		if lastSize > maxFeatureSize {
+			return ErrMalformed("feature size")
+		}
+		buf = append(buf, make([]byte, lastSize)...)
+		if err := readFullAt(r.r, buf[last-start+4:], r.featuresOffset+int64(last)+4); err != nil {
+			return err
+		}
+
+		for _, offset := range offsets[i:j] {
+			at := offset - start
+			size := uint64(binary.LittleEndian.Uint32(buf[at:]))
+			if at+4+size > uint64(len(buf)) {
+				return ErrMalformed("feature size")
+			}
+			f, err := decodeFeature(buf[at:at+4+size], r.header, r.layout)
+			if err != nil {
+				return err
+			}
+			if err := fn(f); err != nil {
+				return err
+			}
+		}
+		i = j
+	}
+	return nil
+}
+
+// readFullAt reads exactly len(buf) bytes at some offset.
+func readFullAt(r io.ReaderAt, buf []byte, offset int64) error {
+	n, err := r.ReadAt(buf, offset)
+	if n == len(buf) {
+		return nil
+	}
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// scan reads all the features, and filters them by their bounding box.
+func (r *IndexedReader) scan(box nodeItem, fn func(*geojson.Feature) error) error {
+	reader := &Reader{
+		r:      bufio.NewReader(io.NewSectionReader(r.r, r.featuresOffset, 1<<62)),
+		header: r.header,
+		layout: r.layout,
+	}
+	for {
+		f, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if f.Geometry == nil {
+			continue
+		}
+		b := f.Geometry.Bounds()
+		if b.IsEmpty() || !box.intersects(nodeItem{minX: b.Min(0), minY: b.Min(1), maxX: b.Max(0), maxY: b.Max(1)}) {
+			continue
+		}
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+}
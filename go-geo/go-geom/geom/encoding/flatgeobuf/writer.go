@@ -0,0 +1,252 @@
+package flatgeobuf
+
+import (
+	"errors"
+	"io"
+	"sort"
+
+	geojson "github.com/fredbi/go-geom/geom/encoding/geojson/twpayne"
+	"github.com/twpayne/go-geom"
+)
+
+// ErrClosed is returned when writing to a closed Writer.
+var ErrClosed = errors.New("flatgeobuf: writer is closed")
+
+// WriterOption configures a Writer.
+type WriterOption func(*writerOptions)
+
+type writerOptions struct {
+	header  Header
+	columns []*Column
+}
+
+// WithName sets the name of the dataset.
+func WithName(name string) WriterOption {
+	return func(o *writerOptions) {
+		o.header.Name = name
+	}
+}
+
+// WithTitle sets the title of the dataset.
+func WithTitle(title string) WriterOption {
+	return func(o *writerOptions) {
+		o.header.Title = title
+	}
+}
+
+// WithDescription sets the description of the dataset.
+func WithDescription(description string) WriterOption {
+	return func(o *writerOptions) {
+		o.header.Description = description
+	}
+}
+
+// WithCRS sets the coordinate reference system of the dataset.
+func WithCRS(crs *CRS) WriterOption {
+	return func(o *writerOptions) {
+		o.header.CRS = crs
+	}
+}
+
+// WithIndexNodeSize sets the number of children of the nodes of the spatial index.
+// The default is 16. Zero disables the index.
+func WithIndexNodeSize(size uint16) WriterOption {
+	return func(o *writerOptions) {
+		if size == 1 {
+			size = 2
+		}
+		o.header.IndexNodeSize = size
+	}
+}
+
+// WithColumns declares the columns of the dataset.
+//
+// By default, columns are inferred from the properties of the features, by order of
+// appearance. With declared columns, properties without a column are ignored.
+func WithColumns(columns ...*Column) WriterOption {
+	return func(o *writerOptions) {
+		o.columns = columns
+	}
+}
+
+// Writer writes features to a FlatGeobuf file.
+//
+// The header and the spatial index describe all the features, so features are buffered
+// until the Writer is closed.
+type Writer struct {
+	w        io.Writer
+	o        writerOptions
+	features []*geojson.Feature
+	columns  []*Column
+	indices  map[string]int
+	infer    bool
+	closed   bool
+}
+
+// NewWriter yields a Writer to w.
+func NewWriter(w io.Writer, opts ...WriterOption) *Writer {
+	o := writerOptions{header: Header{IndexNodeSize: DefaultIndexNodeSize}}
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	fw := &Writer{
+		w:       w,
+		o:       o,
+		columns: o.columns,
+		indices: make(map[string]int, len(o.columns)),
+		infer:   o.columns == nil,
+	}
+	for i, c := range fw.columns {
+		fw.indices[c.Name] = i
+	}
+	return fw
+}
+
+// Write adds a feature.
+//
+// Features may have no geometry. Feature ids are not part of the FlatGeobuf format:
+// they are not written.
+func (w *Writer) Write(f *geojson.Feature) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if f.Geometry != nil {
+		if _, err := geometryTypeOf(f.Geometry); err != nil {
+			return err
+		}
+	}
+
+	if w.infer {
+		names := make([]string, 0, len(f.Properties))
+		for name := range f.Properties {
+			if _, known := w.indices[name]; !known {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			v := f.Properties[name]
+			if v == nil {
+				continue
+			}
+			typ, ok := columnTypeOf(v)
+			if !ok {
+				return ErrPropertyType{Column: name, Type: ColumnJSON, Value: v}
+			}
+			w.indices[name] = len(w.columns)
+			w.columns = append(w.columns, &Column{Name: name, Type: typ, Nullable: true})
+		}
+	}
+
+	w.features = append(w.features, f)
+	return nil
+}
+
+// Close writes the file. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	header := w.o.header
+	header.Columns = w.columns
+	header.FeaturesCount = uint64(len(w.features))
+
+	// the layout and the type of the geometries are those shared by all the features
+	extent := emptyNodeItem()
+	typed := false
+	leaves := make([]nodeItem, len(w.features))
+	for i, f := range w.features {
+		leaves[i] = emptyNodeItem()
+		if f.Geometry == nil {
+			continue
+		}
+		layout := f.Geometry.Layout()
+		header.HasZ = header.HasZ || layout.ZIndex() >= 0
+		header.HasM = header.HasM || layout.MIndex() >= 0
+		typ, _ := geometryTypeOf(f.Geometry)
+		if !typed {
+			header.GeometryType = typ
+			typed = true
+		} else if typ != header.GeometryType {
+			header.GeometryType = Unknown
+		}
+		if b := f.Geometry.Bounds(); !b.IsEmpty() {
+			leaves[i] = nodeItem{minX: b.Min(0), minY: b.Min(1), maxX: b.Max(0), maxY: b.Max(1)}
+			extent.expand(leaves[i])
+		}
+	}
+	if extent.minX <= extent.maxX {
+		header.Envelope = []float64{extent.minX, extent.minY, extent.maxX, extent.maxY}
+	}
+	if len(w.features) == 0 {
+		header.IndexNodeSize = 0
+	}
+
+	order := make([]int, len(w.features))
+	for i := range order {
+		order[i] = i
+	}
+	if header.hasIndex() {
+		order = hilbertSort(leaves, extent)
+	}
+
+	layout := layoutOf(header.HasZ, header.HasM)
+	features := make([][]byte, len(w.features))
+	var offset uint64
+	for i, k := range order {
+		data, err := encodeFeature(w.features[k], &header, layout)
+		if err != nil {
+			return err
+		}
+		features[i] = data
+		leaves[i].offset = offset
+		offset += uint64(len(data))
+	}
+
+	if _, err := w.w.Write(magicBytes); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(header.encode()); err != nil {
+		return err
+	}
+	if header.hasIndex() {
+		if _, err := w.w.Write(buildIndex(leaves, header.IndexNodeSize)); err != nil {
+			return err
+		}
+	}
+	for _, data := range features {
+		if _, err := w.w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Field ids of the feature table.
+const (
+	featureGeometry = iota
+	featureProperties
+	featureColumns
+)
+
+func encodeFeature(f *geojson.Feature, header *Header, layout geom.Layout) ([]byte, error) {
+	t := &fbTable{}
+	if f.Geometry != nil {
+		g, err := encodeGeometry(f.Geometry, layout)
+		if err != nil {
+			return nil, err
+		}
+		t.addRef(featureGeometry, g)
+	}
+	properties, err := encodeProperties(f.Properties, header.Columns)
+	if err != nil {
+		return nil, err
+	}
+	if len(properties) > 0 {
+		t.addRef(featureProperties, bytesVector(properties))
+	}
+	return finishSizePrefixed(t), nil
+}
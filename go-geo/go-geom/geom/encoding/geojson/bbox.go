@@ -3,21 +3,19 @@ package geojson
 import (
 	"fmt"
 
-	//"github.com/twpayne/go-geom"
 	"github.com/fredbi/go-geom/geom"
 	"github.com/fredbi/go-geom/geom/utils"
 )
 
 func NewBBox(args []float64) (*BBox, error) {
 	if len(args) == 0 {
-		return &BBox{utils.NewBounds(geom.NoLayout)}, nil
+		return &BBox{utils.NewBounds(nil, nil, geom.WithLayout(geom.NoLayout))}, nil
 	}
 	if len(args)&1 != 0 {
 		return nil, fmt.Errorf("geojson: bbox even number of arguments required: %d", len(args))
 	}
 
-	gg := utils.NewBounds(geom.Layout(len(args) / 2)).Set(args...)
-	return &BBox{gg}, nil
+	return newBBox(args)
 }
 
 // BBox is for the geojson bbox attribute which is an array with all axes
@@ -26,15 +24,21 @@ type BBox struct {
 	geom.Bounds
 }
 
+func newBBox(coords []float64) (*BBox, error) {
+	n := len(coords) / 2
+	layout, err := guessLayout0(coords[:n])
+	if err != nil {
+		return nil, err
+	}
+	return &BBox{utils.NewBounds(coords[:n], coords[n:], layoutOptions(layout)...)}, nil
+}
+
 func (bb BBox) MarshalJSON() ([]byte, error) {
-	b := bb.Bounds
-	var mins []float64
-	var maxs []float64
-	for i := 0; i < b.Layout().Stride(); i++ {
-		mins = append(mins, b.Min(i))
-		maxs = append(maxs, b.Max(i))
-	}
-	return json.Marshal(append(mins, maxs...))
+	corners := bb.Bounds.FlatCoords()
+	if len(corners) != 2 {
+		return json.Marshal([]float64{})
+	}
+	return json.Marshal(append(append([]float64{}, corners[0]...), corners[1]...))
 }
 
 func (bb *BBox) UnmarshalJSON(data []byte) error {
@@ -45,15 +49,22 @@ func (bb *BBox) UnmarshalJSON(data []byte) error {
 	if len(coords)&1 != 0 {
 		return fmt.Errorf("geojson: bbox even number of arguments required: %d", len(coords))
 	}
-	gg := utils.NewBounds(geom.Layout(len(coords) / 2)).Set(coords...)
-	*bb = BBox{gg}
+	gg, err := newBBox(coords)
+	if err != nil {
+		return err
+	}
+	*bb = *gg
 	return nil
 }
 
 func (bb *BBox) Center() geom.Point {
-	coords := make([]float64, bb.Layout().Stride())
-	for i := 0; i < bb.Layout().Stride(); i++ {
-		coords[i] = (bb.Min(i) + bb.Max(i)) / 2.0
+	corners := bb.FlatCoords()
+	if len(corners) != 2 {
+		return utils.NewPoint(geom.WithLayout(bb.Layout()))
+	}
+	coords := make([]float64, len(corners[0]))
+	for i := range coords {
+		coords[i] = (corners[0][i] + corners[1][i]) / 2.0
 	}
-	return utils.NewPoint(bb.Layout(), coords)
+	return utils.NewPoint(layoutOptions(bb.Layout())...).WithCoords(coords, func(error) {})
 }
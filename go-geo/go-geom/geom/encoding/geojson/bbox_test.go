@@ -3,14 +3,14 @@ package geojson
 import (
 	"testing"
 
-	"github.com/twpayne/go-geom"
-
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
 	"github.com/stretchr/testify/assert"
 )
 
 func mkBBox(args []float64) *BBox {
-	gg := geom.NewBounds(geom.Layout(len(args) / 2)).Set(args...)
-	return &BBox{gg}
+	n := len(args) / 2
+	return &BBox{mustBounds(args[:n], args[n:])}
 }
 
 func TestBBoxValid(t *testing.T) {
@@ -35,7 +35,7 @@ func TestBBoxValid(t *testing.T) {
 		},
 		{
 			name:    "false for nil box",
-			success: &BBox{geom.NewBounds(geom.NoLayout)},
+			success: &BBox{utils.NewBounds(nil, nil, geom.WithLayout(geom.NoLayout))},
 			valid:   true,
 		},
 		{
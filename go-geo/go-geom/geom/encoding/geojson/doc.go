@@ -1,5 +1,8 @@
 // Package geojson encodes and decodes [GeoJSON](http://geojson.org/) into Go structs
-// using the geometries in the [go-geom](https://github.com/twpayne/go-geom) package.
+// using the geometries of the geom package, built with the utils factories.
+//
+// The original encoding for the [go-geom](https://github.com/twpayne/go-geom) geometries is
+// still available in the twpayne sub-package.
 // Supports both the [json.Marshaler](http://golang.org/pkg/encoding/json/#Marshaler) and
 // [json.Unmarshaler](http://golang.org/pkg/encoding/json/#Unmarshaler) interfaces.
 // The package also provides helper functions such as `FeatureCollectionFromJSON` and `FeatureFromJSON`.
@@ -0,0 +1,370 @@
+package geojson
+
+import (
+	"fmt"
+
+	"github.com/fredbi/go-geom/geom"
+)
+
+// EncodeOption configures an Encoder
+type EncodeOption func(*encodeOptions)
+
+type encodeOptions struct {
+	rightHandRule   bool
+	cutAntimeridian bool
+	computeBBox     bool
+	wgs84Only       bool
+	precision       int
+}
+
+func defaultEncodeOptions() *encodeOptions {
+	return &encodeOptions{precision: -1}
+}
+
+// WithRFC7946 enables all the RFC 7946 constraints: right-hand rule, antimeridian cutting,
+// computed bboxes, WGS 84 only and a precision of RFC7946Precision decimals.
+//
+// The precision may be overridden by a subsequent WithPrecision option.
+func WithRFC7946() EncodeOption {
+	return func(o *encodeOptions) {
+		o.rightHandRule = true
+		o.cutAntimeridian = true
+		o.computeBBox = true
+		o.wgs84Only = true
+		o.precision = RFC7946Precision
+	}
+}
+
+// WithRightHandRule orients the exterior rings of polygons counter-clockwise
+// and their holes clockwise.
+func WithRightHandRule() EncodeOption {
+	return func(o *encodeOptions) {
+		o.rightHandRule = true
+	}
+}
+
+// WithAntimeridianCutting splits the line strings and polygons which cross the antimeridian,
+// into MultiLineStrings and MultiPolygons.
+func WithAntimeridianCutting() EncodeOption {
+	return func(o *encodeOptions) {
+		o.cutAntimeridian = true
+	}
+}
+
+// WithComputedBBox computes the bbox of encoded geometries, features and feature collections.
+func WithComputedBBox() EncodeOption {
+	return func(o *encodeOptions) {
+		o.computeBBox = true
+	}
+}
+
+// WithPrecision rounds all coordinates to some number of decimals.
+// A negative precision disables rounding, which is the default.
+func WithPrecision(decimals int) EncodeOption {
+	return func(o *encodeOptions) {
+		o.precision = decimals
+	}
+}
+
+// WithWGS84Only rejects geometries with an SRID other than WGS 84 (4326).
+//
+// Geometries which don't carry an SRID are assumed to be in WGS 84.
+func WithWGS84Only() EncodeOption {
+	return func(o *encodeOptions) {
+		o.wgs84Only = true
+	}
+}
+
+// sridder is implemented by geometries which know their spatial reference system
+type sridder interface {
+	SRID() uint32
+}
+
+// An Encoder marshals geometries, features and feature collections to GeoJSON,
+// optionally enforcing the constraints of RFC 7946.
+//
+// Unlike the json.Marshaler implementations of this package, which keep geometries
+// as they are, the Encoder may transform coordinates.
+type Encoder struct {
+	options encodeOptions
+}
+
+// NewEncoder builds an Encoder
+func NewEncoder(opts ...EncodeOption) *Encoder {
+	o := defaultEncodeOptions()
+	for _, apply := range opts {
+		apply(o)
+	}
+	return &Encoder{options: *o}
+}
+
+// coordinates yields the transformed coordinates of a geometry, or nil for an empty geometry.
+func (e *Encoder) coordinates(g geom.T) (*coordinates, error) {
+	if s, ok := g.(sridder); ok && e.options.wgs84Only {
+		if srid := s.SRID(); srid != 0 && srid != wgs84SRID {
+			return nil, ErrRFC7946(fmt.Sprintf("SRID %d is not WGS 84", srid))
+		}
+	}
+
+	c, err := coordinatesOf(g)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	if e.options.cutAntimeridian {
+		c = cutAntimeridian(c)
+	}
+	if e.options.rightHandRule {
+		c = rightHandRule(c)
+	}
+	if e.options.precision >= 0 {
+		// rounding comes last, so that the positions added by cutting are rounded too
+		c = mapPositions(c, func(p []float64) []float64 {
+			return roundPosition(p, e.options.precision)
+		})
+	}
+	return c, nil
+}
+
+// Geometry converts a geometry to a GeoJSON Geometry.
+//
+// When bboxes are computed, the geometry gets the bbox of its coordinates.
+func (e *Encoder) Geometry(g geom.T) (*Geometry, error) {
+	c, err := e.coordinates(g)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	ng, err := c.geometry()
+	if err != nil {
+		return nil, err
+	}
+	if bbox := computeBBox(c); e.options.computeBBox && bbox != nil {
+		if ng.BBox, err = newBBox(bbox); err != nil {
+			return nil, err
+		}
+	}
+	return ng, nil
+}
+
+// Marshal encodes a geometry as GeoJSON.
+func (e *Encoder) Marshal(g geom.T) ([]byte, error) {
+	ng, err := e.Geometry(g)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(ng)
+}
+
+// feature yields a marshalled feature, with the bbox of its geometry
+func (e *Encoder) feature(f *Feature) ([]byte, []float64, error) {
+	c, err := e.coordinates(f.Geometry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jf := &jsonFeature{
+		ID:         f.ID,
+		BBox:       f.BBox,
+		Type:       TypeFeature,
+		Properties: f.Properties,
+	}
+	if len(jf.Properties) == 0 {
+		jf.Properties = nil
+	}
+	var bbox []float64
+	if c != nil {
+		if jf.Geometry, err = c.geometry(); err != nil {
+			return nil, nil, err
+		}
+		jf.Geometry.ForeignMembers = f.GeometryForeignMembers
+		bbox = computeBBox(c)
+	}
+	if e.options.computeBBox && bbox != nil {
+		if jf.BBox, err = newBBox(bbox); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	data, err := json.Marshal(jf)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err = appendForeignMembers(data, f.ForeignMembers, featureMembers)
+	return data, bbox, err
+}
+
+// MarshalFeature encodes a feature as GeoJSON.
+//
+// When bboxes are computed, the bbox of the feature is replaced by the bbox of its geometry.
+func (e *Encoder) MarshalFeature(f *Feature) ([]byte, error) {
+	data, _, err := e.feature(f)
+	return data, err
+}
+
+// MarshalFeatureCollection encodes a feature collection as GeoJSON.
+//
+// When bboxes are computed, the bbox of the collection is replaced by the union of the bboxes
+// of its features.
+func (e *Encoder) MarshalFeatureCollection(fc *FeatureCollection) ([]byte, error) {
+	features := make([]NoCopyRawMessage, len(fc.Features))
+	var union []float64
+	for i, f := range fc.Features {
+		data, bbox, err := e.feature(f)
+		if err != nil {
+			return nil, err
+		}
+		features[i] = NoCopyRawMessage(data)
+		union = unionBBox(union, bbox)
+	}
+
+	c := struct {
+		Type     ObjectType         `json:"type"`
+		BBox     *BBox              `json:"bbox,omitempty"`
+		Features []NoCopyRawMessage `json:"features"`
+	}{
+		Type:     TypeFeatureCollection,
+		BBox:     fc.BBox,
+		Features: features,
+	}
+	if e.options.computeBBox && union != nil {
+		var err error
+		if c.BBox, err = newBBox(union); err != nil {
+			return nil, err
+		}
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return appendForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
+}
+
+// DecodeOption configures a Decoder
+type DecodeOption func(*decodeOptions)
+
+type decodeOptions struct {
+	validate bool
+}
+
+// WithRFC7946Validation rejects any GeoJSON input which does not comply with RFC 7946,
+// with an ErrRFC7946 error.
+func WithRFC7946Validation() DecodeOption {
+	return func(o *decodeOptions) {
+		o.validate = true
+	}
+}
+
+// A Decoder unmarshals geometries, features and feature collections from GeoJSON,
+// optionally validating their compliance with RFC 7946.
+type Decoder struct {
+	options decodeOptions
+}
+
+// NewDecoder builds a Decoder
+func NewDecoder(opts ...DecodeOption) *Decoder {
+	o := &decodeOptions{}
+	for _, apply := range opts {
+		apply(o)
+	}
+	return &Decoder{options: *o}
+}
+
+// rawFeature is the part of a GeoJSON feature which is subject to validation
+type rawFeature struct {
+	BBox     []float64 `json:"bbox"`
+	Geometry *Geometry `json:"geometry"`
+}
+
+// validateGeometry validates a geometry and the bbox of its feature, if any.
+//
+// It yields the number of dimensions of the positions of the geometry, or 0 if it has no position.
+func (d *Decoder) validateGeometry(g *Geometry, bbox []float64) (int, error) {
+	var c *coordinates
+	if g != nil {
+		var err error
+		if c, err = g.coordinates(); err != nil {
+			return 0, err
+		}
+	}
+	dim := dimensionOf(c)
+
+	if bbox != nil {
+		if err := validateBBox(bbox, dim); err != nil {
+			return 0, err
+		}
+	}
+	if g == nil {
+		return 0, nil
+	}
+	if g.BBox != nil {
+		// only the Bounds of the bbox have been retained
+		corners := g.BBox.FlatCoords()
+		if len(corners) == 2 {
+			if err := validateBBox(append(append([]float64{}, corners[0]...), corners[1]...), dim); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return dim, validateCoordinates(c)
+}
+
+// Unmarshal decodes a GeoJSON geometry.
+func (d *Decoder) Unmarshal(data []byte, g *geom.T) error {
+	gg := &Geometry{}
+	if err := json.Unmarshal(data, gg); err != nil {
+		return err
+	}
+	if d.options.validate {
+		if _, err := d.validateGeometry(gg, nil); err != nil {
+			return err
+		}
+	}
+	var err error
+	*g, err = gg.Geometry()
+	return err
+}
+
+// UnmarshalFeature decodes a GeoJSON feature.
+func (d *Decoder) UnmarshalFeature(data []byte) (*Feature, error) {
+	if d.options.validate {
+		var rf rawFeature
+		if err := json.Unmarshal(data, &rf); err != nil {
+			return nil, err
+		}
+		if _, err := d.validateGeometry(rf.Geometry, rf.BBox); err != nil {
+			return nil, err
+		}
+	}
+	return FeatureFromJSON(data)
+}
+
+// UnmarshalFeatureCollection decodes a GeoJSON feature collection.
+func (d *Decoder) UnmarshalFeatureCollection(data []byte) (*FeatureCollection, error) {
+	if d.options.validate {
+		var rfc struct {
+			BBox     []float64     `json:"bbox"`
+			Features []*rawFeature `json:"features"`
+		}
+		if err := json.Unmarshal(data, &rfc); err != nil {
+			return nil, err
+		}
+		var dim int
+		for i, rf := range rfc.Features {
+			if rf == nil {
+				continue
+			}
+			featureDim, err := d.validateGeometry(rf.Geometry, rf.BBox)
+			if err != nil {
+				return nil, &FeatureError{Index: i, Err: err}
+			}
+			if dim == 0 {
+				dim = featureDim
+			}
+		}
+		if rfc.BBox != nil {
+			if err := validateBBox(rfc.BBox, dim); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return FeatureCollectionFromJSON(data)
+}
@@ -0,0 +1,88 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoderRFC7946(t *testing.T) {
+	enc := NewEncoder(WithRFC7946())
+
+	clockwise := mustPolygon([][][]float64{{{0, 0}, {0, 1.0000001}, {1, 1}, {1, 0}, {0, 0}}})
+	got, err := enc.Marshal(clockwise)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Polygon","bbox":[0,0,1,1],"coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]]]}`, string(got))
+
+	got, err = enc.Marshal(mustLineString([][]float64{{170, 0}, {-170, 10}}))
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"type":"MultiLineString","bbox":[-180,0,180,10],"coordinates":[[[170,0],[180,5]],[[-180,5],[-170,10]]]}`,
+		string(got),
+	)
+
+	// the positions added by cutting are rounded too
+	got, err = enc.Marshal(mustLineString([][]float64{{170, 0}, {-160, 10}}))
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"type":"MultiLineString","bbox":[-180,0,180,10],"coordinates":[[[170,0],[180,3.333333]],[[-180,3.333333],[-160,10]]]}`,
+		string(got),
+	)
+
+	got, err = enc.MarshalFeatureCollection(&FeatureCollection{
+		Features: []*Feature{
+			{Geometry: mustPoint(1, 2)},
+			{Geometry: mustLineString([][]float64{{-1, 5}, {4, -2}})},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"FeatureCollection","bbox":[-1,-2,4,5],"features":[`+
+		`{"type":"Feature","bbox":[1,2,1,2],"geometry":{"type":"Point","coordinates":[1,2]},"properties":null},`+
+		`{"type":"Feature","bbox":[-1,-2,4,5],"geometry":{"type":"LineString","coordinates":[[-1,5],[4,-2]]},"properties":null}]}`,
+		string(got))
+}
+
+func TestEncoderDefaults(t *testing.T) {
+	p := mustPolygon([][][]float64{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}})
+	got, err := NewEncoder().Marshal(p)
+	require.NoError(t, err)
+	want, err := Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(got))
+}
+
+func TestDecoderRFC7946Validation(t *testing.T) {
+	dec := NewDecoder(WithRFC7946Validation())
+
+	var g geom.T
+	require.NoError(t, dec.Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`), &g))
+	assert.Equal(t,
+		ErrRFC7946("exterior ring is not counter-clockwise"),
+		dec.Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,0]]]}`), &g),
+	)
+	assert.Equal(t,
+		ErrRFC7946("position with 4 elements"),
+		dec.Unmarshal([]byte(`{"type":"Point","coordinates":[1,2,3,4]}`), &g),
+	)
+
+	_, err := dec.UnmarshalFeature([]byte(`{"type":"Feature","bbox":[0,10,1,-10],"geometry":null,"properties":null}`))
+	assert.Equal(t, ErrRFC7946("bbox south latitude exceeds north latitude"), err)
+
+	_, err = dec.UnmarshalFeature([]byte(`{"type":"Feature","bbox":[0,0,0,1,1,1],` +
+		`"geometry":{"type":"Point","coordinates":[1,1]},"properties":null}`))
+	assert.Equal(t, ErrRFC7946("bbox with 6 elements, for positions with 2 elements"), err)
+
+	_, err = dec.UnmarshalFeatureCollection([]byte(`{"type":"FeatureCollection","bbox":[0,0,0,1,1,1],"features":[` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,1]},"properties":null}]}`))
+	assert.Equal(t, ErrRFC7946("bbox with 6 elements, for positions with 2 elements"), err)
+
+	_, err = dec.UnmarshalFeatureCollection([]byte(`{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null},` +
+		`{"type":"Feature","geometry":{"type":"LineString","coordinates":[[1,2]]},"properties":null}]}`))
+	assert.Equal(t, &FeatureError{Index: 1, Err: ErrRFC7946("line string with 1 positions")}, err)
+
+	// without validation, non-compliant input is accepted
+	require.NoError(t, NewDecoder().Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,0]]]}`), &g))
+}
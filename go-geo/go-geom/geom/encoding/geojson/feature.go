@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/twpayne/go-geom"
+	"github.com/fredbi/go-geom/geom"
 )
 
 // FeatureFromJSON decodes the data into a GeoJSON feature.
@@ -33,6 +33,13 @@ type Feature struct {
 	BBox       *BBox       `json:"bbox,omitempty"`
 	Geometry   geom.T      `json:"geometry"`
 	Properties Properties  `json:"properties"`
+
+	// ForeignMembers holds the members of the feature which are not defined by RFC 7946
+	ForeignMembers map[string]interface{} `json:"-"`
+
+	// GeometryForeignMembers holds the members of the geometry of the feature which are not
+	// defined by RFC 7946, since geom.T can't hold them
+	GeometryForeignMembers map[string]interface{} `json:"-"`
 }
 
 // MarshalJSON converts the feature object into the proper JSON.
@@ -43,6 +50,9 @@ func (f Feature) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if g != nil {
+		g.ForeignMembers = f.GeometryForeignMembers
+	}
 
 	jf := &jsonFeature{
 		ID:         f.ID,
@@ -56,7 +66,11 @@ func (f Feature) MarshalJSON() ([]byte, error) {
 		jf.Properties = nil
 	}
 
-	return json.Marshal(jf)
+	data, err := json.Marshal(jf)
+	if err != nil {
+		return nil, err
+	}
+	return appendForeignMembers(data, f.ForeignMembers, featureMembers)
 }
 
 // UnmarshalJSON handles the correct unmarshalling of the data
@@ -72,16 +86,29 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 		return ErrUnsupportedType(jf.Type)
 	}
 
-	g, err := jf.Geometry.Geometry()
+	var (
+		g               geom.T
+		geometryForeign map[string]interface{}
+	)
+	if jf.Geometry != nil {
+		if g, err = jf.Geometry.Geometry(); err != nil {
+			return err
+		}
+		geometryForeign = jf.Geometry.ForeignMembers
+	}
+
+	foreign, err := decodeForeignMembers(data, featureMembers)
 	if err != nil {
 		return err
 	}
 
 	*f = Feature{
-		ID:         jf.ID,
-		BBox:       jf.BBox,
-		Properties: jf.Properties,
-		Geometry:   g,
+		ID:                     jf.ID,
+		BBox:                   jf.BBox,
+		Properties:             jf.Properties,
+		Geometry:               g,
+		ForeignMembers:         foreign,
+		GeometryForeignMembers: geometryForeign,
 	}
 
 	return nil
@@ -33,6 +33,9 @@ func NewFeatureCollection() *FeatureCollection {
 type FeatureCollection struct {
 	BBox     *BBox      `json:"bbox,omitempty"`
 	Features []*Feature `json:"features"`
+
+	// ForeignMembers holds the members of the collection which are not defined by RFC 7946
+	ForeignMembers map[string]interface{} `json:"-"`
 }
 
 // Append appends a feature to the collection.
@@ -47,13 +50,18 @@ func (fc *FeatureCollection) Append(feature *Feature) *FeatureCollection {
 func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
 	c := jsonFeatureCollection{
 		Type:     TypeFeatureCollection,
+		BBox:     fc.BBox,
 		Features: fc.Features,
 	}
 
 	if c.Features == nil {
 		c.Features = []*Feature{}
 	}
-	return json.Marshal(c)
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return appendForeignMembers(data, fc.ForeignMembers, featureCollectionMembers)
 }
 
 // UnmarshalJSON implements json.Unmarshaler
@@ -65,7 +73,13 @@ func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
 	if gfc.Type != TypeFeatureCollection {
 		return ErrUnsupportedType(gfc.Type)
 	}
+	foreign, err := decodeForeignMembers(data, featureCollectionMembers)
+	if err != nil {
+		return err
+	}
+	fc.BBox = gfc.BBox
 	fc.Features = gfc.Features
+	fc.ForeignMembers = foreign
 	return nil
 }
 
@@ -89,5 +103,6 @@ func (fc FeatureCollection) MarshalGQL(w io.Writer) {
 
 type jsonFeatureCollection struct {
 	Type     ObjectType `json:"type"`
+	BBox     *BBox      `json:"bbox,omitempty"`
 	Features []*Feature `json:"features"`
 }
@@ -5,8 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/fredbi/go-geom/geom"
 	"github.com/stretchr/testify/assert"
-	"github.com/twpayne/go-geom"
 )
 
 func TestFeatureCollectionFromJSON(t *testing.T) {
@@ -55,17 +55,17 @@ func TestFeatureCollectionFromJSON(t *testing.T) {
 	}
 
 	f := fc.Features[0]
-	if gt, ok := f.Geometry.(*geom.Point); !ok {
+	if gt, ok := f.Geometry.(geom.Point); !ok {
 		t.Errorf("incorrect feature type: %v != %v", gt, "Point")
 	}
 
 	f = fc.Features[1]
-	if gt, ok := f.Geometry.(*geom.LineString); !ok {
+	if gt, ok := f.Geometry.(geom.LineString); !ok {
 		t.Errorf("incorrect feature type: %v != %v", gt, "LineString")
 	}
 
 	f = fc.Features[2]
-	if gt, ok := f.Geometry.(*geom.Polygon); !ok {
+	if gt, ok := f.Geometry.(geom.Polygon); !ok {
 		t.Errorf("incorrect feature type: %v != %v", gt, "Polygon")
 	}
 
@@ -92,7 +92,7 @@ func TestFeatureCollectionFromJSON(t *testing.T) {
 	}
 
 	// not a feature collection
-	data, _ = NewFeature(geom.NewPoint(geom.XY)).MarshalJSON()
+	data, _ = NewFeature(mustPoint(0, 0)).MarshalJSON()
 	_, err = FeatureCollectionFromJSON(data)
 	if err == nil {
 		t.Error("should return error if not a feature collection")
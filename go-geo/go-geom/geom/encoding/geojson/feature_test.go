@@ -7,11 +7,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"github.com/twpayne/go-geom"
 )
 
 func TestFeatureMarshalJSON(t *testing.T) {
-	f := NewFeature(geom.NewPointFlat(geom.XY, []float64{1, 2}))
+	f := NewFeature(mustPoint(1, 2))
 	blob, err := f.MarshalJSON()
 	if err != nil {
 		t.Fatalf("error marshalling to json: %v", err)
@@ -24,8 +23,8 @@ func TestFeatureMarshalJSON(t *testing.T) {
 
 func TestFeatureMarshalJSON_BBox(t *testing.T) {
 	f := &Feature{
-		BBox:       &BBox{geom.NewBounds(geom.XY).Set(1, 1, 2, 2)},
-		Geometry:   geom.NewPointFlat(geom.XY, []float64{1, 2}),
+		BBox:       &BBox{mustBounds([]float64{1, 1}, []float64{2, 2})},
+		Geometry:   mustPoint(1, 2),
 		Properties: make(map[string]interface{}),
 	}
 
@@ -36,17 +35,17 @@ func TestFeatureMarshalJSON_BBox(t *testing.T) {
 	require.NotContains(t, blob, []byte("bbox"))
 
 	// some bbox
-	f.BBox = &BBox{geom.NewBounds(geom.XY).Set(1, 2, 3, 4)}
+	f.BBox = &BBox{mustBounds([]float64{1, 2}, []float64{3, 4})}
 	blob, err = f.MarshalJSON()
 	require.NoError(t, err)
 	require.True(t, bytes.Contains(blob, []byte(`"bbox":[1,2,3,4]`)))
 }
 
 func TestFeatureMarshalJSON_Bound(t *testing.T) {
-	bb := geom.NewBounds(geom.XY).SetCoords(geom.Coord{1, 1}, geom.Coord{2, 2})
+	bb := mustBounds([]float64{1, 1}, []float64{2, 2})
 	f := &Feature{
 		BBox:       &BBox{bb},
-		Geometry:   bb.Polygon(),
+		Geometry:   bb,
 		Properties: make(map[string]interface{}),
 	}
 
@@ -66,7 +65,7 @@ func TestFeatureMarshalJSON_Bound(t *testing.T) {
 }
 
 func TestFeatureMarshal(t *testing.T) {
-	f := NewFeature(geom.NewPointFlat(geom.XY, []float64{1, 2}))
+	f := NewFeature(mustPoint(1, 2))
 	blob, err := json.Marshal(f)
 
 	if err != nil {
@@ -82,7 +81,7 @@ func TestFeatureMarshal(t *testing.T) {
 }
 
 func TestFeatureMarshalValue(t *testing.T) {
-	f := NewFeature(geom.NewPointFlat(geom.XY, []float64{1, 2}))
+	f := NewFeature(mustPoint(1, 2))
 	blob, err := json.Marshal(*f)
 
 	if err != nil {
@@ -147,10 +146,7 @@ func TestFeatureFromJSON_BBox(t *testing.T) {
 		t.Fatalf("unmarshal error: %v", err)
 	}
 
-	require.EqualValues(t, 1, f.BBox.Min(0))
-	require.EqualValues(t, 2, f.BBox.Min(1))
-	require.EqualValues(t, 3, f.BBox.Max(0))
-	require.EqualValues(t, 4, f.BBox.Max(1))
+	require.Equal(t, [][]float64{{1, 2}, {3, 4}}, f.BBox.FlatCoords())
 }
 
 func TestMarshalFeatureID(t *testing.T) {
@@ -0,0 +1,90 @@
+package geojson
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Foreign members are the members of GeoJSON objects which are not defined by RFC 7946,
+// such as "crs", "title" or custom extensions. They are preserved on round-trip.
+
+var (
+	featureMembers           = []string{"type", "id", "bbox", "geometry", "properties"}
+	featureCollectionMembers = []string{"type", "bbox", "features"}
+	geometryMembers          = []string{"type", "bbox", "coordinates", "geometries"}
+)
+
+func isMember(name string, members []string) bool {
+	for _, member := range members {
+		if name == member {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeForeignMembers yields the members of a JSON object which are not known, or nil if there are none.
+func decodeForeignMembers(data []byte, known []string) (map[string]interface{}, error) {
+	var raw map[string]NoCopyRawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var foreign map[string]interface{}
+	for name, value := range raw {
+		if isMember(name, known) {
+			continue
+		}
+		var v interface{}
+		if err := json.Unmarshal(value, &v); err != nil {
+			return nil, err
+		}
+		if foreign == nil {
+			foreign = make(map[string]interface{}, len(raw))
+		}
+		foreign[name] = v
+	}
+	return foreign, nil
+}
+
+// appendForeignMembers adds foreign members to a marshalled JSON object, sorted by name.
+//
+// Foreign members which collide with known members are ignored.
+func appendForeignMembers(data []byte, foreign map[string]interface{}, known []string) ([]byte, error) {
+	if len(foreign) == 0 {
+		return data, nil
+	}
+	names := make([]string, 0, len(foreign))
+	for name := range foreign {
+		if !isMember(name, known) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return data, nil
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	object := bytes.TrimRight(data, " \n")
+	buf.Write(object[:len(object)-1])
+	empty := bytes.HasSuffix(bytes.TrimRight(object[:len(object)-1], " \n"), []byte("{"))
+	for i, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(foreign[name])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 || !empty {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,72 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForeignMembers(t *testing.T) {
+	t.Run("feature", func(t *testing.T) {
+		s := `{"type":"Feature","title":"Dinagat","crs":{"type":"name","properties":{"name":"EPSG:4326"}},` +
+			`"geometry":{"type":"Point","coordinates":[125.6,10.1]},"properties":null}`
+		f, err := FeatureFromJSON([]byte(s))
+		require.NoError(t, err)
+		assert.Equal(t, "Dinagat", f.ForeignMembers["title"])
+		assert.Len(t, f.ForeignMembers, 2)
+
+		b, err := json.Marshal(f)
+		require.NoError(t, err)
+		assert.JSONEq(t, s, string(b))
+	})
+
+	t.Run("feature collection", func(t *testing.T) {
+		s := `{"type":"FeatureCollection","name":"islands","features":[]}`
+		fc, err := FeatureCollectionFromJSON([]byte(s))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"name": "islands"}, fc.ForeignMembers)
+
+		b, err := json.Marshal(fc)
+		require.NoError(t, err)
+		assert.JSONEq(t, s, string(b))
+	})
+
+	t.Run("geometry", func(t *testing.T) {
+		s := `{"type":"GeometryCollection","ext":1,"geometries":[{"type":"Point","coordinates":[1,2],"accuracy":"high"}]}`
+		g := &Geometry{}
+		require.NoError(t, json.Unmarshal([]byte(s), g))
+		assert.Equal(t, map[string]interface{}{"ext": 1.0}, g.ForeignMembers)
+		assert.Equal(t, map[string]interface{}{"accuracy": "high"}, g.Geometries[0].ForeignMembers)
+
+		b, err := json.Marshal(g)
+		require.NoError(t, err)
+		assert.JSONEq(t, s, string(b))
+	})
+
+	t.Run("geometry of a feature", func(t *testing.T) {
+		s := `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2],"accuracy":"high"},"properties":null}`
+		f, err := FeatureFromJSON([]byte(s))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"accuracy": "high"}, f.GeometryForeignMembers)
+		assert.Empty(t, f.ForeignMembers)
+
+		b, err := json.Marshal(f)
+		require.NoError(t, err)
+		assert.JSONEq(t, s, string(b))
+
+		b, err = NewEncoder().MarshalFeature(f)
+		require.NoError(t, err)
+		assert.JSONEq(t, s, string(b))
+	})
+
+	t.Run("members defined by RFC 7946 are not overridden", func(t *testing.T) {
+		f := &Feature{
+			Geometry:       mustPoint(1, 2),
+			ForeignMembers: map[string]interface{}{"type": "Other", "geometry": nil},
+		}
+		b, err := json.Marshal(f)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`, string(b))
+	})
+}
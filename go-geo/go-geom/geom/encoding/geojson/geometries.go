@@ -5,8 +5,9 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
 	jsoniter "github.com/json-iterator/go"
-	"github.com/twpayne/go-geom"
 )
 
 // DefaultLayout is the default layout for empty geometries.
@@ -20,6 +21,13 @@ func (e ErrDimensionalityTooLow) Error() string {
 	return fmt.Sprintf("geojson: dimensionality too low (%d)", int(e))
 }
 
+// ErrDimensionalityTooHigh is returned when the dimensionality is not supported by any layout.
+type ErrDimensionalityTooHigh int
+
+func (e ErrDimensionalityTooHigh) Error() string {
+	return fmt.Sprintf("geojson: dimensionality too high (%d)", int(e))
+}
+
 // ErrUnsupportedType is returned when the type is unsupported.
 type ErrUnsupportedType string
 
@@ -115,81 +123,83 @@ func (m NoCopyRawMessage) MarshalJSON() ([]byte, error) {
 // the input into a GoeJSON geometry. For example, it will convert
 // Rings and Bounds into Polygons.
 func NewGeometry(g geom.T) (*Geometry, error) {
+	c, err := coordinatesOf(g)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return c.geometry()
+}
+
+// coordinates holds the GeoJSON coordinates of a geometry, before they are marshalled.
+//
+// Depending on Type, Coords is a []float64 (Point), a [][]float64 (LineString, MultiPoint),
+// a [][][]float64 (Polygon, MultiLineString) or a [][][][]float64 (MultiPolygon).
+type coordinates struct {
+	Type       ObjectType
+	Coords     interface{}
+	Geometries []*coordinates
+}
+
+func coordinatesOf(g geom.T) (*coordinates, error) {
 	if g == nil {
 		return nil, nil
 	}
 	switch g := g.(type) {
-	case *geom.Point:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
-		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypePoint,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.LineString:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
-		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypeLineString,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.Polygon:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
+	case geom.EmptyGeometry:
+		return nil, nil
+	case geom.Point:
+		return &coordinates{Type: TypePoint, Coords: g.Coords()}, nil
+	case geom.Bounds:
+		return &coordinates{Type: TypePolygon, Coords: [][][]float64{utils.BoundsCoords(g)}}, nil
+	case geom.Ring:
+		return &coordinates{Type: TypePolygon, Coords: [][][]float64{utils.RingCoords(g)}}, nil
+	case geom.Line:
+		return &coordinates{Type: TypeLineString, Coords: g.FlatCoords()}, nil
+	case geom.LineString:
+		return &coordinates{Type: TypeLineString, Coords: g.FlatCoords()}, nil
+	case geom.Polygon:
+		return &coordinates{Type: TypePolygon, Coords: utils.PolygonCoords(g)}, nil
+	case geom.PointCollection:
+		coords := make([][]float64, len(g))
+		for i, p := range g {
+			coords[i] = p.Coords()
 		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypePolygon,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.MultiPoint:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
+		return &coordinates{Type: TypeMultiPoint, Coords: coords}, nil
+	case geom.LineStringCollection:
+		coords := make([][][]float64, len(g))
+		for i, ls := range g {
+			coords[i] = ls.FlatCoords()
 		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypeMultiPoint,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.MultiLineString:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
+		return &coordinates{Type: TypeMultiLineString, Coords: coords}, nil
+	case geom.PolygonCollection:
+		coords := make([][][][]float64, len(g))
+		for i, p := range g {
+			coords[i] = utils.PolygonCoords(p)
 		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypeMultiLineString,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.MultiPolygon:
-		var coords jsoniter.RawMessage
-		coords, err := json.Marshal(g.Coords())
-		if err != nil {
-			return nil, err
+		return &coordinates{Type: TypeMultiPolygon, Coords: coords}, nil
+	case geom.Collection:
+		geometries := make([]*coordinates, 0, len(g))
+		for _, subGeometry := range g {
+			c, err := coordinatesOf(subGeometry)
+			if err != nil {
+				return nil, err
+			}
+			if c != nil {
+				geometries = append(geometries, c)
+			}
 		}
-		rwm := NoCopyRawMessage(coords)
-		return &Geometry{
-			Type:        TypeMultiPolygon,
-			Coordinates: &rwm,
-		}, nil
-	case *geom.GeometryCollection:
-		geometries := make([]*Geometry, len(g.Geoms()))
-		for i, subGeometry := range g.Geoms() {
+		return &coordinates{Type: TypeGeometryCollection, Geometries: geometries}, nil
+	default:
+		return nil, ErrUnsupportedType(fmt.Sprintf("%T", g))
+	}
+}
+
+func (c *coordinates) geometry() (*Geometry, error) {
+	if c.Type == TypeGeometryCollection {
+		geometries := make([]*Geometry, len(c.Geometries))
+		for i, subGeometry := range c.Geometries {
 			var err error
-			geometries[i], err = NewGeometry(subGeometry)
+			geometries[i], err = subGeometry.geometry()
 			if err != nil {
 				return nil, err
 			}
@@ -198,9 +208,66 @@ func NewGeometry(g geom.T) (*Geometry, error) {
 			Type:       TypeGeometryCollection,
 			Geometries: geometries,
 		}, nil
+	}
+
+	raw, err := json.Marshal(c.Coords)
+	if err != nil {
+		return nil, err
+	}
+	rwm := NoCopyRawMessage(raw)
+	return &Geometry{
+		Type:        c.Type,
+		Coordinates: &rwm,
+	}, nil
+}
+
+// coordinates decodes the raw coordinates of a GeoJSON geometry.
+func (g Geometry) coordinates() (*coordinates, error) {
+	c := &coordinates{Type: g.Type}
+	switch g.Type {
+	case TypeGeometryCollection:
+		c.Geometries = make([]*coordinates, len(g.Geometries))
+		for i, subGeometry := range g.Geometries {
+			var err error
+			if c.Geometries[i], err = subGeometry.coordinates(); err != nil {
+				return nil, err
+			}
+		}
+		return c, nil
+	case TypePoint:
+		c.Coords = []float64{}
+	case TypeLineString, TypeMultiPoint:
+		c.Coords = [][]float64{}
+	case TypePolygon, TypeMultiLineString:
+		c.Coords = [][][]float64{}
+	case TypeMultiPolygon:
+		c.Coords = [][][][]float64{}
 	default:
-		return nil, geom.ErrUnsupportedType{Value: g}
+		return nil, ErrUnsupportedType(g.Type)
+	}
+	if g.Coordinates == nil {
+		return c, nil
 	}
+
+	var err error
+	switch coords := c.Coords.(type) {
+	case []float64:
+		err = json.Unmarshal(*g.Coordinates, &coords)
+		c.Coords = coords
+	case [][]float64:
+		err = json.Unmarshal(*g.Coordinates, &coords)
+		c.Coords = coords
+	case [][][]float64:
+		err = json.Unmarshal(*g.Coordinates, &coords)
+		c.Coords = coords
+	case [][][][]float64:
+		err = json.Unmarshal(*g.Coordinates, &coords)
+		c.Coords = coords
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
 }
 
 // A Geometry matches the structure of a GeoJSON Geometry.
@@ -209,6 +276,36 @@ type Geometry struct {
 	BBox        *BBox             `json:"bbox,omitempty"`
 	Coordinates *NoCopyRawMessage `json:"coordinates,omitempty"`
 	Geometries  []*Geometry       `json:"geometries,omitempty"`
+
+	// ForeignMembers holds the members of the geometry which are not defined by RFC 7946
+	ForeignMembers map[string]interface{} `json:"-"`
+}
+
+// jsonGeometry marshals a Geometry without its foreign members
+type jsonGeometry Geometry
+
+// MarshalJSON marshals the geometry, with its foreign members.
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(jsonGeometry(g))
+	if err != nil {
+		return nil, err
+	}
+	return appendForeignMembers(data, g.ForeignMembers, geometryMembers)
+}
+
+// UnmarshalJSON unmarshals the geometry, retaining its foreign members.
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	var jg jsonGeometry
+	if err := json.Unmarshal(data, &jg); err != nil {
+		return err
+	}
+	foreign, err := decodeForeignMembers(data, geometryMembers)
+	if err != nil {
+		return err
+	}
+	*g = Geometry(jg)
+	g.ForeignMembers = foreign
+	return nil
 }
 
 func (Geometry) IsGeoJSONInterface() {}
@@ -224,40 +321,86 @@ func guessLayout0(coords0 []float64) (geom.Layout, error) {
 	case 4:
 		return geom.XYZM, nil
 	default:
-		return geom.Layout(n), nil
+		return geom.NoLayout, ErrDimensionalityTooHigh(len(coords0))
 	}
 }
 
-func guessLayout1(coords1 []geom.Coord) (geom.Layout, error) {
+func guessLayout1(coords1 [][]float64) (geom.Layout, error) {
 	if len(coords1) == 0 {
 		return DefaultLayout, nil
 	}
 	return guessLayout0(coords1[0])
 }
 
-func guessLayout2(coords2 [][]geom.Coord) (geom.Layout, error) {
+func guessLayout2(coords2 [][][]float64) (geom.Layout, error) {
 	if len(coords2) == 0 {
 		return DefaultLayout, nil
 	}
 	return guessLayout1(coords2[0])
 }
 
-func guessLayout3(coords3 [][][]geom.Coord) (geom.Layout, error) {
+func guessLayout3(coords3 [][][][]float64) (geom.Layout, error) {
 	if len(coords3) == 0 {
 		return DefaultLayout, nil
 	}
 	return guessLayout2(coords3[0])
 }
 
+func layoutOptions(layout geom.Layout) []geom.LayoutOption {
+	return []geom.LayoutOption{geom.WithLayout(layout)}
+}
+
+func newPoint(coords []float64, opts []geom.LayoutOption) (geom.Point, error) {
+	var err error
+	p := utils.NewPoint(opts...).WithCoords(coords, func(e error) { err = e })
+	return p, err
+}
+
+func newPoints(coords [][]float64, opts []geom.LayoutOption) ([]geom.Point, error) {
+	points := make([]geom.Point, len(coords))
+	for i, c := range coords {
+		var err error
+		points[i], err = newPoint(c, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
+func newPolygon(coords [][][]float64, opts []geom.LayoutOption) (geom.Polygon, error) {
+	if len(coords) == 0 {
+		return utils.NewPolygon(nil, opts...), nil
+	}
+	exterior, err := newPoints(coords[0], opts)
+	if err != nil {
+		return nil, err
+	}
+	holes := make([][]geom.Point, len(coords)-1)
+	for i, ring := range coords[1:] {
+		holes[i], err = newPoints(ring, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return utils.NewPolygonWithHoles(exterior, holes, opts...), nil
+}
+
 // Geometry returns the geom.T for the geojson Geometry.
-// This will convert the "Geometries" into a geom.GeometryCollection if applicable.
+// This will convert the "Geometries" into a geom.Collection if applicable.
+//
+// Geometries are built with the utils factories, with a layout inferred from the dimension
+// of the coordinates. Null coordinates yield an empty geometry of the declared type.
 func (g Geometry) Geometry() (geom.T, error) {
+	if g.Coordinates == nil {
+		if empty := emptyGeometry(g.Type); empty != nil {
+			return empty, nil
+		}
+	}
+
 	switch g.Type {
 	case TypePoint:
-		if g.Coordinates == nil {
-			return geom.NewPoint(geom.NoLayout), nil
-		}
-		var coords geom.Coord
+		var coords []float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -265,12 +408,9 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewPoint(layout).SetCoords(coords)
+		return newPoint(coords, layoutOptions(layout))
 	case TypeLineString:
-		if g.Coordinates == nil {
-			return geom.NewLineString(geom.NoLayout), nil
-		}
-		var coords []geom.Coord
+		var coords [][]float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -278,12 +418,14 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewLineString(layout).SetCoords(coords)
-	case TypePolygon:
-		if g.Coordinates == nil {
-			return geom.NewPolygon(geom.NoLayout), nil
+		opts := layoutOptions(layout)
+		points, err := newPoints(coords, opts)
+		if err != nil {
+			return nil, err
 		}
-		var coords [][]geom.Coord
+		return utils.NewLineString(points, opts...), nil
+	case TypePolygon:
+		var coords [][][]float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -291,12 +433,9 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewPolygon(layout).SetCoords(coords)
+		return newPolygon(coords, layoutOptions(layout))
 	case TypeMultiPoint:
-		if g.Coordinates == nil {
-			return geom.NewMultiPoint(geom.NoLayout), nil
-		}
-		var coords []geom.Coord
+		var coords [][]float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -304,12 +443,13 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewMultiPoint(layout).SetCoords(coords)
-	case TypeMultiLineString:
-		if g.Coordinates == nil {
-			return geom.NewMultiLineString(geom.NoLayout), nil
+		points, err := newPoints(coords, layoutOptions(layout))
+		if err != nil {
+			return nil, err
 		}
-		var coords [][]geom.Coord
+		return geom.PointCollection(points), nil
+	case TypeMultiLineString:
+		var coords [][][]float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -317,12 +457,18 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewMultiLineString(layout).SetCoords(coords)
-	case TypeMultiPolygon:
-		if g.Coordinates == nil {
-			return geom.NewMultiPolygon(geom.NoLayout), nil
+		opts := layoutOptions(layout)
+		lineStrings := make(geom.LineStringCollection, len(coords))
+		for i, c := range coords {
+			points, err := newPoints(c, opts)
+			if err != nil {
+				return nil, err
+			}
+			lineStrings[i] = utils.NewLineString(points, opts...)
 		}
-		var coords [][][]geom.Coord
+		return lineStrings, nil
+	case TypeMultiPolygon:
+		var coords [][][][]float64
 		if err := json.Unmarshal(*g.Coordinates, &coords); err != nil {
 			return nil, err
 		}
@@ -330,9 +476,17 @@ func (g Geometry) Geometry() (geom.T, error) {
 		if err != nil {
 			return nil, err
 		}
-		return geom.NewMultiPolygon(layout).SetCoords(coords)
+		opts := layoutOptions(layout)
+		polygons := make(geom.PolygonCollection, len(coords))
+		for i, c := range coords {
+			polygons[i], err = newPolygon(c, opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return polygons, nil
 	case TypeGeometryCollection:
-		geoms := make([]geom.T, len(g.Geometries))
+		geoms := make(geom.Collection, len(g.Geometries))
 		for i, subGeometry := range g.Geometries {
 			var err error
 			geoms[i], err = subGeometry.Geometry()
@@ -340,16 +494,35 @@ func (g Geometry) Geometry() (geom.T, error) {
 				return nil, err
 			}
 		}
-		gc := geom.NewGeometryCollection()
-		if err := gc.Push(geoms...); err != nil {
-			return nil, err
-		}
-		return gc, nil
+		return geoms, nil
 	default:
 		return nil, ErrUnsupportedType(g.Type)
 	}
 }
 
+// emptyGeometry yields an empty geometry of some type, with no layout.
+//
+// It yields nil for types which are not defined by coordinates.
+func emptyGeometry(typ ObjectType) geom.T {
+	opts := layoutOptions(geom.NoLayout)
+	switch typ {
+	case TypePoint:
+		return utils.NewPoint(opts...)
+	case TypeLineString:
+		return utils.NewLineString(nil, opts...)
+	case TypePolygon:
+		return utils.NewPolygon(nil, opts...)
+	case TypeMultiPoint:
+		return geom.PointCollection{}
+	case TypeMultiLineString:
+		return geom.LineStringCollection{}
+	case TypeMultiPolygon:
+		return geom.PolygonCollection{}
+	default:
+		return nil
+	}
+}
+
 func Marshal(g geom.T) ([]byte, error) {
 	ng, err := NewGeometry(g)
 	if err != nil {
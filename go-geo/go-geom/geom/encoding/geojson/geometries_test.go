@@ -1,157 +1,155 @@
 package geojson
 
 import (
-	"reflect"
 	"testing"
 
-	"github.com/d4l3k/messagediff"
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/twpayne/go-geom"
 )
 
+func mustLayout(coords []float64) []geom.LayoutOption {
+	layout, err := guessLayout0(coords)
+	if err != nil {
+		panic(err)
+	}
+	return layoutOptions(layout)
+}
+
+func mustPoint(coords ...float64) geom.Point {
+	p, err := newPoint(coords, mustLayout(coords))
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func mustLineString(coords [][]float64) geom.LineString {
+	opts := mustLayout(coords[0])
+	points, err := newPoints(coords, opts)
+	if err != nil {
+		panic(err)
+	}
+	return utils.NewLineString(points, opts...)
+}
+
+func mustPolygon(coords [][][]float64) geom.Polygon {
+	p, err := newPolygon(coords, mustLayout(coords[0][0]))
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func mustBounds(min, max []float64) geom.Bounds {
+	return utils.NewBounds(min, max, mustLayout(min)...)
+}
+
 func TestGeometryDecode_NilCoordinates(t *testing.T) {
+	noLayout := geom.WithLayout(geom.NoLayout)
 	for _, tc := range []struct {
 		geometry Geometry
 		want     geom.T
 	}{
 		{
 			geometry: Geometry{Type: "Point"},
-			want:     geom.NewPoint(geom.NoLayout),
+			want:     utils.NewPoint(noLayout),
 		},
 		{
 			geometry: Geometry{Type: "LineString"},
-			want:     geom.NewLineString(geom.NoLayout),
+			want:     utils.NewLineString(nil, noLayout),
 		},
 		{
 			geometry: Geometry{Type: "Polygon"},
-			want:     geom.NewPolygon(geom.NoLayout),
+			want:     utils.NewPolygon(nil, noLayout),
 		},
 		{
 			geometry: Geometry{Type: "MultiPoint"},
-			want:     geom.NewMultiPoint(geom.NoLayout),
+			want:     geom.PointCollection{},
 		},
 		{
 			geometry: Geometry{Type: "MultiLineString"},
-			want:     geom.NewMultiLineString(geom.NoLayout),
+			want:     geom.LineStringCollection{},
 		},
 		{
 			geometry: Geometry{Type: "MultiPolygon"},
-			want:     geom.NewMultiPolygon(geom.NoLayout),
+			want:     geom.PolygonCollection{},
 		},
 		{
 			geometry: Geometry{Type: "GeometryCollection"},
-			want:     geom.NewGeometryCollection(),
+			want:     geom.Collection{},
 		},
 	} {
-		if got, err := tc.geometry.Geometry(); err != nil || !reflect.DeepEqual(got, tc.want) {
-			t.Errorf("%+v.Decode() == %v, %v, want %v, nil", tc.geometry, got, err, tc.want)
-		}
+		got, err := tc.geometry.Geometry()
+		require.NoErrorf(t, err, "%s.Decode()", tc.geometry.Type)
+		assert.Truef(t, got.IsEmpty(), "%s.Decode()", tc.geometry.Type)
+		assert.Equalf(t, tc.want, got, "%s.Decode()", tc.geometry.Type)
 	}
 }
 
 func TestGeometry(t *testing.T) {
+	for _, s := range []string{
+		`{"type":"Point","coordinates":[1,2]}`,
+		`{"type":"Point","coordinates":[1,2,3]}`,
+		`{"type":"Point","coordinates":[1,2,3,4]}`,
+		`{"type":"LineString","coordinates":[]}`,
+		`{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
+		`{"type":"LineString","coordinates":[[1,2,3],[4,5,6]]}`,
+		`{"type":"LineString","coordinates":[[1,2,3,4],[5,6,7,8]]}`,
+		`{"type":"Polygon","coordinates":[[[1,2],[3,4],[5,6],[1,2]]]}`,
+		`{"type":"Polygon","coordinates":[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,0]],[[1,1],[2,1],[2,2],[1,1]]]}`,
+		`{"type":"MultiPoint","coordinates":[]}`,
+		`{"type":"MultiPoint","coordinates":[[1,2],[3,4]]}`,
+		`{"type":"MultiPoint","coordinates":[[1,2,3],[4,5,6]]}`,
+		`{"type":"MultiPoint","coordinates":[[1,2,3,4],[5,6,7,8]]}`,
+		`{"type":"MultiLineString","coordinates":[]}`,
+		`{"type":"MultiLineString","coordinates":[[[1,2],[3,4],[5,6],[1,2]]]}`,
+		`{"type":"MultiLineString","coordinates":[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]]]}`,
+		`{"type":"MultiPolygon","coordinates":[]}`,
+		`{"type":"MultiPolygon","coordinates":[[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]],[[-1,-2,-3],[-4,-5,-6],[-7,-8,-9],[-1,-2,-3]]]]}`,
+		`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[100,0]},{"type":"LineString","coordinates":[[101,0],[102,1]]}]}`,
+	} {
+		var g geom.T
+		require.NoErrorf(t, Unmarshal([]byte(s), &g), "Unmarshal(%s)", s)
+		got, err := Marshal(g)
+		require.NoErrorf(t, err, "Marshal(%s)", s)
+		assert.JSONEqf(t, s, string(got), "Marshal(Unmarshal(%s))", s)
+	}
+}
+
+func TestGeometryConversions(t *testing.T) {
 	for _, tc := range []struct {
 		g geom.T
 		s string
 	}{
 		{
-			g: geom.NewPoint(DefaultLayout),
-			s: `{"type":"Point","coordinates":[0,0]}`,
-		},
-		{
-			g: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2}),
-			s: `{"type":"Point","coordinates":[1,2]}`,
-		},
-		{
-			g: geom.NewPoint(geom.XYZ).MustSetCoords(geom.Coord{1, 2, 3}),
-			s: `{"type":"Point","coordinates":[1,2,3]}`,
-		},
-		{
-			g: geom.NewPoint(geom.XYZM).MustSetCoords(geom.Coord{1, 2, 3, 4}),
-			s: `{"type":"Point","coordinates":[1,2,3,4]}`,
-		},
-		{
-			g: geom.NewLineString(DefaultLayout),
-			s: `{"type":"LineString","coordinates":[]}`,
-		},
-		{
-			g: geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{1, 2}, {3, 4}}),
-			s: `{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
-		},
-		{
-			g: geom.NewLineString(geom.XYZ).MustSetCoords([]geom.Coord{{1, 2, 3}, {4, 5, 6}}),
-			s: `{"type":"LineString","coordinates":[[1,2,3],[4,5,6]]}`,
-		},
-		{
-			g: geom.NewLineString(geom.XYZM).MustSetCoords([]geom.Coord{{1, 2, 3, 4}, {5, 6, 7, 8}}),
-			s: `{"type":"LineString","coordinates":[[1,2,3,4],[5,6,7,8]]}`,
+			g: mustBounds([]float64{1, 1}, []float64{2, 2}),
+			s: `{"type":"Polygon","coordinates":[[[1,1],[1,2],[2,2],[2,1],[1,1]]]}`,
 		},
 		{
-			g: geom.NewPolygon(DefaultLayout),
-			s: `{"type":"Polygon","coordinates":[]}`,
-		},
-		{
-			g: geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{1, 2}, {3, 4}, {5, 6}, {1, 2}}}),
+			g: mustPolygon([][][]float64{{{1, 2}, {3, 4}, {5, 6}, {1, 2}}}).ExteriorRing(),
 			s: `{"type":"Polygon","coordinates":[[[1,2],[3,4],[5,6],[1,2]]]}`,
 		},
 		{
-			g: geom.NewPolygon(geom.XYZ).MustSetCoords([][]geom.Coord{{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {1, 2, 3}}}),
-			s: `{"type":"Polygon","coordinates":[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]]]}`,
-		},
-		{
-			g: geom.NewMultiPoint(DefaultLayout),
-			s: `{"type":"MultiPoint","coordinates":[]}`,
-		},
-		{
-			g: geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{1, 2}, {3, 4}}),
-			s: `{"type":"MultiPoint","coordinates":[[1,2],[3,4]]}`,
-		},
-		{
-			g: geom.NewMultiPoint(geom.XYZ).MustSetCoords([]geom.Coord{{1, 2, 3}, {4, 5, 6}}),
-			s: `{"type":"MultiPoint","coordinates":[[1,2,3],[4,5,6]]}`,
-		},
-		{
-			g: geom.NewMultiPoint(geom.XYZM).MustSetCoords([]geom.Coord{{1, 2, 3, 4}, {5, 6, 7, 8}}),
-			s: `{"type":"MultiPoint","coordinates":[[1,2,3,4],[5,6,7,8]]}`,
-		},
-		{
-			g: geom.NewMultiLineString(DefaultLayout),
-			s: `{"type":"MultiLineString","coordinates":[]}`,
-		},
-		{
-			g: geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{1, 2}, {3, 4}, {5, 6}, {1, 2}}}),
-			s: `{"type":"MultiLineString","coordinates":[[[1,2],[3,4],[5,6],[1,2]]]}`,
-		},
-		{
-			g: geom.NewMultiLineString(geom.XYZ).MustSetCoords([][]geom.Coord{{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {1, 2, 3}}}),
-			s: `{"type":"MultiLineString","coordinates":[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]]]}`,
-		},
-		{
-			g: geom.NewMultiPolygon(DefaultLayout),
-			s: `{"type":"MultiPolygon","coordinates":[]}`,
-		},
-		{
-			g: geom.NewMultiPolygon(geom.XYZ).MustSetCoords([][][]geom.Coord{{{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}, {1, 2, 3}}, {{-1, -2, -3}, {-4, -5, -6}, {-7, -8, -9}, {-1, -2, -3}}}}),
-			s: `{"type":"MultiPolygon","coordinates":[[[[1,2,3],[4,5,6],[7,8,9],[1,2,3]],[[-1,-2,-3],[-4,-5,-6],[-7,-8,-9],[-1,-2,-3]]]]}`,
-		},
-		{
-			g: geom.NewGeometryCollection().MustPush(
-				geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{100, 0}),
-				geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{101, 0}, {102, 1}}),
-			),
-			s: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[100,0]},{"type":"LineString","coordinates":[[101,0],[102,1]]}]}`,
+			g: geom.Collection{mustPoint(1, 2), geom.PointCollection{mustPoint(3, 4)}},
+			s: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]},{"type":"MultiPoint","coordinates":[[3,4]]}]}`,
 		},
 	} {
-		if got, err := Marshal(tc.g); err != nil || string(got) != tc.s {
-			t.Errorf("Marshal(%#v) == %#v, %v, want %#v, nil", tc.g, string(got), err, tc.s)
-		}
-		var g geom.T
-		if err := Unmarshal([]byte(tc.s), &g); err != nil || !reflect.DeepEqual(g, tc.g) {
-			t.Errorf("Unmarshal(%#v, %#v) == %v, want %#v, nil", tc.s, g, err, tc.g)
-		}
+		got, err := Marshal(tc.g)
+		require.NoError(t, err)
+		assert.JSONEq(t, tc.s, string(got))
 	}
 }
 
+func TestGeometryDecodeErrors(t *testing.T) {
+	var g geom.T
+	assert.Equal(t, ErrDimensionalityTooLow(1), Unmarshal([]byte(`{"type":"Point","coordinates":[1]}`), &g))
+	assert.Equal(t, ErrDimensionalityTooHigh(5), Unmarshal([]byte(`{"type":"Point","coordinates":[1,2,3,4,5]}`), &g))
+	assert.Equal(t, ErrUnsupportedType("Circle"), Unmarshal([]byte(`{"type":"Circle","coordinates":[1,2]}`), &g))
+}
+
 func TestFeature(t *testing.T) {
 	for _, tc := range []struct {
 		f *Feature
@@ -159,7 +157,7 @@ func TestFeature(t *testing.T) {
 	}{
 		{
 			f: &Feature{
-				Geometry: geom.NewPoint(geom.XY).MustSetCoords([]float64{125.6, 10.1}),
+				Geometry: mustPoint(125.6, 10.1),
 				Properties: map[string]interface{}{
 					"name": "Dinagat Islands",
 				},
@@ -168,7 +166,7 @@ func TestFeature(t *testing.T) {
 		},
 		{
 			f: &Feature{
-				Geometry: geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{102, 0}, {103, 1}, {104, 0}, {105, 1}}),
+				Geometry: mustLineString([][]float64{{102, 0}, {103, 1}, {104, 0}, {105, 1}}),
 				Properties: map[string]interface{}{
 					"prop0": "value0",
 					"prop1": 0.0,
@@ -178,7 +176,7 @@ func TestFeature(t *testing.T) {
 		},
 		{
 			f: &Feature{
-				Geometry: geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{100, 0}, {101, 0}, {101, 1}, {100, 1}, {100, 0}}}),
+				Geometry: mustPolygon([][][]float64{{{100, 0}, {101, 0}, {101, 1}, {100, 1}, {100, 0}}}),
 				Properties: map[string]interface{}{
 					"prop0": "value0",
 					"prop1": map[string]interface{}{
@@ -191,14 +189,14 @@ func TestFeature(t *testing.T) {
 		{
 			f: &Feature{
 				ID:       "0",
-				Geometry: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2}),
+				Geometry: mustPoint(1, 2),
 			},
 			s: `{"type":"Feature","id":"0","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`,
 		},
 		{
 			f: &Feature{
 				ID:       "f",
-				Geometry: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2}),
+				Geometry: mustPoint(1, 2),
 			},
 			s: `{"type":"Feature","id":"f","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}`,
 		},
@@ -210,9 +208,9 @@ func TestFeature(t *testing.T) {
 		if err := json.Unmarshal([]byte(tc.s), f); err != nil {
 			t.Errorf("json.Unmarshal(%v, ...) == %v, want nil", tc.s, err)
 		}
-		if diff, equal := messagediff.PrettyDiff(tc.f, f); !equal {
-			t.Errorf("json.Unmarshal(%v, ...), diff\n%s", tc.s, diff)
-		}
+		b, err = json.Marshal(f)
+		require.NoError(t, err)
+		require.JSONEq(t, tc.s, string(b))
 	}
 }
 
@@ -225,7 +223,7 @@ func TestFeatureCollection(t *testing.T) {
 			fc: &FeatureCollection{
 				Features: []*Feature{
 					{
-						Geometry: geom.NewPoint(geom.XY).MustSetCoords([]float64{125.6, 10.1}),
+						Geometry: mustPoint(125.6, 10.1),
 						Properties: map[string]interface{}{
 							"name": "Dinagat Islands",
 						},
@@ -238,20 +236,20 @@ func TestFeatureCollection(t *testing.T) {
 			fc: &FeatureCollection{
 				Features: []*Feature{
 					{
-						Geometry: geom.NewPoint(geom.XY).MustSetCoords([]float64{125.6, 10.1}),
+						Geometry: mustPoint(125.6, 10.1),
 						Properties: map[string]interface{}{
 							"name": "Dinagat Islands",
 						},
 					},
 					{
-						Geometry: geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{102, 0}, {103, 1}, {104, 0}, {105, 1}}),
+						Geometry: mustLineString([][]float64{{102, 0}, {103, 1}, {104, 0}, {105, 1}}),
 						Properties: map[string]interface{}{
 							"prop0": "value0",
 							"prop1": 0.0,
 						},
 					},
 					{
-						Geometry: geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{100, 0}, {101, 0}, {101, 1}, {100, 1}, {100, 0}}}),
+						Geometry: mustPolygon([][][]float64{{{100, 0}, {101, 0}, {101, 1}, {100, 1}, {100, 0}}}),
 						Properties: map[string]interface{}{
 							"prop0": "value0",
 							"prop1": map[string]interface{}{
@@ -271,8 +269,8 @@ func TestFeatureCollection(t *testing.T) {
 		if err := json.Unmarshal([]byte(tc.s), fc); err != nil {
 			t.Errorf("json.Unmarshal(%v, ...) == %v, want nil", tc.s, err)
 		}
-		if diff, equal := messagediff.PrettyDiff(tc.fc, fc); !equal {
-			t.Errorf("json.Unmarshal(%v, ...), diff\n%s", tc.s, diff)
-		}
+		b, err = json.Marshal(fc)
+		require.NoError(t, err)
+		require.JSONEq(t, tc.s, string(b))
 	}
 }
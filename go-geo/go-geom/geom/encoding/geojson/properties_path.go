@@ -0,0 +1,198 @@
+package geojson
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPath is returned when a property path can't be parsed
+	ErrInvalidPath = errors.New("geojson: invalid property path")
+
+	// ErrPathNotFound is returned when a property path doesn't match any value
+	ErrPathNotFound = errors.New("geojson: property path not found")
+)
+
+// pathElement is either a key in an object or an index in an array
+type pathElement struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (e pathElement) String() string {
+	if e.isIndex {
+		return fmt.Sprintf("[%d]", e.index)
+	}
+	return strconv.Quote(e.key)
+}
+
+// parsePath parses paths such as `a.b[2].c` or `a["key.with.dots"]`.
+func parsePath(path string) ([]pathElement, error) {
+	var elements []pathElement
+	invalid := func() error {
+		return fmt.Errorf("%w: %q", ErrInvalidPath, path)
+	}
+
+	for rest, first := path, true; first || len(rest) > 0; first = false {
+		switch {
+		case strings.HasPrefix(rest, `["`):
+			end := closingQuote(rest[2:])
+			if end < 0 || !strings.HasPrefix(rest[2+end+1:], "]") {
+				return nil, invalid()
+			}
+			key, err := strconv.Unquote(rest[1 : 2+end+1])
+			if err != nil {
+				return nil, invalid()
+			}
+			elements = append(elements, pathElement{key: key})
+			rest = rest[2+end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, invalid()
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, invalid()
+			}
+			elements = append(elements, pathElement{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			if !first {
+				if !strings.HasPrefix(rest, ".") {
+					return nil, invalid()
+				}
+				rest = rest[1:]
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, invalid()
+			}
+			elements = append(elements, pathElement{key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+	return elements, nil
+}
+
+// closingQuote yields the position of the first unescaped double quote in s, or -1
+func closingQuote(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// GetPath yields a nested property value, given a path such as `a.b[2].c`.
+//
+// Keys containing dots or brackets may be quoted, as in `a["b.c"]`.
+func (p Properties) GetPath(path string) (interface{}, error) {
+	elements, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var current interface{} = p
+	for _, e := range elements {
+		next, ok := child(current, e)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q at %v", ErrPathNotFound, path, e)
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// GetPathOK yields a nested property value, given a path such as `a.b[2].c`.
+// It returns false if the path is invalid or doesn't match any value.
+func (p Properties) GetPathOK(path string) (interface{}, bool) {
+	v, err := p.GetPath(path)
+	return v, err == nil
+}
+
+// SetPath sets a nested property value, given a path such as `a.b[2].c`.
+//
+// Missing intermediate objects are created, but arrays are not extended:
+// the index of an array element must exist.
+func (p Properties) SetPath(path string, value interface{}) error {
+	elements, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	var current interface{} = p
+	for i, e := range elements {
+		last := i == len(elements)-1
+		switch container := current.(type) {
+		case Properties:
+			current, err = setKey(container, e, elements[i:], last, value)
+		case map[string]interface{}:
+			current, err = setKey(container, e, elements[i:], last, value)
+		case []interface{}:
+			if !e.isIndex || e.index >= len(container) {
+				return fmt.Errorf("%w: %q at %v", ErrPathNotFound, path, e)
+			}
+			if last {
+				container[e.index] = value
+				return nil
+			}
+			current = container[e.index]
+		default:
+			return fmt.Errorf("%w: %q at %v", ErrPathNotFound, path, e)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %q at %v", err, path, e)
+		}
+	}
+	return nil
+}
+
+func setKey(container map[string]interface{}, e pathElement, elements []pathElement, last bool, value interface{}) (interface{}, error) {
+	if e.isIndex {
+		return nil, ErrPathNotFound
+	}
+	if last {
+		container[e.key] = value
+		return nil, nil
+	}
+	next, ok := container[e.key]
+	if !ok || next == nil {
+		if elements[1].isIndex {
+			return nil, ErrPathNotFound
+		}
+		next = make(map[string]interface{})
+		container[e.key] = next
+	}
+	return next, nil
+}
+
+func child(current interface{}, e pathElement) (interface{}, bool) {
+	switch container := current.(type) {
+	case Properties:
+		return child(map[string]interface{}(container), e)
+	case map[string]interface{}:
+		if e.isIndex {
+			return nil, false
+		}
+		v, ok := container[e.key]
+		return v, ok
+	case []interface{}:
+		if !e.isIndex || e.index >= len(container) {
+			return nil, false
+		}
+		return container[e.index], true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,74 @@
+package geojson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertiesGetPath(t *testing.T) {
+	p := Properties{
+		"a": map[string]interface{}{
+			"b": []interface{}{
+				"zero",
+				1.0,
+				map[string]interface{}{"c": "deep"},
+			},
+			"d.e": true,
+		},
+		"nested": Properties{"x": 1},
+	}
+
+	for path, want := range map[string]interface{}{
+		"a.b[0]":       "zero",
+		"a.b[1]":       1.0,
+		"a.b[2].c":     "deep",
+		`a["d.e"]`:     true,
+		`["a"]["d.e"]`: true,
+		"nested.x":     1,
+	} {
+		got, err := p.GetPath(path)
+		require.NoErrorf(t, err, "GetPath(%s)", path)
+		assert.Equalf(t, want, got, "GetPath(%s)", path)
+	}
+
+	for _, path := range []string{"a.b[3]", "a.x", "a[0]", "a.b.c", "a.b[2].c.d"} {
+		_, err := p.GetPath(path)
+		assert.Truef(t, errors.Is(err, ErrPathNotFound), "GetPath(%s): %v", path, err)
+		_, ok := p.GetPathOK(path)
+		assert.False(t, ok)
+	}
+
+	for _, path := range []string{"", "a..b", "a.b[", "a.b[x]", "a.b[-1]", `a["b]`, "a.b[0]c", "a."} {
+		_, err := p.GetPath(path)
+		assert.Truef(t, errors.Is(err, ErrInvalidPath), "GetPath(%s): %v", path, err)
+	}
+}
+
+func TestPropertiesSetPath(t *testing.T) {
+	p := Properties{
+		"a": map[string]interface{}{
+			"b": []interface{}{"zero", 1.0},
+		},
+	}
+
+	require.NoError(t, p.SetPath("a.b[1]", "one"))
+	require.NoError(t, p.SetPath("x.y.z", 2))
+	require.NoError(t, p.SetPath(`a["c.d"]`, nil))
+
+	assert.Equal(t, Properties{
+		"a": map[string]interface{}{
+			"b":   []interface{}{"zero", "one"},
+			"c.d": nil,
+		},
+		"x": map[string]interface{}{
+			"y": map[string]interface{}{"z": 2},
+		},
+	}, p)
+
+	assert.True(t, errors.Is(p.SetPath("a.b[2]", "two"), ErrPathNotFound))
+	assert.True(t, errors.Is(p.SetPath("n[0]", "two"), ErrPathNotFound))
+	assert.True(t, errors.Is(p.SetPath("a.b[0].c", "two"), ErrPathNotFound))
+}
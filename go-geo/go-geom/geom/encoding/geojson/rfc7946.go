@@ -0,0 +1,491 @@
+package geojson
+
+import (
+	"fmt"
+	"math"
+)
+
+// RFC 7946 constraints on coordinates, see https://tools.ietf.org/html/rfc7946
+
+// ErrRFC7946 is returned when some GeoJSON does not comply with RFC 7946.
+type ErrRFC7946 string
+
+func (e ErrRFC7946) Error() string {
+	return fmt.Sprintf("geojson: not RFC 7946 compliant: %s", string(e))
+}
+
+// wgs84SRID is the only SRID allowed by RFC 7946
+const wgs84SRID = 4326
+
+// RFC7946Precision is the number of decimals recommended by RFC 7946 for coordinates,
+// i.e. about 10 centimeters.
+const RFC7946Precision = 6
+
+// forEachPosition calls fn for all positions of some coordinates
+func forEachPosition(c *coordinates, fn func([]float64)) {
+	switch coords := c.Coords.(type) {
+	case []float64:
+		if len(coords) > 0 {
+			fn(coords)
+		}
+	case [][]float64:
+		for _, p := range coords {
+			fn(p)
+		}
+	case [][][]float64:
+		for _, line := range coords {
+			for _, p := range line {
+				fn(p)
+			}
+		}
+	case [][][][]float64:
+		for _, polygon := range coords {
+			for _, ring := range polygon {
+				for _, p := range ring {
+					fn(p)
+				}
+			}
+		}
+	}
+	for _, g := range c.Geometries {
+		forEachPosition(g, fn)
+	}
+}
+
+// computeBBox yields the bbox of some coordinates, as the min values of all axes
+// followed by the max values of all axes. It is nil when there is no position.
+func computeBBox(c *coordinates) []float64 {
+	var mins, maxs []float64
+	forEachPosition(c, func(p []float64) {
+		if mins == nil {
+			mins = append([]float64{}, p...)
+			maxs = append([]float64{}, p...)
+			return
+		}
+		if len(p) < len(mins) {
+			mins, maxs = mins[:len(p)], maxs[:len(p)]
+		}
+		for i := range mins {
+			mins[i] = math.Min(mins[i], p[i])
+			maxs[i] = math.Max(maxs[i], p[i])
+		}
+	})
+	if mins == nil {
+		return nil
+	}
+	return append(mins, maxs...)
+}
+
+// unionBBox yields a bbox covering both a and b
+func unionBBox(a, b []float64) []float64 {
+	if a == nil {
+		return b
+	}
+	if b == nil || len(a) != len(b) {
+		return a
+	}
+	n := len(a) / 2
+	union := make([]float64, len(a))
+	for i := 0; i < n; i++ {
+		union[i] = math.Min(a[i], b[i])
+		union[n+i] = math.Max(a[n+i], b[n+i])
+	}
+	return union
+}
+
+// ringSignedArea yields twice the signed area of a closed ring: it is positive when the
+// ring is counter-clockwise.
+func ringSignedArea(ring [][]float64) float64 {
+	var a float64
+	for i := 0; i+1 < len(ring); i++ {
+		a += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return a
+}
+
+// orientRing yields a ring with the required orientation, reversing a copy of it if needed
+func orientRing(ring [][]float64, counterClockwise bool) [][]float64 {
+	if a := ringSignedArea(ring); a == 0 || (a > 0) == counterClockwise {
+		return ring
+	}
+	reversed := make([][]float64, len(ring))
+	for i, p := range ring {
+		reversed[len(ring)-1-i] = p
+	}
+	return reversed
+}
+
+// applyRightHandRule orients the exterior ring of a polygon counter-clockwise and its holes clockwise
+func applyRightHandRule(polygon [][][]float64) [][][]float64 {
+	oriented := make([][][]float64, len(polygon))
+	for i, ring := range polygon {
+		oriented[i] = orientRing(ring, i == 0)
+	}
+	return oriented
+}
+
+// roundPosition yields a copy of a position, rounded to some decimals
+func roundPosition(p []float64, precision int) []float64 {
+	scale := math.Pow10(precision)
+	rounded := make([]float64, len(p))
+	for i, v := range p {
+		rounded[i] = math.Round(v*scale) / scale
+	}
+	return rounded
+}
+
+// mapPositions yields a copy of some coordinates, with all positions transformed by fn
+func mapPositions(c *coordinates, fn func([]float64) []float64) *coordinates {
+	mapLine := func(line [][]float64) [][]float64 {
+		mapped := make([][]float64, len(line))
+		for i, p := range line {
+			mapped[i] = fn(p)
+		}
+		return mapped
+	}
+	mapPolygon := func(polygon [][][]float64) [][][]float64 {
+		mapped := make([][][]float64, len(polygon))
+		for i, line := range polygon {
+			mapped[i] = mapLine(line)
+		}
+		return mapped
+	}
+
+	mapped := &coordinates{Type: c.Type}
+	switch coords := c.Coords.(type) {
+	case []float64:
+		mapped.Coords = fn(coords)
+	case [][]float64:
+		mapped.Coords = mapLine(coords)
+	case [][][]float64:
+		mapped.Coords = mapPolygon(coords)
+	case [][][][]float64:
+		polygons := make([][][][]float64, len(coords))
+		for i, polygon := range coords {
+			polygons[i] = mapPolygon(polygon)
+		}
+		mapped.Coords = polygons
+	}
+	for _, g := range c.Geometries {
+		mapped.Geometries = append(mapped.Geometries, mapPositions(g, fn))
+	}
+	return mapped
+}
+
+// cutLineString splits a line string wherever it crosses the antimeridian, i.e. whenever the
+// longitude jumps by more than 180 degrees between two consecutive positions.
+func cutLineString(line [][]float64) [][][]float64 {
+	parts := make([][][]float64, 0, 1)
+	current := make([][]float64, 0, len(line))
+	for i, p := range line {
+		if i > 0 {
+			prev := line[i-1]
+			if delta := p[0] - prev[0]; math.Abs(delta) > 180 {
+				// longitude of the antimeridian on the side of prev, and p unwrapped on this side
+				side := math.Copysign(180, prev[0])
+				lon := p[0] + 2*side
+				t := (side - prev[0]) / (lon - prev[0])
+				crossing := interpolate(prev, append([]float64{lon}, p[1:]...), t)
+
+				end := append([]float64{side}, crossing[1:]...)
+				start := append([]float64{-side}, crossing[1:]...)
+				parts = append(parts, append(current, end))
+				current = [][]float64{start}
+			}
+		}
+		current = append(current, p)
+	}
+	return append(parts, current)
+}
+
+func interpolate(a, b []float64, t float64) []float64 {
+	p := make([]float64, len(a))
+	for i := range p {
+		p[i] = a[i] + t*(b[i]-a[i])
+	}
+	return p
+}
+
+// unwrapRing makes the longitudes of a ring continuous, starting near some reference longitude,
+// so that no edge jumps by more than 180 degrees.
+//
+// It returns false if the ring can't be unwrapped, e.g. when it encloses a pole.
+func unwrapRing(ring [][]float64, reference float64) ([][]float64, bool) {
+	if len(ring) == 0 {
+		return ring, true
+	}
+	unwrapped := make([][]float64, len(ring))
+	offset := 360 * math.Round((reference-ring[0][0])/360)
+	for i, p := range ring {
+		if i > 0 {
+			prev := ring[i-1][0]
+			switch delta := p[0] - prev; {
+			case delta > 180:
+				offset -= 360
+			case delta < -180:
+				offset += 360
+			}
+		}
+		unwrapped[i] = append([]float64{p[0] + offset}, p[1:]...)
+	}
+	first, last := unwrapped[0][0], unwrapped[len(unwrapped)-1][0]
+	return unwrapped, math.Abs(first-last) < 1e-9
+}
+
+// clipRing clips a ring against the half-plane of longitudes lower than (west) or greater than (east)
+// some limit, using the Sutherland-Hodgman algorithm. The clipped ring is closed, or nil when empty.
+func clipRing(ring [][]float64, limit float64, west bool) [][]float64 {
+	inside := func(p []float64) bool {
+		if west {
+			return p[0] <= limit
+		}
+		return p[0] >= limit
+	}
+	var clipped [][]float64
+	for i := 0; i+1 < len(ring); i++ {
+		a, b := ring[i], ring[i+1]
+		if inside(a) {
+			clipped = append(clipped, a)
+		}
+		if inside(a) != inside(b) {
+			crossing := interpolate(a, b, (limit-a[0])/(b[0]-a[0]))
+			crossing[0] = limit
+			clipped = append(clipped, crossing)
+		}
+	}
+	if len(clipped) < 3 {
+		return nil
+	}
+	return append(clipped, clipped[0])
+}
+
+func shiftRing(ring [][]float64, offset float64) [][]float64 {
+	shifted := make([][]float64, len(ring))
+	for i, p := range ring {
+		shifted[i] = append([]float64{p[0] + offset}, p[1:]...)
+	}
+	return shifted
+}
+
+// cutPolygon splits a polygon which crosses the antimeridian into several polygons,
+// each lying within [-180, 180] longitudes.
+func cutPolygon(polygon [][][]float64) [][][][]float64 {
+	if len(polygon) == 0 || len(polygon[0]) == 0 {
+		return [][][][]float64{polygon}
+	}
+	reference := polygon[0][0][0]
+	unwrapped := make([][][]float64, len(polygon))
+	minLon, maxLon := math.Inf(1), math.Inf(-1)
+	for i, ring := range polygon {
+		var ok bool
+		if unwrapped[i], ok = unwrapRing(ring, reference); !ok {
+			return [][][][]float64{polygon}
+		}
+		for _, p := range unwrapped[i] {
+			minLon, maxLon = math.Min(minLon, p[0]), math.Max(maxLon, p[0])
+		}
+	}
+
+	var limit, offset float64
+	switch {
+	case maxLon > 180:
+		limit, offset = 180, -360
+	case minLon < -180:
+		limit, offset = -180, 360
+	default:
+		return [][][][]float64{polygon}
+	}
+
+	// the part which lies within the standard range, then the part to shift back into it
+	parts := make([][][][]float64, 0, 2)
+	for _, west := range []bool{limit > 0, limit < 0} {
+		exterior := clipRing(unwrapped[0], limit, west)
+		if exterior == nil {
+			continue
+		}
+		shift := 0.0
+		if !west == (limit > 0) {
+			shift = offset
+		}
+		part := [][][]float64{shiftRing(exterior, shift)}
+		for _, hole := range unwrapped[1:] {
+			if clipped := clipRing(hole, limit, west); clipped != nil {
+				part = append(part, shiftRing(clipped, shift))
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// cutAntimeridian splits the line strings and polygons which cross the antimeridian
+func cutAntimeridian(c *coordinates) *coordinates {
+	switch c.Type {
+	case TypeLineString:
+		parts := cutLineString(c.Coords.([][]float64))
+		if len(parts) == 1 {
+			return c
+		}
+		return &coordinates{Type: TypeMultiLineString, Coords: parts}
+	case TypeMultiLineString:
+		var parts [][][]float64
+		for _, line := range c.Coords.([][][]float64) {
+			parts = append(parts, cutLineString(line)...)
+		}
+		return &coordinates{Type: TypeMultiLineString, Coords: parts}
+	case TypePolygon:
+		parts := cutPolygon(c.Coords.([][][]float64))
+		if len(parts) == 1 {
+			return &coordinates{Type: TypePolygon, Coords: parts[0]}
+		}
+		return &coordinates{Type: TypeMultiPolygon, Coords: parts}
+	case TypeMultiPolygon:
+		var parts [][][][]float64
+		for _, polygon := range c.Coords.([][][][]float64) {
+			parts = append(parts, cutPolygon(polygon)...)
+		}
+		return &coordinates{Type: TypeMultiPolygon, Coords: parts}
+	case TypeGeometryCollection:
+		cut := &coordinates{Type: TypeGeometryCollection, Geometries: make([]*coordinates, len(c.Geometries))}
+		for i, g := range c.Geometries {
+			cut.Geometries[i] = cutAntimeridian(g)
+		}
+		return cut
+	default:
+		return c
+	}
+}
+
+// rightHandRule orients the rings of all polygons according to the right-hand rule
+func rightHandRule(c *coordinates) *coordinates {
+	switch c.Type {
+	case TypePolygon:
+		return &coordinates{Type: TypePolygon, Coords: applyRightHandRule(c.Coords.([][][]float64))}
+	case TypeMultiPolygon:
+		polygons := c.Coords.([][][][]float64)
+		oriented := make([][][][]float64, len(polygons))
+		for i, polygon := range polygons {
+			oriented[i] = applyRightHandRule(polygon)
+		}
+		return &coordinates{Type: TypeMultiPolygon, Coords: oriented}
+	case TypeGeometryCollection:
+		oriented := &coordinates{Type: TypeGeometryCollection, Geometries: make([]*coordinates, len(c.Geometries))}
+		for i, g := range c.Geometries {
+			oriented.Geometries[i] = rightHandRule(g)
+		}
+		return oriented
+	default:
+		return c
+	}
+}
+
+// validateCoordinates checks that some coordinates comply with RFC 7946
+func validateCoordinates(c *coordinates) error {
+	var err error
+	forEachPosition(c, func(p []float64) {
+		if err != nil {
+			return
+		}
+		err = validatePosition(p)
+	})
+	if err != nil {
+		return err
+	}
+
+	switch c.Type {
+	case TypeLineString:
+		return validateLineString(c.Coords.([][]float64))
+	case TypeMultiLineString:
+		for _, line := range c.Coords.([][][]float64) {
+			if err := validateLineString(line); err != nil {
+				return err
+			}
+		}
+	case TypePolygon:
+		return validatePolygon(c.Coords.([][][]float64))
+	case TypeMultiPolygon:
+		for _, polygon := range c.Coords.([][][][]float64) {
+			if err := validatePolygon(polygon); err != nil {
+				return err
+			}
+		}
+	case TypeGeometryCollection:
+		for _, g := range c.Geometries {
+			if err := validateCoordinates(g); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validatePosition(p []float64) error {
+	if len(p) < 2 || len(p) > 3 {
+		return ErrRFC7946(fmt.Sprintf("position with %d elements", len(p)))
+	}
+	if p[0] < -180 || p[0] > 180 || p[1] < -90 || p[1] > 90 {
+		return ErrRFC7946(fmt.Sprintf("position %v is not a WGS 84 longitude and latitude", p))
+	}
+	return nil
+}
+
+func validateLineString(line [][]float64) error {
+	if len(line) < 2 {
+		return ErrRFC7946(fmt.Sprintf("line string with %d positions", len(line)))
+	}
+	return nil
+}
+
+func validatePolygon(polygon [][][]float64) error {
+	for i, ring := range polygon {
+		if len(ring) < 4 {
+			return ErrRFC7946(fmt.Sprintf("linear ring with %d positions", len(ring)))
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if len(first) != len(last) || first[0] != last[0] || first[1] != last[1] {
+			return ErrRFC7946("linear ring is not closed")
+		}
+		if a := ringSignedArea(ring); a != 0 && (a > 0) != (i == 0) {
+			if i == 0 {
+				return ErrRFC7946("exterior ring is not counter-clockwise")
+			}
+			return ErrRFC7946("interior ring is not clockwise")
+		}
+	}
+	return nil
+}
+
+// validateBBox checks a bbox against the number of dimensions of the positions it covers,
+// unless dim is 0.
+func validateBBox(bbox []float64, dim int) error {
+	n := len(bbox) / 2
+	if len(bbox) != 4 && len(bbox) != 6 {
+		return ErrRFC7946(fmt.Sprintf("bbox with %d elements", len(bbox)))
+	}
+	if dim > 0 && len(bbox) != 2*dim {
+		return ErrRFC7946(fmt.Sprintf("bbox with %d elements, for positions with %d elements", len(bbox), dim))
+	}
+	// the west-most longitude may exceed the east-most one when the antimeridian is crossed
+	if bbox[1] > bbox[n+1] {
+		return ErrRFC7946("bbox south latitude exceeds north latitude")
+	}
+	if err := validatePosition(bbox[:n]); err != nil {
+		return err
+	}
+	return validatePosition(bbox[n:])
+}
+
+// dimensionOf yields the number of elements of the first position of some coordinates,
+// 0 if there is no position.
+func dimensionOf(c *coordinates) int {
+	if c == nil {
+		return 0
+	}
+	var dim int
+	forEachPosition(c, func(p []float64) {
+		if dim == 0 {
+			dim = len(p)
+		}
+	})
+	return dim
+}
@@ -0,0 +1,133 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRightHandRule(t *testing.T) {
+	clockwise := [][]float64{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}
+	counterClockwise := [][]float64{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole := [][]float64{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}
+
+	c := rightHandRule(&coordinates{Type: TypePolygon, Coords: [][][]float64{clockwise, hole}})
+	oriented := c.Coords.([][][]float64)
+	assert.Equal(t, counterClockwise, oriented[0])
+	assert.Greater(t, ringSignedArea(oriented[0]), 0.0)
+	assert.Less(t, ringSignedArea(oriented[1]), 0.0)
+	assert.NoError(t, validateCoordinates(c))
+
+	// the input is left untouched
+	assert.Equal(t, []float64{0, 10}, clockwise[1])
+}
+
+func TestCutLineString(t *testing.T) {
+	c := cutAntimeridian(&coordinates{
+		Type:   TypeLineString,
+		Coords: [][]float64{{170, 0}, {-170, 10}, {-160, 10}},
+	})
+	require.Equal(t, TypeMultiLineString, c.Type)
+	parts := c.Coords.([][][]float64)
+	require.Len(t, parts, 2)
+	assert.Equal(t, [][]float64{{170, 0}, {180, 5}}, parts[0])
+	assert.Equal(t, [][]float64{{-180, 5}, {-170, 10}, {-160, 10}}, parts[1])
+
+	same := &coordinates{Type: TypeLineString, Coords: [][]float64{{10, 0}, {20, 10}}}
+	assert.Same(t, same, cutAntimeridian(same))
+}
+
+func TestCutPolygon(t *testing.T) {
+	c := rightHandRule(cutAntimeridian(&coordinates{
+		Type: TypePolygon,
+		Coords: [][][]float64{
+			{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}},
+		},
+	}))
+	require.Equal(t, TypeMultiPolygon, c.Type)
+	polygons := c.Coords.([][][][]float64)
+	require.Len(t, polygons, 2)
+	assert.Equal(t, []float64{170, -10, 180, 10}, computeBBox(&coordinates{Type: TypePolygon, Coords: polygons[0]}))
+	assert.Equal(t, []float64{-180, -10, -170, 10}, computeBBox(&coordinates{Type: TypePolygon, Coords: polygons[1]}))
+	assert.NoError(t, validateCoordinates(c))
+}
+
+func TestComputeBBox(t *testing.T) {
+	c := &coordinates{
+		Type: TypeGeometryCollection,
+		Geometries: []*coordinates{
+			{Type: TypePoint, Coords: []float64{1, 2, 3}},
+			{Type: TypeLineString, Coords: [][]float64{{-1, 5, 0}, {4, -2, 1}}},
+		},
+	}
+	assert.Equal(t, []float64{-1, -2, 0, 4, 5, 3}, computeBBox(c))
+	assert.Nil(t, computeBBox(&coordinates{Type: TypePoint, Coords: []float64{}}))
+	assert.Equal(t, []float64{-1, -2, 4, 5}, unionBBox([]float64{0, 0, 4, 5}, []float64{-1, -2, 1, 1}))
+}
+
+func TestRoundPosition(t *testing.T) {
+	assert.Equal(t, []float64{1.123457, -2.5}, roundPosition([]float64{1.1234567, -2.5}, RFC7946Precision))
+	assert.Equal(t, []float64{1, 3}, roundPosition([]float64{1.4, 2.6}, 0))
+}
+
+func TestValidateCoordinates(t *testing.T) {
+	for _, tc := range []struct {
+		c   *coordinates
+		err error
+	}{
+		{
+			c:   &coordinates{Type: TypePoint, Coords: []float64{1, 2, 3, 4}},
+			err: ErrRFC7946("position with 4 elements"),
+		},
+		{
+			c:   &coordinates{Type: TypePoint, Coords: []float64{181, 2}},
+			err: ErrRFC7946("position [181 2] is not a WGS 84 longitude and latitude"),
+		},
+		{
+			c:   &coordinates{Type: TypeLineString, Coords: [][]float64{{1, 2}}},
+			err: ErrRFC7946("line string with 1 positions"),
+		},
+		{
+			c:   &coordinates{Type: TypePolygon, Coords: [][][]float64{{{0, 0}, {1, 0}, {0, 0}}}},
+			err: ErrRFC7946("linear ring with 3 positions"),
+		},
+		{
+			c:   &coordinates{Type: TypePolygon, Coords: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}}},
+			err: ErrRFC7946("linear ring is not closed"),
+		},
+		{
+			c:   &coordinates{Type: TypePolygon, Coords: [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {0, 0}}}},
+			err: ErrRFC7946("exterior ring is not counter-clockwise"),
+		},
+		{
+			c: &coordinates{Type: TypeGeometryCollection, Geometries: []*coordinates{
+				{Type: TypePolygon, Coords: [][][]float64{
+					{{0, 0}, {10, 0}, {10, 10}, {0, 0}},
+					{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+				}},
+			}},
+			err: ErrRFC7946("interior ring is not clockwise"),
+		},
+		{
+			c: &coordinates{Type: TypeMultiPolygon, Coords: [][][][]float64{
+				{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, {{1, 1}, {2, 2}, {2, 1}, {1, 1}}},
+			}},
+		},
+	} {
+		assert.Equal(t, tc.err, validateCoordinates(tc.c))
+	}
+
+	assert.NoError(t, validateBBox([]float64{170, -10, -170, 10}, 2))
+	assert.NoError(t, validateBBox([]float64{170, -10, 0, -170, 10, 100}, 0))
+	assert.Equal(t, ErrRFC7946("bbox with 5 elements"), validateBBox([]float64{1, 2, 3, 4, 5}, 0))
+	assert.Equal(t,
+		ErrRFC7946("bbox with 6 elements, for positions with 2 elements"),
+		validateBBox([]float64{1, 2, 3, 4, 5, 6}, 2),
+	)
+	assert.Equal(t, ErrRFC7946("bbox south latitude exceeds north latitude"), validateBBox([]float64{0, 10, 1, -10}, 2))
+	assert.Equal(t,
+		ErrRFC7946("position [200 10] is not a WGS 84 longitude and latitude"),
+		validateBBox([]float64{0, 0, 200, 10}, 2),
+	)
+}
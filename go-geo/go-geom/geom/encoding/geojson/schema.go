@@ -0,0 +1,474 @@
+package geojson
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+const defaultMaxEnumValues = 10
+
+var (
+	// ErrMissingProperty is reported when a required property is absent
+	ErrMissingProperty = errors.New("missing property")
+
+	// ErrNullProperty is reported when a property which is not nullable is null
+	ErrNullProperty = errors.New("null value")
+
+	// ErrUnexpectedProperty is reported by a strict schema when a property is not declared
+	ErrUnexpectedProperty = errors.New("unexpected property")
+
+	// ErrNotInEnum is reported when a value is not one of the values allowed by the schema
+	ErrNotInEnum = errors.New("value not in enum")
+
+	// ErrInvalidEnum is reported when the enum of a property holds values which can't be compared,
+	// such as objects or arrays
+	ErrInvalidEnum = errors.New("enum values must be comparable")
+)
+
+// PropertyType is the type of the values of a feature property
+type PropertyType string
+
+// Property types, after the JSON types. PropertyTypeAny accepts any value.
+const (
+	PropertyTypeAny     PropertyType = "any"
+	PropertyTypeString  PropertyType = "string"
+	PropertyTypeInteger PropertyType = "integer"
+	PropertyTypeNumber  PropertyType = "number"
+	PropertyTypeBoolean PropertyType = "boolean"
+	PropertyTypeObject  PropertyType = "object"
+	PropertyTypeArray   PropertyType = "array"
+)
+
+// ErrPropertyType is reported when a value does not match the type declared by the schema
+type ErrPropertyType struct {
+	Expected PropertyType
+	Value    interface{}
+}
+
+func (e ErrPropertyType) Error() string {
+	return fmt.Sprintf("expected %s, but got %T: %v", e.Expected, e.Value, e.Value)
+}
+
+// A PropertyError reports an invalid property
+type PropertyError struct {
+	Name string
+	Err  error
+}
+
+func (e *PropertyError) Error() string {
+	return fmt.Sprintf("property %q: %v", e.Name, e.Err)
+}
+
+// Unwrap yields the reason why the property is invalid
+func (e *PropertyError) Unwrap() error {
+	return e.Err
+}
+
+// PropertyErrors reports all the invalid properties of a feature
+type PropertyErrors []*PropertyError
+
+func (e PropertyErrors) sort() {
+	sort.Slice(e, func(i, j int) bool { return e[i].Name < e[j].Name })
+}
+
+func (e PropertyErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "geojson: invalid properties: " + strings.Join(msgs, "; ")
+}
+
+// PropertySchema describes a feature property
+type PropertySchema struct {
+	Name     string
+	Type     PropertyType
+	Required bool
+	Nullable bool
+
+	// Enum holds the allowed values, if any.
+	//
+	// Values are normalized: int64 for integers, float64 for numbers.
+	// Values must be comparable: objects and arrays can't be enumerated.
+	Enum []interface{}
+}
+
+// A Schema describes the properties of features, sorted by name.
+type Schema struct {
+	Properties []PropertySchema
+
+	// Strict schemas reject undeclared properties
+	Strict bool
+}
+
+// InferOption configures the inference of a Schema
+type InferOption func(*inferOptions)
+
+type inferOptions struct {
+	maxEnumValues int
+}
+
+// WithMaxEnumValues sets the maximum number of distinct values of a string or integer
+// property for these values to be retained as an enum. The default is 10.
+//
+// A zero value disables enum inference.
+func WithMaxEnumValues(n int) InferOption {
+	return func(o *inferOptions) {
+		o.maxEnumValues = n
+	}
+}
+
+// propertyStats accumulates what is seen about a property while inferring a schema
+type propertyStats struct {
+	typ      PropertyType
+	count    int
+	nullable bool
+	values   map[interface{}]struct{}
+	order    []interface{}
+}
+
+// InferSchema infers the schema of the properties of the features of a collection.
+//
+// Properties present in all features are required. Integers and numbers are merged as numbers,
+// other mismatching types yield PropertyTypeAny. String and integer properties with only a few
+// distinct repeated values get enum candidates.
+func InferSchema(fc *FeatureCollection, opts ...InferOption) *Schema {
+	o := &inferOptions{maxEnumValues: defaultMaxEnumValues}
+	for _, apply := range opts {
+		apply(o)
+	}
+
+	stats := make(map[string]*propertyStats)
+	for _, f := range fc.Features {
+		if f == nil {
+			continue
+		}
+		for name, value := range f.Properties {
+			s, ok := stats[name]
+			if !ok {
+				s = &propertyStats{values: make(map[interface{}]struct{})}
+				stats[name] = s
+			}
+			s.count++
+			s.add(value, o.maxEnumValues)
+		}
+	}
+
+	schema := &Schema{Properties: make([]PropertySchema, 0, len(stats))}
+	for name, s := range stats {
+		ps := PropertySchema{
+			Name:     name,
+			Type:     s.typ,
+			Required: s.count == len(fc.Features),
+			Nullable: s.nullable,
+		}
+		if ps.Type == "" {
+			// only null values were seen
+			ps.Type = PropertyTypeAny
+		}
+		if s.values != nil && len(s.order) > 0 && len(s.order) < s.count &&
+			(ps.Type == PropertyTypeString || ps.Type == PropertyTypeInteger) {
+			ps.Enum = s.order
+		}
+		schema.Properties = append(schema.Properties, ps)
+	}
+	sort.Slice(schema.Properties, func(i, j int) bool {
+		return schema.Properties[i].Name < schema.Properties[j].Name
+	})
+	return schema
+}
+
+func (s *propertyStats) add(value interface{}, maxEnumValues int) {
+	typ, normalized := typeOfProperty(value)
+	if value == nil {
+		s.nullable = true
+		return
+	}
+
+	switch {
+	case s.typ == "":
+		s.typ = typ
+	case s.typ == typ || s.typ == PropertyTypeAny:
+	case isNumeric(s.typ) && isNumeric(typ):
+		s.typ = PropertyTypeNumber
+	default:
+		s.typ = PropertyTypeAny
+	}
+
+	if s.values == nil {
+		return
+	}
+	if typ != PropertyTypeString && typ != PropertyTypeInteger {
+		s.values = nil
+		return
+	}
+	if _, ok := s.values[normalized]; !ok {
+		if len(s.order) >= maxEnumValues {
+			s.values, s.order = nil, nil
+			return
+		}
+		s.values[normalized] = struct{}{}
+		s.order = append(s.order, normalized)
+	}
+}
+
+func isNumeric(typ PropertyType) bool {
+	return typ == PropertyTypeInteger || typ == PropertyTypeNumber
+}
+
+// typeOfProperty yields the type of a property value, and the value normalized for comparisons.
+//
+// Integral numbers are integers.
+func typeOfProperty(value interface{}) (PropertyType, interface{}) {
+	switch v := value.(type) {
+	case string:
+		return PropertyTypeString, v
+	case bool:
+		return PropertyTypeBoolean, v
+	case float64:
+		if swag.IsFloat64AJSONInteger(v) {
+			return PropertyTypeInteger, int64(v)
+		}
+		return PropertyTypeNumber, v
+	case float32:
+		return typeOfProperty(float64(v))
+	case int:
+		return PropertyTypeInteger, int64(v)
+	case int8:
+		return PropertyTypeInteger, int64(v)
+	case int16:
+		return PropertyTypeInteger, int64(v)
+	case int32:
+		return PropertyTypeInteger, int64(v)
+	case int64:
+		return PropertyTypeInteger, v
+	case uint:
+		return typeOfProperty(uint64(v))
+	case uint8:
+		return PropertyTypeInteger, int64(v)
+	case uint16:
+		return PropertyTypeInteger, int64(v)
+	case uint32:
+		return PropertyTypeInteger, int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			// not representable as an integer property
+			return PropertyTypeAny, v
+		}
+		return PropertyTypeInteger, int64(v)
+	case map[string]interface{}, Properties:
+		return PropertyTypeObject, v
+	case []interface{}:
+		return PropertyTypeArray, v
+	default:
+		return PropertyTypeAny, v
+	}
+}
+
+// Property yields the schema of a property.
+//
+// Properties are not required to be sorted by name: declared schemas may list them in any order.
+func (s *Schema) Property(name string) (*PropertySchema, bool) {
+	for i := range s.Properties {
+		if s.Properties[i].Name == name {
+			return &s.Properties[i], true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks some properties against the schema.
+//
+// It returns nil or PropertyErrors, sorted by property name.
+func (s *Schema) Validate(p Properties) error {
+	var errs PropertyErrors
+	for i := range s.Properties {
+		ps := &s.Properties[i]
+		value, ok := p[ps.Name]
+		if !ok {
+			if ps.Required {
+				errs = append(errs, &PropertyError{Name: ps.Name, Err: ErrMissingProperty})
+			}
+			continue
+		}
+		if err := ps.validate(value); err != nil {
+			errs = append(errs, &PropertyError{Name: ps.Name, Err: err})
+		}
+	}
+	errs = append(errs, s.unexpected(p)...)
+	if len(errs) == 0 {
+		return nil
+	}
+	errs.sort()
+	return errs
+}
+
+func (s *Schema) unexpected(p Properties) PropertyErrors {
+	if !s.Strict {
+		return nil
+	}
+	var errs PropertyErrors
+	for name := range p {
+		if _, ok := s.Property(name); !ok {
+			errs = append(errs, &PropertyError{Name: name, Err: ErrUnexpectedProperty})
+		}
+	}
+	return errs
+}
+
+func (ps *PropertySchema) validate(value interface{}) error {
+	if value == nil {
+		if ps.Nullable {
+			return nil
+		}
+		return ErrNullProperty
+	}
+
+	if err := ps.checkEnum(); err != nil {
+		return err
+	}
+
+	typ, normalized := typeOfProperty(value)
+	switch {
+	case ps.Type == PropertyTypeAny || ps.Type == typ:
+	case ps.Type == PropertyTypeNumber && typ == PropertyTypeInteger:
+		normalized = float64(normalized.(int64))
+	default:
+		return ErrPropertyType{Expected: ps.Type, Value: value}
+	}
+
+	if len(ps.Enum) == 0 {
+		return nil
+	}
+	for _, allowed := range ps.Enum {
+		if allowed == normalized {
+			return nil
+		}
+	}
+	return ErrNotInEnum
+}
+
+// checkEnum ensures that the enum values may be compared to property values, without panicking
+func (ps *PropertySchema) checkEnum() error {
+	for _, allowed := range ps.Enum {
+		if allowed != nil && !reflect.TypeOf(allowed).Comparable() {
+			return ErrInvalidEnum
+		}
+	}
+	return nil
+}
+
+// ValidateFeatures checks the properties of features against the schema.
+//
+// It reports a *FeatureError wrapping PropertyErrors for each invalid feature.
+func (s *Schema) ValidateFeatures(features []*Feature) []*FeatureError {
+	var errs []*FeatureError
+	for i, f := range features {
+		if f == nil {
+			continue
+		}
+		if err := s.Validate(f.Properties); err != nil {
+			errs = append(errs, &FeatureError{Index: i, Err: err})
+		}
+	}
+	return errs
+}
+
+// Coerce converts properties to the types declared by the schema, then validates them.
+//
+// Strings are parsed into integers, numbers and booleans, and scalars are formatted
+// into strings. Integers are yielded as int64 and numbers as float64.
+// Undeclared properties are copied as they are.
+func (s *Schema) Coerce(p Properties) (Properties, error) {
+	coerced := p.Clone()
+	var errs PropertyErrors
+	for i := range s.Properties {
+		ps := &s.Properties[i]
+		value, ok := p[ps.Name]
+		if !ok || value == nil {
+			continue
+		}
+		v, err := coerceProperty(value, ps.Type)
+		if err != nil {
+			errs = append(errs, &PropertyError{Name: ps.Name, Err: err})
+			continue
+		}
+		coerced[ps.Name] = v
+	}
+	if len(errs) > 0 {
+		errs.sort()
+		return nil, errs
+	}
+	if err := s.Validate(coerced); err != nil {
+		return nil, err
+	}
+	return coerced, nil
+}
+
+func coerceProperty(value interface{}, to PropertyType) (interface{}, error) {
+	typ, normalized := typeOfProperty(value)
+	fail := ErrPropertyType{Expected: to, Value: value}
+
+	switch to {
+	case PropertyTypeString:
+		switch typ {
+		case PropertyTypeString:
+			return normalized, nil
+		case PropertyTypeInteger:
+			return strconv.FormatInt(normalized.(int64), 10), nil
+		case PropertyTypeNumber:
+			return strconv.FormatFloat(normalized.(float64), 'g', -1, 64), nil
+		case PropertyTypeBoolean:
+			return strconv.FormatBool(normalized.(bool)), nil
+		}
+	case PropertyTypeInteger:
+		switch typ {
+		case PropertyTypeInteger:
+			return normalized, nil
+		case PropertyTypeString:
+			i, err := strconv.ParseInt(strings.TrimSpace(normalized.(string)), 10, 64)
+			if err != nil {
+				return nil, fail
+			}
+			return i, nil
+		}
+	case PropertyTypeNumber:
+		switch typ {
+		case PropertyTypeInteger:
+			return float64(normalized.(int64)), nil
+		case PropertyTypeNumber:
+			return normalized, nil
+		case PropertyTypeString:
+			f, err := strconv.ParseFloat(strings.TrimSpace(normalized.(string)), 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fail
+			}
+			return f, nil
+		}
+	case PropertyTypeBoolean:
+		switch typ {
+		case PropertyTypeBoolean:
+			return normalized, nil
+		case PropertyTypeString:
+			b, err := strconv.ParseBool(strings.TrimSpace(normalized.(string)))
+			if err != nil {
+				return nil, fail
+			}
+			return b, nil
+		}
+	case PropertyTypeObject, PropertyTypeArray:
+		if typ == to {
+			return value, nil
+		}
+	default:
+		return value, nil
+	}
+	return nil, fail
+}
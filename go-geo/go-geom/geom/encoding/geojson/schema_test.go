@@ -0,0 +1,146 @@
+package geojson
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func schemaFixture(t testing.TB) *FeatureCollection {
+	fc, err := FeatureCollectionFromJSON([]byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":null,"properties":{"name":"a","kind":"road","lanes":2,"width":3.5,"lit":true,"tags":{"x":1}}},
+		{"type":"Feature","geometry":null,"properties":{"name":"b","kind":"road","lanes":4,"width":7,"lit":null}},
+		{"type":"Feature","geometry":null,"properties":{"name":"c","kind":"path","lanes":1,"width":1.5,"mixed":"x"}},
+		{"type":"Feature","geometry":null,"properties":{"name":"d","kind":"path","lanes":1,"width":2,"mixed":1}}
+	]}`))
+	require.NoError(t, err)
+	return fc
+}
+
+func TestInferSchema(t *testing.T) {
+	schema := InferSchema(schemaFixture(t))
+
+	assert.Equal(t, []PropertySchema{
+		{Name: "kind", Type: PropertyTypeString, Required: true, Enum: []interface{}{"road", "path"}},
+		{Name: "lanes", Type: PropertyTypeInteger, Required: true, Enum: []interface{}{int64(2), int64(4), int64(1)}},
+		{Name: "lit", Type: PropertyTypeBoolean, Nullable: true},
+		{Name: "mixed", Type: PropertyTypeAny},
+		{Name: "name", Type: PropertyTypeString, Required: true},
+		{Name: "tags", Type: PropertyTypeObject},
+		{Name: "width", Type: PropertyTypeNumber, Required: true},
+	}, schema.Properties)
+
+	schema = InferSchema(schemaFixture(t), WithMaxEnumValues(2))
+	lanes, ok := schema.Property("lanes")
+	require.True(t, ok)
+	assert.Empty(t, lanes.Enum)
+	_, ok = schema.Property("unknown")
+	assert.False(t, ok)
+}
+
+func TestSchemaValidate(t *testing.T) {
+	schema := InferSchema(schemaFixture(t))
+
+	for _, f := range schemaFixture(t).Features {
+		assert.NoError(t, schema.Validate(f.Properties))
+	}
+
+	err := schema.Validate(Properties{"kind": "lane", "lanes": 2.5, "width": nil, "extra": true})
+	require.Error(t, err)
+	var errs PropertyErrors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 4)
+	assert.Equal(t, "kind", errs[0].Name)
+	assert.Equal(t, ErrNotInEnum, errs[0].Err)
+	assert.Equal(t, "lanes", errs[1].Name)
+	assert.Equal(t, ErrPropertyType{Expected: PropertyTypeInteger, Value: 2.5}, errs[1].Err)
+	assert.Equal(t, "name", errs[2].Name)
+	assert.True(t, errors.Is(errs[2], ErrMissingProperty))
+	assert.Equal(t, "width", errs[3].Name)
+	assert.Equal(t, ErrNullProperty, errs[3].Err)
+
+	schema.Strict = true
+	err = schema.Validate(Properties{"kind": "road", "lanes": 1, "name": "e", "width": 1, "extra": true})
+	assert.Equal(t, PropertyErrors{{Name: "extra", Err: ErrUnexpectedProperty}}, err)
+
+	report := schema.ValidateFeatures([]*Feature{
+		{Properties: Properties{"kind": "road", "lanes": 1, "name": "e", "width": 1}},
+		{Properties: Properties{"kind": "road", "lanes": 1, "width": 1}},
+	})
+	require.Len(t, report, 1)
+	assert.Equal(t, 1, report[0].Index)
+}
+
+func TestSchemaValidateEdgeCases(t *testing.T) {
+	schema := &Schema{Properties: []PropertySchema{
+		{Name: "i", Type: PropertyTypeInteger},
+		{Name: "tags", Type: PropertyTypeArray, Enum: []interface{}{[]interface{}{"a"}}},
+	}}
+
+	err := schema.Validate(Properties{"i": uint64(math.MaxInt64)})
+	assert.NoError(t, err)
+
+	err = schema.Validate(Properties{"i": uint64(math.MaxUint64), "tags": []interface{}{"a"}})
+	assert.Equal(t, PropertyErrors{
+		{Name: "i", Err: ErrPropertyType{Expected: PropertyTypeInteger, Value: uint64(math.MaxUint64)}},
+		{Name: "tags", Err: ErrInvalidEnum},
+	}, err)
+}
+
+func TestSchemaUnsorted(t *testing.T) {
+	schema := &Schema{
+		Properties: []PropertySchema{
+			{Name: "z", Type: PropertyTypeString, Required: true},
+			{Name: "b", Type: PropertyTypeBoolean},
+			{Name: "m", Type: PropertyTypeInteger},
+		},
+		Strict: true,
+	}
+
+	for _, name := range []string{"z", "b", "m"} {
+		ps, ok := schema.Property(name)
+		require.Truef(t, ok, name)
+		assert.Equal(t, name, ps.Name)
+	}
+	_, ok := schema.Property("a")
+	assert.False(t, ok)
+
+	assert.NoError(t, schema.Validate(Properties{"z": "x", "b": true, "m": 1}))
+
+	err := schema.Validate(Properties{"b": 1, "m": 1, "a": true})
+	assert.Equal(t, PropertyErrors{
+		{Name: "a", Err: ErrUnexpectedProperty},
+		{Name: "b", Err: ErrPropertyType{Expected: PropertyTypeBoolean, Value: 1}},
+		{Name: "z", Err: ErrMissingProperty},
+	}, err)
+
+	got, err := schema.Coerce(Properties{"z": 1, "b": "false", "m": "3"})
+	require.NoError(t, err)
+	assert.Equal(t, Properties{"z": "1", "b": false, "m": int64(3)}, got)
+}
+
+func TestSchemaCoerce(t *testing.T) {
+	schema := &Schema{Properties: []PropertySchema{
+		{Name: "b", Type: PropertyTypeBoolean},
+		{Name: "i", Type: PropertyTypeInteger, Required: true},
+		{Name: "n", Type: PropertyTypeNumber},
+		{Name: "s", Type: PropertyTypeString},
+	}}
+
+	got, err := schema.Coerce(Properties{"b": "true", "i": " 12 ", "n": 3.0, "s": 1.5, "other": "x"})
+	require.NoError(t, err)
+	assert.Equal(t, Properties{"b": true, "i": int64(12), "n": 3.0, "s": "1.5", "other": "x"}, got)
+	assert.Equal(t, int64(12), got.MustGetInt64("i"))
+
+	_, err = schema.Coerce(Properties{"i": "twelve", "b": 1.0})
+	assert.Equal(t, PropertyErrors{
+		{Name: "b", Err: ErrPropertyType{Expected: PropertyTypeBoolean, Value: 1.0}},
+		{Name: "i", Err: ErrPropertyType{Expected: PropertyTypeInteger, Value: "twelve"}},
+	}, err)
+
+	_, err = schema.Coerce(Properties{})
+	assert.Equal(t, PropertyErrors{{Name: "i", Err: ErrMissingProperty}}, err)
+}
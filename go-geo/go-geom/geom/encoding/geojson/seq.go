@@ -0,0 +1,179 @@
+package geojson
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+)
+
+// SequenceFormat tells how the features of a sequence are delimited
+type SequenceFormat uint8
+
+const (
+	// TextSequence is the RFC 8142 GeoJSON Text Sequence format (application/geo+json-seq):
+	// each record is preceded by an ASCII record separator (RS) and terminated by a line feed.
+	TextSequence SequenceFormat = iota
+
+	// NewlineDelimited is the newline-delimited JSON format (NDJSON): one feature per line.
+	NewlineDelimited
+)
+
+const recordSeparator = 0x1e
+
+func (f SequenceFormat) separator() byte {
+	if f == TextSequence {
+		return recordSeparator
+	}
+	return '\n'
+}
+
+// A SequenceReader decodes a sequence of GeoJSON features, such as a feature log.
+//
+// Records are independent: whenever a record is malformed, a *FeatureError is returned
+// and the reader resynchronizes on the next record.
+//
+// Reading may resume after io.EOF, e.g. when more records are appended to a file.
+// A record which is not terminated yet at the end of the input is kept buffered until
+// the rest of it is appended.
+type SequenceReader struct {
+	r       *bufio.Reader
+	format  SequenceFormat
+	index   int
+	started bool
+	pending []byte
+	err     error
+}
+
+// NewSequenceReader builds a SequenceReader, reading features from r in the given format.
+func NewSequenceReader(r io.Reader, format SequenceFormat, opts ...StreamOption) *SequenceReader {
+	o := defaultStreamOptions()
+	for _, apply := range opts {
+		apply(o)
+	}
+	return &SequenceReader{
+		r:      bufio.NewReaderSize(r, o.bufferSize),
+		format: format,
+	}
+}
+
+// Next yields the next feature of the sequence.
+//
+// It returns io.EOF at the end of the input. A *FeatureError is returned when a record
+// can't be decoded: the reader may then proceed with the next record. Any other error is final.
+func (sr *SequenceReader) Next(ctx context.Context) (*Feature, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	record, err := sr.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	index := sr.index
+	sr.index++
+
+	f := &Feature{}
+	if err := f.UnmarshalJSON(record); err != nil {
+		return nil, &FeatureError{Index: index, Err: err}
+	}
+	return f, nil
+}
+
+// readRecord yields the next non-empty record, without its delimiters.
+//
+// Reaching the end of the input is not final, so that a growing log may be tailed.
+func (sr *SequenceReader) readRecord() ([]byte, error) {
+	if sr.err != nil {
+		return nil, sr.err
+	}
+	sep := sr.format.separator()
+	for !sr.started && sr.format == TextSequence {
+		// any garbage before the first record separator is skipped
+		_, err := sr.r.ReadSlice(sep)
+		switch err {
+		case nil:
+			sr.started = true
+		case bufio.ErrBufferFull:
+		case io.EOF:
+			return nil, err
+		default:
+			sr.err = err
+			return nil, err
+		}
+	}
+
+	for {
+		chunk, err := sr.r.ReadBytes(sep)
+		switch err {
+		case nil:
+			chunk = chunk[:len(chunk)-1]
+		case io.EOF:
+			// the record may be partially written: wait for the rest of it
+			sr.pending = append(sr.pending, chunk...)
+			if !sr.isTerminated(sr.pending) {
+				return nil, err
+			}
+			chunk = nil
+		default:
+			sr.err = err
+			return nil, err
+		}
+
+		record := bytes.TrimSpace(append(sr.pending, chunk...))
+		sr.pending = nil
+		if len(record) > 0 {
+			return record, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isTerminated tells if a record which is not followed by a delimiter is complete nevertheless.
+//
+// This is the case of the last record of a text sequence, which is terminated by a line feed,
+// and of a valid last line of a newline-delimited sequence, which may lack its line feed.
+func (sr *SequenceReader) isTerminated(record []byte) bool {
+	if sr.format == TextSequence && !bytes.HasSuffix(record, []byte{'\n'}) {
+		return false
+	}
+	return json.Valid(record)
+}
+
+// A SequenceWriter encodes features as a sequence, one record at a time.
+//
+// Each record is written to the underlying writer with a single call to Write,
+// so that appending to a feature log never interleaves partial records.
+type SequenceWriter struct {
+	w      io.Writer
+	format SequenceFormat
+	buf    bytes.Buffer
+}
+
+// NewSequenceWriter builds a SequenceWriter, writing features to w in the given format.
+func NewSequenceWriter(w io.Writer, format SequenceFormat) *SequenceWriter {
+	return &SequenceWriter{
+		w:      w,
+		format: format,
+	}
+}
+
+// Write appends a feature to the sequence.
+func (sw *SequenceWriter) Write(f *Feature) error {
+	data, err := f.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	sw.buf.Reset()
+	if sw.format == TextSequence {
+		sw.buf.WriteByte(recordSeparator)
+	}
+	sw.buf.Write(data)
+	sw.buf.WriteByte('\n')
+
+	_, err = sw.w.Write(sw.buf.Bytes())
+	return err
+}
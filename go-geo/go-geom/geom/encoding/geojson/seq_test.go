@@ -0,0 +1,140 @@
+package geojson
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readSequence(t *testing.T, sr *SequenceReader) ([]interface{}, []int) {
+	var (
+		ids    []interface{}
+		failed []int
+	)
+	for {
+		f, err := sr.Next(context.Background())
+		if err == io.EOF {
+			return ids, failed
+		}
+		var featureErr *FeatureError
+		if errors.As(err, &featureErr) {
+			failed = append(failed, featureErr.Index)
+			continue
+		}
+		require.NoError(t, err)
+		ids = append(ids, f.ID)
+	}
+}
+
+func TestSequenceReader(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		format SequenceFormat
+		input  string
+	}{
+		{
+			name:   "text sequence",
+			format: TextSequence,
+			input: "garbage\x1e{\"type\":\"Feature\",\"id\":\"a\",\"geometry\":null}\n" +
+				"\x1e{\"type\":\"Feature\",\"id\":\"b\",\"geom\n" + // truncated record
+				"\x1e\n" +
+				"\x1e{\"type\":\"Feature\",\"id\":\"c\",\"geometry\":null}\n",
+		},
+		{
+			name:   "newline delimited",
+			format: NewlineDelimited,
+			input: "{\"type\":\"Feature\",\"id\":\"a\",\"geometry\":null}\n" +
+				"{\"type\":\"Feature\",\"id\":\"b\",\"geom\n" + // truncated record
+				"\n" +
+				"{\"type\":\"Feature\",\"id\":\"c\",\"geometry\":null}\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ids, failed := readSequence(t, NewSequenceReader(strings.NewReader(tc.input), tc.format, WithBufferSize(16)))
+			assert.Equal(t, []interface{}{"a", "c"}, ids)
+			assert.Equal(t, []int{1}, failed)
+		})
+	}
+}
+
+func TestSequenceTail(t *testing.T) {
+	var log bytes.Buffer
+	sw := NewSequenceWriter(&log, TextSequence)
+	sr := NewSequenceReader(&log, TextSequence)
+
+	require.NoError(t, sw.Write(&Feature{ID: "a"}))
+	ids, _ := readSequence(t, sr)
+	assert.Equal(t, []interface{}{"a"}, ids)
+
+	require.NoError(t, sw.Write(&Feature{ID: "b"}))
+	require.NoError(t, sw.Write(&Feature{ID: "c"}))
+	ids, _ = readSequence(t, sr)
+	assert.Equal(t, []interface{}{"b", "c"}, ids)
+}
+
+func TestSequenceTailPartialRecord(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		format SequenceFormat
+		first  string
+		second string
+	}{
+		{
+			name:   "text sequence",
+			format: TextSequence,
+			first:  "\x1e{\"type\":\"Feature\",\"id\":\"a\",\"geometry\":null}\n\x1e{\"type\":\"Feature\",",
+			second: "\"id\":\"b\",\"geometry\":null}\n",
+		},
+		{
+			name:   "newline delimited",
+			format: NewlineDelimited,
+			first:  "{\"type\":\"Feature\",\"id\":\"a\",\"geometry\":null}\n{\"type\":\"Feature\",",
+			second: "\"id\":\"b\",\"geometry\":null}\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var log bytes.Buffer
+			sr := NewSequenceReader(&log, tc.format, WithBufferSize(16))
+
+			log.WriteString(tc.first)
+			ids, failed := readSequence(t, sr)
+			assert.Equal(t, []interface{}{"a"}, ids)
+			assert.Empty(t, failed)
+
+			log.WriteString(tc.second)
+			ids, failed = readSequence(t, sr)
+			assert.Equal(t, []interface{}{"b"}, ids)
+			assert.Empty(t, failed)
+		})
+	}
+}
+
+func TestSequenceReaderLastLine(t *testing.T) {
+	// the last line of a newline-delimited sequence may lack its line feed
+	input := "{\"type\":\"Feature\",\"id\":\"a\",\"geometry\":null}\n" +
+		"{\"type\":\"Feature\",\"id\":\"b\",\"geometry\":null}"
+	ids, failed := readSequence(t, NewSequenceReader(strings.NewReader(input), NewlineDelimited, WithBufferSize(16)))
+	assert.Equal(t, []interface{}{"a", "b"}, ids)
+	assert.Empty(t, failed)
+}
+
+func TestSequenceWriter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewSequenceWriter(&buf, TextSequence).Write(&Feature{ID: "a"}))
+	assert.Equal(t, "\x1e{\"id\":\"a\",\"type\":\"Feature\",\"geometry\":null,\"properties\":null}\n", buf.String())
+
+	buf.Reset()
+	sw := NewSequenceWriter(&buf, NewlineDelimited)
+	require.NoError(t, sw.Write(&Feature{ID: "a"}))
+	require.NoError(t, sw.Write(&Feature{ID: "b"}))
+	assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+	assert.NotContains(t, buf.String(), "\x1e")
+}
@@ -0,0 +1,322 @@
+package geojson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const defaultStreamBufferSize = 64 * 1024
+
+// ErrFeatureWriterClosed is returned when writing to a closed FeatureWriter.
+var ErrFeatureWriterClosed = errors.New("geojson: write to closed FeatureWriter")
+
+// A FeatureError is returned by a FeatureReader when a feature of the stream could
+// not be decoded. The FeatureReader may still be used to read the next features.
+type FeatureError struct {
+	Index int
+	Err   error
+}
+
+func (e *FeatureError) Error() string {
+	return fmt.Sprintf("geojson: feature %d: %v", e.Index, e.Err)
+}
+
+// Unwrap yields the error which caused the feature to be rejected
+func (e *FeatureError) Unwrap() error {
+	return e.Err
+}
+
+// StreamOption configures a FeatureReader or a FeatureWriter
+type StreamOption func(*streamOptions)
+
+type streamOptions struct {
+	bufferSize int
+	bbox       *BBox
+}
+
+func defaultStreamOptions() *streamOptions {
+	return &streamOptions{bufferSize: defaultStreamBufferSize}
+}
+
+// WithBufferSize sets the size of the buffer used to read or write the stream.
+// The default is 64 KiB.
+func WithBufferSize(size int) StreamOption {
+	return func(o *streamOptions) {
+		if size > 0 {
+			o.bufferSize = size
+		}
+	}
+}
+
+// WithBBox sets the bbox of the feature collection written by a FeatureWriter.
+//
+// This option is ignored by a FeatureReader.
+func WithBBox(bbox *BBox) StreamOption {
+	return func(o *streamOptions) {
+		o.bbox = bbox
+	}
+}
+
+type readerState uint8
+
+const (
+	stateStart readerState = iota
+	stateFeatures
+	stateDone
+)
+
+// A FeatureReader decodes the features of a GeoJSON FeatureCollection one by one,
+// so that arbitrarily large collections may be processed in constant memory.
+//
+// The bbox and other members of the collection are read as they come: members
+// which follow the features are only known after the last feature has been read.
+type FeatureReader struct {
+	r      *ctxReader
+	iter   *jsoniter.Iterator
+	state  readerState
+	index  int
+	typ    ObjectType
+	bbox   *BBox
+	err    error
+	hasTyp bool
+
+	foreign map[string]interface{}
+}
+
+// NewFeatureReader builds a FeatureReader, reading a FeatureCollection from r.
+func NewFeatureReader(r io.Reader, opts ...StreamOption) *FeatureReader {
+	o := defaultStreamOptions()
+	for _, apply := range opts {
+		apply(o)
+	}
+	cr := &ctxReader{r: r, ctx: context.Background()}
+	return &FeatureReader{
+		r:    cr,
+		iter: jsoniter.Parse(json, cr, o.bufferSize),
+	}
+}
+
+// BBox yields the bbox of the feature collection, if it has been read already.
+func (fr *FeatureReader) BBox() *BBox {
+	return fr.bbox
+}
+
+// ForeignMembers yields the members of the feature collection which are not defined by RFC 7946,
+// if they have been read already.
+func (fr *FeatureReader) ForeignMembers() map[string]interface{} {
+	return fr.foreign
+}
+
+// Next yields the next feature of the collection.
+//
+// It returns io.EOF once all features have been read. A *FeatureError is returned
+// when a single feature can't be decoded: the reader may then proceed with the next feature.
+// Any other error is final.
+//
+// The context is checked whenever more input is needed, so that a canceled context interrupts
+// the reading of a long feature or of long collection members. Such an interruption is final.
+func (fr *FeatureReader) Next(ctx context.Context) (*Feature, error) {
+	if fr.err != nil {
+		return nil, fr.err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	fr.r.ctx = ctx
+	defer func() { fr.r.ctx = context.Background() }()
+
+	if fr.state == stateStart {
+		if fr.iter.WhatIsNext() != jsoniter.ObjectValue {
+			return nil, fr.fail(fmt.Errorf("geojson: expected a FeatureCollection object"))
+		}
+		if !fr.readMembers() {
+			return nil, fr.finish()
+		}
+		fr.state = stateFeatures
+	}
+
+	if fr.state == stateFeatures {
+		if fr.iter.ReadArray() {
+			raw := fr.iter.SkipAndReturnBytes()
+			if fr.iter.Error != nil {
+				return nil, fr.fail(fr.iter.Error)
+			}
+			index := fr.index
+			fr.index++
+
+			f := &Feature{}
+			if err := f.UnmarshalJSON(raw); err != nil {
+				return nil, &FeatureError{Index: index, Err: err}
+			}
+			return f, nil
+		}
+		if fr.iter.Error != nil {
+			return nil, fr.fail(fr.iter.Error)
+		}
+		if fr.readMembers() {
+			return nil, fr.fail(fmt.Errorf("geojson: duplicate features member in FeatureCollection"))
+		}
+		return nil, fr.finish()
+	}
+
+	return nil, io.EOF
+}
+
+// readMembers reads the members of the collection, up to the features array.
+//
+// It returns false when the end of the collection is reached, or when the collection
+// is known not to be a FeatureCollection.
+func (fr *FeatureReader) readMembers() bool {
+	for field := fr.iter.ReadObject(); field != ""; field = fr.iter.ReadObject() {
+		switch field {
+		case "features":
+			return true
+		case "type":
+			fr.typ = ObjectType(fr.iter.ReadString())
+			fr.hasTyp = true
+			if fr.iter.Error == nil && fr.typ != TypeFeatureCollection {
+				// fail early, rather than reading through a large object of the wrong type
+				fr.fail(ErrUnsupportedType(fr.typ))
+				return false
+			}
+		case "bbox":
+			fr.bbox = &BBox{}
+			fr.iter.ReadVal(fr.bbox)
+		default:
+			var v interface{}
+			fr.iter.ReadVal(&v)
+			if fr.foreign == nil {
+				fr.foreign = make(map[string]interface{})
+			}
+			fr.foreign[field] = v
+		}
+		if fr.iter.Error != nil {
+			return false
+		}
+	}
+	return false
+}
+
+// finish checks the collection once it has been fully read
+func (fr *FeatureReader) finish() error {
+	if fr.err != nil {
+		return fr.err
+	}
+	if fr.iter.Error != nil {
+		return fr.fail(fr.iter.Error)
+	}
+	if !fr.hasTyp || fr.typ != TypeFeatureCollection {
+		return fr.fail(ErrUnsupportedType(fr.typ))
+	}
+	fr.state = stateDone
+	return io.EOF
+}
+
+func (fr *FeatureReader) fail(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	fr.err = err
+	return err
+}
+
+// ctxReader fails reading once its context is done
+type ctxReader struct {
+	r   io.Reader
+	ctx context.Context
+}
+
+func (cr *ctxReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
+
+// A FeatureWriter encodes a GeoJSON FeatureCollection incrementally, one feature at a time.
+//
+// Close must be called to terminate the collection.
+type FeatureWriter struct {
+	stream     *jsoniter.Stream
+	bufferSize int
+	bbox       *BBox
+	count      int
+	closed     bool
+}
+
+// NewFeatureWriter builds a FeatureWriter, writing a FeatureCollection to w.
+func NewFeatureWriter(w io.Writer, opts ...StreamOption) *FeatureWriter {
+	o := defaultStreamOptions()
+	for _, apply := range opts {
+		apply(o)
+	}
+	return &FeatureWriter{
+		stream:     jsoniter.NewStream(json, w, o.bufferSize),
+		bufferSize: o.bufferSize,
+		bbox:       o.bbox,
+	}
+}
+
+// Write appends a feature to the collection.
+func (fw *FeatureWriter) Write(f *Feature) error {
+	if fw.closed {
+		return ErrFeatureWriterClosed
+	}
+	if fw.count == 0 {
+		fw.writeHeader()
+	} else {
+		fw.stream.WriteMore()
+	}
+	fw.count++
+
+	fw.stream.WriteVal(f)
+	if fw.stream.Error != nil {
+		return fw.stream.Error
+	}
+	if fw.stream.Buffered() >= fw.bufferSize {
+		return fw.stream.Flush()
+	}
+	return nil
+}
+
+// Count yields the number of features written so far.
+func (fw *FeatureWriter) Count() int {
+	return fw.count
+}
+
+// Close terminates the collection and flushes it to the underlying writer.
+//
+// It does not close the underlying writer.
+func (fw *FeatureWriter) Close() error {
+	if fw.closed {
+		return nil
+	}
+	fw.closed = true
+	if fw.count == 0 {
+		fw.writeHeader()
+	}
+	fw.stream.WriteArrayEnd()
+	fw.stream.WriteObjectEnd()
+	if fw.stream.Error != nil {
+		return fw.stream.Error
+	}
+	return fw.stream.Flush()
+}
+
+func (fw *FeatureWriter) writeHeader() {
+	fw.stream.WriteObjectStart()
+	fw.stream.WriteObjectField("type")
+	fw.stream.WriteString(string(TypeFeatureCollection))
+	fw.stream.WriteMore()
+	if fw.bbox != nil {
+		fw.stream.WriteObjectField("bbox")
+		fw.stream.WriteVal(fw.bbox)
+		fw.stream.WriteMore()
+	}
+	fw.stream.WriteObjectField("features")
+	fw.stream.WriteArrayStart()
+}
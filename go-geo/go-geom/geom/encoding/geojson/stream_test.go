@@ -0,0 +1,190 @@
+package geojson
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const streamedCollection = `{
+  "bbox": [100, 0, 105, 1],
+  "features": [
+    {"type": "Feature", "id": 1, "geometry": {"type": "Point", "coordinates": [102.0, 0.5]}, "properties": {"prop0": "value0"}},
+    {"type": "Feature", "id": 2, "geometry": {"type": "Circle", "coordinates": [102.0, 0.5]}, "properties": null},
+    {"type": "Feature", "id": 3, "geometry": {"type": "LineString", "coordinates": [[102.0, 0.0], [103.0, 1.0]]}, "properties": {"prop1": 0}}
+  ],
+  "type": "FeatureCollection",
+  "foreign": {"kept": [1, 2, 3]}
+}`
+
+func readAllFeatures(t *testing.T, fr *FeatureReader) ([]*Feature, []error) {
+	var (
+		features []*Feature
+		errs     []error
+	)
+	for {
+		f, err := fr.Next(context.Background())
+		if err == io.EOF {
+			return features, errs
+		}
+		var featureErr *FeatureError
+		if errors.As(err, &featureErr) {
+			errs = append(errs, err)
+			continue
+		}
+		require.NoError(t, err)
+		features = append(features, f)
+	}
+}
+
+func TestFeatureReader(t *testing.T) {
+	fr := NewFeatureReader(strings.NewReader(streamedCollection), WithBufferSize(16))
+	features, errs := readAllFeatures(t, fr)
+
+	require.Len(t, features, 2)
+	assert.EqualValues(t, 1, features[0].ID)
+	assert.EqualValues(t, 3, features[1].ID)
+	assert.Equal(t, "value0", features[0].Properties["prop0"])
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, 1, errs[0].(*FeatureError).Index)
+	assert.Equal(t, ErrUnsupportedType("Circle"), errors.Unwrap(errs[0]))
+
+	require.NotNil(t, fr.BBox())
+	assert.Equal(t, map[string]interface{}{
+		"foreign": map[string]interface{}{"kept": []interface{}{1.0, 2.0, 3.0}},
+	}, fr.ForeignMembers())
+
+	_, err := fr.Next(context.Background())
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestFeatureReaderErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		json string
+		want error
+	}{
+		{name: "truncated", json: `{"type": "FeatureCollection", "features": [{"type": "Feature"`, want: io.ErrUnexpectedEOF},
+		{name: "not a collection", json: `{"type": "Feature", "features": []}`, want: ErrUnsupportedType(TypeFeature)},
+		{name: "missing type", json: `{"features": []}`, want: ErrUnsupportedType("")},
+	} {
+		fr := NewFeatureReader(strings.NewReader(tc.json))
+		var err error
+		for err == nil {
+			_, err = fr.Next(context.Background())
+		}
+		if tc.want == io.ErrUnexpectedEOF {
+			assert.Errorf(t, err, tc.name)
+			assert.NotEqualf(t, io.EOF, err, tc.name)
+			continue
+		}
+		assert.Equalf(t, tc.want, err, tc.name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := NewFeatureReader(strings.NewReader(streamedCollection)).Next(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+// cancelingReader cancels a context once it has yielded some input
+type cancelingReader struct {
+	r      io.Reader
+	after  int
+	cancel context.CancelFunc
+}
+
+func (cr *cancelingReader) Read(p []byte) (int, error) {
+	if cr.after <= 0 {
+		cr.cancel()
+	}
+	n, err := cr.r.Read(p)
+	cr.after -= n
+	return n, err
+}
+
+func TestFeatureReaderFailsEarly(t *testing.T) {
+	t.Run("with a top-level type other than FeatureCollection", func(t *testing.T) {
+		// the object is never terminated: the type must be checked before reading any further
+		r := io.MultiReader(
+			strings.NewReader(`{"type": "Feature", "properties": {"p": [`),
+			&repeatReader{b: []byte("1,")},
+		)
+		_, err := NewFeatureReader(r, WithBufferSize(16)).Next(context.Background())
+		assert.Equal(t, ErrUnsupportedType(TypeFeature), err)
+	})
+
+	t.Run("with a context canceled while reading a feature", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r := &cancelingReader{
+			r: io.MultiReader(
+				strings.NewReader(`{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"p": [`),
+				&repeatReader{b: []byte("1,")},
+			),
+			after:  256,
+			cancel: cancel,
+		}
+		fr := NewFeatureReader(r, WithBufferSize(16))
+		_, err := fr.Next(ctx)
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		_, err = fr.Next(context.Background())
+		assert.True(t, errors.Is(err, context.Canceled), "an interruption is final")
+	})
+}
+
+// repeatReader yields the same bytes forever
+type repeatReader struct {
+	b []byte
+}
+
+func (rr *repeatReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		n += copy(p[n:], rr.b)
+	}
+	return n, nil
+}
+
+func TestFeatureWriter(t *testing.T) {
+	var buf bytes.Buffer
+	fw := NewFeatureWriter(&buf, WithBufferSize(8))
+	require.NoError(t, fw.Write(&Feature{ID: "a"}))
+	require.NoError(t, fw.Write(&Feature{ID: "b", Properties: Properties{"k": "v"}}))
+	require.NoError(t, fw.Close())
+	assert.Equal(t, 2, fw.Count())
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[
+		{"id":"a","type":"Feature","geometry":null,"properties":null},
+		{"id":"b","type":"Feature","geometry":null,"properties":{"k":"v"}}
+	]}`, buf.String())
+	assert.Equal(t, ErrFeatureWriterClosed, fw.Write(&Feature{}))
+
+	buf.Reset()
+	fw = NewFeatureWriter(&buf)
+	require.NoError(t, fw.Close())
+	assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, buf.String())
+}
+
+func TestFeatureWriterReaderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	fw := NewFeatureWriter(&buf)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, fw.Write(&Feature{ID: float64(i)}))
+	}
+	require.NoError(t, fw.Close())
+
+	features, errs := readAllFeatures(t, NewFeatureReader(&buf, WithBufferSize(32)))
+	require.Empty(t, errs)
+	require.Len(t, features, 100)
+	for i, f := range features {
+		assert.EqualValues(t, i, f.ID)
+	}
+}
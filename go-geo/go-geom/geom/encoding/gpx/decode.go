@@ -0,0 +1,107 @@
+package gpx
+
+import (
+	"math"
+
+	"github.com/twpayne/go-geom"
+)
+
+func decode(doc *gpxType) *GPX {
+	g := &GPX{
+		Creator:     doc.Creator,
+		Name:        doc.Name,
+		Description: doc.Desc,
+	}
+	if doc.Metadata != nil {
+		g.Name = doc.Metadata.Name
+		g.Description = doc.Metadata.Desc
+	}
+
+	for _, wpt := range doc.Waypoints {
+		points := []wptType{wpt}
+		layout := layoutOf(points)
+		g.Waypoints = append(g.Waypoints, &Waypoint{
+			Point:       geom.NewPointFlat(layout, appendFlatCoords(nil, points, layout)),
+			Name:        wpt.Name,
+			Comment:     wpt.Cmt,
+			Description: wpt.Desc,
+			Symbol:      wpt.Sym,
+			Type:        wpt.Type,
+		})
+	}
+
+	for _, rte := range doc.Routes {
+		layout := layoutOf(rte.Points)
+		g.Routes = append(g.Routes, &Route{
+			LineString:  geom.NewLineStringFlat(layout, appendFlatCoords(nil, rte.Points, layout)),
+			Name:        rte.Name,
+			Comment:     rte.Cmt,
+			Description: rte.Desc,
+			Type:        rte.Type,
+		})
+	}
+
+	for _, trk := range doc.Tracks {
+		var all []wptType
+		for _, seg := range trk.Segments {
+			all = append(all, seg.Points...)
+		}
+		layout := layoutOf(all)
+		var flatCoords []float64
+		ends := make([]int, 0, len(trk.Segments))
+		for _, seg := range trk.Segments {
+			flatCoords = appendFlatCoords(flatCoords, seg.Points, layout)
+			ends = append(ends, len(flatCoords))
+		}
+		g.Tracks = append(g.Tracks, &Track{
+			MultiLineString: geom.NewMultiLineStringFlat(layout, flatCoords, ends),
+			Name:            trk.Name,
+			Comment:         trk.Cmt,
+			Description:     trk.Desc,
+			Type:            trk.Type,
+		})
+	}
+
+	return g
+}
+
+// layoutOf yields the layout able to represent the elevations and timestamps of some points.
+func layoutOf(points []wptType) geom.Layout {
+	var hasZ, hasM bool
+	for _, p := range points {
+		hasZ = hasZ || p.Ele != nil
+		hasM = hasM || p.Time != nil
+	}
+	switch {
+	case hasZ && hasM:
+		return geom.XYZM
+	case hasZ:
+		return geom.XYZ
+	case hasM:
+		return geom.XYM
+	default:
+		return geom.XY
+	}
+}
+
+func appendFlatCoords(flatCoords []float64, points []wptType, layout geom.Layout) []float64 {
+	zIndex, mIndex := layout.ZIndex(), layout.MIndex()
+	for _, p := range points {
+		coord := make([]float64, layout.Stride())
+		coord[0], coord[1] = float64(p.Lon), float64(p.Lat)
+		if zIndex >= 0 {
+			coord[zIndex] = math.NaN()
+			if p.Ele != nil {
+				coord[zIndex] = float64(*p.Ele)
+			}
+		}
+		if mIndex >= 0 {
+			coord[mIndex] = math.NaN()
+			if p.Time != nil {
+				coord[mIndex] = M(*p.Time)
+			}
+		}
+		flatCoords = append(flatCoords, coord...)
+	}
+	return flatCoords
+}
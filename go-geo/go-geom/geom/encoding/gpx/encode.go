@@ -0,0 +1,91 @@
+package gpx
+
+import (
+	"errors"
+	"math"
+
+	"github.com/twpayne/go-geom"
+)
+
+var errEmptyWaypoint = errors.New("gpx: a waypoint must have a location")
+
+func encode(g *GPX) (*gpxType, error) {
+	doc := &gpxType{
+		Xmlns:   Namespace,
+		Version: Version,
+		Creator: g.Creator,
+	}
+	if doc.Creator == "" {
+		doc.Creator = defaultCreator
+	}
+	if g.Name != "" || g.Description != "" {
+		doc.Metadata = &metadataType{Name: g.Name, Desc: g.Description}
+	}
+
+	for _, w := range g.Waypoints {
+		if w.Point == nil || w.Point.Empty() {
+			return nil, errEmptyWaypoint
+		}
+		points, err := pointsOf(w.Point.FlatCoords(), w.Point.Layout())
+		if err != nil {
+			return nil, err
+		}
+		wpt := points[0]
+		wpt.Name, wpt.Cmt, wpt.Desc, wpt.Sym, wpt.Type = w.Name, w.Comment, w.Description, w.Symbol, w.Type
+		doc.Waypoints = append(doc.Waypoints, wpt)
+	}
+
+	for _, r := range g.Routes {
+		rte := rteType{Name: r.Name, Cmt: r.Comment, Desc: r.Description, Type: r.Type}
+		if r.LineString != nil {
+			points, err := pointsOf(r.LineString.FlatCoords(), r.LineString.Layout())
+			if err != nil {
+				return nil, err
+			}
+			rte.Points = points
+		}
+		doc.Routes = append(doc.Routes, rte)
+	}
+
+	for _, t := range g.Tracks {
+		trk := trkType{Name: t.Name, Cmt: t.Comment, Desc: t.Description, Type: t.Type}
+		if mls := t.MultiLineString; mls != nil {
+			for i := 0; i < mls.NumLineStrings(); i++ {
+				ls := mls.LineString(i)
+				points, err := pointsOf(ls.FlatCoords(), ls.Layout())
+				if err != nil {
+					return nil, err
+				}
+				trk.Segments = append(trk.Segments, trksegType{Points: points})
+			}
+		}
+		doc.Tracks = append(doc.Tracks, trk)
+	}
+
+	return doc, nil
+}
+
+// pointsOf converts flat coordinates to GPX points. NaN elevations and timestamps are omitted.
+func pointsOf(flatCoords []float64, layout geom.Layout) ([]wptType, error) {
+	stride := layout.Stride()
+	if stride < 2 {
+		return nil, ErrUnsupportedLayout(layout)
+	}
+	zIndex, mIndex := layout.ZIndex(), layout.MIndex()
+	points := make([]wptType, 0, len(flatCoords)/stride)
+	for i := 0; i+stride <= len(flatCoords); i += stride {
+		coord := flatCoords[i : i+stride]
+		p := wptType{Lon: decimal(coord[0]), Lat: decimal(coord[1])}
+		if zIndex >= 0 && !math.IsNaN(coord[zIndex]) {
+			ele := decimal(coord[zIndex])
+			p.Ele = &ele
+		}
+		if mIndex >= 0 {
+			if ts, ok := Time(coord[mIndex]); ok {
+				p.Time = &ts
+			}
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
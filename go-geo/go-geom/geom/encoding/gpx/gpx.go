@@ -0,0 +1,132 @@
+// Package gpx implements GPX 1.1 encoding and decoding.
+//
+// Waypoints are decoded as points, routes as line strings and tracks as multi line strings,
+// with one line string per track segment.
+//
+// Elevations are stored as Z values and timestamps as M values, in seconds since the Unix epoch:
+// the layout of a geometry has a Z (resp. M) dimension when at least one of its points has an
+// elevation (resp. a timestamp). Missing elevations and timestamps are NaN.
+package gpx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/twpayne/go-geom"
+)
+
+const (
+	// Namespace is the XML namespace of GPX 1.1 documents.
+	Namespace = "http://www.topografix.com/GPX/1/1"
+
+	// Version is the version of the GPX documents produced.
+	Version = "1.1"
+
+	defaultCreator = "go-geom"
+)
+
+// ErrUnsupportedLayout is returned when a geometry layout can't be represented in GPX.
+type ErrUnsupportedLayout geom.Layout
+
+func (e ErrUnsupportedLayout) Error() string {
+	return fmt.Sprintf("gpx: unsupported layout %s", geom.Layout(e))
+}
+
+// GPX is a GPX document.
+type GPX struct {
+	Creator     string
+	Name        string
+	Description string
+	Waypoints   []*Waypoint
+	Routes      []*Route
+	Tracks      []*Track
+}
+
+// A Waypoint is a point of interest.
+type Waypoint struct {
+	Point       *geom.Point
+	Name        string
+	Comment     string
+	Description string
+	Symbol      string
+	Type        string
+}
+
+// A Route is an ordered list of points, leading to a destination.
+type Route struct {
+	LineString  *geom.LineString
+	Name        string
+	Comment     string
+	Description string
+	Type        string
+}
+
+// A Track is an ordered list of points describing a path, split in segments
+// where the recording was interrupted.
+type Track struct {
+	MultiLineString *geom.MultiLineString
+	Name            string
+	Comment         string
+	Description     string
+	Type            string
+}
+
+// Time converts an M value to a timestamp. It returns false when the M value is NaN.
+func Time(m float64) (time.Time, bool) {
+	if math.IsNaN(m) {
+		return time.Time{}, false
+	}
+	sec, frac := math.Modf(m)
+	return time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC(), true
+}
+
+// M converts a timestamp to an M value.
+func M(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+}
+
+// Marshal translates a GPX document to XML.
+func Marshal(g *GPX) ([]byte, error) {
+	doc, err := encode(g)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// Unmarshal translates a GPX 1.0 or 1.1 document.
+func Unmarshal(data []byte) (*GPX, error) {
+	var doc gpxType
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("gpx: %w", err)
+	}
+	return decode(&doc), nil
+}
+
+// decimal formats numbers without exponent, as required by the xsd:decimal type.
+type decimal float64
+
+func (d decimal) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(d), 'f', -1, 64), nil
+}
+
+func (d *decimal) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseFloat(string(bytes.TrimSpace(text)), 64)
+	if err != nil {
+		return err
+	}
+	*d = decimal(v)
+	return nil
+}
@@ -0,0 +1,131 @@
+package gpx
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx xmlns="http://www.topografix.com/GPX/1/1" version="1.1" creator="test">
+  <metadata>
+    <name>Morning run</name>
+    <link href="https://example.com"><text>skipped</text></link>
+  </metadata>
+  <wpt lat="48.85" lon="2.35">
+    <ele>35</ele>
+    <name>Paris</name>
+    <sym>Flag</sym>
+  </wpt>
+  <rte>
+    <name>Route</name>
+    <rtept lat="1" lon="2"></rtept>
+    <rtept lat="3" lon="4"></rtept>
+  </rte>
+  <trk>
+    <name>Track</name>
+    <trkseg>
+      <trkpt lat="10" lon="20"><ele>100.5</ele><time>2020-06-01T08:00:00Z</time></trkpt>
+      <trkpt lat="11" lon="21"><time>2020-06-01T08:00:10.5Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="12" lon="22"><ele>101</ele></trkpt>
+    </trkseg>
+    <extensions><speed>3</speed></extensions>
+  </trk>
+</gpx>`
+
+func TestUnmarshal(t *testing.T) {
+	g, err := Unmarshal([]byte(testGPX))
+	require.NoError(t, err)
+	assert.Equal(t, "test", g.Creator)
+	assert.Equal(t, "Morning run", g.Name)
+
+	require.Len(t, g.Waypoints, 1)
+	w := g.Waypoints[0]
+	assert.Equal(t, "Paris", w.Name)
+	assert.Equal(t, "Flag", w.Symbol)
+	assert.Equal(t, geom.XYZ, w.Point.Layout())
+	assert.Equal(t, []float64{2.35, 48.85, 35}, w.Point.FlatCoords())
+
+	require.Len(t, g.Routes, 1)
+	assert.Equal(t, "Route", g.Routes[0].Name)
+	assert.Equal(t, geom.XY, g.Routes[0].LineString.Layout())
+	assert.Equal(t, []float64{2, 1, 4, 3}, g.Routes[0].LineString.FlatCoords())
+
+	require.Len(t, g.Tracks, 1)
+	mls := g.Tracks[0].MultiLineString
+	assert.Equal(t, geom.XYZM, mls.Layout())
+	assert.Equal(t, []int{8, 12}, mls.Ends())
+	start := M(time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC))
+	coords := mls.FlatCoords()
+	assert.Equal(t, []float64{20, 10, 100.5, start}, coords[0:4])
+	assert.Equal(t, []float64{21, 11}, coords[4:6])
+	assert.True(t, math.IsNaN(coords[6]))
+	assert.Equal(t, start+10.5, coords[7])
+	assert.Equal(t, []float64{22, 12, 101}, coords[8:11])
+	assert.True(t, math.IsNaN(coords[11]))
+}
+
+func TestUnmarshalGPX10(t *testing.T) {
+	g, err := Unmarshal([]byte(`<gpx xmlns="http://www.topografix.com/GPX/1/0" version="1.0">
+  <name>Old</name>
+  <wpt lat="1" lon="2"><time>2004-01-01T00:00:00Z</time></wpt>
+</gpx>`))
+	require.NoError(t, err)
+	assert.Equal(t, "Old", g.Name)
+	require.Len(t, g.Waypoints, 1)
+	assert.Equal(t, geom.XYM, g.Waypoints[0].Point.Layout())
+	ts, ok := Time(g.Waypoints[0].Point.FlatCoords()[2])
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2004, 1, 1, 0, 0, 0, 0, time.UTC), ts)
+}
+
+func TestRoundTrip(t *testing.T) {
+	start := M(time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC))
+	g := &GPX{
+		Creator: "test",
+		Name:    "Ride",
+		Waypoints: []*Waypoint{
+			{Point: geom.NewPointFlat(geom.XY, []float64{0.00001, -45.5}), Name: "Start"},
+		},
+		Routes: []*Route{
+			{LineString: geom.NewLineStringFlat(geom.XYZ, []float64{1, 2, 3, 4, 5, math.NaN()}), Name: "Route"},
+		},
+		Tracks: []*Track{
+			{
+				MultiLineString: geom.NewMultiLineStringFlat(geom.XYM, []float64{1, 2, start, 3, 4, start + 0.25, 5, 6, start + 60}, []int{6, 9}),
+				Name:            "Track",
+				Type:            "cycling",
+			},
+		},
+	}
+
+	data, err := Marshal(g)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<gpx xmlns="http://www.topografix.com/GPX/1/1" version="1.1" creator="test">`)
+	assert.Contains(t, string(data), `<wpt lat="-45.5" lon="0.00001">`)
+	assert.Contains(t, string(data), `<time>2020-06-01T08:00:00.25Z</time>`)
+
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, "Ride", decoded.Name)
+	assert.Equal(t, g.Waypoints, decoded.Waypoints)
+	assert.Equal(t, "Route", decoded.Routes[0].Name)
+	routeCoords := decoded.Routes[0].LineString.FlatCoords()
+	assert.Equal(t, []float64{1, 2, 3, 4, 5}, routeCoords[:5])
+	assert.True(t, math.IsNaN(routeCoords[5]))
+	assert.Equal(t, g.Tracks, decoded.Tracks)
+}
+
+func TestMarshalErrors(t *testing.T) {
+	_, err := Marshal(&GPX{Waypoints: []*Waypoint{{Point: geom.NewPointEmpty(geom.XY)}}})
+	assert.Error(t, err)
+
+	_, err = Unmarshal([]byte("<gpx"))
+	assert.Error(t, err)
+}
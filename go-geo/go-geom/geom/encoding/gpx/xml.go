@@ -0,0 +1,62 @@
+package gpx
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// The XML types follow the order of elements of the GPX 1.1 schema.
+// Elements not covered by this package, such as links and extensions, are skipped.
+
+type gpxType struct {
+	XMLName  xml.Name      `xml:"gpx"`
+	Xmlns    string        `xml:"xmlns,attr,omitempty"`
+	Version  string        `xml:"version,attr"`
+	Creator  string        `xml:"creator,attr"`
+	Metadata *metadataType `xml:"metadata"`
+
+	// GPX 1.0 has no metadata element
+	Name string `xml:"name,omitempty"`
+	Desc string `xml:"desc,omitempty"`
+
+	Waypoints []wptType `xml:"wpt"`
+	Routes    []rteType `xml:"rte"`
+	Tracks    []trkType `xml:"trk"`
+}
+
+type metadataType struct {
+	Name string `xml:"name,omitempty"`
+	Desc string `xml:"desc,omitempty"`
+}
+
+type wptType struct {
+	Lat  decimal    `xml:"lat,attr"`
+	Lon  decimal    `xml:"lon,attr"`
+	Ele  *decimal   `xml:"ele,omitempty"`
+	Time *time.Time `xml:"time,omitempty"`
+	Name string     `xml:"name,omitempty"`
+	Cmt  string     `xml:"cmt,omitempty"`
+	Desc string     `xml:"desc,omitempty"`
+	Sym  string     `xml:"sym,omitempty"`
+	Type string     `xml:"type,omitempty"`
+}
+
+type rteType struct {
+	Name   string    `xml:"name,omitempty"`
+	Cmt    string    `xml:"cmt,omitempty"`
+	Desc   string    `xml:"desc,omitempty"`
+	Type   string    `xml:"type,omitempty"`
+	Points []wptType `xml:"rtept"`
+}
+
+type trkType struct {
+	Name     string       `xml:"name,omitempty"`
+	Cmt      string       `xml:"cmt,omitempty"`
+	Desc     string       `xml:"desc,omitempty"`
+	Type     string       `xml:"type,omitempty"`
+	Segments []trksegType `xml:"trkseg"`
+}
+
+type trksegType struct {
+	Points []wptType `xml:"trkpt"`
+}
@@ -0,0 +1,421 @@
+package kml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+)
+
+var errNoRoot = errors.New("no root element")
+
+// pendingData is schema data, typed once all the schemas of the document are known.
+type pendingData struct {
+	placemark *Placemark
+	schemaURL string
+	data      simpleDataType
+}
+
+type decoder struct {
+	d        *xml.Decoder
+	doc      *Document
+	captured bool // the name and description of the document are captured
+	schemas  map[string]map[string]string
+	pending  []pendingData
+}
+
+func newDecoder(r io.Reader) *decoder {
+	return &decoder{
+		d:       xml.NewDecoder(r),
+		doc:     &Document{},
+		schemas: make(map[string]map[string]string),
+	}
+}
+
+func (dec *decoder) decode() error {
+	for {
+		tok, err := dec.d.Token()
+		if err == io.EOF {
+			return errNoRoot
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if err := dec.container(start.Name.Local == "Document"); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	for _, p := range dec.pending {
+		fields := dec.schemas[strings.TrimPrefix(p.schemaURL, "#")]
+		typ, ok := fields[p.data.Name]
+		if !ok {
+			p.placemark.Properties[p.data.Name] = p.data.Value
+			continue
+		}
+		p.placemark.Properties[p.data.Name] = parseTyped(typ, p.data.Value)
+	}
+	return nil
+}
+
+// container walks the children of the kml, Document and Folder elements.
+func (dec *decoder) container(capture bool) error {
+	if capture {
+		dec.captured = true
+	}
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "kml", "Folder":
+				err = dec.container(false)
+			case "Document":
+				err = dec.container(!dec.captured)
+			case "name":
+				if capture {
+					err = dec.d.DecodeElement(&dec.doc.Name, &t)
+				} else {
+					err = dec.d.Skip()
+				}
+			case "description":
+				if capture {
+					err = dec.d.DecodeElement(&dec.doc.Description, &t)
+				} else {
+					err = dec.d.Skip()
+				}
+			case "Schema":
+				err = dec.schema(t)
+			case "Placemark":
+				err = dec.placemark(t)
+			default:
+				err = dec.d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (dec *decoder) schema(start xml.StartElement) error {
+	var s schemaType
+	if err := dec.d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	fields := make(map[string]string, len(s.Fields))
+	for _, field := range s.Fields {
+		fields[field.Name] = field.Type
+	}
+	// schemaUrl refers to the id of the schema, but some producers refer to its name
+	if s.Name != "" {
+		dec.schemas[s.Name] = fields
+	}
+	if s.ID != "" {
+		dec.schemas[s.ID] = fields
+	}
+	return nil
+}
+
+func (dec *decoder) placemark(start xml.StartElement) error {
+	p := &Placemark{Properties: make(map[string]interface{})}
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "id" {
+			p.ID = attr.Value
+		}
+	}
+
+	var g *node
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			if g != nil {
+				p.Geometry = g.build(g.layout())
+			}
+			dec.doc.Placemarks = append(dec.doc.Placemarks, p)
+			return nil
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "name":
+				err = dec.d.DecodeElement(&p.Name, &t)
+			case t.Name.Local == "description":
+				err = dec.d.DecodeElement(&p.Description, &t)
+			case t.Name.Local == "ExtendedData":
+				err = dec.extendedData(p, t)
+			case isGeometry(t.Name.Local):
+				g, err = dec.geometry(t)
+			default:
+				err = dec.d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (dec *decoder) extendedData(p *Placemark, start xml.StartElement) error {
+	var extendedData extendedDataType
+	if err := dec.d.DecodeElement(&extendedData, &start); err != nil {
+		return err
+	}
+	for _, data := range extendedData.Data {
+		p.Properties[data.Name] = data.Value
+	}
+	for _, schemaData := range extendedData.SchemaData {
+		for _, data := range schemaData.SimpleData {
+			dec.pending = append(dec.pending, pendingData{placemark: p, schemaURL: schemaData.SchemaURL, data: data})
+		}
+	}
+	return nil
+}
+
+func isGeometry(name string) bool {
+	switch name {
+	case "Point", "LineString", "LinearRing", "Polygon", "MultiGeometry":
+		return true
+	default:
+		return false
+	}
+}
+
+// node is a decoded geometry, before its layout is known.
+type node struct {
+	kind     string
+	rings    [][][]float64 // the coordinates of points, line strings and linear rings, or the rings of polygons
+	children []*node
+}
+
+func (dec *decoder) geometry(start xml.StartElement) (*node, error) {
+	n := &node{kind: start.Name.Local}
+	var outer [][]float64
+	var inners [][][]float64
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			if n.kind == "Polygon" {
+				n.rings = append([][][]float64{outer}, inners...)
+			}
+			return n, nil
+		case xml.StartElement:
+			switch name := t.Name.Local; {
+			case name == "coordinates" && n.kind != "Polygon" && n.kind != "MultiGeometry":
+				var text string
+				if err := dec.d.DecodeElement(&text, &t); err != nil {
+					return nil, err
+				}
+				coords, err := parseCoordinates(text)
+				if err != nil {
+					return nil, err
+				}
+				n.rings = [][][]float64{coords}
+			case (name == "outerBoundaryIs" || name == "innerBoundaryIs") && n.kind == "Polygon":
+				rings, err := dec.boundary()
+				if err != nil {
+					return nil, err
+				}
+				if name == "outerBoundaryIs" && len(rings) > 0 {
+					outer = rings[0]
+					rings = rings[1:]
+				}
+				inners = append(inners, rings...)
+			case isGeometry(name) && n.kind == "MultiGeometry":
+				child, err := dec.geometry(t)
+				if err != nil {
+					return nil, err
+				}
+				n.children = append(n.children, child)
+			default:
+				if err := dec.d.Skip(); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+// boundary decodes the linear rings of the boundary of a polygon.
+func (dec *decoder) boundary() ([][][]float64, error) {
+	var rings [][][]float64
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return rings, nil
+		case xml.StartElement:
+			if t.Name.Local != "LinearRing" {
+				if err := dec.d.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			ring, err := dec.geometry(t)
+			if err != nil {
+				return nil, err
+			}
+			if len(ring.rings) > 0 {
+				rings = append(rings, ring.rings[0])
+			}
+		}
+	}
+}
+
+// parseCoordinates parses "lon,lat[,alt]" tuples, separated by spaces.
+func parseCoordinates(text string) ([][]float64, error) {
+	tuples := strings.Fields(text)
+	coords := make([][]float64, 0, len(tuples))
+	for _, tuple := range tuples {
+		values := strings.Split(tuple, ",")
+		if len(values) < 2 || len(values) > 3 {
+			return nil, fmt.Errorf("invalid coordinates %q", tuple)
+		}
+		coord := make([]float64, len(values))
+		for i, value := range values {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coordinates %q", tuple)
+			}
+			coord[i] = v
+		}
+		coords = append(coords, coord)
+	}
+	return coords, nil
+}
+
+// layout yields XYZ when some altitudes are provided, XY otherwise.
+func (n *node) layout() geom.Layout {
+	for _, ring := range n.rings {
+		for _, coord := range ring {
+			if len(coord) == 3 {
+				return geom.XYZ
+			}
+		}
+	}
+	for _, child := range n.children {
+		if child.layout() == geom.XYZ {
+			return geom.XYZ
+		}
+	}
+	return geom.XY
+}
+
+func appendFlatCoords(flatCoords []float64, ring [][]float64, stride int) []float64 {
+	for _, coord := range ring {
+		for i := 0; i < stride; i++ {
+			if i < len(coord) {
+				flatCoords = append(flatCoords, coord[i])
+			} else {
+				flatCoords = append(flatCoords, 0)
+			}
+		}
+	}
+	return flatCoords
+}
+
+func (n *node) build(layout geom.Layout) geom.T {
+	stride := layout.Stride()
+	var ring [][]float64
+	if len(n.rings) > 0 {
+		ring = n.rings[0]
+	}
+
+	switch n.kind {
+	case "Point":
+		if len(ring) == 0 {
+			return geom.NewPointEmpty(layout)
+		}
+		return geom.NewPointFlat(layout, appendFlatCoords(nil, ring[:1], stride))
+	case "LineString":
+		return geom.NewLineStringFlat(layout, appendFlatCoords(nil, ring, stride))
+	case "LinearRing":
+		return geom.NewLinearRingFlat(layout, appendFlatCoords(nil, ring, stride))
+	case "Polygon":
+		var flatCoords []float64
+		ends := make([]int, 0, len(n.rings))
+		for _, r := range n.rings {
+			flatCoords = appendFlatCoords(flatCoords, r, stride)
+			ends = append(ends, len(flatCoords))
+		}
+		return geom.NewPolygonFlat(layout, flatCoords, ends)
+	default:
+		return n.buildMulti(layout)
+	}
+}
+
+// buildMulti builds a multi geometry as a homogeneous multi geometry when possible.
+func (n *node) buildMulti(layout geom.Layout) geom.T {
+	stride := layout.Stride()
+	kind := ""
+	for i, child := range n.children {
+		if i > 0 && child.kind != kind {
+			kind = ""
+			break
+		}
+		kind = child.kind
+		if kind == "Point" && (len(child.rings) == 0 || len(child.rings[0]) == 0) {
+			// empty points have no representation in multi points
+			kind = ""
+			break
+		}
+	}
+
+	switch kind {
+	case "Point":
+		var flatCoords []float64
+		for _, child := range n.children {
+			flatCoords = appendFlatCoords(flatCoords, child.rings[0][:1], stride)
+		}
+		return geom.NewMultiPointFlat(layout, flatCoords)
+	case "LineString":
+		var flatCoords []float64
+		ends := make([]int, 0, len(n.children))
+		for _, child := range n.children {
+			if len(child.rings) > 0 {
+				flatCoords = appendFlatCoords(flatCoords, child.rings[0], stride)
+			}
+			ends = append(ends, len(flatCoords))
+		}
+		return geom.NewMultiLineStringFlat(layout, flatCoords, ends)
+	case "Polygon":
+		var flatCoords []float64
+		endss := make([][]int, 0, len(n.children))
+		for _, child := range n.children {
+			ends := make([]int, 0, len(child.rings))
+			for _, r := range child.rings {
+				flatCoords = appendFlatCoords(flatCoords, r, stride)
+				ends = append(ends, len(flatCoords))
+			}
+			endss = append(endss, ends)
+		}
+		return geom.NewMultiPolygonFlat(layout, flatCoords, endss)
+	default:
+		collection := geom.NewGeometryCollection()
+		for _, child := range n.children {
+			_ = collection.Push(child.build(layout))
+		}
+		return collection
+	}
+}
@@ -0,0 +1,125 @@
+package kml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+)
+
+func encode(doc *Document) (*kmlType, error) {
+	k := &kmlType{
+		Xmlns: Namespace,
+		Document: documentType{
+			Name:        doc.Name,
+			Description: doc.Description,
+		},
+	}
+
+	schema := inferSchema(doc.Placemarks)
+	if len(schema.Fields) > 0 {
+		k.Document.Schema = schema
+	}
+
+	for i, p := range doc.Placemarks {
+		pm := placemarkType{
+			ID:          p.ID,
+			Name:        p.Name,
+			Description: p.Description,
+		}
+		extendedData, err := encodeProperties(p.Properties, schema)
+		if err != nil {
+			return nil, fmt.Errorf("kml: placemark %d: %w", i, err)
+		}
+		pm.ExtendedData = extendedData
+		if p.Geometry != nil {
+			g, err := encodeGeometry(p.Geometry)
+			if err != nil {
+				return nil, fmt.Errorf("kml: placemark %d: %w", i, err)
+			}
+			pm.Geometry = g
+		}
+		k.Document.Placemarks = append(k.Document.Placemarks, pm)
+	}
+	return k, nil
+}
+
+func encodeGeometry(g geom.T) (interface{}, error) {
+	if _, ok := g.(*geom.GeometryCollection); !ok && g.Stride() < 2 {
+		return nil, ErrUnsupportedLayout(g.Layout())
+	}
+
+	switch g := g.(type) {
+	case *geom.Point:
+		return pointType{Coordinates: coordinates(g.FlatCoords(), g.Layout())}, nil
+	case *geom.LineString:
+		return lineStringType{Coordinates: coordinates(g.FlatCoords(), g.Layout())}, nil
+	case *geom.LinearRing:
+		return linearRingType{Coordinates: coordinates(g.FlatCoords(), g.Layout())}, nil
+	case *geom.Polygon:
+		return polygon(g), nil
+	case *geom.MultiPoint:
+		multi := multiGeometryType{}
+		for i := 0; i < g.NumPoints(); i++ {
+			multi.Geometries = append(multi.Geometries, pointType{Coordinates: coordinates(g.Point(i).FlatCoords(), g.Layout())})
+		}
+		return multi, nil
+	case *geom.MultiLineString:
+		multi := multiGeometryType{}
+		for i := 0; i < g.NumLineStrings(); i++ {
+			multi.Geometries = append(multi.Geometries, lineStringType{Coordinates: coordinates(g.LineString(i).FlatCoords(), g.Layout())})
+		}
+		return multi, nil
+	case *geom.MultiPolygon:
+		multi := multiGeometryType{}
+		for i := 0; i < g.NumPolygons(); i++ {
+			multi.Geometries = append(multi.Geometries, polygon(g.Polygon(i)))
+		}
+		return multi, nil
+	case *geom.GeometryCollection:
+		multi := multiGeometryType{}
+		for _, member := range g.Geoms() {
+			encoded, err := encodeGeometry(member)
+			if err != nil {
+				return nil, err
+			}
+			multi.Geometries = append(multi.Geometries, encoded)
+		}
+		return multi, nil
+	default:
+		return nil, ErrUnsupportedType(fmt.Sprintf("%T", g))
+	}
+}
+
+func polygon(g *geom.Polygon) polygonType {
+	var p polygonType
+	for i := 0; i < g.NumLinearRings(); i++ {
+		ring := boundaryType{LinearRing: linearRingType{Coordinates: coordinates(g.LinearRing(i).FlatCoords(), g.Layout())}}
+		if i == 0 {
+			p.Outer = ring
+			continue
+		}
+		p.Inner = append(p.Inner, ring)
+	}
+	return p
+}
+
+// coordinates formats flat coordinates as "lon,lat[,alt]" tuples, separated by spaces.
+func coordinates(flatCoords []float64, layout geom.Layout) string {
+	stride, zIndex := layout.Stride(), layout.ZIndex()
+	var sb strings.Builder
+	for i := 0; i+stride <= len(flatCoords); i += stride {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(strconv.FormatFloat(flatCoords[i], 'f', -1, 64))
+		sb.WriteByte(',')
+		sb.WriteString(strconv.FormatFloat(flatCoords[i+1], 'f', -1, 64))
+		if zIndex >= 0 {
+			sb.WriteByte(',')
+			sb.WriteString(strconv.FormatFloat(flatCoords[i+zIndex], 'f', -1, 64))
+		}
+	}
+	return sb.String()
+}
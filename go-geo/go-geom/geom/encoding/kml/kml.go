@@ -0,0 +1,80 @@
+// Package kml implements KML 2.2 encoding and decoding of placemarks.
+//
+// Placemarks are collected from the whole document, including nested folders. Their geometries
+// are points, line strings, linear rings, polygons and multi geometries: a multi geometry is
+// decoded as a multi point, multi line string or multi polygon when all its members have the same
+// type, and as a geometry collection otherwise. Coordinates have an XY layout, or XYZ when some
+// altitudes are provided. Missing altitudes are 0. M values are not represented in KML and are
+// dropped by the encoder.
+//
+// The extended data of placemarks is mapped to properties. Typed data, declared by a schema, is
+// decoded as bool, int64, float64 or string values, and untyped data as strings. The encoder
+// declares a schema for the properties that have a consistent scalar type across placemarks.
+package kml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/twpayne/go-geom"
+)
+
+// Namespace is the XML namespace of KML 2.2 documents.
+const Namespace = "http://www.opengis.net/kml/2.2"
+
+// ErrUnsupportedType is returned when a geometry can't be represented in KML.
+type ErrUnsupportedType string
+
+func (e ErrUnsupportedType) Error() string {
+	return fmt.Sprintf("kml: unsupported type: %s", string(e))
+}
+
+// ErrUnsupportedLayout is returned when a geometry layout can't be represented in KML.
+type ErrUnsupportedLayout geom.Layout
+
+func (e ErrUnsupportedLayout) Error() string {
+	return fmt.Sprintf("kml: unsupported layout %s", geom.Layout(e))
+}
+
+// A Document is a KML document.
+type Document struct {
+	Name        string
+	Description string
+	Placemarks  []*Placemark
+}
+
+// A Placemark is a geographic feature.
+type Placemark struct {
+	ID          string
+	Name        string
+	Description string
+	Geometry    geom.T
+	Properties  map[string]interface{}
+}
+
+// Marshal translates a document to KML.
+func Marshal(doc *Document) ([]byte, error) {
+	k, err := encode(doc)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(k); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// Unmarshal translates a KML document.
+func Unmarshal(data []byte) (*Document, error) {
+	dec := newDecoder(bytes.NewReader(data))
+	if err := dec.decode(); err != nil {
+		return nil, fmt.Errorf("kml: %w", err)
+	}
+	return dec.doc, nil
+}
@@ -0,0 +1,164 @@
+package kml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+const testKML = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <name>Places</name>
+    <Style id="red"><LineStyle><color>ff0000ff</color></LineStyle></Style>
+    <Folder>
+      <name>Folder name is not the document name</name>
+      <Placemark id="p1">
+        <name>Eiffel tower</name>
+        <ExtendedData>
+          <Data name="kind"><value>monument</value></Data>
+          <SchemaData schemaUrl="#s">
+            <SimpleData name="height">330</SimpleData>
+            <SimpleData name="open">1</SimpleData>
+            <SimpleData name="visitors">7.5e6</SimpleData>
+            <SimpleData name="undeclared">x</SimpleData>
+          </SchemaData>
+        </ExtendedData>
+        <Point><extrude>1</extrude><coordinates> 2.2945,48.8584,330 </coordinates></Point>
+      </Placemark>
+      <Placemark>
+        <name>Park</name>
+        <Polygon>
+          <outerBoundaryIs><LinearRing><coordinates>0,0 4,0 4,4 0,4 0,0</coordinates></LinearRing></outerBoundaryIs>
+          <innerBoundaryIs><LinearRing><coordinates>1,1 2,1 2,2 1,1</coordinates></LinearRing></innerBoundaryIs>
+        </Polygon>
+      </Placemark>
+    </Folder>
+    <Placemark>
+      <MultiGeometry>
+        <Point><coordinates>1,2</coordinates></Point>
+        <LineString><coordinates>1,2,3 4,5,6</coordinates></LineString>
+      </MultiGeometry>
+    </Placemark>
+    <Placemark>
+      <MultiGeometry>
+        <LineString><coordinates>1,2 3,4</coordinates></LineString>
+        <LineString><coordinates>5,6 7,8</coordinates></LineString>
+      </MultiGeometry>
+    </Placemark>
+    <Schema name="s" id="s">
+      <SimpleField type="int" name="height"/>
+      <SimpleField type="bool" name="open"/>
+      <SimpleField type="double" name="visitors"/>
+    </Schema>
+  </Document>
+</kml>`
+
+func TestUnmarshal(t *testing.T) {
+	doc, err := Unmarshal([]byte(testKML))
+	require.NoError(t, err)
+	assert.Equal(t, "Places", doc.Name)
+	require.Len(t, doc.Placemarks, 4)
+
+	p := doc.Placemarks[0]
+	assert.Equal(t, "p1", p.ID)
+	assert.Equal(t, "Eiffel tower", p.Name)
+	assert.Equal(t, geom.NewPointFlat(geom.XYZ, []float64{2.2945, 48.8584, 330}), p.Geometry)
+	assert.Equal(t, map[string]interface{}{
+		"kind":       "monument",
+		"height":     int64(330),
+		"open":       true,
+		"visitors":   7.5e6,
+		"undeclared": "x",
+	}, p.Properties)
+
+	assert.Equal(t, geom.NewPolygonFlat(geom.XY, []float64{0, 0, 4, 0, 4, 4, 0, 4, 0, 0, 1, 1, 2, 1, 2, 2, 1, 1}, []int{10, 18}), doc.Placemarks[1].Geometry)
+
+	collection, ok := doc.Placemarks[2].Geometry.(*geom.GeometryCollection)
+	require.True(t, ok)
+	assert.Equal(t, []geom.T{
+		geom.NewPointFlat(geom.XYZ, []float64{1, 2, 0}),
+		geom.NewLineStringFlat(geom.XYZ, []float64{1, 2, 3, 4, 5, 6}),
+	}, collection.Geoms())
+
+	assert.Equal(t, geom.NewMultiLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []int{4, 8}), doc.Placemarks[3].Geometry)
+}
+
+func TestRoundTrip(t *testing.T) {
+	doc := &Document{
+		Name: "Round trip",
+		Placemarks: []*Placemark{
+			{
+				ID:       "a",
+				Name:     "A",
+				Geometry: geom.NewPointFlat(geom.XY, []float64{0.00001, -45.5}),
+				Properties: map[string]interface{}{
+					"name":  "first",
+					"count": int64(1),
+					"ratio": 0.5,
+					"ok":    true,
+					"tags":  []interface{}{"x"},
+					"mixed": "text",
+				},
+			},
+			{
+				Geometry: geom.NewMultiPolygonFlat(geom.XYZ, []float64{0, 0, 1, 1, 0, 1, 1, 1, 1, 0, 0, 1}, [][]int{{12}}),
+				Properties: map[string]interface{}{
+					"name":  "second",
+					"count": 2.5,
+					"mixed": 3.0,
+					"ok":    nil,
+				},
+			},
+			{
+				Geometry: geom.NewMultiPointFlat(geom.XY, []float64{1, 2, 3, 4}),
+			},
+		},
+	}
+
+	data, err := Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<kml xmlns="http://www.opengis.net/kml/2.2">`)
+	assert.Contains(t, string(data), `<coordinates>0.00001,-45.5</coordinates>`)
+	assert.Contains(t, string(data), `<SimpleField type="double" name="count"></SimpleField>`)
+
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, "Round trip", decoded.Name)
+	require.Len(t, decoded.Placemarks, 3)
+	assert.Equal(t, "a", decoded.Placemarks[0].ID)
+	assert.Equal(t, doc.Placemarks[0].Geometry, decoded.Placemarks[0].Geometry)
+	assert.Equal(t, map[string]interface{}{
+		"name":  "first",
+		"count": 1.0,
+		"ratio": 0.5,
+		"ok":    true,
+		"tags":  `["x"]`,
+		"mixed": "text",
+	}, decoded.Placemarks[0].Properties)
+	assert.Equal(t, map[string]interface{}{
+		"name":  "second",
+		"count": 2.5,
+		"mixed": "3",
+	}, decoded.Placemarks[1].Properties)
+	assert.Equal(t, doc.Placemarks[1].Geometry, decoded.Placemarks[1].Geometry)
+	assert.Equal(t, doc.Placemarks[2].Geometry, decoded.Placemarks[2].Geometry)
+	assert.Empty(t, decoded.Placemarks[2].Properties)
+}
+
+func TestErrors(t *testing.T) {
+	for _, input := range []string{
+		"",
+		`<kml><Placemark><Point><coordinates>1</coordinates></Point></Placemark></kml>`,
+		`<kml><Placemark><Point><coordinates>a,b</coordinates></Point></Placemark></kml>`,
+		`<kml><Placemark>`,
+	} {
+		_, err := Unmarshal([]byte(input))
+		assert.Error(t, err, input)
+	}
+
+	_, err := Marshal(&Document{Placemarks: []*Placemark{{Geometry: geom.NewPointFlat(geom.NoLayout, nil)}}})
+	assert.Error(t, err)
+}
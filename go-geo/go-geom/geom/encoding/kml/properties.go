@@ -0,0 +1,156 @@
+package kml
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// schemaID is the id of the schema declared by the encoder for typed properties.
+	schemaID = "properties"
+
+	typeString = "string"
+	typeInt    = "int"
+	typeDouble = "double"
+	typeBool   = "bool"
+)
+
+// kmlTypeOf yields the KML type of a scalar value, or "" for other values.
+func kmlTypeOf(v interface{}) string {
+	switch v.(type) {
+	case string:
+		return typeString
+	case bool:
+		return typeBool
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return typeInt
+	case float32, float64:
+		return typeDouble
+	default:
+		return ""
+	}
+}
+
+// inferSchema declares the properties with a consistent scalar type across placemarks.
+//
+// Integers and floats are declared as doubles when mixed. Null values are ignored.
+func inferSchema(placemarks []*Placemark) *schemaType {
+	types := make(map[string]string)
+	for _, p := range placemarks {
+		for name, value := range p.Properties {
+			if value == nil {
+				continue
+			}
+			typ := kmlTypeOf(value)
+			previous, seen := types[name]
+			switch {
+			case !seen || previous == typ:
+				types[name] = typ
+			case (previous == typeInt && typ == typeDouble) || (previous == typeDouble && typ == typeInt):
+				types[name] = typeDouble
+			default:
+				types[name] = ""
+			}
+		}
+	}
+
+	schema := &schemaType{ID: schemaID}
+	for name, typ := range types {
+		if typ != "" {
+			schema.Fields = append(schema.Fields, simpleFieldType{Name: name, Type: typ})
+		}
+	}
+	sort.Slice(schema.Fields, func(i, j int) bool { return schema.Fields[i].Name < schema.Fields[j].Name })
+	return schema
+}
+
+// encodeProperties encodes typed properties as schema data, and others as untyped data.
+func encodeProperties(properties map[string]interface{}, schema *schemaType) (*extendedDataType, error) {
+	if len(properties) == 0 {
+		return nil, nil
+	}
+	typed := make(map[string]bool, len(schema.Fields))
+	for _, field := range schema.Fields {
+		typed[field.Name] = true
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	extendedData := &extendedDataType{}
+	schemaData := schemaDataType{SchemaURL: "#" + schemaID}
+	for _, name := range names {
+		value := properties[name]
+		if value == nil {
+			continue
+		}
+		if typed[name] {
+			schemaData.SimpleData = append(schemaData.SimpleData, simpleDataType{Name: name, Value: formatScalar(value)})
+			continue
+		}
+		text, err := formatValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", name, err)
+		}
+		extendedData.Data = append(extendedData.Data, dataType{Name: name, Value: text})
+	}
+	if len(schemaData.SimpleData) > 0 {
+		extendedData.SchemaData = []schemaDataType{schemaData}
+	}
+	if len(extendedData.Data) == 0 && len(extendedData.SchemaData) == 0 {
+		return nil, nil
+	}
+	return extendedData, nil
+}
+
+func formatScalar(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatValue formats untyped data: scalars as text, and other values as JSON.
+func formatValue(value interface{}) (string, error) {
+	if kmlTypeOf(value) != "" {
+		return formatScalar(value), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parseTyped decodes the value of a field declared by a schema.
+// Values which can't be parsed according to their declared type are left as strings.
+func parseTyped(typ, text string) interface{} {
+	trimmed := strings.TrimSpace(text)
+	switch typ {
+	case "int", "uint", "short", "ushort":
+		if v, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return v
+		}
+	case "float", "double":
+		if v, err := strconv.ParseFloat(trimmed, 64); err == nil {
+			return v
+		}
+	case "bool":
+		if v, err := strconv.ParseBool(trimmed); err == nil {
+			return v
+		}
+	}
+	return text
+}
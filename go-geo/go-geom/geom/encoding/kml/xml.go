@@ -0,0 +1,88 @@
+package kml
+
+import "encoding/xml"
+
+// The XML types follow the order of elements of the KML 2.2 schema.
+// Geometries are decoded by walking the tokens, to preserve the order of the members of multi geometries.
+
+type kmlType struct {
+	XMLName  xml.Name     `xml:"kml"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Document documentType `xml:"Document"`
+}
+
+type documentType struct {
+	Name        string          `xml:"name,omitempty"`
+	Description string          `xml:"description,omitempty"`
+	Schema      *schemaType     `xml:"Schema"`
+	Placemarks  []placemarkType `xml:"Placemark"`
+}
+
+type schemaType struct {
+	Name   string            `xml:"name,attr,omitempty"`
+	ID     string            `xml:"id,attr"`
+	Fields []simpleFieldType `xml:"SimpleField"`
+}
+
+type simpleFieldType struct {
+	Type string `xml:"type,attr"`
+	Name string `xml:"name,attr"`
+}
+
+type placemarkType struct {
+	ID           string            `xml:"id,attr,omitempty"`
+	Name         string            `xml:"name,omitempty"`
+	Description  string            `xml:"description,omitempty"`
+	ExtendedData *extendedDataType `xml:"ExtendedData"`
+	Geometry     interface{}
+}
+
+type extendedDataType struct {
+	Data       []dataType       `xml:"Data"`
+	SchemaData []schemaDataType `xml:"SchemaData"`
+}
+
+type dataType struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type schemaDataType struct {
+	SchemaURL  string           `xml:"schemaUrl,attr"`
+	SimpleData []simpleDataType `xml:"SimpleData"`
+}
+
+type simpleDataType struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+type pointType struct {
+	XMLName     xml.Name `xml:"Point"`
+	Coordinates string   `xml:"coordinates"`
+}
+
+type lineStringType struct {
+	XMLName     xml.Name `xml:"LineString"`
+	Coordinates string   `xml:"coordinates"`
+}
+
+type linearRingType struct {
+	XMLName     xml.Name `xml:"LinearRing"`
+	Coordinates string   `xml:"coordinates"`
+}
+
+type boundaryType struct {
+	LinearRing linearRingType
+}
+
+type polygonType struct {
+	XMLName xml.Name       `xml:"Polygon"`
+	Outer   boundaryType   `xml:"outerBoundaryIs"`
+	Inner   []boundaryType `xml:"innerBoundaryIs"`
+}
+
+type multiGeometryType struct {
+	XMLName    xml.Name `xml:"MultiGeometry"`
+	Geometries []interface{}
+}
@@ -0,0 +1,172 @@
+// Package polyline implements the Google encoded polyline algorithm format.
+//
+// A polyline encodes a sequence of latitudes and longitudes as a compact ASCII string:
+// see https://developers.google.com/maps/documentation/utilities/polylinealgorithm.
+//
+// Coordinates are rounded to a number of decimal digits, the precision, which is not
+// carried by the encoded string: encoder and decoder must agree on it. Google uses a
+// precision of 5 digits, whereas OSRM and Valhalla use 6 digits.
+//
+// Only X (longitude) and Y (latitude) are encoded: other dimensions are dropped.
+package polyline
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/twpayne/go-geom"
+)
+
+const (
+	// DefaultPrecision is the precision of Google polylines.
+	DefaultPrecision = 5
+
+	// maxPrecision keeps the scaled coordinates well within the range of exactly representable integers.
+	maxPrecision = 10
+)
+
+// ErrSyntax is returned when an encoded polyline is malformed.
+//
+// Offset is the 0-based byte offset in the input where the error was detected.
+type ErrSyntax struct {
+	Offset int
+	Msg    string
+}
+
+func (e ErrSyntax) Error() string {
+	return fmt.Sprintf("polyline: syntax error at offset %d: %s", e.Offset, e.Msg)
+}
+
+// ErrUnsupportedType is returned when a geometry can't be represented as a polyline.
+type ErrUnsupportedType string
+
+func (e ErrUnsupportedType) Error() string {
+	return fmt.Sprintf("polyline: unsupported type: %s", string(e))
+}
+
+// Option configures the encoding and decoding of polylines.
+type Option func(*options)
+
+type options struct {
+	precision int
+}
+
+func optionsWithDefaults(opts []Option) *options {
+	o := &options{precision: DefaultPrecision}
+	for _, apply := range opts {
+		apply(o)
+	}
+	return o
+}
+
+// WithPrecision sets the number of decimal digits of the encoded coordinates,
+// e.g. 6 for OSRM or Valhalla polylines. The default is 5.
+//
+// Precisions outside of the range [0, 10] are ignored.
+func WithPrecision(digits int) Option {
+	return func(o *options) {
+		if digits >= 0 && digits <= maxPrecision {
+			o.precision = digits
+		}
+	}
+}
+
+// Marshal encodes a point, a line string or a linear ring as a polyline.
+func Marshal(g geom.T, opts ...Option) (string, error) {
+	switch g.(type) {
+	case *geom.Point, *geom.LineString, *geom.LinearRing:
+	default:
+		return "", ErrUnsupportedType(fmt.Sprintf("%T", g))
+	}
+	o := optionsWithDefaults(opts)
+	return string(AppendFlatCoords(nil, g.FlatCoords(), g.Stride(), o.precision)), nil
+}
+
+// Unmarshal decodes a polyline as a line string, with an XY layout.
+func Unmarshal(polyline string, opts ...Option) (*geom.LineString, error) {
+	o := optionsWithDefaults(opts)
+	flatCoords, err := DecodeFlatCoords(polyline, o.precision)
+	if err != nil {
+		return nil, err
+	}
+	return geom.NewLineStringFlat(geom.XY, flatCoords), nil
+}
+
+// AppendFlatCoords appends the polyline encoding of flat coordinates to a buffer.
+//
+// Only the first two values of each coordinate, longitude and latitude, are encoded.
+func AppendFlatCoords(buf []byte, flatCoords []float64, stride, precision int) []byte {
+	factor := math.Pow10(precision)
+	var lastLat, lastLng int64
+	for i := 0; i+1 < len(flatCoords); i += stride {
+		lat := int64(math.Round(flatCoords[i+1] * factor))
+		lng := int64(math.Round(flatCoords[i] * factor))
+		buf = appendValue(buf, lat-lastLat)
+		buf = appendValue(buf, lng-lastLng)
+		lastLat, lastLng = lat, lng
+	}
+	return buf
+}
+
+// appendValue appends a zigzag-encoded value by chunks of 5 bits, from the least significant.
+func appendValue(buf []byte, v int64) []byte {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		buf = append(buf, byte(0x20|u&0x1f)+63)
+		u >>= 5
+	}
+	return append(buf, byte(u)+63)
+}
+
+// DecodeFlatCoords decodes a polyline as flat XY coordinates.
+func DecodeFlatCoords(polyline string, precision int) ([]float64, error) {
+	factor := math.Pow10(precision)
+	flatCoords := make([]float64, 0, len(polyline)/2)
+	var lat, lng int64
+	for offset := 0; offset < len(polyline); {
+		dlat, n, err := decodeValue(polyline, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset += n
+		if offset == len(polyline) {
+			return nil, ErrSyntax{Offset: offset, Msg: "missing longitude"}
+		}
+		dlng, n, err := decodeValue(polyline, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset += n
+		lat += dlat
+		lng += dlng
+		flatCoords = append(flatCoords, float64(lng)/factor, float64(lat)/factor)
+	}
+	return flatCoords, nil
+}
+
+// decodeValue decodes the value at some offset, and yields the number of bytes consumed.
+func decodeValue(polyline string, offset int) (int64, int, error) {
+	var u uint64
+	for i, shift := offset, uint(0); i < len(polyline); i, shift = i+1, shift+5 {
+		c := polyline[i]
+		if c < 63 || c > 126 {
+			return 0, 0, ErrSyntax{Offset: i, Msg: fmt.Sprintf("invalid character %q", c)}
+		}
+		if shift > 60 {
+			return 0, 0, ErrSyntax{Offset: i, Msg: "value overflow"}
+		}
+		chunk := uint64(c - 63)
+		u |= (chunk & 0x1f) << shift
+		if chunk < 0x20 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, i + 1 - offset, nil
+		}
+	}
+	return 0, 0, ErrSyntax{Offset: len(polyline), Msg: "truncated value"}
+}
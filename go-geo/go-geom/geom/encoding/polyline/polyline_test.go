@@ -0,0 +1,92 @@
+package polyline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestPolyline(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		g        geom.T
+		opts     []Option
+		polyline string
+	}{
+		{
+			// the example of the specification
+			name:     "google",
+			g:        geom.NewLineStringFlat(geom.XY, []float64{-120.2, 38.5, -120.95, 40.7, -126.453, 43.252}),
+			polyline: "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+		},
+		{
+			name:     "precision 6",
+			g:        geom.NewLineStringFlat(geom.XY, []float64{-120.2, 38.5, -120.95, 40.7, -126.453, 43.252}),
+			opts:     []Option{WithPrecision(6)},
+			polyline: "_izlhA~rlgdF_{geC~ywl@_kwzCn`{nI",
+		},
+		{
+			name:     "point",
+			g:        geom.NewPointFlat(geom.XY, []float64{2.35, 48.85}),
+			polyline: "o_diHo~iM",
+		},
+		{
+			name:     "empty",
+			g:        geom.NewLineString(geom.XY),
+			polyline: "",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			polyline, err := Marshal(tc.g, tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.polyline, polyline)
+
+			ls, err := Unmarshal(polyline, tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, geom.XY, ls.Layout())
+			assert.InDeltaSlice(t, tc.g.FlatCoords(), ls.FlatCoords(), 1e-9)
+		})
+	}
+}
+
+func TestPolylineDropsDimensions(t *testing.T) {
+	polyline, err := Marshal(geom.NewLineStringFlat(geom.XYZM, []float64{1, 2, 3, 4, 5, 6, 7, 8}))
+	require.NoError(t, err)
+
+	ls, err := Unmarshal(polyline)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{1, 2, 5, 6}, ls.FlatCoords(), 1e-9)
+}
+
+func TestPolylineRounding(t *testing.T) {
+	polyline, err := Marshal(geom.NewLineStringFlat(geom.XY, []float64{0.123456789, -0.987654321, 0.123451, -0.987651}))
+	require.NoError(t, err)
+
+	ls, err := Unmarshal(polyline)
+	require.NoError(t, err)
+	// rounding errors don't accumulate along the line
+	assert.InDeltaSlice(t, []float64{0.12346, -0.98765, 0.12345, -0.98765}, ls.FlatCoords(), 1e-9)
+}
+
+func TestPolylineErrors(t *testing.T) {
+	_, err := Marshal(geom.NewPolygon(geom.XY))
+	assert.IsType(t, ErrUnsupportedType(""), err)
+
+	for _, tc := range []struct {
+		polyline string
+		offset   int
+	}{
+		{polyline: "_p~iF", offset: 5},
+		{polyline: "_p~i", offset: 4},
+		{polyline: "_p~iF~ps| ", offset: 9},
+		{polyline: "~~~~~~~~~~~~~~?", offset: 13},
+	} {
+		_, err := Unmarshal(tc.polyline)
+		syntaxErr, ok := err.(ErrSyntax)
+		require.True(t, ok, tc.polyline)
+		assert.Equal(t, tc.offset, syntaxErr.Offset, tc.polyline)
+	}
+}
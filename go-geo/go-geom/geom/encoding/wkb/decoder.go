@@ -0,0 +1,484 @@
+package wkb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+// decoderChunk is the maximum number of ordinates read at once from the input.
+//
+// Reading coordinates by chunks bounds the allocations made on behalf of an untrusted
+// element count: memory grows only as data actually arrives.
+const decoderChunk = 1024
+
+// ErrLimitExceeded is returned by a Decoder when the input exceeds one of its configured limits.
+type ErrLimitExceeded struct {
+	Limit string
+	Value int64
+	Max   int64
+}
+
+func (e ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("wkb: %s limit exceeded: %d > %d", e.Limit, e.Value, e.Max)
+}
+
+type decoderOptions struct {
+	maxDepth  int
+	maxPoints int64
+	maxBytes  int64
+}
+
+// A DecoderOption is a possible parameter to the Decoder.
+type DecoderOption func(*decoderOptions)
+
+// WithMaxDepth limits the nesting level of geometries, e.g. geometry collections within
+// geometry collections. The parts of a multi-geometry stand one level below the multi-geometry.
+//
+// Zero or a negative value means no limit. The default is 32.
+func WithMaxDepth(depth int) DecoderOption {
+	return func(o *decoderOptions) {
+		o.maxDepth = depth
+	}
+}
+
+// WithMaxPoints limits the total number of points in a single geometry.
+//
+// Zero or a negative value means no limit, which is the default.
+func WithMaxPoints(points int64) DecoderOption {
+	return func(o *decoderOptions) {
+		o.maxPoints = points
+	}
+}
+
+// WithMaxBytes limits the size in bytes of the encoding of a single geometry.
+//
+// Zero or a negative value means no limit, which is the default.
+func WithMaxBytes(size int64) DecoderOption {
+	return func(o *decoderOptions) {
+		o.maxBytes = size
+	}
+}
+
+// Decoder reads a stream of WKB or EWKB geometries.
+//
+// Unlike Read, a Decoder enforces its own limits rather than the global wkbcommon.MaxGeometryElements,
+// and reuses its internal buffers across geometries. With DecodeInto, the coordinates of an existing
+// geometry are overwritten in place, so that decoding a large number of geometries does not allocate.
+//
+// A Decoder buffers its input, so it may read more bytes from the stream than the geometries it decodes.
+//
+// A Decoder is not safe for concurrent use.
+type Decoder struct {
+	r    *bufio.Reader
+	opts decoderOptions
+	buf  []byte
+
+	// accounting for the geometry being decoded
+	bytes  int64
+	points int64
+}
+
+// NewDecoder builds a Decoder reading from r.
+func NewDecoder(r io.Reader, opts ...DecoderOption) *Decoder {
+	d := &Decoder{
+		r: bufio.NewReader(r),
+		opts: decoderOptions{
+			maxDepth: 32,
+		},
+		buf: make([]byte, 0, 8*decoderChunk),
+	}
+	for _, apply := range opts {
+		apply(&d.opts)
+	}
+	return d
+}
+
+// Reset the Decoder to read from another stream, retaining its options and buffers.
+func (d *Decoder) Reset(r io.Reader) {
+	d.r.Reset(r)
+}
+
+// Decode reads the next geometry from the stream.
+//
+// It returns io.EOF when the stream ends cleanly before a new geometry,
+// and io.ErrUnexpectedEOF when it ends in the middle of a geometry.
+func (d *Decoder) Decode() (geom.T, error) {
+	d.bytes, d.points = 0, 0
+	byteOrder, t, layout, srid, err := d.readHeader(true)
+	if err != nil {
+		return nil, err
+	}
+	g, err := d.decodeBody(byteOrder, t, layout, 0)
+	if err != nil {
+		return nil, err
+	}
+	if srid != 0 {
+		return setSRID(g, srid), nil
+	}
+	return g, nil
+}
+
+// DecodeInto reads the next geometry from the stream into g.
+//
+// The flat coordinates and ends of g are reused and overwritten, so the caller should not retain
+// them across calls. g must be a pointer to a geometry of the same type as the decoded one, otherwise
+// a wkbcommon.ErrUnexpectedType error is returned, and g is left untouched. The mismatched geometry
+// is consumed nonetheless, so that decoding may go on with the next geometry of the stream.
+// The layout of g is set to the decoded layout.
+//
+// Like Decode, it returns io.EOF when the stream ends cleanly.
+func (d *Decoder) DecodeInto(g geom.T) error {
+	d.bytes, d.points = 0, 0
+	byteOrder, t, layout, srid, err := d.readHeader(true)
+	if err != nil {
+		return err
+	}
+	stride := layout.Stride()
+
+	if want, err := geometryTypeID(g); err != nil || want != t {
+		got, err := d.decodeBody(byteOrder, t, layout, 0)
+		if err != nil {
+			return err
+		}
+		return wkbcommon.ErrUnexpectedType{Got: got, Want: g}
+	}
+
+	switch g := g.(type) {
+	case *geom.Point:
+		flatCoords, err := d.readCoords(byteOrder, stride, 1, g.FlatCoords()[:0])
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewPointFlat(layout, flatCoords)
+		g.SetSRID(srid)
+	case *geom.LineString:
+		flatCoords, err := d.readCoords1(byteOrder, stride, g.FlatCoords()[:0])
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewLineStringFlat(layout, flatCoords)
+		g.SetSRID(srid)
+	case *geom.Polygon:
+		flatCoords, ends, err := d.readCoords2(byteOrder, stride, g.FlatCoords()[:0], g.Ends()[:0])
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewPolygonFlat(layout, flatCoords, ends)
+		g.SetSRID(srid)
+	case *geom.MultiPoint:
+		flatCoords, err := d.readMultiPoint(byteOrder, layout, g.FlatCoords()[:0], 0)
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewMultiPointFlat(layout, flatCoords)
+		g.SetSRID(srid)
+	case *geom.MultiLineString:
+		flatCoords, ends, err := d.readMultiLineString(byteOrder, layout, g.FlatCoords()[:0], g.Ends()[:0], 0)
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewMultiLineStringFlat(layout, flatCoords, ends)
+		g.SetSRID(srid)
+	case *geom.MultiPolygon:
+		flatCoords, endss, err := d.readMultiPolygon(byteOrder, layout, g.FlatCoords()[:0], g.Endss()[:0], 0)
+		if err != nil {
+			return err
+		}
+		*g = *geom.NewMultiPolygonFlat(layout, flatCoords, endss)
+		g.SetSRID(srid)
+	case *geom.GeometryCollection:
+		gc, err := d.decodeBody(byteOrder, t, layout, 0)
+		if err != nil {
+			return err
+		}
+		*g = *gc.(*geom.GeometryCollection)
+		g.SetSRID(srid)
+	}
+	return nil
+}
+
+func (d *Decoder) decodeBody(byteOrder binary.ByteOrder, t uint32, layout geom.Layout, depth int) (geom.T, error) {
+	stride := layout.Stride()
+
+	switch t {
+	case wkbcommon.PointID:
+		flatCoords, err := d.readCoords(byteOrder, stride, 1, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewPointFlat(layout, flatCoords), nil
+	case wkbcommon.LineStringID:
+		flatCoords, err := d.readCoords1(byteOrder, stride, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewLineStringFlat(layout, flatCoords), nil
+	case wkbcommon.PolygonID:
+		flatCoords, ends, err := d.readCoords2(byteOrder, stride, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewPolygonFlat(layout, flatCoords, ends), nil
+	case wkbcommon.MultiPointID:
+		flatCoords, err := d.readMultiPoint(byteOrder, layout, nil, depth)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPointFlat(layout, flatCoords), nil
+	case wkbcommon.MultiLineStringID:
+		flatCoords, ends, err := d.readMultiLineString(byteOrder, layout, nil, nil, depth)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiLineStringFlat(layout, flatCoords, ends), nil
+	case wkbcommon.MultiPolygonID:
+		flatCoords, endss, err := d.readMultiPolygon(byteOrder, layout, nil, nil, depth)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPolygonFlat(layout, flatCoords, endss), nil
+	case wkbcommon.GeometryCollectionID:
+		n, err := d.readCount(byteOrder, 5)
+		if err != nil {
+			return nil, err
+		}
+		gc := geom.NewGeometryCollection()
+		for i := 0; i < n; i++ {
+			if err := d.checkDepth(depth + 1); err != nil {
+				return nil, err
+			}
+			childByteOrder, ct, cl, _, err := d.readHeader(false)
+			if err != nil {
+				return nil, err
+			}
+			g, err := d.decodeBody(childByteOrder, ct, cl, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if err := gc.Push(g); err != nil {
+				return nil, err
+			}
+		}
+		return gc, nil
+	default:
+		return nil, wkbcommon.ErrUnsupportedType(t)
+	}
+}
+
+// readMultiPoint reads the points of a multipoint directly into flat coordinates.
+func (d *Decoder) readMultiPoint(byteOrder binary.ByteOrder, layout geom.Layout, flatCoords []float64, depth int) ([]float64, error) {
+	n, err := d.readCount(byteOrder, 5)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		partByteOrder, err := d.readPartHeader(wkbcommon.PointID, layout, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if flatCoords, err = d.readCoords(partByteOrder, layout.Stride(), 1, flatCoords); err != nil {
+			return nil, err
+		}
+	}
+	return flatCoords, nil
+}
+
+// readMultiLineString reads the linestrings of a multilinestring directly into flat coordinates.
+func (d *Decoder) readMultiLineString(byteOrder binary.ByteOrder, layout geom.Layout, flatCoords []float64, ends []int, depth int) ([]float64, []int, error) {
+	n, err := d.readCount(byteOrder, 5)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := 0; i < n; i++ {
+		partByteOrder, err := d.readPartHeader(wkbcommon.LineStringID, layout, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if flatCoords, err = d.readCoords1(partByteOrder, layout.Stride(), flatCoords); err != nil {
+			return nil, nil, err
+		}
+		ends = append(ends, len(flatCoords))
+	}
+	return flatCoords, ends, nil
+}
+
+// readMultiPolygon reads the polygons of a multipolygon directly into flat coordinates.
+//
+// The inner slices of endss are reused whenever possible.
+func (d *Decoder) readMultiPolygon(byteOrder binary.ByteOrder, layout geom.Layout, flatCoords []float64, endss [][]int, depth int) ([]float64, [][]int, error) {
+	n, err := d.readCount(byteOrder, 5)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := 0; i < n; i++ {
+		partByteOrder, err := d.readPartHeader(wkbcommon.PolygonID, layout, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		var ends []int
+		if i < cap(endss) {
+			ends = endss[:i+1][i][:0]
+		}
+		if flatCoords, ends, err = d.readCoords2(partByteOrder, layout.Stride(), flatCoords, ends); err != nil {
+			return nil, nil, err
+		}
+		endss = append(endss, ends)
+	}
+	return flatCoords, endss, nil
+}
+
+// readPartHeader reads the header of a part of a multi-geometry, which must match the expected type and layout.
+func (d *Decoder) readPartHeader(t uint32, layout geom.Layout, depth int) (binary.ByteOrder, error) {
+	if err := d.checkDepth(depth); err != nil {
+		return nil, err
+	}
+	byteOrder, pt, pl, _, err := d.readHeader(false)
+	if err != nil {
+		return nil, err
+	}
+	if pt != t || pl != layout {
+		got, _ := emptyGeometry(pt, pl)
+		want, _ := emptyGeometry(t, layout)
+		return nil, wkbcommon.ErrUnexpectedType{Got: got, Want: want}
+	}
+	return byteOrder, nil
+}
+
+// readHeader reads the byte order, the type and the optional SRID of a geometry.
+//
+// At the top level, a clean end of stream yields io.EOF.
+func (d *Decoder) readHeader(top bool) (binary.ByteOrder, uint32, geom.Layout, int, error) {
+	b, err := d.read(1)
+	if err != nil {
+		if top && err == io.ErrUnexpectedEOF && d.bytes == 0 {
+			return nil, 0, geom.NoLayout, 0, io.EOF
+		}
+		return nil, 0, geom.NoLayout, 0, err
+	}
+
+	var byteOrder binary.ByteOrder
+	switch b[0] {
+	case wkbcommon.XDRID:
+		byteOrder = XDR
+	case wkbcommon.NDRID:
+		byteOrder = NDR
+	default:
+		return nil, 0, geom.NoLayout, 0, wkbcommon.ErrUnknownByteOrder(b[0])
+	}
+
+	b, err = d.read(4)
+	if err != nil {
+		return nil, 0, geom.NoLayout, 0, err
+	}
+	t, layout, hasSRID, err := decodeType(byteOrder.Uint32(b))
+	if err != nil {
+		return nil, 0, geom.NoLayout, 0, err
+	}
+
+	var srid int
+	if hasSRID {
+		if b, err = d.read(4); err != nil {
+			return nil, 0, geom.NoLayout, 0, err
+		}
+		srid = int(int32(byteOrder.Uint32(b)))
+	}
+	return byteOrder, t, layout, srid, nil
+}
+
+// readCount reads a number of elements, each of which encoded with at least minSize bytes.
+func (d *Decoder) readCount(byteOrder binary.ByteOrder, minSize int64) (int, error) {
+	b, err := d.read(4)
+	if err != nil {
+		return 0, err
+	}
+	n := int64(byteOrder.Uint32(b))
+	if d.opts.maxBytes > 0 && d.bytes+n*minSize > d.opts.maxBytes {
+		return 0, ErrLimitExceeded{Limit: "bytes", Value: d.bytes + n*minSize, Max: d.opts.maxBytes}
+	}
+	if n > math.MaxInt32 {
+		return 0, ErrLimitExceeded{Limit: "elements", Value: n, Max: math.MaxInt32}
+	}
+	return int(n), nil
+}
+
+func (d *Decoder) readCoords1(byteOrder binary.ByteOrder, stride int, flatCoords []float64) ([]float64, error) {
+	n, err := d.readCount(byteOrder, int64(8*stride))
+	if err != nil {
+		return nil, err
+	}
+	return d.readCoords(byteOrder, stride, n, flatCoords)
+}
+
+func (d *Decoder) readCoords2(byteOrder binary.ByteOrder, stride int, flatCoords []float64, ends []int) ([]float64, []int, error) {
+	n, err := d.readCount(byteOrder, 4)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := 0; i < n; i++ {
+		if flatCoords, err = d.readCoords1(byteOrder, stride, flatCoords); err != nil {
+			return nil, nil, err
+		}
+		ends = append(ends, len(flatCoords))
+	}
+	return flatCoords, ends, nil
+}
+
+// readCoords reads n coordinates and appends them to flatCoords.
+func (d *Decoder) readCoords(byteOrder binary.ByteOrder, stride, n int, flatCoords []float64) ([]float64, error) {
+	d.points += int64(n)
+	if d.opts.maxPoints > 0 && d.points > d.opts.maxPoints {
+		return nil, ErrLimitExceeded{Limit: "points", Value: d.points, Max: d.opts.maxPoints}
+	}
+
+	for remaining := n * stride; remaining > 0; {
+		chunk := remaining
+		if chunk > decoderChunk {
+			chunk = decoderChunk
+		}
+		b, err := d.read(8 * chunk)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < chunk; i++ {
+			flatCoords = append(flatCoords, math.Float64frombits(byteOrder.Uint64(b[8*i:])))
+		}
+		remaining -= chunk
+	}
+	return flatCoords, nil
+}
+
+func (d *Decoder) checkDepth(depth int) error {
+	if d.opts.maxDepth > 0 && depth > d.opts.maxDepth {
+		return ErrLimitExceeded{Limit: "depth", Value: int64(depth), Max: int64(d.opts.maxDepth)}
+	}
+	return nil
+}
+
+// read n bytes from the stream into the internal buffer.
+//
+// The returned slice is only valid until the next call to read.
+func (d *Decoder) read(n int) ([]byte, error) {
+	if d.opts.maxBytes > 0 && d.bytes+int64(n) > d.opts.maxBytes {
+		return nil, ErrLimitExceeded{Limit: "bytes", Value: d.bytes + int64(n), Max: d.opts.maxBytes}
+	}
+	if cap(d.buf) < n {
+		d.buf = make([]byte, n)
+	}
+	b := d.buf[:n]
+	m, err := io.ReadFull(d.r, b)
+	d.bytes += int64(m)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return b, nil
+}
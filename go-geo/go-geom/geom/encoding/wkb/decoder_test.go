@@ -0,0 +1,159 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+func mustMarshalStream(t testing.TB, geoms ...geom.T) []byte {
+	var buf bytes.Buffer
+	for i, g := range geoms {
+		var byteOrder binary.ByteOrder = NDR
+		if i%2 == 1 {
+			byteOrder = XDR
+		}
+		require.NoError(t, WriteEWKB(&buf, byteOrder, g))
+	}
+	return buf.Bytes()
+}
+
+func TestDecoder(t *testing.T) {
+	geoms := []geom.T{
+		geom.NewPointFlat(geom.XY, []float64{1, 2}).SetSRID(4326),
+		geom.NewLineStringFlat(geom.XYZ, []float64{1, 2, 3, 4, 5, 6}),
+		geom.NewPolygonFlat(geom.XY, []float64{0, 0, 1, 0, 1, 1, 0, 0}, []int{8}),
+		geom.NewMultiPointFlat(geom.XYM, []float64{1, 2, 3, 4, 5, 6}),
+		geom.NewMultiLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []int{4, 8}),
+		geom.NewMultiPolygonFlat(geom.XY, []float64{
+			0, 0, 1, 0, 1, 1, 0, 0,
+			5, 5, 6, 5, 6, 6, 5, 5,
+		}, [][]int{{8}, {16}}),
+		geom.NewGeometryCollection().MustPush(
+			geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			geom.NewGeometryCollection().MustPush(geom.NewLineStringFlat(geom.XY, []float64{3, 4, 5, 6})),
+		),
+	}
+
+	d := NewDecoder(bytes.NewReader(mustMarshalStream(t, geoms...)))
+	for _, want := range geoms {
+		g, err := d.Decode()
+		require.NoError(t, err)
+		assert.Equal(t, want, g)
+	}
+	_, err := d.Decode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecoderDecodeInto(t *testing.T) {
+	lines := []geom.T{
+		geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6}),
+		geom.NewLineStringFlat(geom.XY, []float64{7, 8, 9, 10}),
+		geom.NewLineStringFlat(geom.XYZ, []float64{1, 2, 3, 4, 5, 6}).SetSRID(3857),
+	}
+	d := NewDecoder(bytes.NewReader(mustMarshalStream(t, lines...)))
+
+	var ls geom.LineString
+	require.NoError(t, d.DecodeInto(&ls))
+	assert.Equal(t, lines[0], &ls)
+	backing := &ls.FlatCoords()[0]
+
+	require.NoError(t, d.DecodeInto(&ls))
+	assert.Equal(t, lines[1], &ls)
+	assert.Same(t, backing, &ls.FlatCoords()[0], "expected the coordinates buffer to be reused")
+
+	require.NoError(t, d.DecodeInto(&ls))
+	assert.Equal(t, lines[2], &ls)
+
+	assert.Equal(t, io.EOF, d.DecodeInto(&ls))
+
+	d.Reset(bytes.NewReader(mustMarshalStream(t, lines[0], lines[1])))
+	var p geom.Point
+	err := d.DecodeInto(&p)
+	assert.IsType(t, wkbcommon.ErrUnexpectedType{}, err)
+	require.NoError(t, d.DecodeInto(&ls), "the mismatched geometry is consumed")
+	assert.Equal(t, lines[1], &ls)
+}
+
+func TestDecoderDecodeIntoMultiPolygon(t *testing.T) {
+	polygons := []geom.T{
+		geom.NewMultiPolygonFlat(geom.XY, []float64{
+			0, 0, 1, 0, 1, 1, 0, 0,
+			5, 5, 6, 5, 6, 6, 5, 5,
+		}, [][]int{{8}, {16}}),
+		geom.NewMultiPolygonFlat(geom.XY, []float64{
+			0, 0, 2, 0, 2, 2, 0, 0,
+		}, [][]int{{8}}),
+	}
+	d := NewDecoder(bytes.NewReader(mustMarshalStream(t, polygons...)))
+
+	var mp geom.MultiPolygon
+	for _, want := range polygons {
+		require.NoError(t, d.DecodeInto(&mp))
+		assert.Equal(t, want.FlatCoords(), mp.FlatCoords())
+		assert.Equal(t, want.Endss(), mp.Endss())
+	}
+}
+
+func TestDecoderLimits(t *testing.T) {
+	ls := geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6})
+	nested := geom.NewGeometryCollection().MustPush(
+		geom.NewGeometryCollection().MustPush(
+			geom.NewGeometryCollection().MustPush(geom.NewPointFlat(geom.XY, []float64{1, 2})),
+		),
+	)
+
+	for _, tc := range []struct {
+		name  string
+		g     geom.T
+		opts  []DecoderOption
+		limit string
+	}{
+		{name: "points", g: ls, opts: []DecoderOption{WithMaxPoints(2)}, limit: "points"},
+		{name: "bytes", g: ls, opts: []DecoderOption{WithMaxBytes(20)}, limit: "bytes"},
+		{name: "depth", g: nested, opts: []DecoderOption{WithMaxDepth(2)}, limit: "depth"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewDecoder(bytes.NewReader(mustMarshalStream(t, tc.g)), tc.opts...).Decode()
+			require.Error(t, err)
+			e, ok := err.(ErrLimitExceeded)
+			require.Truef(t, ok, "expected ErrLimitExceeded, got %T: %v", err, err)
+			assert.Equal(t, tc.limit, e.Limit)
+		})
+	}
+
+	// a forged count must be rejected before any large allocation
+	forged := []byte{0x01, 0x02, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x7f}
+	_, err := NewDecoder(bytes.NewReader(forged), WithMaxBytes(1024)).Decode()
+	assert.IsType(t, ErrLimitExceeded{}, err)
+
+	_, err = NewDecoder(bytes.NewReader(forged)).Decode()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func BenchmarkDecoderDecodeInto(b *testing.B) {
+	flatCoords := make([]float64, 2*1000)
+	for i := range flatCoords {
+		flatCoords[i] = float64(i)
+	}
+	data := mustMarshalStream(b, geom.NewLineStringFlat(geom.XY, flatCoords))
+	r := bytes.NewReader(data)
+	d := NewDecoder(r)
+	var ls geom.LineString
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Reset(data)
+		if err := d.DecodeInto(&ls); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
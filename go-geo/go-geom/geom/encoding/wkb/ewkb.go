@@ -0,0 +1,121 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+// EWKB is the PostGIS extension to WKB, which encodes dimensions as high-bit flags
+// on the geometry type and may embed the SRID of the geometry.
+const (
+	ewkbZ     = 0x80000000
+	ewkbM     = 0x40000000
+	ewkbSRID  = 0x20000000
+	ewkbFlags = ewkbZ | ewkbM | ewkbSRID
+)
+
+// dialect tells which geometry type codes are used when writing.
+type dialect uint8
+
+const (
+	isoDialect dialect = iota
+	ewkbDialect
+)
+
+// ReadEWKB reads an arbitrary geometry from r.
+//
+// This is equivalent to Read, which detects EWKB type codes: it is provided for symmetry with WriteEWKB.
+func ReadEWKB(r io.Reader) (geom.T, error) {
+	return Read(r)
+}
+
+// UnmarshalEWKB unmarshals an arbitrary geometry from a []byte, retaining any SRID
+// embedded in the EWKB encoding.
+func UnmarshalEWKB(data []byte) (geom.T, error) {
+	return Read(bytes.NewBuffer(data))
+}
+
+// WriteEWKB writes an arbitrary geometry to w, using the PostGIS EWKB encoding.
+//
+// The SRID of the geometry is embedded whenever it is not zero.
+func WriteEWKB(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
+	return write(w, byteOrder, g, ewkbDialect, g.SRID())
+}
+
+// MarshalEWKB marshals an arbitrary geometry to a []byte, using the PostGIS EWKB encoding.
+func MarshalEWKB(g geom.T, byteOrder binary.ByteOrder) ([]byte, error) {
+	w := bytes.NewBuffer(nil)
+	if err := WriteEWKB(w, byteOrder, g); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+func decodeEWKBType(wkbGeometryType uint32) (uint32, geom.Layout, bool, error) {
+	var layout geom.Layout
+	switch wkbGeometryType & (ewkbZ | ewkbM) {
+	case 0:
+		layout = geom.XY
+	case ewkbZ:
+		layout = geom.XYZ
+	case ewkbM:
+		layout = geom.XYM
+	default:
+		layout = geom.XYZM
+	}
+
+	t := wkbGeometryType &^ ewkbFlags
+	if t > wkbcommon.GeometryCollectionID {
+		return 0, geom.NoLayout, false, wkbcommon.ErrUnknownType(wkbGeometryType)
+	}
+	return t, layout, wkbGeometryType&ewkbSRID != 0, nil
+}
+
+func writeEWKBHeader(w io.Writer, byteOrder binary.ByteOrder, wkbGeometryType uint32, layout geom.Layout, srid int) error {
+	switch layout {
+	case geom.NoLayout, geom.XY:
+	case geom.XYZ:
+		wkbGeometryType |= ewkbZ
+	case geom.XYM:
+		wkbGeometryType |= ewkbM
+	case geom.XYZM:
+		wkbGeometryType |= ewkbZ | ewkbM
+	default:
+		return geom.ErrUnsupportedLayout(layout)
+	}
+	if srid != 0 {
+		wkbGeometryType |= ewkbSRID
+	}
+	if err := wkbcommon.WriteUInt32(w, byteOrder, wkbGeometryType); err != nil {
+		return err
+	}
+	if srid == 0 {
+		return nil
+	}
+	return wkbcommon.WriteUInt32(w, byteOrder, uint32(srid))
+}
+
+func setSRID(g geom.T, srid int) geom.T {
+	switch g := g.(type) {
+	case *geom.Point:
+		return g.SetSRID(srid)
+	case *geom.LineString:
+		return g.SetSRID(srid)
+	case *geom.Polygon:
+		return g.SetSRID(srid)
+	case *geom.MultiPoint:
+		return g.SetSRID(srid)
+	case *geom.MultiLineString:
+		return g.SetSRID(srid)
+	case *geom.MultiPolygon:
+		return g.SetSRID(srid)
+	case *geom.GeometryCollection:
+		return g.SetSRID(srid)
+	default:
+		return g
+	}
+}
@@ -0,0 +1,67 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestEWKB(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		g    geom.T
+		ndr  string
+	}{
+		{
+			name: "point with SRID",
+			g:    geom.NewPointFlat(geom.XY, []float64{1, 2}).SetSRID(4326),
+			ndr:  "0101000020e6100000000000000000f03f0000000000000040",
+		},
+		{
+			name: "point Z without SRID",
+			g:    geom.NewPointFlat(geom.XYZ, []float64{1, 2, 3}),
+			ndr:  "0101000080000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name: "point M with SRID",
+			g:    geom.NewPointFlat(geom.XYM, []float64{1, 2, 3}).SetSRID(3857),
+			ndr:  "0101000060110f0000000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name: "multipoint ZM with SRID",
+			g:    geom.NewMultiPointFlat(geom.XYZM, []float64{1, 2, 3, 4}).SetSRID(4326),
+			ndr:  "01040000e0e61000000100000001010000c0000000000000f03f000000000000004000000000000008400000000000001040",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := MarshalEWKB(tc.g, NDR)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ndr, hex.EncodeToString(got))
+
+			data, err := hex.DecodeString(tc.ndr)
+			require.NoError(t, err)
+			g, err := UnmarshalEWKB(data)
+			require.NoError(t, err)
+			assert.Equal(t, tc.g, g)
+			assert.Equal(t, tc.g.SRID(), g.SRID())
+
+			// round trip with XDR
+			xdr, err := MarshalEWKB(tc.g, XDR)
+			require.NoError(t, err)
+			g, err = Unmarshal(xdr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.g, g)
+		})
+	}
+}
+
+func TestEWKBUnknownType(t *testing.T) {
+	data, err := hex.DecodeString("0111000020e6100000")
+	require.NoError(t, err)
+	_, err = UnmarshalEWKB(data)
+	assert.Error(t, err)
+}
@@ -0,0 +1,464 @@
+// Package native implements Well Known Binary encoding and decoding for the geometries
+// of the geom package.
+//
+// Unlike its parent wkb package, which works with github.com/twpayne/go-geom types, this package
+// encodes geom.T geometries directly and decodes them using the utils factories, with the layout
+// and SRID found in the input.
+//
+// Both ISO WKB and PostGIS EWKB are supported. Measured (M) layouts are not supported.
+package native
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+const (
+	wkbXYZID = 1000
+	wkbXYMID = 2000
+
+	ewkbZ     = 0x80000000
+	ewkbM     = 0x40000000
+	ewkbSRID  = 0x20000000
+	ewkbFlags = ewkbZ | ewkbM | ewkbSRID
+)
+
+// sridder is implemented by geometries which know about their SRID
+type sridder interface {
+	SRID() uint32
+}
+
+// ErrUnsupportedType is returned when a geometry can't be represented in WKB.
+type ErrUnsupportedType struct {
+	Value interface{}
+}
+
+func (e ErrUnsupportedType) Error() string {
+	return fmt.Sprintf("wkb: unsupported type: %T", e.Value)
+}
+
+// Read reads an arbitrary geometry from r.
+//
+// Both ISO WKB and PostGIS EWKB type codes are supported. When the input is EWKB with
+// an embedded SRID, the geometry is built with this SRID.
+func Read(r io.Reader) (geom.T, error) {
+	return read(r, 0)
+}
+
+// Unmarshal unmarshals an arbitrary geometry from a []byte.
+func Unmarshal(data []byte) (geom.T, error) {
+	return Read(bytes.NewReader(data))
+}
+
+// Write writes an arbitrary geometry to w, using ISO WKB type codes.
+func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
+	return write(w, byteOrder, g, false, 0)
+}
+
+// Marshal marshals an arbitrary geometry to a []byte, using ISO WKB type codes.
+func Marshal(g geom.T, byteOrder binary.ByteOrder) ([]byte, error) {
+	w := bytes.NewBuffer(nil)
+	if err := Write(w, byteOrder, g); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+// WriteEWKB writes an arbitrary geometry to w, using the PostGIS EWKB encoding.
+//
+// The SRID of the geometry is embedded whenever it is known and not zero.
+func WriteEWKB(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
+	var srid uint32
+	if s, ok := g.(sridder); ok {
+		srid = s.SRID()
+	}
+	return write(w, byteOrder, g, true, srid)
+}
+
+// MarshalEWKB marshals an arbitrary geometry to a []byte, using the PostGIS EWKB encoding.
+func MarshalEWKB(g geom.T, byteOrder binary.ByteOrder) ([]byte, error) {
+	w := bytes.NewBuffer(nil)
+	if err := WriteEWKB(w, byteOrder, g); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+// header describes a geometry as found in the input
+type header struct {
+	byteOrder binary.ByteOrder
+	typ       uint32
+	layout    geom.Layout
+	srid      uint32
+}
+
+// opts yields the options to build the geometry with the utils factories
+func (h header) opts() []geom.LayoutOption {
+	opts := []geom.LayoutOption{geom.WithLayout(h.layout)}
+	if h.srid != 0 {
+		opts = append(opts, geom.WithSRID(h.srid))
+	}
+	return opts
+}
+
+func readHeader(r io.Reader, srid uint32) (header, error) {
+	wkbByteOrder, err := wkbcommon.ReadByte(r)
+	if err != nil {
+		return header{}, err
+	}
+	var byteOrder binary.ByteOrder
+	switch wkbByteOrder {
+	case wkbcommon.XDRID:
+		byteOrder = wkbcommon.XDR
+	case wkbcommon.NDRID:
+		byteOrder = wkbcommon.NDR
+	default:
+		return header{}, wkbcommon.ErrUnknownByteOrder(wkbByteOrder)
+	}
+
+	wkbGeometryType, err := wkbcommon.ReadUInt32(r, byteOrder)
+	if err != nil {
+		return header{}, err
+	}
+
+	layout := geom.XY
+	t := wkbGeometryType
+	switch {
+	case t&ewkbFlags != 0:
+		if t&ewkbM != 0 {
+			return header{}, wkbcommon.ErrUnsupportedType(wkbGeometryType)
+		}
+		if t&ewkbZ != 0 {
+			layout = geom.XYZ
+		}
+		if t&ewkbSRID != 0 {
+			if srid, err = wkbcommon.ReadUInt32(r, byteOrder); err != nil {
+				return header{}, err
+			}
+		}
+		t &^= ewkbFlags
+	case t >= wkbXYMID:
+		return header{}, wkbcommon.ErrUnsupportedType(wkbGeometryType)
+	case t >= wkbXYZID:
+		layout = geom.XYZ
+		t -= wkbXYZID
+	}
+	if t < wkbcommon.PointID || t > wkbcommon.GeometryCollectionID {
+		return header{}, wkbcommon.ErrUnknownType(wkbGeometryType)
+	}
+
+	return header{byteOrder: byteOrder, typ: t, layout: layout, srid: srid}, nil
+}
+
+// read reads a geometry, which inherits the SRID of its enclosing collection, if any
+func read(r io.Reader, srid uint32) (geom.T, error) {
+	h, err := readHeader(r, srid)
+	if err != nil {
+		return nil, err
+	}
+	srid = h.srid
+
+	switch h.typ {
+	case wkbcommon.PointID:
+		return readPoint(r, h)
+	case wkbcommon.LineStringID:
+		points, err := readPoints(r, h, 1)
+		if err != nil {
+			return nil, err
+		}
+		return utils.NewLineString(points, h.opts()...), nil
+	case wkbcommon.PolygonID:
+		return readPolygon(r, h)
+	case wkbcommon.MultiPointID:
+		n, err := readCount(r, h.byteOrder, 1)
+		if err != nil {
+			return nil, err
+		}
+		points := make(geom.PointCollection, n)
+		for i := range points {
+			g, err := read(r, srid)
+			if err != nil {
+				return nil, err
+			}
+			p, ok := g.(geom.Point)
+			if !ok {
+				return nil, wkbcommon.ErrUnexpectedType{Got: g, Want: points}
+			}
+			points[i] = p
+		}
+		return points, nil
+	case wkbcommon.MultiLineStringID:
+		n, err := readCount(r, h.byteOrder, 2)
+		if err != nil {
+			return nil, err
+		}
+		lineStrings := make(geom.LineStringCollection, n)
+		for i := range lineStrings {
+			g, err := read(r, srid)
+			if err != nil {
+				return nil, err
+			}
+			ls, ok := g.(geom.LineString)
+			if !ok {
+				return nil, wkbcommon.ErrUnexpectedType{Got: g, Want: lineStrings}
+			}
+			lineStrings[i] = ls
+		}
+		return lineStrings, nil
+	case wkbcommon.MultiPolygonID:
+		n, err := readCount(r, h.byteOrder, 3)
+		if err != nil {
+			return nil, err
+		}
+		polygons := make(geom.PolygonCollection, n)
+		for i := range polygons {
+			g, err := read(r, srid)
+			if err != nil {
+				return nil, err
+			}
+			p, ok := g.(geom.Polygon)
+			if !ok {
+				return nil, wkbcommon.ErrUnexpectedType{Got: g, Want: polygons}
+			}
+			polygons[i] = p
+		}
+		return polygons, nil
+	default: // wkbcommon.GeometryCollectionID
+		n, err := readCount(r, h.byteOrder, 1)
+		if err != nil {
+			return nil, err
+		}
+		geoms := make(geom.Collection, n)
+		for i := range geoms {
+			if geoms[i], err = read(r, srid); err != nil {
+				return nil, err
+			}
+		}
+		return geoms, nil
+	}
+}
+
+func readCount(r io.Reader, byteOrder binary.ByteOrder, level int) (int, error) {
+	n, err := wkbcommon.ReadUInt32(r, byteOrder)
+	if err != nil {
+		return 0, err
+	}
+	if limit := wkbcommon.MaxGeometryElements[level]; limit >= 0 && int(n) > limit {
+		return 0, wkbcommon.ErrGeometryTooLarge{Level: level, N: int(n), Limit: limit}
+	}
+	return int(n), nil
+}
+
+func readCoords(r io.Reader, h header) ([]float64, error) {
+	coords := make([]float64, h.layout.Dimensions())
+	if err := wkbcommon.ReadFloatArray(r, h.byteOrder, coords); err != nil {
+		return nil, err
+	}
+	return coords, nil
+}
+
+func newPoint(coords []float64, h header) (geom.Point, error) {
+	var err error
+	p := utils.NewPoint(h.opts()...).WithCoords(coords, func(e error) { err = e })
+	return p, err
+}
+
+func readPoint(r io.Reader, h header) (geom.Point, error) {
+	coords, err := readCoords(r, h)
+	if err != nil {
+		return nil, err
+	}
+	return newPoint(coords, h)
+}
+
+func readPoints(r io.Reader, h header, level int) ([]geom.Point, error) {
+	n, err := readCount(r, h.byteOrder, level)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]geom.Point, n)
+	for i := range points {
+		if points[i], err = readPoint(r, h); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
+func readPolygon(r io.Reader, h header) (geom.Polygon, error) {
+	n, err := readCount(r, h.byteOrder, 1)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return utils.NewPolygon(nil, h.opts()...), nil
+	}
+	exterior, err := readPoints(r, h, 2)
+	if err != nil {
+		return nil, err
+	}
+	holes := make([][]geom.Point, n-1)
+	for i := range holes {
+		if holes[i], err = readPoints(r, h, 2); err != nil {
+			return nil, err
+		}
+	}
+	return utils.NewPolygonWithHoles(exterior, holes, h.opts()...), nil
+}
+
+func write(w io.Writer, byteOrder binary.ByteOrder, g geom.T, ewkb bool, srid uint32) error {
+	layout := g.Layout()
+	if layout == geom.NoLayout {
+		// empty geometries with no layout, such as empty collections
+		layout = geom.XY
+	}
+	switch g := g.(type) {
+	case geom.EmptyGeometry:
+		return writeCollection(w, byteOrder, geom.Collection{}, geom.XY, ewkb, srid)
+	case geom.Point:
+		if err := writeHeader(w, byteOrder, wkbcommon.PointID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return wkbcommon.WriteFlatCoords0(w, byteOrder, g.Coords())
+	case geom.Bounds:
+		if err := writeHeader(w, byteOrder, wkbcommon.PolygonID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return writeRings(w, byteOrder, [][][]float64{utils.BoundsCoords(g)})
+	case geom.Ring:
+		if err := writeHeader(w, byteOrder, wkbcommon.PolygonID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return writeRings(w, byteOrder, [][][]float64{utils.RingCoords(g)})
+	case geom.Line:
+		if err := writeHeader(w, byteOrder, wkbcommon.LineStringID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return writePoints(w, byteOrder, g.FlatCoords())
+	case geom.LineString:
+		if err := writeHeader(w, byteOrder, wkbcommon.LineStringID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return writePoints(w, byteOrder, g.FlatCoords())
+	case geom.Polygon:
+		if err := writeHeader(w, byteOrder, wkbcommon.PolygonID, layout, ewkb, srid); err != nil {
+			return err
+		}
+		return writeRings(w, byteOrder, utils.PolygonCoords(g))
+	case geom.PointCollection:
+		members := make(geom.Collection, len(g))
+		for i := range g {
+			members[i] = g[i]
+		}
+		return writeMulti(w, byteOrder, wkbcommon.MultiPointID, members, layout, ewkb, srid)
+	case geom.LineStringCollection:
+		members := make(geom.Collection, len(g))
+		for i := range g {
+			members[i] = g[i]
+		}
+		return writeMulti(w, byteOrder, wkbcommon.MultiLineStringID, members, layout, ewkb, srid)
+	case geom.PolygonCollection:
+		members := make(geom.Collection, len(g))
+		for i := range g {
+			members[i] = g[i]
+		}
+		return writeMulti(w, byteOrder, wkbcommon.MultiPolygonID, members, layout, ewkb, srid)
+	case geom.Collection:
+		return writeCollection(w, byteOrder, g, layout, ewkb, srid)
+	default:
+		return ErrUnsupportedType{Value: g}
+	}
+}
+
+func writeCollection(w io.Writer, byteOrder binary.ByteOrder, c geom.Collection, layout geom.Layout, ewkb bool, srid uint32) error {
+	return writeMulti(w, byteOrder, wkbcommon.GeometryCollectionID, c, layout, ewkb, srid)
+}
+
+// writeMulti writes a collection: members are written with their own header, but without any SRID
+func writeMulti(w io.Writer, byteOrder binary.ByteOrder, t uint32, members geom.Collection, layout geom.Layout, ewkb bool, srid uint32) error {
+	if err := writeHeader(w, byteOrder, t, layout, ewkb, srid); err != nil {
+		return err
+	}
+	if err := wkbcommon.WriteUInt32(w, byteOrder, uint32(len(members))); err != nil {
+		return err
+	}
+	for _, g := range members {
+		if err := write(w, byteOrder, g, ewkb, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHeader(w io.Writer, byteOrder binary.ByteOrder, t uint32, layout geom.Layout, ewkb bool, srid uint32) error {
+	var wkbByteOrder byte
+	switch byteOrder {
+	case wkbcommon.XDR:
+		wkbByteOrder = wkbcommon.XDRID
+	case wkbcommon.NDR:
+		wkbByteOrder = wkbcommon.NDRID
+	default:
+		return wkbcommon.ErrUnsupportedByteOrder{}
+	}
+	if err := wkbcommon.WriteByte(w, wkbByteOrder); err != nil {
+		return err
+	}
+
+	var hasZ bool
+	switch layout {
+	case geom.XY, geom.XYEarth, geom.XYSpherical, geom.S2:
+	case geom.XYZ, geom.XYZEarth, geom.XYZSpherical, geom.S3:
+		hasZ = true
+	default:
+		// measured layouts are not supported
+		return codes.ErrUnsupportedLayout
+	}
+
+	switch {
+	case !ewkb && hasZ:
+		t += wkbXYZID
+	case ewkb && hasZ:
+		t |= ewkbZ
+	}
+	if ewkb && srid != 0 {
+		t |= ewkbSRID
+	}
+	if err := wkbcommon.WriteUInt32(w, byteOrder, t); err != nil {
+		return err
+	}
+	if !ewkb || srid == 0 {
+		return nil
+	}
+	return wkbcommon.WriteUInt32(w, byteOrder, srid)
+}
+
+func writePoints(w io.Writer, byteOrder binary.ByteOrder, coords [][]float64) error {
+	if err := wkbcommon.WriteUInt32(w, byteOrder, uint32(len(coords))); err != nil {
+		return err
+	}
+	for _, c := range coords {
+		if err := wkbcommon.WriteFloatArray(w, byteOrder, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeRings(w io.Writer, byteOrder binary.ByteOrder, rings [][][]float64) error {
+	if err := wkbcommon.WriteUInt32(w, byteOrder, uint32(len(rings))); err != nil {
+		return err
+	}
+	for _, ring := range rings {
+		if err := writePoints(w, byteOrder, ring); err != nil {
+			return err
+		}
+	}
+	return nil
+}
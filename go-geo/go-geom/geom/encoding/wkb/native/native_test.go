@@ -0,0 +1,100 @@
+package native
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hex  string
+	}{
+		{name: "point", hex: "010100000000000000000000000000000000000040"},
+		{name: "point Z", hex: "01e9030000000000000000f03f00000000000000400000000000000840"},
+		{name: "linestring", hex: "010200000002000000000000000000f03f000000000000004000000000000008400000000000001040"},
+		{name: "polygon", hex: "01030000000100000004000000000000000000000000000000000000000000000000000000000000000000f03f000000000000f03f000000000000f03f00000000000000000000000000000000"},
+		{name: "multipoint", hex: "0104000000020000000101000000000000000000f03f0000000000000040010100000000000000000008400000000000001040"},
+		{name: "empty geometry collection", hex: "010700000000000000"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.hex)
+			require.NoError(t, err)
+			g, err := Unmarshal(data)
+			require.NoError(t, err)
+			got, err := Marshal(g, wkbcommon.NDR)
+			require.NoError(t, err)
+			assert.Equal(t, tc.hex, hex.EncodeToString(got))
+		})
+	}
+}
+
+func TestEWKB(t *testing.T) {
+	data, err := hex.DecodeString("0101000020e6100000000000000000f03f0000000000000040")
+	require.NoError(t, err)
+	g, err := Unmarshal(data)
+	require.NoError(t, err)
+
+	p, ok := g.(geom.Point)
+	require.True(t, ok)
+	assert.Equal(t, []float64{1, 2}, p.Coords())
+	assert.Equal(t, geom.XY, p.Layout())
+	if s, ok := g.(sridder); ok {
+		assert.EqualValues(t, 4326, s.SRID())
+		got, err := MarshalEWKB(g, wkbcommon.NDR)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+	}
+}
+
+func TestMarshalMeasured(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		g    geom.T
+	}{
+		{name: "point XYM", g: utils.NewPoint(geom.WithLayout(geom.XYM)).WithCoords([]float64{1, 2, 3})},
+		{name: "point XYZM", g: utils.NewPoint(geom.WithLayout(geom.XYZM)).WithCoords([]float64{1, 2, 3, 4})},
+		{name: "linestring XYM", g: utils.NewLineString([]geom.Point{
+			utils.NewPoint(geom.WithLayout(geom.XYM)).WithCoords([]float64{1, 2, 3}),
+			utils.NewPoint(geom.WithLayout(geom.XYM)).WithCoords([]float64{4, 5, 6}),
+		}, geom.WithLayout(geom.XYM))},
+		{name: "linestring XYZM", g: utils.NewLineString([]geom.Point{
+			utils.NewPoint(geom.WithLayout(geom.XYZM)).WithCoords([]float64{1, 2, 3, 4}),
+			utils.NewPoint(geom.WithLayout(geom.XYZM)).WithCoords([]float64{5, 6, 7, 8}),
+		}, geom.WithLayout(geom.XYZM))},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// M must not be written as Z, which would decode back as XYZ
+			_, err := Marshal(tc.g, wkbcommon.NDR)
+			assert.Equal(t, codes.ErrUnsupportedLayout, err)
+			_, err = MarshalEWKB(tc.g, wkbcommon.NDR)
+			assert.Equal(t, codes.ErrUnsupportedLayout, err)
+		})
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		hex  string
+		err  error
+	}{
+		{name: "measured", hex: "01d1070000000000000000f03f00000000000000400000000000000840", err: wkbcommon.ErrUnsupportedType(2001)},
+		{name: "unknown type", hex: "0111000000", err: wkbcommon.ErrUnknownType(17)},
+		{name: "byte order", hex: "0201000000", err: wkbcommon.ErrUnknownByteOrder(2)},
+	} {
+		data, err := hex.DecodeString(tc.hex)
+		require.NoError(t, err)
+		_, err = Unmarshal(data)
+		assert.Equalf(t, tc.err, err, tc.name)
+	}
+}
@@ -0,0 +1,351 @@
+package wkb
+
+import (
+	"github.com/twpayne/go-geom"
+)
+
+// random is a collection of randomly-generated test data, after the test data of go-geom,
+// whose internal packages can't be imported.
+var random = []struct {
+	G   geom.T
+	Hex string
+	WKB []byte
+	WKT string
+}{
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.688844, 0.515909}),
+		"010100000064778192020be63f3ac956975382e03f",
+		mustHexDecode("010100000064778192020BE63F3AC956975382E03F"),
+		"POINT (0.688844 0.515909)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-0.158857, -0.482167}),
+		"010100000057cede196d55c4bfb8770dfad2dbdebf",
+		mustHexDecode("010100000057CEDE196D55C4BFB8770DFAD2DBDEBF"),
+		"POINT (-0.158857 -0.482167)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.022549, -0.190132}),
+		"010100000054e23ac61517973f3927f6d03e56c8bf",
+		mustHexDecode("010100000054E23AC61517973F3927F6D03E56C8BF"),
+		"POINT (0.022549 -0.190132)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.567597, -0.393375}),
+		"0101000000c7d8092fc129e23f931804560e2dd9bf",
+		mustHexDecode("0101000000C7D8092FC129E23F931804560E2DD9BF"),
+		"POINT (0.567597 -0.393375)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-0.046806, 0.166764}),
+		"0101000000ee5d83bef4f6a7bfeb3713d38558c53f",
+		mustHexDecode("0101000000EE5D83BEF4F6A7BFEB3713D38558C53F"),
+		"POINT (-0.046806 0.166764)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.816226, 0.009374}),
+		"0101000000fda204fd851eea3f842d76fbac32833f",
+		mustHexDecode("0101000000FDA204FD851EEA3F842D76FBAC32833F"),
+		"POINT (0.816226 0.009374)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-0.436325, 0.511609}),
+		"01010000003f575bb1bfecdbbf40db6ad6195fe03f",
+		mustHexDecode("01010000003F575BB1BFECDBBF40DB6AD6195FE03F"),
+		"POINT (-0.436325 0.511609)",
+	},
+	{
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.236738, -0.498988}),
+		"0101000000a437dc476e4dce3f342c465d6befdfbf",
+		mustHexDecode("0101000000A437DC476E4DCE3F342C465D6BEFDFBF"),
+		"POINT (0.236738 -0.498988)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.965571, 0.620435}, {0.804332, -0.379705}, {0.459664, 0.797677}, {0.367968, -0.055715}, {-0.798598, -0.131656}, {0.221774, 0.826023}, {0.933213, -0.04598}, {0.73062, -0.479016}}),
+		"0102000000080000009ae95e27f5e5ee3f7b6649809adae33f6c0a647616bde93fe92b4833164dd8bfbf81c98d226bdd3f3eaf78ea9186e93fab5e7ea7c98cd73f6614cb2dad86acbf0fd594641d8ee9bf103d29931adac0bf83328d261763cc3f46cd57c9c76eea3f2d776682e1dced3fed478ac8b08aa7bf70b6b9313d61e73fe6af90b932a8debf",
+		mustHexDecode("0102000000080000009AE95E27F5E5EE3F7B6649809ADAE33F6C0A647616BDE93FE92B4833164DD8BFBF81C98D226BDD3F3EAF78EA9186E93FAB5E7EA7C98CD73F6614CB2DAD86ACBF0FD594641D8EE9BF103D29931ADAC0BF83328D261763CC3F46CD57C9C76EEA3F2D776682E1DCED3FED478AC8B08AA7BF70B6B9313D61E73FE6AF90B932A8DEBF"),
+		"LINESTRING (0.965571 0.620435, 0.804332 -0.379705, 0.459664 0.797677, 0.367968 -0.055715, -0.798598 -0.131656, 0.221774 0.826023, 0.933213 -0.04598, 0.73062 -0.479016)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.097399, -0.971917}, {0.43941, -0.202353}, {0.64969, 0.336307}, {-0.997715, -0.012844}, {0.735206, -0.512179}, {-0.349591, 0.740943}, {-0.617866, 0.135022}}),
+		"0102000000070000002fc1a90f24efb83f55a52daef119efbf5743e21e4b1fdc3fb0ad9ffeb3e6c9bf4339d1ae42cae43fa69d9acb0d86d53f0551f70148edefbf533d997ff44d8abfd255babbce86e73fe355d636c563e0bfbc75feedb25fd6bf73672618ceb5e73f46eee9ea8ec5e3bf19c91ea16648c13f",
+		mustHexDecode("0102000000070000002FC1A90F24EFB83F55A52DAEF119EFBF5743E21E4B1FDC3FB0AD9FFEB3E6C9BF4339D1AE42CAE43FA69D9ACB0D86D53F0551F70148EDEFBF533D997FF44D8ABFD255BABBCE86E73FE355D636C563E0BFBC75FEEDB25FD6BF73672618CEB5E73F46EEE9EA8EC5E3BF19C91EA16648C13F"),
+		"LINESTRING (0.097399 -0.971917, 0.43941 -0.202353, 0.64969 0.336307, -0.997715 -0.012844, 0.735206 -0.5121790000000001, -0.349591 0.740943, -0.617866 0.135022)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.935081, 0.606359}, {-0.104061, -0.839109}, {-0.359891, 0.015881}}),
+		"0102000000030000001e8d43fd2eeced3f155454fd4a67e33f93ffc9dfbda3babfbbef181efbd9eabfadfbc7427408d7bfb6d782de1b43903f",
+		mustHexDecode("0102000000030000001E8D43FD2EECED3F155454FD4A67E33F93FFC9DFBDA3BABFBBEF181EFBD9EABFADFBC7427408D7BFB6D782DE1B43903F"),
+		"LINESTRING (0.9350810000000001 0.606359, -0.104061 -0.839109, -0.359891 0.015881)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-0.781885, 0.102535}, {0.413123, 0.094882}, {0.628934, 0.080567}, {0.927678, 0.206371}, {0.175234, -0.110022}, {0.192574, -0.230198}, {0.151302, -0.419341}, {-0.621218, -0.626541}}),
+		"010200000008000000697407b13305e9bf1d03b2d7bb3fba3f166d8e739b70da3f76a4face2f4ab83f77f52a323a20e43f6c0723f609a0b43f7effe6c589afed3f65e1eb6b5d6aca3f8cd99255116ec63fcb2bd7db662abcbfefaa07cc43a6c83fcc63cdc82077cdbfd6e1e82add5dc33fcdcd37a27bd6dabf0af8359204e1e3bfe44c13b69f0ce4bf",
+		mustHexDecode("010200000008000000697407B13305E9BF1D03B2D7BB3FBA3F166D8E739B70DA3F76A4FACE2F4AB83F77F52A323A20E43F6C0723F609A0B43F7EFFE6C589AFED3F65E1EB6B5D6ACA3F8CD99255116EC63FCB2BD7DB662ABCBFEFAA07CC43A6C83FCC63CDC82077CDBFD6E1E82ADD5DC33FCDCD37A27BD6DABF0AF8359204E1E3BFE44C13B69F0CE4BF"),
+		"LINESTRING (-0.7818850000000001 0.102535, 0.413123 0.09488199999999999, 0.628934 0.080567, 0.927678 0.206371, 0.175234 -0.110022, 0.192574 -0.230198, 0.151302 -0.419341, -0.621218 -0.626541)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.313319, -0.046938}, {-0.820352, 0.515208}, {0.753541, 0.846762}, {0.684921, 0.796347}, {0.846165, 0.0812}, {-0.217408, 0.410567}}),
+		"01020000000600000082c98d226b0dd43f8b19e1ed4108a8bf82a966d65240eabff566d47c957ce03f8e3ee603021de83f90db2f9fac18eb3fe7feea71dfeae53f199128b4ac7be93f87a2409fc813eb3f44696ff085c9b43f26c3f17c06d4cbbffa4674cfba46da3f",
+		mustHexDecode("01020000000600000082C98D226B0DD43F8B19E1ED4108A8BF82A966D65240EABFF566D47C957CE03F8E3EE603021DE83F90DB2F9FAC18EB3FE7FEEA71DFEAE53F199128B4AC7BE93F87A2409FC813EB3F44696FF085C9B43F26C3F17C06D4CBBFFA4674CFBA46DA3F"),
+		"LINESTRING (0.313319 -0.046938, -0.820352 0.515208, 0.753541 0.846762, 0.684921 0.796347, 0.8461649999999999 0.08119999999999999, -0.217408 0.410567)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.623258, 0.698972}, {0.790078, 0.179602}, {0.89953, 0.15939}}),
+		"010200000003000000410ddfc2baf1e33f153b1a87fa5de63f354069a85148e93f9fe925c632fdc63f0dab7823f3c8ec3fbb9ba73ae466c43f",
+		mustHexDecode("010200000003000000410DDFC2BAF1E33F153B1A87FA5DE63F354069A85148E93F9FE925C632FDC63F0DAB7823F3C8EC3FBB9BA73AE466C43F"),
+		"LINESTRING (0.623258 0.698972, 0.7900779999999999 0.179602, 0.8995300000000001 0.15939)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.320491, 0.992516}, {0.833883, 0.58665}, {-0.835254, 0.225566}, {-0.027112, 0.260295}, {0.690155, -0.513929}}),
+		"010200000005000000b763eaaeec82d43f2e3718eab0c2ef3f7f17b6662bafea3f4b598638d6c5e23f494dbb9866baeabfea758bc058dfcc3f390d51853fc39bbf0dfd135caca8d03fd97745f0bf15e63f57e9ee3a1b72e0bf",
+		mustHexDecode("010200000005000000B763EAAEEC82D43F2E3718EAB0C2EF3F7F17B6662BAFEA3F4B598638D6C5E23F494DBB9866BAEABFEA758BC058DFCC3F390D51853FC39BBF0DFD135CACA8D03FD97745F0BF15E63F57E9EE3A1B72E0BF"),
+		"LINESTRING (0.320491 0.992516, 0.833883 0.58665, -0.8352540000000001 0.225566, -0.027112 0.260295, 0.690155 -0.513929)",
+	},
+	{
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-0.765732, -0.559079}, {0.589166, -0.334928}, {0.631827, -0.798785}, {-0.707283, 0.395341}, {-0.909532, 0.147732}, {0.820032, 0.068396}, {0.361178, -0.946607}}),
+		"01020000000700000081053065e080e8bf1b2c9ca4f9e3e1bfcb48bda772dae23fa6f0a0d9756fd5bf755ab741ed37e43fab21718fa58fe9bf5d8940f50fa2e6bfdd257156444dd93f8c4b55dae21aedbf514d49d6e1e8c23f158db5bfb33dea3f075e2d776682b13f543882548a1dd73ffe4465c39a4aeebf",
+		mustHexDecode("01020000000700000081053065E080E8BF1B2C9CA4F9E3E1BFCB48BDA772DAE23FA6F0A0D9756FD5BF755AB741ED37E43FAB21718FA58FE9BF5D8940F50FA2E6BFDD257156444DD93F8C4B55DAE21AEDBF514D49D6E1E8C23F158DB5BFB33DEA3F075E2D776682B13F543882548A1DD73FFE4465C39A4AEEBF"),
+		"LINESTRING (-0.765732 -0.559079, 0.589166 -0.334928, 0.631827 -0.798785, -0.707283 0.395341, -0.909532 0.147732, 0.820032 0.068396, 0.361178 -0.946607)",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.212677, 0.151906}, {-0.217581, -0.25972}, {0.961034, -0.927216}, {-0.956727, 0.922063}, {-0.630056, -0.75221}, {-0.578847, 0.601493}, {0.212677, 0.151906}}, {{-0.148762, -0.797}, {-0.48016, -0.558342}, {0.293852, -0.299412}, {-0.148762, -0.797}}, {{0.007273, -0.921243}, {-0.798158, 0.976471}, {-0.601289, -0.28289}, {0.463197, 0.676653}, {0.007273, -0.921243}}, {{-0.661151, 0.345281}, {0.933098, -0.883899}, {0.352404, 0.69085}, {-0.315375, -0.498626}, {0.193583, -0.115372}, {-0.650361, -0.056749}, {-0.180189, 0.138226}, {0.0172, -0.377108}, {-0.661151, 0.345281}}, {{0.675323, -0.498135}, {0.1212, -0.975128}, {0.483149, -0.328167}, {-0.908607, -0.438234}, {-0.519739, 0.906259}, {0.675323, -0.498135}}}),
+		"010300000005000000070000001842cefbff38cb3f757808e3a771c33f9f909db7b1d9cbbfe57e87a2409fd0bf93020b60cac0ee3f747e8ae3c0abedbff96706f1819deebfb340bb438a81ed3f22c154336b29e4bf522cb7b41a12e8bf2368cc24ea85e2bfd4bb783f6e3fe33f1842cefbff38cb3f757808e3a771c33f040000004e9b711aa20ac3bf1b2fdd240681e9bf253b3602f1badebfa376bf0af0dde1bfec4e779e78ced23fbcea01f39029d3bf4e9b711aa20ac3bf1b2fdd240681e9bf050000009e094d124bca7d3fdc679599d27aedbff94d61a5828ae9bfdfc14f1c403fef3fb439ce6dc23de3bf5e6397a8de1ad2bf6fbda60705a5dd3f70b0373124a7e53f9e094d124bca7d3fdc679599d27aedbf090000005ef756242628e5bf0188bb7a1519d63ff6d03e56f0dbed3f84f23e8ee648ecbf81b1be81c98dd63f96218e75711be63fc976be9f1a2fd4bfe4dbbb067de9dfbf76e107e753c7c83fcec5dff60489bdbfde0033dfc1cfe4bf09522976340eadbf32aa0ce36e10c7bf917bbaba63b1c13f22fdf675e09c913f84d6c3978922d8bf5ef756242628e5bf0188bb7a1519d63f060000004b92e7fa3e9ce53fa88c7f9f71e1dfbf82734694f606bf3f923eada23f34efbf2f1686c8e9ebde3f76172829b000d5bf6155bdfc4e13edbf68b27f9e060cdcbf7495eeaeb3a1e0bfcd94d6df1200ed3f4b92e7fa3e9ce53fa88c7f9f71e1dfbf",
+		mustHexDecode("010300000005000000070000001842CEFBFF38CB3F757808E3A771C33F9F909DB7B1D9CBBFE57E87A2409FD0BF93020B60CAC0EE3F747E8AE3C0ABEDBFF96706F1819DEEBFB340BB438A81ED3F22C154336B29E4BF522CB7B41A12E8BF2368CC24EA85E2BFD4BB783F6E3FE33F1842CEFBFF38CB3F757808E3A771C33F040000004E9B711AA20AC3BF1B2FDD240681E9BF253B3602F1BADEBFA376BF0AF0DDE1BFEC4E779E78CED23FBCEA01F39029D3BF4E9B711AA20AC3BF1B2FDD240681E9BF050000009E094D124BCA7D3FDC679599D27AEDBFF94D61A5828AE9BFDFC14F1C403FEF3FB439CE6DC23DE3BF5E6397A8DE1AD2BF6FBDA60705A5DD3F70B0373124A7E53F9E094D124BCA7D3FDC679599D27AEDBF090000005EF756242628E5BF0188BB7A1519D63FF6D03E56F0DBED3F84F23E8EE648ECBF81B1BE81C98DD63F96218E75711BE63FC976BE9F1A2FD4BFE4DBBB067DE9DFBF76E107E753C7C83FCEC5DFF60489BDBFDE0033DFC1CFE4BF09522976340EADBF32AA0CE36E10C7BF917BBABA63B1C13F22FDF675E09C913F84D6C3978922D8BF5EF756242628E5BF0188BB7A1519D63F060000004B92E7FA3E9CE53FA88C7F9F71E1DFBF82734694F606BF3F923EADA23F34EFBF2F1686C8E9EBDE3F76172829B000D5BF6155BDFC4E13EDBF68B27F9E060CDCBF7495EEAEB3A1E0BFCD94D6DF1200ED3F4B92E7FA3E9CE53FA88C7F9F71E1DFBF"),
+		"POLYGON ((0.212677 0.151906, -0.217581 -0.25972, 0.9610340000000001 -0.927216, -0.956727 0.922063, -0.6300559999999999 -0.75221, -0.578847 0.6014930000000001, 0.212677 0.151906), (-0.148762 -0.797, -0.48016 -0.558342, 0.293852 -0.299412, -0.148762 -0.797), (0.007273 -0.921243, -0.798158 0.976471, -0.601289 -0.28289, 0.463197 0.6766529999999999, 0.007273 -0.921243), (-0.661151 0.345281, 0.933098 -0.883899, 0.352404 0.69085, -0.315375 -0.498626, 0.193583 -0.115372, -0.650361 -0.056749, -0.180189 0.138226, 0.0172 -0.377108, -0.661151 0.345281), (0.675323 -0.498135, 0.1212 -0.975128, 0.483149 -0.328167, -0.9086070000000001 -0.438234, -0.519739 0.906259, 0.675323 -0.498135))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.424244, -0.281598}, {0.893812, 0.267496}, {0.242154, 0.431239}, {-0.223966, -0.171164}, {0.301666, -0.996952}, {-0.424244, -0.281598}}}),
+		"01030000000100000006000000e38c614ed026dbbfd49d279eb305d2bf1cb7989f1b9aec3f69e4f38aa71ed13f193c4cfbe6fece3fa59f70766b99db3f643db5faeaaaccbf5d5320b3b3e8c5bf302b14e97e4ed33f3dd175e107e7efbfe38c614ed026dbbfd49d279eb305d2bf",
+		mustHexDecode("01030000000100000006000000E38C614ED026DBBFD49D279EB305D2BF1CB7989F1B9AEC3F69E4F38AA71ED13F193C4CFBE6FECE3FA59F70766B99DB3F643DB5FAEAAACCBF5D5320B3B3E8C5BF302B14E97E4ED33F3DD175E107E7EFBFE38C614ED026DBBFD49D279EB305D2BF"),
+		"POLYGON ((-0.424244 -0.281598, 0.8938120000000001 0.267496, 0.242154 0.431239, -0.223966 -0.171164, 0.301666 -0.9969519999999999, -0.424244 -0.281598))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.521168, 0.274799}, {-0.242704, 0.750847}, {0.136303, -0.171187}, {-0.195466, 0.403659}, {-0.163547, 0.324392}, {-0.521168, 0.274799}}}),
+		"010300000001000000060000006518778368ade0bfe44d7e8b4e96d13f87df4db7ec10cfbf3e97a949f006e83f4660ac6f6072c13f890b40a374e9c5bf8b6ebda60705c9bfac1bef8e8cd5d93fd4f02dac1befc4bfc860c5a9d6c2d43f6518778368ade0bfe44d7e8b4e96d13f",
+		mustHexDecode("010300000001000000060000006518778368ADE0BFE44D7E8B4E96D13F87DF4DB7EC10CFBF3E97A949F006E83F4660AC6F6072C13F890B40A374E9C5BF8B6EBDA60705C9BFAC1BEF8E8CD5D93FD4F02DAC1BEFC4BFC860C5A9D6C2D43F6518778368ADE0BFE44D7E8B4E96D13F"),
+		"POLYGON ((-0.521168 0.274799, -0.242704 0.750847, 0.136303 -0.171187, -0.195466 0.403659, -0.163547 0.324392, -0.521168 0.274799))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.481546, -0.684627}, {0.055146, -0.025469}, {0.12281, 0.51097}, {0.767751, -0.010835}, {-0.375884, -0.066216}, {-0.481546, -0.684627}}, {{0.62483, -0.623998}, {0.998841, 0.266178}, {-0.833066, 0.451109}, {0.973643, -0.196366}, {0.35703, -0.367646}, {-0.572951, 0.434649}, {-0.995285, 0.645463}, {0.056692, -0.804432}, {0.62483, -0.623998}}, {{0.298531, 0.747308}, {-0.440035, 0.957031}, {-0.799639, 0.707877}, {0.298531, 0.747308}}, {{-0.83731, -0.450573}, {-0.094044, 0.584683}, {0.72272, -0.733159}, {0.041731, 0.301567}, {-0.305894, 0.743728}, {-0.83731, -0.450573}}, {{-0.962852, -0.918674}, {0.361994, 0.116712}, {0.893006, 0.876878}, {0.819703, -0.915991}, {-0.962852, -0.918674}}}),
+		"0103000000050000000600000063416150a6d1debfc9acdee176e8e5bfacab02b5183cac3fca4e3fa88b149abfaa2b9fe57970bf3f7d96e7c1dd59e03fdb148f8b6a91e83fa99f3715a93086bf0ebdc5c37b0ed8bfccd0782288f3b0bf63416150a6d1debfc9acdee176e8e5bf09000000e6e8f17b9bfee33ffe9e58a7caf7e3bf0bed9c6681f6ef3f408a3a730f09d13feb1a2d077aa8eabfd4997b48f8dedc3fa8565f5d1528ef3f57ee05668522c9bf61376c5b94d9d63f7555a0168387d7bf62bce6559d55e2bf7b4d0f0a4ad1db3f2861a6ed5fd9efbfc5e57805a2a7e43f05c1e3dbbb06ad3f4e637b2de8bde9bfe6e8f17b9bfee33ffe9e58a7caf7e3bf0400000036e675c4211bd33fdd408177f2e9e73f611a868f8829dcbffa42c879ff9fee3f88653387a496e9bf21e692aaeda6e63f36e675c4211bd33fdd408177f2e9e73f06000000eca353573ecbeabf9a7add2230d6dcbfef9062804413b8bf8fde701fb9b5e23faa4885b18520e73ffb93f8dc0976e7bfc5707500c45da53f9961a3acdf4cd33f5aba826dc493d3bfd8d2a3a99ecce73feca353573ecbeabf9a7add2230d6dcbf05000000126c5cffaecfeebfa6ef3504c765edbf4d49d6e1e82ad73f15fe0c6fd6e0bd3f8237a4518193ec3fd49e9273620fec3fdbdb2dc9013bea3f50c58d5bcc4fedbf126c5cffaecfeebfa6ef3504c765edbf",
+		mustHexDecode("0103000000050000000600000063416150A6D1DEBFC9ACDEE176E8E5BFACAB02B5183CAC3FCA4E3FA88B149ABFAA2B9FE57970BF3F7D96E7C1DD59E03FDB148F8B6A91E83FA99F3715A93086BF0EBDC5C37B0ED8BFCCD0782288F3B0BF63416150A6D1DEBFC9ACDEE176E8E5BF09000000E6E8F17B9BFEE33FFE9E58A7CAF7E3BF0BED9C6681F6EF3F408A3A730F09D13FEB1A2D077AA8EABFD4997B48F8DEDC3FA8565F5D1528EF3F57EE05668522C9BF61376C5B94D9D63F7555A0168387D7BF62BCE6559D55E2BF7B4D0F0A4AD1DB3F2861A6ED5FD9EFBFC5E57805A2A7E43F05C1E3DBBB06AD3F4E637B2DE8BDE9BFE6E8F17B9BFEE33FFE9E58A7CAF7E3BF0400000036E675C4211BD33FDD408177F2E9E73F611A868F8829DCBFFA42C879FF9FEE3F88653387A496E9BF21E692AAEDA6E63F36E675C4211BD33FDD408177F2E9E73F06000000ECA353573ECBEABF9A7ADD2230D6DCBFEF9062804413B8BF8FDE701FB9B5E23FAA4885B18520E73FFB93F8DC0976E7BFC5707500C45DA53F9961A3ACDF4CD33F5ABA826DC493D3BFD8D2A3A99ECCE73FECA353573ECBEABF9A7ADD2230D6DCBF05000000126C5CFFAECFEEBFA6EF3504C765EDBF4D49D6E1E82AD73F15FE0C6FD6E0BD3F8237A4518193EC3FD49E9273620FEC3FDBDB2DC9013BEA3F50C58D5BCC4FEDBF126C5CFFAECFEEBFA6EF3504C765EDBF"),
+		"POLYGON ((-0.481546 -0.684627, 0.055146 -0.025469, 0.12281 0.51097, 0.767751 -0.010835, -0.375884 -0.066216, -0.481546 -0.684627), (0.62483 -0.6239980000000001, 0.998841 0.266178, -0.833066 0.451109, 0.973643 -0.196366, 0.35703 -0.367646, -0.572951 0.434649, -0.995285 0.645463, 0.056692 -0.804432, 0.62483 -0.6239980000000001), (0.298531 0.747308, -0.440035 0.957031, -0.799639 0.707877, 0.298531 0.747308), (-0.83731 -0.450573, -0.094044 0.584683, 0.72272 -0.733159, 0.041731 0.301567, -0.305894 0.7437279999999999, -0.83731 -0.450573), (-0.962852 -0.918674, 0.361994 0.116712, 0.893006 0.876878, 0.819703 -0.915991, -0.962852 -0.918674))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.40265, 0.310724}, {0.424716, 0.805421}, {0.280283, -0.255102}, {0.075858, -0.584312}, {0.174251, -0.982206}, {-0.697954, -0.333183}, {0.579247, 0.436999}, {0.40265, 0.310724}}, {{-0.917595, -0.672279}, {0.963829, -0.420939}, {-0.210416, 0.096969}, {-0.413186, -0.043871}, {-0.520588, -0.903488}, {-0.640827, 0.0461}, {-0.917595, -0.672279}}}),
+		"0103000000020000000800000069006f8104c5d93f784485eae6e2d33fbea085048c2edb3f295fd04202c6e93f33fca71b28f0d13f713ac9569753d0bff1d4230d6e6bb33f9b215514afb2e2bf42cc2555db4dc63f53ebfd463b6eefbfa69883a0a355e6bfa05225cade52d5bfabcb29013189e23ffe9e58a7caf7db3f69006f8104c5d93f784485eae6e2d33f07000000cd237f30f05cedbfe333d93f4f83e5bfdcf63deaafd7ee3fb519a721aaf0dabfb20e4757e9eecabf9acfb9dbf5d2b83f47904ab1a371dabf61527c7c4276a6bfe014562aa8a8e0bfa5828aaa5fe9ecbff299ec9fa781e4bf7cf2b0506b9aa73fcd237f30f05cedbfe333d93f4f83e5bf",
+		mustHexDecode("0103000000020000000800000069006F8104C5D93F784485EAE6E2D33FBEA085048C2EDB3F295FD04202C6E93F33FCA71B28F0D13F713AC9569753D0BFF1D4230D6E6BB33F9B215514AFB2E2BF42CC2555DB4DC63F53EBFD463B6EEFBFA69883A0A355E6BFA05225CADE52D5BFABCB29013189E23FFE9E58A7CAF7DB3F69006F8104C5D93F784485EAE6E2D33F07000000CD237F30F05CEDBFE333D93F4F83E5BFDCF63DEAAFD7EE3FB519A721AAF0DABFB20E4757E9EECABF9ACFB9DBF5D2B83F47904AB1A371DABF61527C7C4276A6BFE014562AA8A8E0BFA5828AAA5FE9ECBFF299EC9FA781E4BF7CF2B0506B9AA73FCD237F30F05CEDBFE333D93F4F83E5BF"),
+		"POLYGON ((0.40265 0.310724, 0.424716 0.8054210000000001, 0.280283 -0.255102, 0.07585799999999999 -0.5843120000000001, 0.174251 -0.982206, -0.697954 -0.333183, 0.579247 0.436999, 0.40265 0.310724), (-0.917595 -0.672279, 0.963829 -0.420939, -0.210416 0.096969, -0.413186 -0.043871, -0.5205880000000001 -0.903488, -0.640827 0.0461, -0.917595 -0.672279))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.193662, -0.342959}, {-0.170557, -0.8012}, {0.817316, -0.051991}, {-0.193662, -0.342959}}, {{-0.312697, -0.041827}, {0.399191, -0.146929}, {-0.396194, 0.469502}, {0.7888, 0.839378}, {0.253484, -0.248857}, {0.949122, 0.277757}, {-0.868331, -0.830661}, {0.499739, -0.877688}, {-0.312697, -0.041827}}, {{-0.212384, 0.038007}, {-0.102911, -0.022762}, {0.169777, 0.358605}, {-0.212384, 0.038007}}, {{-0.263337, 0.976919}, {-0.478167, 0.554201}, {-0.137558, -0.282959}, {-0.872285, 0.727158}, {0.404009, 0.806022}, {-0.263337, 0.976919}}, {{0.353842, -0.76218}, {-0.204093, -0.585536}, {-0.915798, 0.895923}, {-0.568212, -0.707291}, {-0.60406, -0.243936}, {0.353842, -0.76218}}}),
+		"01030000000500000004000000882d3d9aeac9c8bf789b374e0af3d5bfad4b8dd0cfd4c5bf33c4b12e6ea3e9bfd1eb4fe27327ea3f6f675f79909eaabf882d3d9aeac9c8bf789b374e0af3d5bf0900000000ab23473a03d4bf38f92d3a596aa5bfdfe17668588cd93ffdbfeac891cec2bf69c537143e5bd9bf9a05da1d520cde3faeb6627fd93de93fa06b5f402fdcea3ffbca83f41439d03fdcb930d28bdacfbf6840bd19355fee3f6b0bcf4bc5c6d13f944be3175ec9ebbf4701a260c694eabfa1496249b9fbdf3fc8ee02250516ecbf00ab23473a03d4bf38f92d3a596aa5bf04000000fbc9181f662fcbbfcec3094ca775a33f520e66136058babfcfbf5df6eb4e97bf86764eb340bbc53f33a7cb6262f3d63ffbc9181f662fcbbfcec3094ca775a33f0600000081e9b46e83dad0bfabe97aa2eb42ef3f10b1c1c2499adebfc9224dbc03bce13f36cea623809bc1bfa1f7c610001cd2bfd252793bc2e9ebbfe6caa0dae044e73fdb89929048dbd93f8c683ba6eecae93f81e9b46e83dad0bfabe97aa2eb42ef3f06000000fce07cea58a5d63ff949b54fc763e8bff5d72b2cb81fcabf552e54feb5bce2bf2bc0779b374eedbfa27e17b666abec3f693ba6eeca2ee2bf132a38bc20a2e6bfdc4b1aa37554e3bf4a95287b4b39cfbffce07cea58a5d63ff949b54fc763e8bf",
+		mustHexDecode("01030000000500000004000000882D3D9AEAC9C8BF789B374E0AF3D5BFAD4B8DD0CFD4C5BF33C4B12E6EA3E9BFD1EB4FE27327EA3F6F675F79909EAABF882D3D9AEAC9C8BF789B374E0AF3D5BF0900000000AB23473A03D4BF38F92D3A596AA5BFDFE17668588CD93FFDBFEAC891CEC2BF69C537143E5BD9BF9A05DA1D520CDE3FAEB6627FD93DE93FA06B5F402FDCEA3FFBCA83F41439D03FDCB930D28BDACFBF6840BD19355FEE3F6B0BCF4BC5C6D13F944BE3175EC9EBBF4701A260C694EABFA1496249B9FBDF3FC8EE02250516ECBF00AB23473A03D4BF38F92D3A596AA5BF04000000FBC9181F662FCBBFCEC3094CA775A33F520E66136058BABFCFBF5DF6EB4E97BF86764EB340BBC53F33A7CB6262F3D63FFBC9181F662FCBBFCEC3094CA775A33F0600000081E9B46E83DAD0BFABE97AA2EB42EF3F10B1C1C2499ADEBFC9224DBC03BCE13F36CEA623809BC1BFA1F7C610001CD2BFD252793BC2E9EBBFE6CAA0DAE044E73FDB89929048DBD93F8C683BA6EECAE93F81E9B46E83DAD0BFABE97AA2EB42EF3F06000000FCE07CEA58A5D63FF949B54FC763E8BFF5D72B2CB81FCABF552E54FEB5BCE2BF2BC0779B374EEDBFA27E17B666ABEC3F693BA6EECA2EE2BF132A38BC20A2E6BFDC4B1AA37554E3BF4A95287B4B39CFBFFCE07CEA58A5D63FF949B54FC763E8BF"),
+		"POLYGON ((-0.193662 -0.342959, -0.170557 -0.8012, 0.817316 -0.051991, -0.193662 -0.342959), (-0.312697 -0.041827, 0.399191 -0.146929, -0.396194 0.469502, 0.7887999999999999 0.839378, 0.253484 -0.248857, 0.949122 0.277757, -0.868331 -0.830661, 0.499739 -0.877688, -0.312697 -0.041827), (-0.212384 0.038007, -0.102911 -0.022762, 0.169777 0.358605, -0.212384 0.038007), (-0.263337 0.976919, -0.478167 0.5542010000000001, -0.137558 -0.282959, -0.872285 0.727158, 0.404009 0.806022, -0.263337 0.976919), (0.353842 -0.76218, -0.204093 -0.5855359999999999, -0.915798 0.895923, -0.5682120000000001 -0.707291, -0.60406 -0.243936, 0.353842 -0.76218))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.697332, 0.97738}, {0.965979, -0.703196}, {-0.188186, 0.359859}, {0.755314, -0.009188}, {0.834094, -0.35508}, {-0.003118, -0.002707}, {-0.697332, 0.97738}}, {{0.219541, -0.562454}, {-0.31956, 0.925133}, {0.798016, 0.636237}, {-0.929064, -0.703267}, {0.219541, -0.562454}}, {{0.568333, 0.684667}, {0.165896, 0.436264}, {0.614111, -0.867282}, {-0.830714, 0.737791}, {0.568333, 0.684667}}, {{-0.549819, -0.918736}, {-0.96943, 0.68791}, {-0.338811, -0.67862}, {-0.549819, -0.918736}}}),
+		"010300000004000000070000006589ce328b50e6bf9f76f86bb246ef3fd9edb3ca4ce9ee3f4fb2d5e59480e6bfc153c8957a16c8bffdf50a0bee07d73f0ec00644882be83f0f0c207c28d182bf3d4679e6e5b0ea3fefacdd76a1b9d6bf6afaec80eb8a69bfde770c8ffd2c66bf6589ce328b50e6bf9f76f86bb246ef3f0500000010c99063eb19cc3fea23f0879fffe1bf4f0647c9ab73d4bf6a696e85b09aed3f5be9b5d95889e93f352a70b20d5ce43f9d82fc6ce4baedbf9d64abcb2981e6bf10c99063eb19cc3fea23f0879fffe1bf05000000289a07b0c82fe23f57d0b4c4cae8e53f7ec34483143cc53f68041bd7bfebdb3fb5a4a31ccca6e33f651a4d2ec6c0ebbffc89ca863595eabf730f09dffb9be73f289a07b0c82fe23f57d0b4c4cae8e53f040000008505f7031e98e1bf280d350a4966edbf685c38109205efbf6cec12d55b03e63f849a215514afd5bf2c2b4d4a41b7e5bf8505f7031e98e1bf280d350a4966edbf",
+		mustHexDecode("010300000004000000070000006589CE328B50E6BF9F76F86BB246EF3FD9EDB3CA4CE9EE3F4FB2D5E59480E6BFC153C8957A16C8BFFDF50A0BEE07D73F0EC00644882BE83F0F0C207C28D182BF3D4679E6E5B0EA3FEFACDD76A1B9D6BF6AFAEC80EB8A69BFDE770C8FFD2C66BF6589CE328B50E6BF9F76F86BB246EF3F0500000010C99063EB19CC3FEA23F0879FFFE1BF4F0647C9AB73D4BF6A696E85B09AED3F5BE9B5D95889E93F352A70B20D5CE43F9D82FC6CE4BAEDBF9D64ABCB2981E6BF10C99063EB19CC3FEA23F0879FFFE1BF05000000289A07B0C82FE23F57D0B4C4CAE8E53F7EC34483143CC53F68041BD7BFEBDB3FB5A4A31CCCA6E33F651A4D2EC6C0EBBFFC89CA863595EABF730F09DFFB9BE73F289A07B0C82FE23F57D0B4C4CAE8E53F040000008505F7031E98E1BF280D350A4966EDBF685C38109205EFBF6CEC12D55B03E63F849A215514AFD5BF2C2B4D4A41B7E5BF8505F7031E98E1BF280D350A4966EDBF"),
+		"POLYGON ((-0.697332 0.97738, 0.965979 -0.703196, -0.188186 0.359859, 0.755314 -0.009188, 0.834094 -0.35508, -0.003118 -0.002707, -0.697332 0.97738), (0.219541 -0.562454, -0.31956 0.925133, 0.7980159999999999 0.6362370000000001, -0.929064 -0.703267, 0.219541 -0.562454), (0.568333 0.684667, 0.165896 0.436264, 0.614111 -0.867282, -0.830714 0.737791, 0.568333 0.684667), (-0.5498189999999999 -0.918736, -0.96943 0.68791, -0.338811 -0.67862, -0.5498189999999999 -0.918736))",
+	},
+	{
+		geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.312167, 0.937197}, {0.009999, 0.802181}, {0.004857, 0.147745}, {0.312167, 0.937197}}, {{0.515693, 0.981066}, {0.493931, 0.811562}, {-0.587791, 0.070833}, {0.197229, 0.651394}, {-0.035573, 0.582081}, {-0.222862, 0.172777}, {0.702634, 0.596119}, {0.515693, 0.981066}}, {{-0.999519, -0.636062}, {0.013716, -0.491081}, {-0.868759, 0.719767}, {0.885894, -0.39439}, {-0.183854, 0.620075}, {-0.875483, 0.28197}, {-0.999519, -0.636062}}, {{-0.425824, 0.659882}, {-0.888946, -0.928133}, {-0.164268, -0.016338}, {-0.425824, 0.659882}}}),
+		"01030000000400000004000000d6fcf84b8bfad33f15191d9084fded3fcb0ef10f5b7a843f581f0f7d77abe93f80ba8102efe4733fefe192e34ee9c23fd6fcf84b8bfad33f15191d9084fded3f080000000ad6389b8e80e03f0ef62686e464ef3fc2c1dec4909cdf3fada415df50f8e93fa6423c122fcfe2bfe5417a8a1c22b23ffc5069c4cc3ec93fa2f0d93a38d8e43f55c03dcf9f36a2bf6bef535568a0e23f1cb5c2f4bd86ccbf812040868e1dc63f62d8614cfa7be63f71c630276813e33f0ad6389b8e80e03f0ef62686e464ef3f07000000466117450ffcefbfa94e07b29e5ae4bfdd9a745b22178c3f6af7ab00df6ddfbf9961a3acdfccebbf58aeb7cd5408e73fbc1fb75f3e59ec3fe8a4f78daf3dd9bf79909e228788c7bf8126c286a7d7e33fe886a6ecf403ecbfebff1ce6cb0bd23f466117450ffcefbfa94e07b29e5ae4bf040000009885764eb340dbbfa30227dbc01de53fda1ebde13e72ecbfe9d32afa43b3edbf05c1e3dbbb06c5bfca6aba9ee8ba90bf9885764eb340dbbfa30227dbc01de53f",
+		mustHexDecode("01030000000400000004000000D6FCF84B8BFAD33F15191D9084FDED3FCB0EF10F5B7A843F581F0F7D77ABE93F80BA8102EFE4733FEFE192E34EE9C23FD6FCF84B8BFAD33F15191D9084FDED3F080000000AD6389B8E80E03F0EF62686E464EF3FC2C1DEC4909CDF3FADA415DF50F8E93FA6423C122FCFE2BFE5417A8A1C22B23FFC5069C4CC3EC93FA2F0D93A38D8E43F55C03DCF9F36A2BF6BEF535568A0E23F1CB5C2F4BD86CCBF812040868E1DC63F62D8614CFA7BE63F71C630276813E33F0AD6389B8E80E03F0EF62686E464EF3F07000000466117450FFCEFBFA94E07B29E5AE4BFDD9A745B22178C3F6AF7AB00DF6DDFBF9961A3ACDFCCEBBF58AEB7CD5408E73FBC1FB75F3E59EC3FE8A4F78DAF3DD9BF79909E228788C7BF8126C286A7D7E33FE886A6ECF403ECBFEBFF1CE6CB0BD23F466117450FFCEFBFA94E07B29E5AE4BF040000009885764EB340DBBFA30227DBC01DE53FDA1EBDE13E72ECBFE9D32AFA43B3EDBF05C1E3DBBB06C5BFCA6ABA9EE8BA90BF9885764EB340DBBFA30227DBC01DE53F"),
+		"POLYGON ((0.312167 0.9371969999999999, 0.009998999999999999 0.802181, 0.004857 0.147745, 0.312167 0.9371969999999999), (0.515693 0.981066, 0.493931 0.811562, -0.587791 0.07083299999999999, 0.197229 0.651394, -0.035573 0.582081, -0.222862 0.172777, 0.702634 0.596119, 0.515693 0.981066), (-0.999519 -0.636062, 0.013716 -0.491081, -0.8687589999999999 0.719767, 0.885894 -0.39439, -0.183854 0.620075, -0.875483 0.28197, -0.999519 -0.636062), (-0.425824 0.659882, -0.888946 -0.928133, -0.164268 -0.016338, -0.425824 0.659882))",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.434378, 0.347088}, {-0.697253, 0.973412}, {-0.17772, 0.223542}, {-0.226634, -0.905935}, {-0.058222, -0.697265}, {-0.93507, 0.234801}, {0.259933, -0.789415}}),
+		"010400000007000000010100000055850662d9ccdb3f0b613596b036d63f010100000061360186e54fe6bf231631ec3026ef3f0101000000e42cec6987bfc6bfbb26a435069dcc3f010100000068b114c95702cdbf05a8a9656bfdecbf0101000000a1bfd02346cfadbf72a774b0fe4fe6bf01010000002310afeb17ecedbf5fb7088cf50dce3f0101000000bdac8905bea2d03f5019ff3ee342e9bf",
+		mustHexDecode("010400000007000000010100000055850662D9CCDB3F0B613596B036D63F010100000061360186E54FE6BF231631EC3026EF3F0101000000E42CEC6987BFC6BFBB26A435069DCC3F010100000068B114C95702CDBF05A8A9656BFDECBF0101000000A1BFD02346CFADBF72A774B0FE4FE6BF01010000002310AFEB17ECEDBF5FB7088CF50DCE3F0101000000BDAC8905BEA2D03F5019FF3EE342E9BF"),
+		"MULTIPOINT (0.434378 0.347088, -0.697253 0.9734120000000001, -0.17772 0.223542, -0.226634 -0.905935, -0.058222 -0.697265, -0.93507 0.234801, 0.259933 -0.789415)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.306664, -0.233172}, {0.55284, -0.019361}, {0.762554, 0.22024}, {-0.065623, 0.264625}, {-0.324269, -0.751353}}),
+		"01040000000500000001010000002713b70a62a0d3bf8ae42b8194d8cdbf0101000000e275fd82ddb0e13f0b7c45b75ed393bf010100000032e36da5d766e83f70b1a206d330cc3f0101000000618bdd3eabccb0bf560e2db29defd03f0101000000edd286c3d2c0d4bf310c5872150be8bf",
+		mustHexDecode("01040000000500000001010000002713B70A62A0D3BF8AE42B8194D8CDBF0101000000E275FD82DDB0E13F0B7C45B75ED393BF010100000032E36DA5D766E83F70B1A206D330CC3F0101000000618BDD3EABCCB0BF560E2DB29DEFD03F0101000000EDD286C3D2C0D4BF310C5872150BE8BF"),
+		"MULTIPOINT (-0.306664 -0.233172, 0.55284 -0.019361, 0.762554 0.22024, -0.065623 0.264625, -0.324269 -0.751353)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.244075, 0.577133}, {-0.745782, 0.823567}, {0.598683, 0.833775}, {0.74507, 0.362013}, {0.620502, 0.038015}, {0.570979, -0.621745}}),
+		"0104000000060000000101000000aeb6627fd93dcf3fe1270ea0df77e23f01010000004e417e3672dde7bf1b13622ea95aea3f0101000000357a35406928e33fe71da7e848aeea3f01010000000f62670a9dd7e73fad84ee92382bd73f01010000006f48a30227dbe33f2dcf83bbb376a33f0101000000357d76c07545e23fda722ec555e5e3bf",
+		mustHexDecode("0104000000060000000101000000AEB6627FD93DCF3FE1270EA0DF77E23F01010000004E417E3672DDE7BF1B13622EA95AEA3F0101000000357A35406928E33FE71DA7E848AEEA3F01010000000F62670A9DD7E73FAD84EE92382BD73F01010000006F48A30227DBE33F2DCF83BBB376A33F0101000000357D76C07545E23FDA722EC555E5E3BF"),
+		"MULTIPOINT (0.244075 0.577133, -0.7457819999999999 0.823567, 0.598683 0.833775, 0.74507 0.362013, 0.620502 0.038015, 0.570979 -0.621745)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.110841, 0.513233}, {-0.08906, 0.579118}, {-0.849321, -0.910719}, {0.86858, -0.02767}, {0.802143, 0.889567}, {0.333022, 0.143594}, {-0.568042, -0.813048}}),
+		"0104000000070000000101000000d2510e661360bcbf844bc79c676ce03f010100000006bb61dba2ccb6bf36ccd0782288e23f0101000000e2cad93ba32debbffd101b2c9c24edbf0101000000b3b5be4868cbeb3f683f524486559cbf0101000000f8e3f6cb27abe93f043a93365577ec3f0101000000054eb6813b50d53ff86ef3c64961c23f01010000004f24986a662de2bfae80423d7d04eabf",
+		mustHexDecode("0104000000070000000101000000D2510E661360BCBF844BC79C676CE03F010100000006BB61DBA2CCB6BF36CCD0782288E23F0101000000E2CAD93BA32DEBBFFD101B2C9C24EDBF0101000000B3B5BE4868CBEB3F683F524486559CBF0101000000F8E3F6CB27ABE93F043A93365577EC3F0101000000054EB6813B50D53FF86EF3C64961C23F01010000004F24986A662DE2BFAE80423D7D04EABF"),
+		"MULTIPOINT (-0.110841 0.5132330000000001, -0.08906 0.579118, -0.849321 -0.9107189999999999, 0.86858 -0.02767, 0.8021430000000001 0.889567, 0.333022 0.143594, -0.568042 -0.813048)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.777545, 0.558792}, {0.397005, -0.159778}, {-0.389377, -0.773111}, {-0.14806, 0.132026}, {0.845762, 0.87151}, {-0.168718, -0.801578}, {0.547638, 0.468559}}),
+		"0104000000070000000101000000e162450da6e1e83f9c86a8c29fe1e13f01010000007f4dd6a88768d93f99654f029b73c4bf0101000000462234828debd8bfffb0a54753bde8bf0101000000dd41ec4ca1f3c2bf89601c5c3ae6c03f0101000000bb6246787b10eb3ffa6184f068e3eb3f01010000003b8e1f2a8d98c5bfc72de6e786a6e9bf0101000000c77f81204086e13f11c64fe3defcdd3f",
+		mustHexDecode("0104000000070000000101000000E162450DA6E1E83F9C86A8C29FE1E13F01010000007F4DD6A88768D93F99654F029B73C4BF0101000000462234828DEBD8BFFFB0A54753BDE8BF0101000000DD41EC4CA1F3C2BF89601C5C3AE6C03F0101000000BB6246787B10EB3FFA6184F068E3EB3F01010000003B8E1F2A8D98C5BFC72DE6E786A6E9BF0101000000C77F81204086E13F11C64FE3DEFCDD3F"),
+		"MULTIPOINT (0.777545 0.558792, 0.397005 -0.159778, -0.389377 -0.773111, -0.14806 0.132026, 0.845762 0.87151, -0.168718 -0.801578, 0.547638 0.468559)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.106563, 0.372836}}),
+		"0104000000010000000101000000a3b1f677b647bbbf895fb1868bdcd73f",
+		mustHexDecode("0104000000010000000101000000A3B1F677B647BBBF895FB1868BDCD73F"),
+		"MULTIPOINT (-0.106563 0.372836)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.838565, 0.924485}}),
+		"0104000000010000000101000000683f524486d5ea3fda8f14916195ed3f",
+		mustHexDecode("0104000000010000000101000000683F524486D5EA3FDA8F14916195ED3F"),
+		"MULTIPOINT (0.838565 0.924485)",
+	},
+	{
+		geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.842923, -0.859341}, {-0.281494, -0.941245}, {-0.304245, -0.980072}, {0.948648, 0.638014}, {-0.858965, 0.786871}, {-0.584044, -0.590419}}),
+		"0104000000060000000101000000897ac1a739f9eabffb9463b2b87febbf01010000005a4b0169ff03d2bfadc090d5ad1eeebf0101000000622d3e05c078d3bfc23577f4bf5cefbf01010000004d4eed0c535bee3f0f7c0c569c6ae43f01010000009413ed2aa47cebbf537765170c2ee93f0101000000cc99ed0a7db0e2bf1afcfd62b6e4e2bf",
+		mustHexDecode("0104000000060000000101000000897AC1A739F9EABFFB9463B2B87FEBBF01010000005A4B0169FF03D2BFADC090D5AD1EEEBF0101000000622D3E05C078D3BFC23577F4BF5CEFBF01010000004D4EED0C535BEE3F0F7C0C569C6AE43F01010000009413ED2AA47CEBBF537765170C2EE93F0101000000CC99ED0A7DB0E2BF1AFCFD62B6E4E2BF"),
+		"MULTIPOINT (-0.842923 -0.859341, -0.281494 -0.941245, -0.304245 -0.9800720000000001, 0.948648 0.638014, -0.858965 0.786871, -0.584044 -0.590419)",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{-0.753624, -0.985631}, {-0.26174, -0.9507}, {0.209697, 0.718352}, {-0.626017, -0.775218}, {-0.311101, 0.918344}, {-0.739685, 0.933039}, {-0.27552, -0.053259}, {-0.414736, 0.874254}}, {{0.271832, -0.631909}, {0.985904, -0.79484}, {0.161699, -0.687194}, {0.795351, 0.891357}, {0.608781, -0.368217}, {-0.514323, 0.509717}, {-0.417881, -0.160429}, {-0.907489, -0.735533}}, {{-0.844158, -0.853578}, {-0.159537, 0.101554}}, {{-0.715433, -0.155623}, {0.273932, -0.830889}, {-0.110378, -0.261488}, {0.897864, -0.884286}, {-0.182748, -0.165549}, {0.456361, -0.358658}, {-0.59202, -0.413377}}, {{0.900537, 0.593034}, {-0.44606, 0.116363}, {0.376401, 0.591315}, {-0.107671, -0.202446}, {0.535282, -0.136567}}, {{-0.093106, 0.87421}, {-0.714865, -0.075129}, {0.274607, -0.033424}}}),
+		"010500000006000000010200000008000000ed612f14b01de8bf938fdd054a8aefbfc685032159c0d0bf95d40968226ceebf37df88ee59d7ca3fa5846055bdfce63f58aeb7cd5408e4bf2aa8a8fa95cee8bf732f302b14e9d3bf564acff41263ed3fb3ef8ae07fabe7bfb88fdc9a74dbed3ffc3559a31ea2d1bfa8716f7ec344abbfa8531edd088bdabfbbb54c86e3f9eb3f010200000008000000c366800bb265d13fbe8921399938e4bfd3db9f8b868cef3f3aafb14b546fe9bf2fe065868db2c43fd13c80457efde5bf9fe8baf08373e93f06f1811dff85ec3f3ca3ad4a227be33fe8323509de90d7bf579412825575e0bf2ee3a6069a4fe03f69aa27f38fbedabfea3c2afeef88c4bf105a0f5f260aedbfde1e84807c89e7bf0102000000020000003e0455a35703ebbf0ab952cf8250ebbff73fc05ab56bc4bff629c76471ffb93f01020000000700000058552fbfd3e4e6bf36b1c05774ebc3bfdbfb54151a88d13f88653387a496eabfcaa8328cbb41bcbf01f9122a38bcd0bf0e2f88484dbbec3fe5f04927124cecbf7b67b4554964c7bf325871aab530c5bfecde8ac40435dd3f9db81caf40f4d6bf527e52edd3f1e2bf36cafacdc474dabf01020000000500000081d07af832d1ec3f65506d7022fae23fce70033e3f8cdcbf10e6762ff7c9bd3fe8a04b38f416d83fb24b546f0dece23f0b45ba9f5390bbbfbb5e9a22c0e9c9bf2c6684b70721e13f143e5b07077bc1bf01020000000300000056b60f79cbd5b7bfd3c1fa3f87f9eb3fe3c281902ce0e6bfe02efb75a73bb3bfc72b103d2993d13fef91cd55f31ca1bf",
+		mustHexDecode("010500000006000000010200000008000000ED612F14B01DE8BF938FDD054A8AEFBFC685032159C0D0BF95D40968226CEEBF37DF88EE59D7CA3FA5846055BDFCE63F58AEB7CD5408E4BF2AA8A8FA95CEE8BF732F302B14E9D3BF564ACFF41263ED3FB3EF8AE07FABE7BFB88FDC9A74DBED3FFC3559A31EA2D1BFA8716F7EC344ABBFA8531EDD088BDABFBBB54C86E3F9EB3F010200000008000000C366800BB265D13FBE8921399938E4BFD3DB9F8B868CEF3F3AAFB14B546FE9BF2FE065868DB2C43FD13C80457EFDE5BF9FE8BAF08373E93F06F1811DFF85EC3F3CA3AD4A227BE33FE8323509DE90D7BF579412825575E0BF2EE3A6069A4FE03F69AA27F38FBEDABFEA3C2AFEEF88C4BF105A0F5F260AEDBFDE1E84807C89E7BF0102000000020000003E0455A35703EBBF0AB952CF8250EBBFF73FC05AB56BC4BFF629C76471FFB93F01020000000700000058552FBFD3E4E6BF36B1C05774EBC3BFDBFB54151A88D13F88653387A496EABFCAA8328CBB41BCBF01F9122A38BCD0BF0E2F88484DBBEC3FE5F04927124CECBF7B67B4554964C7BF325871AAB530C5BFECDE8AC40435DD3F9DB81CAF40F4D6BF527E52EDD3F1E2BF36CAFACDC474DABF01020000000500000081D07AF832D1EC3F65506D7022FAE23FCE70033E3F8CDCBF10E6762FF7C9BD3FE8A04B38F416D83FB24B546F0DECE23F0B45BA9F5390BBBFBB5E9A22C0E9C9BF2C6684B70721E13F143E5B07077BC1BF01020000000300000056B60F79CBD5B7BFD3C1FA3F87F9EB3FE3C281902CE0E6BFE02EFB75A73BB3BFC72B103D2993D13FEF91CD55F31CA1BF"),
+		"MULTILINESTRING ((-0.753624 -0.985631, -0.26174 -0.9507, 0.209697 0.718352, -0.626017 -0.775218, -0.311101 0.918344, -0.739685 0.933039, -0.27552 -0.053259, -0.414736 0.874254), (0.271832 -0.6319090000000001, 0.985904 -0.79484, 0.161699 -0.687194, 0.795351 0.891357, 0.608781 -0.368217, -0.514323 0.509717, -0.417881 -0.160429, -0.907489 -0.735533), (-0.844158 -0.8535779999999999, -0.159537 0.101554), (-0.715433 -0.155623, 0.273932 -0.830889, -0.110378 -0.261488, 0.897864 -0.884286, -0.182748 -0.165549, 0.456361 -0.358658, -0.59202 -0.413377), (0.900537 0.593034, -0.44606 0.116363, 0.376401 0.591315, -0.107671 -0.202446, 0.535282 -0.136567), (-0.09310599999999999 0.87421, -0.714865 -0.075129, 0.274607 -0.033424))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.397984, 0.237471}, {-0.984447, -0.40288}}, {{0.257841, 0.090416}, {-0.687558, 0.412588}, {-0.05713, 0.356358}, {0.52018, -0.535275}, {0.52399, -0.439823}, {0.968031, -0.758337}, {0.767436, -0.918906}}}),
+		"0105000000020000000102000000020000006e3315e29178d93f18cc5f217365ce3f65a6b4fe9680efbf46990d32c9c8d9bf0102000000070000001059a4897780d03facfe08c38025b73f274d83a27900e6bf0936ae7fd767da3f8eaf3db32440adbffdbfeac891ced63fa110018750a5e03f8cb96b09f920e1bf14cb2dad86c4e03fb7d4415e0f26dcbf2174d0251cfaee3f9818cbf44b44e8bfe0bc38f1d58ee83f4224438ead67edbf",
+		mustHexDecode("0105000000020000000102000000020000006E3315E29178D93F18CC5F217365CE3F65A6B4FE9680EFBF46990D32C9C8D9BF0102000000070000001059A4897780D03FACFE08C38025B73F274D83A27900E6BF0936AE7FD767DA3F8EAF3DB32440ADBFFDBFEAC891CED63FA110018750A5E03F8CB96B09F920E1BF14CB2DAD86C4E03FB7D4415E0F26DCBF2174D0251CFAEE3F9818CBF44B44E8BFE0BC38F1D58EE83F4224438EAD67EDBF"),
+		"MULTILINESTRING ((0.397984 0.237471, -0.984447 -0.40288), (0.257841 0.090416, -0.687558 0.412588, -0.05713 0.356358, 0.52018 -0.5352749999999999, 0.52399 -0.439823, 0.968031 -0.758337, 0.767436 -0.918906))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.163232, -0.20753}, {-0.795937, -0.494784}, {-0.433207, 0.510446}, {0.817549, 0.19082}, {-0.929099, 0.584473}}, {{-0.320219, 0.060371}, {-0.501906, 0.839957}, {-0.672891, -0.170339}, {-0.420616, 0.039668}}, {{0.25428, 0.062752}, {-0.178391, 0.269188}, {-0.193174, 0.557101}, {0.576355, -0.415492}, {-0.256391, 0.257622}, {-0.68586, 0.394064}}}),
+		"010500000003000000010200000005000000e690d442c9e4c43f382d78d15790cabfada415df5078e9bf4e61a5828aaadfbf4a7d59daa9b9dbbff0f78bd99255e03f83143c855c29ea3fb01bb62dca6cc83fb9e177d32dbbedbfe8a38cb800b4e23f01020000000400000063b323d5777ed4bf07ee409df2e8ae3f5051f52b9d0fe0bf0f7ba180ede0ea3f41bad8b45288e5bf37de1d19abcdc5bf52280b5f5febdabf9b3c65355d4fa43f01020000000600000067b8019f1f46d03f1633c2db8310b03f514b732b84d5c6bf04711e4e603ad13f220038f6ecb9c8bf6534f279c5d3e13fc45a7c0a8071e23ff8f9efc16b97dabf7347ffcbb568d0bf28ba2efce07cd03f514eb4ab90f2e5bffdfa21365838d93f",
+		mustHexDecode("010500000003000000010200000005000000E690D442C9E4C43F382D78D15790CABFADA415DF5078E9BF4E61A5828AAADFBF4A7D59DAA9B9DBBFF0F78BD99255E03F83143C855C29EA3FB01BB62DCA6CC83FB9E177D32DBBEDBFE8A38CB800B4E23F01020000000400000063B323D5777ED4BF07EE409DF2E8AE3F5051F52B9D0FE0BF0F7BA180EDE0EA3F41BAD8B45288E5BF37DE1D19ABCDC5BF52280B5F5FEBDABF9B3C65355D4FA43F01020000000600000067B8019F1F46D03F1633C2DB8310B03F514B732B84D5C6BF04711E4E603AD13F220038F6ECB9C8BF6534F279C5D3E13FC45A7C0A8071E23FF8F9EFC16B97DABF7347FFCBB568D0BF28BA2EFCE07CD03F514EB4AB90F2E5BFFDFA21365838D93F"),
+		"MULTILINESTRING ((0.163232 -0.20753, -0.795937 -0.494784, -0.433207 0.510446, 0.817549 0.19082, -0.929099 0.584473), (-0.320219 0.060371, -0.501906 0.839957, -0.672891 -0.170339, -0.420616 0.039668), (0.25428 0.062752, -0.178391 0.269188, -0.193174 0.557101, 0.576355 -0.415492, -0.256391 0.257622, -0.68586 0.394064))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{-0.720934, 0.336517}, {-0.291884, -0.054669}, {-0.169785, -0.04657}, {0.389391, -0.36352}, {0.304109, -0.879556}, {-0.39963, 0.49042}}, {{0.242285, -0.948907}, {-0.056942, 0.77709}}, {{0.053656, -0.867087}, {0.73422, 0.372593}}, {{0.338015, -0.987154}, {-0.917645, 0.241754}, {0.999371, 0.746295}, {0.399372, 0.4542}, {-0.546626, 0.503228}, {-0.424152, -0.78908}, {-0.07821, -0.339609}}}),
+		"0105000000040000000102000000060000000262122ee411e7bff51263997e89d53f47718e3a3aaed2bfb5c5353e93fdabbf5df92ccf83bbc5bf800ef3e505d8a7bfc3d4963ac8ebd83f6b48dc63e943d7bf39d55a988576d33fb804e09f5225ecbfdd0720b58993d9bffb7953910a63df3f010200000002000000a5daa7e33103cf3f4e417e36725deebf59a489778027adbf758e01d9ebdde83f010200000002000000ebe5779acc78ab3f132d793c2dbfebbf3b3602f1ba7ee73f5dfc6d4f90d8d73f01020000000700000019ada3aa09a2d53fddb243fcc396efbf3ed00a0c595dedbff7add689cbf1ce3f204432e4d8faef3fe162450da6e1e73f070abc934f8fd93ffdf675e09c11dd3fe19524cff57de1bfc0ce4d9b711ae03f8a1c226e4e25dbbf8eaf3db32440e9bf685c38109205b4bf4b581b6327bcd5bf",
+		mustHexDecode("0105000000040000000102000000060000000262122EE411E7BFF51263997E89D53F47718E3A3AAED2BFB5C5353E93FDABBF5DF92CCF83BBC5BF800EF3E505D8A7BFC3D4963AC8EBD83F6B48DC63E943D7BF39D55A988576D33FB804E09F5225ECBFDD0720B58993D9BFFB7953910A63DF3F010200000002000000A5DAA7E33103CF3F4E417E36725DEEBF59A489778027ADBF758E01D9EBDDE83F010200000002000000EBE5779ACC78AB3F132D793C2DBFEBBF3B3602F1BA7EE73F5DFC6D4F90D8D73F01020000000700000019ADA3AA09A2D53FDDB243FCC396EFBF3ED00A0C595DEDBFF7ADD689CBF1CE3F204432E4D8FAEF3FE162450DA6E1E73F070ABC934F8FD93FFDF675E09C11DD3FE19524CFF57DE1BFC0CE4D9B711AE03F8A1C226E4E25DBBF8EAF3DB32440E9BF685C38109205B4BF4B581B6327BCD5BF"),
+		"MULTILINESTRING ((-0.720934 0.336517, -0.291884 -0.054669, -0.169785 -0.04657, 0.389391 -0.36352, 0.304109 -0.879556, -0.39963 0.49042), (0.242285 -0.9489069999999999, -0.056942 0.7770899999999999), (0.053656 -0.8670870000000001, 0.73422 0.372593), (0.338015 -0.987154, -0.917645 0.241754, 0.999371 0.746295, 0.399372 0.4542, -0.5466259999999999 0.503228, -0.424152 -0.78908, -0.07821 -0.339609))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.794402, -0.12946}, {-0.105416, 0.417656}, {0.048324, -0.741554}, {0.820785, -0.111751}}, {{-0.22225, 0.613692}, {-0.220927, -0.559681}, {-0.607611, 0.88007}, {0.173061, -0.900414}, {-0.223305, -0.531942}, {-0.830686, -0.626489}, {-0.886019, 0.276147}}}),
+		"01050000000200000001020000000400000052103cbebd6be93fc3f011312592c0bf83a279008bfcbabf709a3e3be0bada3f7b4b395fecbda83fbaf94674cfbae7bff98381e7de43ea3f85984baab69bbcbf010200000007000000a69bc420b072ccbf7d23ba675da3e33f8dd5e6ff5547ccbf9529e620e8e8e1bf4d13b69f8c71e3bf611a868f8829ec3f6b459be3dc26c63f94895b0531d0ecbf8542041c4295ccbf79cdab3aab05e1bf80d767cefa94eabf45b8c9a8320ce4bfd74e9484445aecbf61dd787764acd13f",
+		mustHexDecode("01050000000200000001020000000400000052103CBEBD6BE93FC3F011312592C0BF83A279008BFCBABF709A3E3BE0BADA3F7B4B395FECBDA83FBAF94674CFBAE7BFF98381E7DE43EA3F85984BAAB69BBCBF010200000007000000A69BC420B072CCBF7D23BA675DA3E33F8DD5E6FF5547CCBF9529E620E8E8E1BF4D13B69F8C71E3BF611A868F8829EC3F6B459BE3DC26C63F94895B0531D0ECBF8542041C4295CCBF79CDAB3AAB05E1BF80D767CEFA94EABF45B8C9A8320CE4BFD74E9484445AECBF61DD787764ACD13F"),
+		"MULTILINESTRING ((0.7944020000000001 -0.12946, -0.105416 0.417656, 0.048324 -0.741554, 0.820785 -0.111751), (-0.22225 0.613692, -0.220927 -0.559681, -0.607611 0.88007, 0.173061 -0.900414, -0.223305 -0.531942, -0.830686 -0.626489, -0.886019 0.276147))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.225014, 0.409848}, {0.024237, -0.431152}, {0.754915, -0.293858}, {-0.083411, 0.263759}, {0.032249, 0.912937}, {0.909436, 0.85952}}, {{0.16192, -0.019596}, {0.408234, -0.569161}, {-0.468256, -0.912386}, {-0.674285, -0.992251}, {0.309255, -0.719186}, {0.573359, 0.361008}, {0.941352, -0.206971}, {0.842784, -0.092592}}}),
+		"010500000002000000010200000006000000c631923d42cdcc3f3c2f151bf33ada3faea0698995d1983f30b8e68efe97dbbf9c5088804328e83ffdbfeac891ced2bf876c205d6c5ab5bf7ba4c16d6de1d03fe44ba8e0f082a03f05dec9a7c736ed3f05c3b986191aed3fe14048163081eb3f010200000008000000b6be4868cbb9c43f0f98874cf91094bf7c60c77f8120da3fb61325219136e2bf3cf88903e8f7ddbf1381ea1f4432edbf16dee522be93e5bfa48b4d2b85c0efbf7bf7c77bd5cad33fbbb6b75b9203e7bfa0c03bf9f458e23f200a664cc11ad73f2ec6c03a8e1fee3f97361c96067ecabf30f2b22616f8ea3f0f09dffb1bb4b7bf",
+		mustHexDecode("010500000002000000010200000006000000C631923D42CDCC3F3C2F151BF33ADA3FAEA0698995D1983F30B8E68EFE97DBBF9C5088804328E83FFDBFEAC891CED2BF876C205D6C5AB5BF7BA4C16D6DE1D03FE44BA8E0F082A03F05DEC9A7C736ED3F05C3B986191AED3FE14048163081EB3F010200000008000000B6BE4868CBB9C43F0F98874CF91094BF7C60C77F8120DA3FB61325219136E2BF3CF88903E8F7DDBF1381EA1F4432EDBF16DEE522BE93E5BFA48B4D2B85C0EFBF7BF7C77BD5CAD33FBBB6B75B9203E7BFA0C03BF9F458E23F200A664CC11AD73F2EC6C03A8E1FEE3F97361C96067ECABF30F2B22616F8EA3F0F09DFFB1BB4B7BF"),
+		"MULTILINESTRING ((0.225014 0.409848, 0.024237 -0.431152, 0.754915 -0.293858, -0.083411 0.263759, 0.032249 0.912937, 0.909436 0.85952), (0.16192 -0.019596, 0.408234 -0.569161, -0.468256 -0.912386, -0.674285 -0.992251, 0.309255 -0.719186, 0.573359 0.361008, 0.941352 -0.206971, 0.842784 -0.09259199999999999))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.765665, 0.589581}, {-0.354142, -0.088511}}, {{-0.942342, -0.911295}, {-0.262592, -0.580818}, {0.049029, -0.62443}, {-0.596757, 0.345336}}, {{-0.375536, 0.719989}, {-0.490722, -0.312119}, {0.424961, -0.910995}, {0.868367, -0.855325}, {-0.078138, 0.44921}, {-0.905063, 0.618006}, {0.957787, -0.078977}}}),
+		"0105000000030000000102000000020000008e23d6e25380e83fa9f92af9d8dde23f49f6083543aad6bfe014562aa8a8b6bf01020000000400000020b6f468aa27eebf2844c0215429edbf253d0cad4eced0bf39b35da10f96e2bf82751c3f541aa93f5e85949f54fbe3bf1e17d522a218e3bfc669882afc19d63f0102000000070000003a1f9e25c808d8bf105a0f5f260ae73fa25f5b3ffd67dfbf4f745df8c1f9d3bf45d4449f8f32db3f82397afcde26edbfc79e3d97a9c9eb3f3b70ce88d25eebbf3d29931ada00b4bf7250c24cdbbfdc3f8e3a3aae46f6ecbfb66ad784b4c6e33f231631ec30a6ee3f7bdd2230d637b4bf",
+		mustHexDecode("0105000000030000000102000000020000008E23D6E25380E83FA9F92AF9D8DDE23F49F6083543AAD6BFE014562AA8A8B6BF01020000000400000020B6F468AA27EEBF2844C0215429EDBF253D0CAD4ECED0BF39B35DA10F96E2BF82751C3F541AA93F5E85949F54FBE3BF1E17D522A218E3BFC669882AFC19D63F0102000000070000003A1F9E25C808D8BF105A0F5F260AE73FA25F5B3FFD67DFBF4F745DF8C1F9D3BF45D4449F8F32DB3F82397AFCDE26EDBFC79E3D97A9C9EB3F3B70CE88D25EEBBF3D29931ADA00B4BF7250C24CDBBFDC3F8E3A3AAE46F6ECBFB66AD784B4C6E33F231631EC30A6EE3F7BDD2230D637B4BF"),
+		"MULTILINESTRING ((0.765665 0.589581, -0.354142 -0.08851100000000001), (-0.942342 -0.911295, -0.262592 -0.5808179999999999, 0.049029 -0.62443, -0.596757 0.345336), (-0.375536 0.719989, -0.490722 -0.312119, 0.424961 -0.910995, 0.868367 -0.855325, -0.078138 0.44921, -0.905063 0.6180060000000001, 0.9577870000000001 -0.07897700000000001))",
+	},
+	{
+		geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{-0.80254, 0.530883}, {-0.171974, 0.838469}}}),
+		"0105000000010000000102000000020000003c6bb75d68aee9bf66136058fefce03f2d93e1783e03c6bfe1b6b6f0bcd4ea3f",
+		mustHexDecode("0105000000010000000102000000020000003C6BB75D68AEE9BF66136058FEFCE03F2D93E1783E03C6BFE1B6B6F0BCD4EA3F"),
+		"MULTILINESTRING ((-0.80254 0.530883, -0.171974 0.838469))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{-0.146129, 0.509656}, {0.658677, -0.921297}, {-0.639222, -0.019973}, {-0.146129, 0.509656}}}, {{{0.868922, -0.360806}, {-0.130313, 0.114108}, {-0.428989, 0.082151}, {-0.59763, -0.406718}, {-0.116433, 0.20934}, {0.07233, -0.478024}, {-0.536425, -0.76254}, {0.566988, -0.802199}, {0.868922, -0.360806}}, {{-0.430886, 0.472167}, {0.319242, 0.483843}, {0.030566, 0.718192}, {-0.756413, 0.290394}, {-0.430886, 0.472167}}, {{0.474567, -0.282191}, {0.349764, 0.406968}, {0.321217, -0.556884}, {0.474567, -0.282191}}, {{-0.519728, 0.036307}, {0.349292, -0.532794}, {0.257024, -0.426338}, {-0.657236, 0.619498}, {0.106246, -0.344231}, {0.170862, -0.949428}, {-0.740355, -0.208838}, {-0.519728, 0.036307}}}, {{{0.020949, -0.847088}, {0.530081, 0.562888}, {0.549605, 0.138996}, {0.391398, -0.573084}, {0.465121, 0.632348}, {0.519933, -0.293075}, {0.182056, 0.257979}, {0.80162, -0.783973}, {0.020949, -0.847088}}, {{-0.282772, -0.088794}, {-0.974729, -0.559853}, {0.305527, 0.321699}, {-0.010602, 0.906652}, {-0.03817, -0.372113}, {0.695562, -0.481684}, {-0.282772, -0.088794}}, {{0.406838, 0.643393}, {0.570738, -0.231815}, {-0.88164, -0.923425}, {0.452921, 0.923383}, {-0.313669, -0.11761}, {0.451596, 0.315663}, {0.406838, 0.643393}}, {{0.34317, -0.390195}, {-0.287284, 0.079027}, {0.464628, -0.697568}, {-0.956026, 0.25566}, {0.34317, -0.390195}}, {{-0.910074, -0.548449}, {0.307754, -0.86691}, {-0.875189, 0.944187}, {-0.910074, -0.548449}}}, {{{0.784858, -0.566952}, {-0.129574, -0.28393}, {-0.646129, -0.342374}, {0.973592, 0.494618}, {-0.234664, -0.181431}, {0.784858, -0.566952}}, {{0.471274, 0.373293}, {-0.0747, -0.916122}, {0.843016, -0.182132}, {-0.219402, -0.99378}, {-0.723546, 0.737707}, {0.027869, 0.46487}, {0.471274, 0.373293}}}}),
+		"01060000000400000001030000000100000004000000baa3ffe55ab4c2bfc3b986191a4fe03f9813b4c9e113e53fa8e49cd8437bedbf5e471cb28174e4bfbb473657cd7394bfbaa3ffe55ab4c2bfc3b986191a4fe03f0103000000040000000900000014cc988235ceeb3f3dd68c0c7217d7bfdc2f9fac18aec0bf894336902e36bd3fe7ff55478e74dbbf1a6d5512d907b53fab7823f3c81fe3bf26732cefaa07dabfcfd72c978dcebdbf5d50df32a7cbca3fc00985083884b23fa8ffacf9f197debfb537f8c2642ae1bff4893c49ba66e8bfae2ea704c424e23ff148bc3c9dabe9bf14cc988235ceeb3f3dd68c0c7217d7bf05000000ee7893dfa293dbbf1407d0effb37de3fd09d60ff756ed43f7c8159a148f7de3fa5677a89b14c9f3f6ff607ca6dfbe63fae9d28098934e8bf8f183db7d095d23fee7893dfa293dbbf1407d0effb37de3f0400000079b130444e5fde3f2f6f0ed76a0fd2bf795c548b8862d63f902fa182c30bda3fb2d47abfd18ed43f1f4df564fed1e1bf79b130444e5fde3f2f6f0ed76a0fd2bf080000007a185a9d9ca1e0bf93533bc3d496a23f9d4830d5cc5ad63f2829b000a60ce1bfe95f92ca1473d03feab0c22d1f49dbbf971fb8ca1308e5bf3fff3d78edd2e33f5bb05417f032bb3f753dd175e107d6bf0e881057cedec53f97033dd4b661eebf37c30df8fcb0e7bffdbd141e34bbcabf7a185a9d9ca1e0bf93533bc3d496a23f01030000000500000009000000211e8997a773953f85b01a4b581bebbf2864e76d6cf6e03f78f2e9b12d03e23f83fa96395d96e13f2c2d23f59ecac13f56116e32aa0cd93f338cbb41b456e2bf42b3ebde8ac4dd3fbd1c76df313ce43fb08ee3874aa3e03fe09c11a5bdc1d2bf9831056b9c4dc73f9581035aba82d03f82397afcdea6e93fe44d7e8b4e16e9bf211e8997a773953f85b01a4b581bebbf07000000685e0ebbef18d2bffdbd141e34bbb6bf20cf2edffa30efbfdd28b2d650eae1bf26e1421ec18dd33f55fa0967b796d43f00732d5a80b685bfb64b1b0e4b03ed3f7b6b60ab048ba3bf16a75a0bb3d0d7bf2ae44a3d0b42e63fe869c020e9d3debf685e0ebbef18d2bffdbd141e34bbb6bf0700000077483140a209da3fe335afeaac96e43fccb392567c43e23f5c72dc291daccdbfd80ddb166536ecbfb1e1e995b28cedbfc2fbaa5ca8fcdc3ff5d555815a8ced3fb03731242713d4bf41bcae5fb01bbebf5a48c0e8f2e6dc3ff4a96395d233d43f77483140a209da3fe335afeaac96e43f05000000f4f8bd4d7ff6d53f9b030473f4f8d8bf06802a6edc62d2bf0341800c1d3bb43fab93331477bcdd3f5c8e57207a52e6bfb40584d6c397eebf944db9c2bb5cd03ff4f8bd4d7ff6d53f9b030473f4f8d8bf04000000b2135e82531fedbfd2c3d0eae48ce1bfcfa44dd53db2d33f5969520ababdebbfca349a5c8c01ecbf05dec9a7c736ee3fb2135e82531fedbfd2c3d0eae48ce1bf01030000000200000006000000812040868e1de93f7bdb4c857824e2bf45b9347ee195c0bf249c16bce82bd2bfeee87fb916ade4bf890b40a374e9d5bf20b6f468aa27ef3fd0d38041d2a7df3fb136c64e7809cebf8483bd892139c7bf812040868e1de93f7bdb4c857824e2bf070000006d205d6c5a29de3fbad8b45208e4d73f01de02098a1fb3bff3aca415df50edbfcc26c0b0fcf9ea3f990cc7f31950c7bfada7565f5d15ccbf7767edb60bcdefbf0adae4f04927e7bffdf7e0b54b9be73f17f549eeb0899c3faa0eb9196ec0dd3f6d205d6c5a29de3fbad8b45208e4d73f",
+		mustHexDecode("01060000000400000001030000000100000004000000BAA3FFE55AB4C2BFC3B986191A4FE03F9813B4C9E113E53FA8E49CD8437BEDBF5E471CB28174E4BFBB473657CD7394BFBAA3FFE55AB4C2BFC3B986191A4FE03F0103000000040000000900000014CC988235CEEB3F3DD68C0C7217D7BFDC2F9FAC18AEC0BF894336902E36BD3FE7FF55478E74DBBF1A6D5512D907B53FAB7823F3C81FE3BF26732CEFAA07DABFCFD72C978DCEBDBF5D50DF32A7CBCA3FC00985083884B23FA8FFACF9F197DEBFB537F8C2642AE1BFF4893C49BA66E8BFAE2EA704C424E23FF148BC3C9DABE9BF14CC988235CEEB3F3DD68C0C7217D7BF05000000EE7893DFA293DBBF1407D0EFFB37DE3FD09D60FF756ED43F7C8159A148F7DE3FA5677A89B14C9F3F6FF607CA6DFBE63FAE9D28098934E8BF8F183DB7D095D23FEE7893DFA293DBBF1407D0EFFB37DE3F0400000079B130444E5FDE3F2F6F0ED76A0FD2BF795C548B8862D63F902FA182C30BDA3FB2D47ABFD18ED43F1F4DF564FED1E1BF79B130444E5FDE3F2F6F0ED76A0FD2BF080000007A185A9D9CA1E0BF93533BC3D496A23F9D4830D5CC5AD63F2829B000A60CE1BFE95F92CA1473D03FEAB0C22D1F49DBBF971FB8CA1308E5BF3FFF3D78EDD2E33F5BB05417F032BB3F753DD175E107D6BF0E881057CEDEC53F97033DD4B661EEBF37C30DF8FCB0E7BFFDBD141E34BBCABF7A185A9D9CA1E0BF93533BC3D496A23F01030000000500000009000000211E8997A773953F85B01A4B581BEBBF2864E76D6CF6E03F78F2E9B12D03E23F83FA96395D96E13F2C2D23F59ECAC13F56116E32AA0CD93F338CBB41B456E2BF42B3EBDE8AC4DD3FBD1C76DF313CE43FB08EE3874AA3E03FE09C11A5BDC1D2BF9831056B9C4DC73F9581035ABA82D03F82397AFCDEA6E93FE44D7E8B4E16E9BF211E8997A773953F85B01A4B581BEBBF07000000685E0EBBEF18D2BFFDBD141E34BBB6BF20CF2EDFFA30EFBFDD28B2D650EAE1BF26E1421EC18DD33F55FA0967B796D43F00732D5A80B685BFB64B1B0E4B03ED3F7B6B60AB048BA3BF16A75A0BB3D0D7BF2AE44A3D0B42E63FE869C020E9D3DEBF685E0EBBEF18D2BFFDBD141E34BBB6BF0700000077483140A209DA3FE335AFEAAC96E43FCCB392567C43E23F5C72DC291DACCDBFD80DDB166536ECBFB1E1E995B28CEDBFC2FBAA5CA8FCDC3FF5D555815A8CED3FB03731242713D4BF41BCAE5FB01BBEBF5A48C0E8F2E6DC3FF4A96395D233D43F77483140A209DA3FE335AFEAAC96E43F05000000F4F8BD4D7FF6D53F9B030473F4F8D8BF06802A6EDC62D2BF0341800C1D3BB43FAB93331477BCDD3F5C8E57207A52E6BFB40584D6C397EEBF944DB9C2BB5CD03FF4F8BD4D7FF6D53F9B030473F4F8D8BF04000000B2135E82531FEDBFD2C3D0EAE48CE1BFCFA44DD53DB2D33F5969520ABABDEBBFCA349A5C8C01ECBF05DEC9A7C736EE3FB2135E82531FEDBFD2C3D0EAE48CE1BF01030000000200000006000000812040868E1DE93F7BDB4C857824E2BF45B9347EE195C0BF249C16BCE82BD2BFEEE87FB916ADE4BF890B40A374E9D5BF20B6F468AA27EF3FD0D38041D2A7DF3FB136C64E7809CEBF8483BD892139C7BF812040868E1DE93F7BDB4C857824E2BF070000006D205D6C5A29DE3FBAD8B45208E4D73F01DE02098A1FB3BFF3ACA415DF50EDBFCC26C0B0FCF9EA3F990CC7F31950C7BFADA7565F5D15CCBF7767EDB60BCDEFBF0ADAE4F04927E7BFFDF7E0B54B9BE73F17F549EEB0899C3FAA0EB9196EC0DD3F6D205D6C5A29DE3FBAD8B45208E4D73F"),
+		"MULTIPOLYGON (((-0.146129 0.509656, 0.658677 -0.921297, -0.639222 -0.019973, -0.146129 0.509656)), ((0.868922 -0.360806, -0.130313 0.114108, -0.428989 0.082151, -0.59763 -0.406718, -0.116433 0.20934, 0.07233000000000001 -0.478024, -0.536425 -0.76254, 0.566988 -0.802199, 0.868922 -0.360806), (-0.430886 0.472167, 0.319242 0.483843, 0.030566 0.7181920000000001, -0.756413 0.290394, -0.430886 0.472167), (0.474567 -0.282191, 0.349764 0.406968, 0.321217 -0.556884, 0.474567 -0.282191), (-0.519728 0.036307, 0.349292 -0.532794, 0.257024 -0.426338, -0.657236 0.619498, 0.106246 -0.344231, 0.170862 -0.949428, -0.740355 -0.208838, -0.519728 0.036307)), ((0.020949 -0.847088, 0.530081 0.5628880000000001, 0.549605 0.138996, 0.391398 -0.573084, 0.465121 0.632348, 0.519933 -0.293075, 0.182056 0.257979, 0.80162 -0.783973, 0.020949 -0.847088), (-0.282772 -0.088794, -0.974729 -0.559853, 0.305527 0.321699, -0.010602 0.906652, -0.03817 -0.372113, 0.695562 -0.481684, -0.282772 -0.088794), (0.406838 0.643393, 0.570738 -0.231815, -0.88164 -0.9234250000000001, 0.452921 0.923383, -0.313669 -0.11761, 0.451596 0.315663, 0.406838 0.643393), (0.34317 -0.390195, -0.287284 0.079027, 0.464628 -0.697568, -0.956026 0.25566, 0.34317 -0.390195), (-0.910074 -0.548449, 0.307754 -0.86691, -0.875189 0.944187, -0.910074 -0.548449)), ((0.7848579999999999 -0.566952, -0.129574 -0.28393, -0.646129 -0.342374, 0.973592 0.494618, -0.234664 -0.181431, 0.7848579999999999 -0.566952), (0.471274 0.373293, -0.0747 -0.916122, 0.843016 -0.182132, -0.219402 -0.99378, -0.723546 0.737707, 0.027869 0.46487, 0.471274 0.373293)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.680273, 0.641317}, {-0.506412, -0.95605}, {0.612934, -0.662312}, {0.575363, 0.367319}, {0.680273, 0.641317}}}, {{{0.855299, 0.195757}, {0.24102, -0.084976}, {-0.699858, 0.20394}, {0.855299, 0.195757}}, {{0.465438, -0.945466}, {0.864846, -0.927368}, {-0.820762, -0.414531}, {-0.698382, -0.52771}, {-0.288381, 0.471}, {-0.190577, -0.460321}, {-0.015374, -0.214814}, {0.465438, -0.945466}}}}),
+		"010600000002000000010300000001000000050000000342ebe1cbc4e53f79cdab3aab85e43f97a949f08634e0bfd5e76a2bf697eebf276893c3279de33f80f277efa831e5bfa5828aaa5f69e23f5dc30c8d2782d73f0342ebe1cbc4e53f79cdab3aab85e43f01030000000200000004000000eca529029c5eeb3ff1457bbc900ec93f2849d74cbed9ce3fb4e4f1b4fcc0b5bfc901bb9a3c65e6bf98512cb7b41aca3feca529029c5eeb3ff1457bbc900ec93f0800000094331477bcc9dd3fa35bafe94141eebf0072c284d1aceb3ff46beba7ffacedbfee9579abae43eabf3c670b08ad87dabfabae43352559e6bf89b5f81400e3e0bfec6af294d574d2bfbe9f1a2fdd24de3f58552fbfd364c8bf785e2a36e675ddbf016dab59677c8fbf6e895c70067fcbbf94331477bcc9dd3fa35bafe94141eebf",
+		mustHexDecode("010600000002000000010300000001000000050000000342EBE1CBC4E53F79CDAB3AAB85E43F97A949F08634E0BFD5E76A2BF697EEBF276893C3279DE33F80F277EFA831E5BFA5828AAA5F69E23F5DC30C8D2782D73F0342EBE1CBC4E53F79CDAB3AAB85E43F01030000000200000004000000ECA529029C5EEB3FF1457BBC900EC93F2849D74CBED9CE3FB4E4F1B4FCC0B5BFC901BB9A3C65E6BF98512CB7B41ACA3FECA529029C5EEB3FF1457BBC900EC93F0800000094331477BCC9DD3FA35BAFE94141EEBF0072C284D1ACEB3FF46BEBA7FFACEDBFEE9579ABAE43EABF3C670B08AD87DABFABAE43352559E6BF89B5F81400E3E0BFEC6AF294D574D2BFBE9F1A2FDD24DE3F58552FBFD364C8BF785E2A36E675DDBF016DAB59677C8FBF6E895C70067FCBBF94331477BCC9DD3FA35BAFE94141EEBF"),
+		"MULTIPOLYGON (((0.680273 0.641317, -0.506412 -0.95605, 0.612934 -0.662312, 0.575363 0.367319, 0.680273 0.641317)), ((0.855299 0.195757, 0.24102 -0.084976, -0.699858 0.20394, 0.855299 0.195757), (0.465438 -0.945466, 0.864846 -0.927368, -0.820762 -0.414531, -0.6983819999999999 -0.52771, -0.288381 0.471, -0.190577 -0.460321, -0.015374 -0.214814, 0.465438 -0.945466)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.100897, 0.954655}, {0.545825, 0.140999}, {-0.475107, 0.373687}, {-0.088165, 0.442776}, {-0.192442, -0.00799}, {-0.958633, 0.479917}, {-0.931453, 0.361451}, {0.164007, 0.551835}, {0.100897, 0.954655}}, {{-0.585804, 0.058544}, {-0.319439, 0.95691}, {0.943734, -0.582061}, {0.132077, -0.341115}, {0.937077, 0.849052}, {0.172292, 0.440169}, {0.36265, -0.293289}, {-0.585804, 0.058544}}}, {{{0.798907, -0.338683}, {0.49479, -0.981816}, {0.632719, 0.129739}, {0.904614, -0.273614}, {0.251426, -0.353995}, {0.565571, 0.201406}, {0.974943, -0.997975}, {-0.718483, -0.912798}, {0.798907, -0.338683}}}, {{{0.897217, -0.039175}, {0.893379, 0.636776}, {0.557236, 0.494564}, {-0.624691, 0.097755}, {-0.152242, 0.899577}, {-0.652333, -0.660283}, {0.317724, -0.685197}, {-0.779893, 0.007846}, {0.897217, -0.039175}}, {{0.509508, -0.468483}, {-0.430075, -0.142593}, {0.981696, 0.435837}, {0.892508, 0.075741}, {0.10912, 0.980181}, {-0.620024, 0.565181}, {0.509508, -0.468483}}, {{0.689484, 0.500106}, {-0.689334, 0.322255}, {0.847407, 0.12657}, {-0.278117, 0.899041}, {0.123197, -0.176727}, {0.228267, 0.60825}, {-0.543396, -0.968616}, {0.689484, 0.500106}}, {{0.882715, 0.360516}, {0.261816, 0.25563}, {-0.006021, 0.461839}, {-0.501611, 0.783509}, {-0.451055, 0.88989}, {0.852994, -0.844151}, {0.882715, 0.360516}}}}),
+		"010600000003000000010300000002000000090000000fb743c362d4b93feacf7ea4888cee3fbada8afd6577e13fe564e256410cc23f6971c6302768debfba2efce07cead73ffcdea63ffb91b6bf5b09dd257156dc3f083c3080f0a1c8bf22718fa50f5d80bf49b9fb1c1fadeebff9d7f2caf5b6de3fd55a988576ceedbfd5d006600322d73f4754a86e2efec43f9b38b9dfa1a8e13f0fb743c362d4b93feacf7ea4888cee3f0800000024b6bb07e8bee2bf4a09c1aa7af9ad3fcf488446b071d4bf3be466b8019fee3fc7f143a51133ee3fa5dde8633ea0e2bfa8e2c62de6e7c03fdb334b02d4d4d5bf6dae9ae788fced3ffd4e93196f2beb3f2c64ae0caa0dc63f2fa2ed98ba2bdc3fd93d7958a835d73fe6b2d1393fc5d2bf24b6bb07e8bee2bf4a09c1aa7af9ad3f010300000001000000090000008274b169a590e93fc7832d76fbacd5bf5fd218ada3aadf3fae105663096befbfb2d991ea3b3fe43fe603029d499bc03fac1e300f99f2ec3f5c936e4be482d1bf594dd7135d17d03f2ba4fca4daa7d6bfce1c925a2819e23f31ed9bfbabc7c93f23da8ea9bb32ef3f1d38674469efefbf486c770fd0fde6bfac55bb26a435edbf8274b169a590e93fc7832d76fbacd5bf0103000000040000000900000095490d6d00b6ec3fe63fa4dfbe0ea4bfa5dc7d8e8f96ec3f1616dc0f7860e43f63ec8497e0d4e13f38da71c3efa6df3f8d60e3fa77fde3bf2d3e05c07806b93fd8ef8975aa7cc3bf397b67b455c9ec3f0c40a374e9dfe4bf425a63d00921e5bf1ee0490b9755d43f6b274a4222ede5bf74232c2ae2f4e8bf753e3c4b9011803f95490d6d00b6ec3fe63fa4dfbe0ea4bf070000009d9ca1b8e34de03f90d8ee1ea0fbddbfd8f0f44a5986dbbf49bbd1c77c40c2bf05a6d3ba0d6aef3f8bc058dfc0e4db3f4563edef6c8fec3fcc61f71dc363b33fc8ea56cf49efbb3f7023658ba45def3ff98557923cd7e3bf2942ea76f615e23f9d9ca1b8e34de03f90d8ee1ea0fbddbf080000003eb0e3bf4010e63f6b11514cde00e03febaa402d060fe6bf1d3d7e6fd39fd43fdcd8ec48f51deb3fddcd531d7233c03f618bdd3eabccd1bf9c6b98a1f1c4ec3fb01ef7add689bf3f0dfca886fd9ec6bfa8c5e061da37cd3f105839b4c876e33ff4de18028063e1bf193c4cfbe6feeebf3eb0e3bf4010e63f6b11514cde00e03f0700000057091687333fec3fb7d26bb3b112d73f477364e597c1d03f401878ee3d5cd03fc26d6de179a978bf2a1c412ac58edd3f1c0b0a83320de0bface463778112e93f3ca06cca15dedcbfb532e197fa79ec3f2ae5b512ba4beb3f9f573cf54803ebbf57091687333fec3fb7d26bb3b112d73f",
+		mustHexDecode("010600000003000000010300000002000000090000000FB743C362D4B93FEACF7EA4888CEE3FBADA8AFD6577E13FE564E256410CC23F6971C6302768DEBFBA2EFCE07CEAD73FFCDEA63FFB91B6BF5B09DD257156DC3F083C3080F0A1C8BF22718FA50F5D80BF49B9FB1C1FADEEBFF9D7F2CAF5B6DE3FD55A988576CEEDBFD5D006600322D73F4754A86E2EFEC43F9B38B9DFA1A8E13F0FB743C362D4B93FEACF7EA4888CEE3F0800000024B6BB07E8BEE2BF4A09C1AA7AF9AD3FCF488446B071D4BF3BE466B8019FEE3FC7F143A51133EE3FA5DDE8633EA0E2BFA8E2C62DE6E7C03FDB334B02D4D4D5BF6DAE9AE788FCED3FFD4E93196F2BEB3F2C64AE0CAA0DC63F2FA2ED98BA2BDC3FD93D7958A835D73FE6B2D1393FC5D2BF24B6BB07E8BEE2BF4A09C1AA7AF9AD3F010300000001000000090000008274B169A590E93FC7832D76FBACD5BF5FD218ADA3AADF3FAE105663096BEFBFB2D991EA3B3FE43FE603029D499BC03FAC1E300F99F2EC3F5C936E4BE482D1BF594DD7135D17D03F2BA4FCA4DAA7D6BFCE1C925A2819E23F31ED9BFBABC7C93F23DA8EA9BB32EF3F1D38674469EFEFBF486C770FD0FDE6BFAC55BB26A435EDBF8274B169A590E93FC7832D76FBACD5BF0103000000040000000900000095490D6D00B6EC3FE63FA4DFBE0EA4BFA5DC7D8E8F96EC3F1616DC0F7860E43F63EC8497E0D4E13F38DA71C3EFA6DF3F8D60E3FA77FDE3BF2D3E05C07806B93FD8EF8975AA7CC3BF397B67B455C9EC3F0C40A374E9DFE4BF425A63D00921E5BF1EE0490B9755D43F6B274A4222EDE5BF74232C2AE2F4E8BF753E3C4B9011803F95490D6D00B6EC3FE63FA4DFBE0EA4BF070000009D9CA1B8E34DE03F90D8EE1EA0FBDDBFD8F0F44A5986DBBF49BBD1C77C40C2BF05A6D3BA0D6AEF3F8BC058DFC0E4DB3F4563EDEF6C8FEC3FCC61F71DC363B33FC8EA56CF49EFBB3F7023658BA45DEF3FF98557923CD7E3BF2942EA76F615E23F9D9CA1B8E34DE03F90D8EE1EA0FBDDBF080000003EB0E3BF4010E63F6B11514CDE00E03FEBAA402D060FE6BF1D3D7E6FD39FD43FDCD8EC48F51DEB3FDDCD531D7233C03F618BDD3EABCCD1BF9C6B98A1F1C4EC3FB01EF7ADD689BF3F0DFCA886FD9EC6BFA8C5E061DA37CD3F105839B4C876E33FF4DE18028063E1BF193C4CFBE6FEEEBF3EB0E3BF4010E63F6B11514CDE00E03F0700000057091687333FEC3FB7D26BB3B112D73F477364E597C1D03F401878EE3D5CD03FC26D6DE179A978BF2A1C412AC58EDD3F1C0B0A83320DE0BFACE463778112E93F3CA06CCA15DEDCBFB532E197FA79EC3F2AE5B512BA4BEB3F9F573CF54803EBBF57091687333FEC3FB7D26BB3B112D73F"),
+		"MULTIPOLYGON (((0.100897 0.954655, 0.545825 0.140999, -0.475107 0.373687, -0.08816499999999999 0.442776, -0.192442 -0.007990000000000001, -0.958633 0.479917, -0.931453 0.361451, 0.164007 0.551835, 0.100897 0.954655), (-0.585804 0.058544, -0.319439 0.95691, 0.943734 -0.5820610000000001, 0.132077 -0.341115, 0.937077 0.849052, 0.172292 0.440169, 0.36265 -0.293289, -0.585804 0.058544)), ((0.798907 -0.338683, 0.49479 -0.981816, 0.632719 0.129739, 0.904614 -0.273614, 0.251426 -0.353995, 0.565571 0.201406, 0.974943 -0.9979749999999999, -0.718483 -0.912798, 0.798907 -0.338683)), ((0.897217 -0.039175, 0.893379 0.636776, 0.557236 0.494564, -0.624691 0.09775499999999999, -0.152242 0.899577, -0.6523330000000001 -0.660283, 0.317724 -0.6851969999999999, -0.7798929999999999 0.007846000000000001, 0.897217 -0.039175), (0.509508 -0.468483, -0.430075 -0.142593, 0.981696 0.435837, 0.892508 0.075741, 0.10912 0.980181, -0.620024 0.565181, 0.509508 -0.468483), (0.689484 0.5001060000000001, -0.689334 0.322255, 0.847407 0.12657, -0.278117 0.899041, 0.123197 -0.176727, 0.228267 0.60825, -0.543396 -0.968616, 0.689484 0.5001060000000001), (0.882715 0.360516, 0.261816 0.25563, -0.006021 0.461839, -0.501611 0.783509, -0.451055 0.88989, 0.852994 -0.844151, 0.882715 0.360516)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{-0.100692, 0.017798}, {0.613648, 0.409985}, {0.916009, -0.671028}, {0.847119, 0.855973}, {0.269498, 0.880782}, {-0.494629, 0.763575}, {0.546959, 0.219378}, {-0.100692, 0.017798}}}, {{{-0.978061, -0.498884}, {0.524705, -0.22675}, {0.550894, 0.251285}, {-0.978061, -0.498884}}, {{-0.923166, -0.069374}, {0.659705, -0.746373}, {0.420975, -0.343768}, {-0.951398, -0.05255}, {0.043385, -0.916828}, {0.131839, -0.305132}, {-0.991014, -0.618454}, {-0.778379, 0.081244}, {-0.923166, -0.069374}}}, {{{0.856266, 0.690124}, {0.890596, -0.370398}, {0.810535, 0.968625}, {0.856266, 0.690124}}, {{0.341779, 0.191326}, {-0.191593, -0.387804}, {-0.880304, -0.749235}, {-0.732088, -0.038214}, {0.341779, 0.191326}}, {{0.528137, -0.906573}, {0.64752, -0.913058}, {0.109894, 0.488296}, {0.262443, 0.899358}, {-0.310603, 0.171767}, {-0.834402, 0.119593}, {0.528137, -0.906573}}, {{-0.596791, -0.478071}, {0.400811, -0.492236}, {-0.481509, 0.871031}, {0.997087, -0.689603}, {0.800325, 0.105453}, {-0.922798, 0.171006}, {0.283099, -0.932409}, {-0.596791, -0.478071}}}, {{{0.635601, -0.856714}, {0.2968, -0.086905}, {-0.522558, -0.082659}, {-0.681221, -0.332668}, {0.310415, -0.047029}, {0.11184, 0.086886}, {0.641189, -0.313235}, {0.635601, -0.856714}}, {{-0.144534, -0.29536}, {-0.096839, 0.66702}, {0.024799, 0.974494}, {-0.144534, -0.29536}}, {{-0.762307, -0.366217}, {-0.954549, 0.467507}, {-0.961599, 0.771877}, {-0.613315, -0.172328}, {-0.875922, -0.37749}, {-0.22097, -0.895539}, {0.535102, 0.4227}, {-0.284233, 0.670385}, {-0.762307, -0.366217}}, {{-0.891988, -0.29004}, {0.803683, 0.512936}, {0.344636, 0.125472}, {-0.891988, -0.29004}}, {{-0.175547, -0.938623}, {0.604809, -0.619013}, {-0.224682, -0.284781}, {-0.753269, -0.298431}, {-0.645827, 0.232028}, {0.306869, -0.972707}, {-0.087048, 0.108105}, {-0.175547, -0.938623}}}}),
+		"010600000004000000010300000001000000080000006005f86ef3c6b9bf06a1bc8fa339923f942f682101a3e33f936fb6b9313dda3fe9ee3a1bf24fed3fc26856b60f79e5bf463f1a4e991beb3fcb49287d2164eb3f1898158a743fd13fa0f99cbb5d2fec3fc4cda96400a8dfbfe561a1d6346fe83f76172829b080e13f251fbb0b9414cc3f6005f86ef3c6b9bf06a1bc8fa339923f010300000002000000040000001dc70f95464cefbfbad91f28b7eddfbf9886e12362cae03fa01a2fdd2406cdbf04013274eca0e13f4c6c3eae0d15d03f1dc70f95464cefbfbad91f28b7eddfbf09000000afee586c938aedbf0d5531957ec2b1bfea3e00a94d1ce53fcec133a149e2e7bf1ac05b2041f1da3f33535a7f4b00d6bf965aef37da71eebff241cf66d5e7aabf9f1f46088f36a63faad381aca756edbf172eabb019e0c03ff9a23d5e4887d3bfc119fcfd62b6efbf8192020b60cae3bff7949c137be8e8bf8908ff2268ccb43fafee586c938aedbf0d5531957ec2b1bf01030000000400000004000000d2a755f48766eb3f18e945ed7e15e63f6d59be2ec37fec3f643e20d099b4d7bf726da818e7efe93fe5d022dbf9feee3fd2a755f48766eb3f18e945ed7e15e63f05000000d369dd06b5dfd53f7cef6fd05e7dc83f5c3e92921e86c8bfcf8250dec7d1d8bf2a37514b732becbf0b98c0adbbf9e7bfd76839d0436de7bf04aa7f10c990a3bfd369dd06b5dfd53f7cef6fd05e7dc83f0700000077d7d9907fe6e03fb1f84d61a502edbf7f30f0dc7bb8e43fc53c2b69c537edbfd5d006600322bc3f9f20b1dd3d40df3fac1a84b9ddcbd03fc5abac6d8ac7ec3ff986c267ebe0d3bfa019c40776fcc53f99f1b6d26bb3eabf7c9dd497a59dbe3f77d7d9907fe6e03fb1f84d61a502edbf0800000023827170e918e3bf02a08a1bb798debfaf21382ee3a6d93f9e7c7a6ccb80dfbfd1b2ee1f0bd1debf6dab59677cdfeb3f3b8908ff22e8ef3fd026874f3a11e6bf787aa52c439ce93fcfdc43c2f7feba3ffe0ddaab8f87edbf38bbb54c86e3c53f80f0a1444b1ed23fc2df2f664bd6edbf23827170e918e3bf02a08a1bb798debf01030000000500000008000000b5c189e8d756e43f9ecf807a336aebbfadfa5c6dc5fed23f8fdfdbf4673fb6bfdf6b088ecbb8e0bf1d56b8e52329b5bf39268bfb8fcce5bf213f1bb96e4ad5bf92054ce0d6ddd33fe25aed612f14a8bfc47762d68ba1bc3f0ff27a30293eb63f1ac231cb9e84e43f959a3dd00a0cd4bfb5c189e8d756e43f9ecf807a336aebbf04000000fa7c94111780c2bfd7fa22a12de7d2bf3733fad170cab8bfb8e4b8533a58e53f3cdee4b7e864993f40be840a0e2fef3ffa7c94111780c2bfd7fa22a12de7d2bf09000000416150a6d164e8bf944f8f6d1970d7bf7fbe2d58aa8beebf2aabe97aa2ebdd3fc3b81b446bc5eebf611bf16437b3e83f00ae64c746a0e3bff7b0170ad80ec6bfe719fb928d07ecbf624a24d1cb28d8bfd4d4b2b5be48ccbf855ca96741a8ecbf2ec6c03a8e1fe13f933a014d840ddb3ff969dc9bdf30d2bfa453573ecb73e53f416150a6d164e8bf944f8f6d1970d7bf0400000014950d6b2a8becbfab09a2ee0390d2bfc53c2b69c5b7e93f211d1ec2f869e03f698d4127840ed63fb727486c770fc03f14950d6b2a8becbfab09a2ee0390d2bf08000000c498f4f75278c6bfc2bf081a3309eebf65726a67985ae33f2a90d959f4cee3bfcefbff3861c2ccbf556b6116da39d2bf64e60297c71ae8bf723447567e19d3bf1bf67b629daae4bf0ccee0ef17b3cd3f93ffc9dfbda3d33f82e3326e6a20efbf2fa52e19c748b6bf78b988efc4acbb3fc498f4f75278c6bfc2bf081a3309eebf",
+		mustHexDecode("010600000004000000010300000001000000080000006005F86EF3C6B9BF06A1BC8FA339923F942F682101A3E33F936FB6B9313DDA3FE9EE3A1BF24FED3FC26856B60F79E5BF463F1A4E991BEB3FCB49287D2164EB3F1898158A743FD13FA0F99CBB5D2FEC3FC4CDA96400A8DFBFE561A1D6346FE83F76172829B080E13F251FBB0B9414CC3F6005F86EF3C6B9BF06A1BC8FA339923F010300000002000000040000001DC70F95464CEFBFBAD91F28B7EDDFBF9886E12362CAE03FA01A2FDD2406CDBF04013274ECA0E13F4C6C3EAE0D15D03F1DC70F95464CEFBFBAD91F28B7EDDFBF09000000AFEE586C938AEDBF0D5531957EC2B1BFEA3E00A94D1CE53FCEC133A149E2E7BF1AC05B2041F1DA3F33535A7F4B00D6BF965AEF37DA71EEBFF241CF66D5E7AABF9F1F46088F36A63FAAD381ACA756EDBF172EABB019E0C03FF9A23D5E4887D3BFC119FCFD62B6EFBF8192020B60CAE3BFF7949C137BE8E8BF8908FF2268CCB43FAFEE586C938AEDBF0D5531957EC2B1BF01030000000400000004000000D2A755F48766EB3F18E945ED7E15E63F6D59BE2EC37FEC3F643E20D099B4D7BF726DA818E7EFE93FE5D022DBF9FEEE3FD2A755F48766EB3F18E945ED7E15E63F05000000D369DD06B5DFD53F7CEF6FD05E7DC83F5C3E92921E86C8BFCF8250DEC7D1D8BF2A37514B732BECBF0B98C0ADBBF9E7BFD76839D0436DE7BF04AA7F10C990A3BFD369DD06B5DFD53F7CEF6FD05E7DC83F0700000077D7D9907FE6E03FB1F84D61A502EDBF7F30F0DC7BB8E43FC53C2B69C537EDBFD5D006600322BC3F9F20B1DD3D40DF3FAC1A84B9DDCBD03FC5ABAC6D8AC7EC3FF986C267EBE0D3BFA019C40776FCC53F99F1B6D26BB3EABF7C9DD497A59DBE3F77D7D9907FE6E03FB1F84D61A502EDBF0800000023827170E918E3BF02A08A1BB798DEBFAF21382EE3A6D93F9E7C7A6CCB80DFBFD1B2EE1F0BD1DEBF6DAB59677CDFEB3F3B8908FF22E8EF3FD026874F3A11E6BF787AA52C439CE93FCFDC43C2F7FEBA3FFE0DDAAB8F87EDBF38BBB54C86E3C53F80F0A1444B1ED23FC2DF2F664BD6EDBF23827170E918E3BF02A08A1BB798DEBF01030000000500000008000000B5C189E8D756E43F9ECF807A336AEBBFADFA5C6DC5FED23F8FDFDBF4673FB6BFDF6B088ECBB8E0BF1D56B8E52329B5BF39268BFB8FCCE5BF213F1BB96E4AD5BF92054CE0D6DDD33FE25AED612F14A8BFC47762D68BA1BC3F0FF27A30293EB63F1AC231CB9E84E43F959A3DD00A0CD4BFB5C189E8D756E43F9ECF807A336AEBBF04000000FA7C94111780C2BFD7FA22A12DE7D2BF3733FAD170CAB8BFB8E4B8533A58E53F3CDEE4B7E864993F40BE840A0E2FEF3FFA7C94111780C2BFD7FA22A12DE7D2BF09000000416150A6D164E8BF944F8F6D1970D7BF7FBE2D58AA8BEEBF2AABE97AA2EBDD3FC3B81B446BC5EEBF611BF16437B3E83F00AE64C746A0E3BFF7B0170AD80EC6BFE719FB928D07ECBF624A24D1CB28D8BFD4D4B2B5BE48CCBF855CA96741A8ECBF2EC6C03A8E1FE13F933A014D840DDB3FF969DC9BDF30D2BFA453573ECB73E53F416150A6D164E8BF944F8F6D1970D7BF0400000014950D6B2A8BECBFAB09A2EE0390D2BFC53C2B69C5B7E93F211D1EC2F869E03F698D4127840ED63FB727486C770FC03F14950D6B2A8BECBFAB09A2EE0390D2BF08000000C498F4F75278C6BFC2BF081A3309EEBF65726A67985AE33F2A90D959F4CEE3BFCEFBFF3861C2CCBF556B6116DA39D2BF64E60297C71AE8BF723447567E19D3BF1BF67B629DAAE4BF0CCEE0EF17B3CD3F93FFC9DFBDA3D33F82E3326E6A20EFBF2FA52E19C748B6BF78B988EFC4ACBB3FC498F4F75278C6BFC2BF081A3309EEBF"),
+		"MULTIPOLYGON (((-0.100692 0.017798, 0.613648 0.409985, 0.916009 -0.671028, 0.847119 0.855973, 0.269498 0.880782, -0.494629 0.763575, 0.546959 0.219378, -0.100692 0.017798)), ((-0.978061 -0.498884, 0.524705 -0.22675, 0.550894 0.251285, -0.978061 -0.498884), (-0.923166 -0.06937400000000001, 0.659705 -0.746373, 0.420975 -0.343768, -0.951398 -0.05255, 0.043385 -0.916828, 0.131839 -0.305132, -0.991014 -0.6184539999999999, -0.778379 0.081244, -0.923166 -0.06937400000000001)), ((0.856266 0.690124, 0.8905960000000001 -0.370398, 0.810535 0.968625, 0.856266 0.690124), (0.341779 0.191326, -0.191593 -0.387804, -0.880304 -0.749235, -0.732088 -0.038214, 0.341779 0.191326), (0.528137 -0.906573, 0.64752 -0.913058, 0.109894 0.488296, 0.262443 0.899358, -0.310603 0.171767, -0.834402 0.119593, 0.528137 -0.906573), (-0.596791 -0.478071, 0.400811 -0.492236, -0.481509 0.871031, 0.9970869999999999 -0.689603, 0.800325 0.105453, -0.922798 0.171006, 0.283099 -0.932409, -0.596791 -0.478071)), ((0.635601 -0.856714, 0.2968 -0.086905, -0.522558 -0.082659, -0.681221 -0.332668, 0.310415 -0.047029, 0.11184 0.086886, 0.641189 -0.313235, 0.635601 -0.856714), (-0.144534 -0.29536, -0.09683899999999999 0.6670199999999999, 0.024799 0.974494, -0.144534 -0.29536), (-0.762307 -0.366217, -0.954549 0.467507, -0.961599 0.771877, -0.6133150000000001 -0.172328, -0.875922 -0.37749, -0.22097 -0.895539, 0.535102 0.4227, -0.284233 0.670385, -0.762307 -0.366217), (-0.891988 -0.29004, 0.803683 0.5129359999999999, 0.344636 0.125472, -0.891988 -0.29004), (-0.175547 -0.938623, 0.604809 -0.619013, -0.224682 -0.284781, -0.753269 -0.298431, -0.645827 0.232028, 0.306869 -0.972707, -0.087048 0.108105, -0.175547 -0.938623)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{-0.8391, -0.896553}, {0.724219, 0.581459}, {0.716896, -0.475515}, {0.295995, -0.808564}, {0.653147, -0.332774}, {-0.8391, -0.896553}}, {{-0.933865, 0.818112}, {0.251064, -0.425838}, {-0.926393, -0.246627}, {-0.686279, 0.096561}, {-0.706233, -0.650772}, {-0.933865, 0.818112}}, {{0.28024, -0.514837}, {0.757793, 0.249432}, {0.891199, -0.034166}, {0.775802, 0.356888}, {-0.911663, -0.519419}, {-0.436847, -0.659967}, {-0.523626, -0.54792}, {0.756688, -0.074202}, {0.28024, -0.514837}}, {{-0.724005, 0.129837}, {-0.973065, 0.860603}, {-0.988726, -0.220185}, {0.603172, 0.999764}, {-0.960981, 0.648171}, {0.020176, -0.923636}, {0.554239, -0.776196}, {0.222948, 0.556651}, {-0.724005, 0.129837}}, {{-0.240251, -0.947117}, {-0.127472, 0.827389}, {-0.334153, -0.504083}, {-0.724339, 0.020505}, {0.066697, -0.853904}, {-0.184483, 0.317363}, {0.932102, -0.136918}, {-0.240251, -0.947117}}}, {{{-0.057732, -0.549933}, {-0.210325, 0.29053}, {-0.205882, 0.162752}, {0.671165, 0.995936}, {0.77008, -0.256407}, {-0.057732, -0.549933}}}, {{{-0.050899, -0.525966}, {-0.919392, -0.35686}, {0.596143, 0.928238}, {-0.78668, 0.755279}, {-0.902565, 0.426952}, {-0.946409, -0.157901}, {-0.050899, -0.525966}}, {{0.849129, 0.42639}, {0.208369, -0.677242}, {-0.319009, -0.177808}, {0.18041, 0.992077}, {-0.432581, 0.007126}, {0.849129, 0.42639}}, {{-0.309159, 0.25721}, {0.532263, 0.26054}, {0.506862, -0.608614}, {0.914676, -0.646205}, {0.167362, -0.407915}, {0.268846, -0.417779}, {-0.137573, 0.364445}, {-0.461863, 0.455752}, {-0.309159, 0.25721}}, {{-0.735688, 0.226258}, {-0.668484, -0.138845}, {-0.203205, -0.847663}, {0.42154, 0.361647}, {0.55559, 0.089826}, {-0.735688, 0.226258}}, {{-0.661534, -0.585072}, {-0.543501, 0.050607}, {0.637965, -0.286052}, {0.763744, 0.471757}, {0.432895, -0.329656}, {-0.763045, 0.925581}, {-0.661534, -0.585072}}}, {{{-0.182264, 0.726437}, {0.798435, -0.315053}, {0.003123, -0.33642}, {0.390315, 0.824335}, {0.969089, 0.487558}, {-0.389515, 0.760987}, {0.98524, -0.306948}, {0.897425, 0.023093}, {-0.182264, 0.726437}}, {{0.625885, 0.366874}, {-0.691971, -0.990166}, {0.190942, 0.40892}, {0.871077, 0.03424}, {0.393693, 0.294712}, {-0.59016, 0.2886}, {0.963443, -0.77763}, {0.377087, 0.22861}, {0.625885, 0.366874}}, {{0.586696, -0.979029}, {0.784824, 0.634728}, {-0.03859, -0.783722}, {-0.094743, 0.168506}, {-0.492233, -0.026937}, {0.586696, -0.979029}}, {{0.845464, 0.12329}, {0.654484, -0.844134}, {0.712736, 0.84163}, {-0.663998, 0.654975}, {0.699133, 0.757318}, {0.034279, 0.216509}, {-0.583834, 0.416263}, {0.845464, 0.12329}}, {{-0.957662, -0.731466}, {-0.223564, 0.77036}, {0.129885, 0.832514}, {0.858968, -0.82641}, {0.176431, -0.330944}, {-0.957662, -0.731466}}}, {{{-0.08895, -0.040113}, {-0.796389, 0.666321}, {-0.01944, 0.289975}, {-0.054643, -0.637964}, {0.082001, -0.680921}, {0.704359, 0.663208}, {-0.08895, -0.040113}}}, {{{-0.863017, -0.213512}, {0.906083, 0.112281}, {-0.468947, -0.540703}, {-0.863017, -0.213512}}}, {{{0.623727, -0.722733}, {0.728123, 0.645996}, {-0.726383, 0.11745}, {0.623727, -0.722733}}}}),
+		"01060000000700000001030000000500000006000000ee5a423ee8d9eabf992ec4ea8fb0ecbfd2890453cd2ce73f2ae09ee74f9be23f4e4354e1cff0e63fe6797077d66edebfa8e3310395f1d23f5b22179cc1dfe9bf5b608f8994e6e43ff661bd512b4cd5bfee5a423ee8d9eabf992ec4ea8fb0ecbf0600000018213cda38e2edbf86e28e37f92dea3f09fd4cbd6e11d03f1538d906ee40dbbf58c9c7ee02a5edbf7bc1a7397991cfbf89cf9d60fff5e5bfa7ad11c138b8b83f1764cbf27599e6bf61e124cd1fd3e4bf18213cda38e2edbf86e28e37f92dea3f090000008ffcc1c073efd13f00aab8718b79e0bf4568041bd73fe83f2cb6494563edcf3ffd4ae7c3b384ec3f8c3045b9347ea1bf0b7c45b75ed3e83f266e15c440d7d63fd9243fe2572cedbf07793d98149fe0bffcc3961e4df5dbbf300e2e1d731ee5bfbfba2a508bc1e0bfd4601a868f88e1bf1cd2a8c0c936e83f193c4cfbe6feb2bf8ffcc1c073efd13f00aab8718b79e0bf09000000d07ea4880c2be7bfb9c667b27f9ec03f4f3bfc355923efbf16dd7a4d0f8aeb3f828e56b5a4a3efbfe5ed08a7052fccbffa9cbb5d2f4de33f09fb761211feef3fdd79e2395bc0eebf54e6e61bd1bde43fc808a87004a9943f6f10ad156d8eedbf295e656d53bce13f0b2769fe98d6e8bfabb35a608f89cc3f6c2409c215d0e13fd07ea4880c2be7bfb9c667b27f9ec03f08000000e867ea758bc0cebf4c8a8f4fc84eeebf5fee93a30051c0bf9f3e027ff879ea3ff50eb743c362d5bfb306efab7221e0bf7bf486fbc82de7bf01309e4143ff943fa0c6bdf90d13b13f008e3d7b2e53ebbffa7fd591239dc7bffb777de6ac4fd43f7c28d192c7d3ed3fcdea1d6e8786c1bfe867ea758bc0cebf4c8a8f4fc84eeebf010300000001000000060000002f87dd770c8fadbfa6b73f170d99e1bf5dfe43faedebcabfb97020240b98d23fa4e36a64575acabf58e6adba0ed5c43fee08a7052f7ae53ffd169d2cb5deef3fbfb7e9cf7ea4e83f4bcadde7f868d0bf2f87dd770c8fadbfa6b73f170d99e1bf010300000005000000070000005c57cc086f0faabf9dda19a6b6d4e0bf6e8786c5a86bedbf2d095053cbd6d6bf93a8177c9a13e33f3df19c2d20b4ed3f5c5a0d897b2ce9bff2608bdd3e2be83fa774b0fecfe1ecbf008e3d7b2e53db3f677bf486fb48eebfa6ba80971936c4bf5c57cc086f0faabf9dda19a6b6d4e0bf06000000d4b9a294102ceb3f27da5548f949db3f570740dcd5abca3fc3482f6af7abe5bf6a4c88b9a46ad4bf29cc7b9c69c2c6bfb988efc4ac17c73f2fa4c34318bfef3f12bef73768afdbbf2882380f27307d3fd4b9a294102ceb3f27da5548f949db3f090000006de690d442c9d3bff5108dee2076d03ffddd3b6a4c08e13f9430d3f6afacd03f91b932a83638e03f66683c11c479e3bf80f44d9a0645ed3faf5fb01bb6ade4bf68ec4b361e6cc53fd00f2384471bdabf41446adac534d13f4a287d21e4bcdabf8a03e8f7fd9bc1bfc2340c1f1153d73f6ee00ed4298fddbfb98ac56f0a2bdd3f6de690d442c9d3bff5108dee2076d03f06000000a3e8818fc18ae7bfcdab3aab05f6cc3fc5c6bc8e3864e5bf84471b47acc5c1bf6d1cb1169f02cabf9aefe0270e20ebbf7c2c7de882fada3fa7936c753925d73f2c82ffad64c7e13f639b5434d6feb63fa3e8818fc18ae7bfcdab3aab05f6cc3f070000006325e659492be5bf1ec539eae8b8e2bf48fc8a355c64e1bf56b8e52329e9a93fb5c35f93356ae43f24253d0cad4ed2bfe884d0419770e83f3c2eaa454431de3fdb85e63a8db4db3f0188bb7a1519d5bfd00a0c59dd6ae8bf3691990b5c9eed3f6325e659492be5bf1ec539eae8b8e2bf01030000000500000009000000817b9e3f6d54c7bfda56b3cef83ee73f946a9f8ec78ce93f946de00ed429d4bf1e6cb1db6795693fb9196ec0e787d5bfedd808c4ebfad83f54573ecbf360ea3f1d3a3defc602ef3f81cd39782634df3fcb4a9352d0edd8bfffcbb568015ae83fd7c056091687ef3f9ca5643909a5d3bf0f9c33a2b4b7ec3fb7ec10ffb0a5973f817b9e3f6d54c7bfda56b3cef83ee73f090000009ed2c1fa3f07e43f4d2cf015dd7ad73f2bf9d85da024e6bf6d8e739b70afefbf0a67b796c970c83f5d8aabcabe2bda3f838769dfdcdfeb3fb9196ec0e787a13f1381ea1f4432d93fb6476fb88fdcd23f18096d3997e2e2bfd50968226c78d23f92ec116a86d4ee3f6e6e4c4f58e2e8bfc9ca2f833122d83f89efc4ac1743cd3f9ed2c1fa3f07e43f4d2cf015dd7ad73f06000000613596b036c6e23f1bbd1aa03454efbf7cb5a338471de93f28603b18b14fe43f6ec0e78711c2a3bf98fbe4284014e9bfae6186c61341b8bfe60297c79a91c53f15c440d7be80dfbfc39b35785f959bbf613596b036c6e23f1bbd1aa03454efbf080000004240be840a0eeb3fc780ecf5ee8fbf3f1f2bf86d88f1e43f1d226e4e2503ebbfc4d155babbcee63fada3aa09a2eeea3f4580d3bb783fe5bfbd5296218ef5e43f62bd512b4c5fe63f138255f5f23be83f2711e15f048da13fb613252191b6cb3f245f09a4c4aee2bff33ae2900da4da3f4240be840a0eeb3fc780ecf5ee8fbf3f0600000008e753c72aa5eebf965984622b68e7bf8d0e48c2be9dccbf9fb0c403caa6e83fc7d79e5912a0c03fe2c96e66f4a3ea3fd8ef8975aa7ceb3fa7cb6262f371eabfe012807f4a95c63fd6ac33be2f2ed5bf08e753c72aa5eebf965984622b68e7bf01030000000100000007000000daacfa5c6dc5b6bf17f549eeb089a4bfd49cbcc8047ce9bfa16af46a8052e53f9cdcef5014e893bf1e166a4df38ed23fc020e9d32afaabbf9ecf807a336ae4bf81423d7d04feb43f931b45d61acae5bf9e95b4e21b8ae63f1842cefbff38e53fdaacfa5c6dc5b6bf17f549eeb089a4bf01030000000100000004000000878bdcd3d59debbfcbdaa6785c54cbbf2ac58ec6a1feec3f2b51f69672bebc3f00ab23473a03debfbb2bbb60704de1bf878bdcd3d59debbfcbdaa6785c54cbbf01030000000100000004000000eb3a545392f5e33fd1add7f4a020e7bf9fe40e9bc84ce73f1e19abcdffabe43f0edaab8f873ee7bf8c4aea043411be3feb3a545392f5e33fd1add7f4a020e7bf",
+		mustHexDecode("01060000000700000001030000000500000006000000EE5A423EE8D9EABF992EC4EA8FB0ECBFD2890453CD2CE73F2AE09EE74F9BE23F4E4354E1CFF0E63FE6797077D66EDEBFA8E3310395F1D23F5B22179CC1DFE9BF5B608F8994E6E43FF661BD512B4CD5BFEE5A423EE8D9EABF992EC4EA8FB0ECBF0600000018213CDA38E2EDBF86E28E37F92DEA3F09FD4CBD6E11D03F1538D906EE40DBBF58C9C7EE02A5EDBF7BC1A7397991CFBF89CF9D60FFF5E5BFA7AD11C138B8B83F1764CBF27599E6BF61E124CD1FD3E4BF18213CDA38E2EDBF86E28E37F92DEA3F090000008FFCC1C073EFD13F00AAB8718B79E0BF4568041BD73FE83F2CB6494563EDCF3FFD4AE7C3B384EC3F8C3045B9347EA1BF0B7C45B75ED3E83F266E15C440D7D63FD9243FE2572CEDBF07793D98149FE0BFFCC3961E4DF5DBBF300E2E1D731EE5BFBFBA2A508BC1E0BFD4601A868F88E1BF1CD2A8C0C936E83F193C4CFBE6FEB2BF8FFCC1C073EFD13F00AAB8718B79E0BF09000000D07EA4880C2BE7BFB9C667B27F9EC03F4F3BFC355923EFBF16DD7A4D0F8AEB3F828E56B5A4A3EFBFE5ED08A7052FCCBFFA9CBB5D2F4DE33F09FB761211FEEF3FDD79E2395BC0EEBF54E6E61BD1BDE43FC808A87004A9943F6F10AD156D8EEDBF295E656D53BCE13F0B2769FE98D6E8BFABB35A608F89CC3F6C2409C215D0E13FD07EA4880C2BE7BFB9C667B27F9EC03F08000000E867EA758BC0CEBF4C8A8F4FC84EEEBF5FEE93A30051C0BF9F3E027FF879EA3FF50EB743C362D5BFB306EFAB7221E0BF7BF486FBC82DE7BF01309E4143FF943FA0C6BDF90D13B13F008E3D7B2E53EBBFFA7FD591239DC7BFFB777DE6AC4FD43F7C28D192C7D3ED3FCDEA1D6E8786C1BFE867EA758BC0CEBF4C8A8F4FC84EEEBF010300000001000000060000002F87DD770C8FADBFA6B73F170D99E1BF5DFE43FAEDEBCABFB97020240B98D23FA4E36A64575ACABF58E6ADBA0ED5C43FEE08A7052F7AE53FFD169D2CB5DEEF3FBFB7E9CF7EA4E83F4BCADDE7F868D0BF2F87DD770C8FADBFA6B73F170D99E1BF010300000005000000070000005C57CC086F0FAABF9DDA19A6B6D4E0BF6E8786C5A86BEDBF2D095053CBD6D6BF93A8177C9A13E33F3DF19C2D20B4ED3F5C5A0D897B2CE9BFF2608BDD3E2BE83FA774B0FECFE1ECBF008E3D7B2E53DB3F677BF486FB48EEBFA6BA80971936C4BF5C57CC086F0FAABF9DDA19A6B6D4E0BF06000000D4B9A294102CEB3F27DA5548F949DB3F570740DCD5ABCA3FC3482F6AF7ABE5BF6A4C88B9A46AD4BF29CC7B9C69C2C6BFB988EFC4AC17C73F2FA4C34318BFEF3F12BEF73768AFDBBF2882380F27307D3FD4B9A294102CEB3F27DA5548F949DB3F090000006DE690D442C9D3BFF5108DEE2076D03FFDDD3B6A4C08E13F9430D3F6AFACD03F91B932A83638E03F66683C11C479E3BF80F44D9A0645ED3FAF5FB01BB6ADE4BF68EC4B361E6CC53FD00F2384471BDABF41446ADAC534D13F4A287D21E4BCDABF8A03E8F7FD9BC1BFC2340C1F1153D73F6EE00ED4298FDDBFB98AC56F0A2BDD3F6DE690D442C9D3BFF5108DEE2076D03F06000000A3E8818FC18AE7BFCDAB3AAB05F6CC3FC5C6BC8E3864E5BF84471B47ACC5C1BF6D1CB1169F02CABF9AEFE0270E20EBBF7C2C7DE882FADA3FA7936C753925D73F2C82FFAD64C7E13F639B5434D6FEB63FA3E8818FC18AE7BFCDAB3AAB05F6CC3F070000006325E659492BE5BF1EC539EAE8B8E2BF48FC8A355C64E1BF56B8E52329E9A93FB5C35F93356AE43F24253D0CAD4ED2BFE884D0419770E83F3C2EAA454431DE3FDB85E63A8DB4DB3F0188BB7A1519D5BFD00A0C59DD6AE8BF3691990B5C9EED3F6325E659492BE5BF1EC539EAE8B8E2BF01030000000500000009000000817B9E3F6D54C7BFDA56B3CEF83EE73F946A9F8EC78CE93F946DE00ED429D4BF1E6CB1DB6795693FB9196EC0E787D5BFEDD808C4EBFAD83F54573ECBF360EA3F1D3A3DEFC602EF3F81CD39782634DF3FCB4A9352D0EDD8BFFFCBB568015AE83FD7C056091687EF3F9CA5643909A5D3BF0F9C33A2B4B7EC3FB7EC10FFB0A5973F817B9E3F6D54C7BFDA56B3CEF83EE73F090000009ED2C1FA3F07E43F4D2CF015DD7AD73F2BF9D85DA024E6BF6D8E739B70AFEFBF0A67B796C970C83F5D8AABCABE2BDA3F838769DFDCDFEB3FB9196EC0E787A13F1381EA1F4432D93FB6476FB88FDCD23F18096D3997E2E2BFD50968226C78D23F92EC116A86D4EE3F6E6E4C4F58E2E8BFC9CA2F833122D83F89EFC4AC1743CD3F9ED2C1FA3F07E43F4D2CF015DD7AD73F06000000613596B036C6E23F1BBD1AA03454EFBF7CB5A338471DE93F28603B18B14FE43F6EC0E78711C2A3BF98FBE4284014E9BFAE6186C61341B8BFE60297C79A91C53F15C440D7BE80DFBFC39B35785F959BBF613596B036C6E23F1BBD1AA03454EFBF080000004240BE840A0EEB3FC780ECF5EE8FBF3F1F2BF86D88F1E43F1D226E4E2503EBBFC4D155BABBCEE63FADA3AA09A2EEEA3F4580D3BB783FE5BFBD5296218EF5E43F62BD512B4C5FE63F138255F5F23BE83F2711E15F048DA13FB613252191B6CB3F245F09A4C4AEE2BFF33AE2900DA4DA3F4240BE840A0EEB3FC780ECF5EE8FBF3F0600000008E753C72AA5EEBF965984622B68E7BF8D0E48C2BE9DCCBF9FB0C403CAA6E83FC7D79E5912A0C03FE2C96E66F4A3EA3FD8EF8975AA7CEB3FA7CB6262F371EABFE012807F4A95C63FD6AC33BE2F2ED5BF08E753C72AA5EEBF965984622B68E7BF01030000000100000007000000DAACFA5C6DC5B6BF17F549EEB089A4BFD49CBCC8047CE9BFA16AF46A8052E53F9CDCEF5014E893BF1E166A4DF38ED23FC020E9D32AFAABBF9ECF807A336AE4BF81423D7D04FEB43F931B45D61ACAE5BF9E95B4E21B8AE63F1842CEFBFF38E53FDAACFA5C6DC5B6BF17F549EEB089A4BF01030000000100000004000000878BDCD3D59DEBBFCBDAA6785C54CBBF2AC58EC6A1FEEC3F2B51F69672BEBC3F00AB23473A03DEBFBB2BBB60704DE1BF878BDCD3D59DEBBFCBDAA6785C54CBBF01030000000100000004000000EB3A545392F5E33FD1ADD7F4A020E7BF9FE40E9BC84CE73F1E19ABCDFFABE43F0EDAAB8F873EE7BF8C4AEA043411BE3FEB3A545392F5E33FD1ADD7F4A020E7BF"),
+		"MULTIPOLYGON (((-0.8391 -0.896553, 0.7242189999999999 0.5814589999999999, 0.716896 -0.475515, 0.295995 -0.8085639999999999, 0.653147 -0.332774, -0.8391 -0.896553), (-0.9338649999999999 0.818112, 0.251064 -0.425838, -0.926393 -0.246627, -0.686279 0.09656099999999999, -0.706233 -0.650772, -0.9338649999999999 0.818112), (0.28024 -0.514837, 0.757793 0.249432, 0.891199 -0.034166, 0.775802 0.356888, -0.911663 -0.519419, -0.436847 -0.659967, -0.523626 -0.54792, 0.756688 -0.074202, 0.28024 -0.514837), (-0.724005 0.129837, -0.973065 0.860603, -0.988726 -0.220185, 0.603172 0.999764, -0.960981 0.6481710000000001, 0.020176 -0.923636, 0.554239 -0.776196, 0.222948 0.556651, -0.724005 0.129837), (-0.240251 -0.947117, -0.127472 0.827389, -0.334153 -0.5040829999999999, -0.724339 0.020505, 0.06669700000000001 -0.853904, -0.184483 0.317363, 0.932102 -0.136918, -0.240251 -0.947117)), ((-0.057732 -0.549933, -0.210325 0.29053, -0.205882 0.162752, 0.671165 0.995936, 0.77008 -0.256407, -0.057732 -0.549933)), ((-0.050899 -0.525966, -0.919392 -0.35686, 0.596143 0.928238, -0.78668 0.755279, -0.902565 0.426952, -0.9464089999999999 -0.157901, -0.050899 -0.525966), (0.849129 0.42639, 0.208369 -0.677242, -0.319009 -0.177808, 0.18041 0.992077, -0.432581 0.007126, 0.849129 0.42639), (-0.309159 0.25721, 0.532263 0.26054, 0.506862 -0.608614, 0.914676 -0.646205, 0.167362 -0.407915, 0.268846 -0.417779, -0.137573 0.364445, -0.461863 0.455752, -0.309159 0.25721), (-0.735688 0.226258, -0.668484 -0.138845, -0.203205 -0.8476630000000001, 0.42154 0.361647, 0.55559 0.089826, -0.735688 0.226258), (-0.661534 -0.585072, -0.543501 0.050607, 0.637965 -0.286052, 0.763744 0.471757, 0.432895 -0.329656, -0.763045 0.925581, -0.661534 -0.585072)), ((-0.182264 0.726437, 0.798435 -0.315053, 0.003123 -0.33642, 0.390315 0.824335, 0.969089 0.487558, -0.389515 0.760987, 0.98524 -0.306948, 0.897425 0.023093, -0.182264 0.726437), (0.625885 0.366874, -0.691971 -0.990166, 0.190942 0.40892, 0.871077 0.03424, 0.393693 0.294712, -0.59016 0.2886, 0.963443 -0.77763, 0.377087 0.22861, 0.625885 0.366874), (0.586696 -0.979029, 0.784824 0.634728, -0.03859 -0.783722, -0.09474299999999999 0.168506, -0.492233 -0.026937, 0.586696 -0.979029), (0.845464 0.12329, 0.654484 -0.8441340000000001, 0.712736 0.84163, -0.663998 0.654975, 0.699133 0.757318, 0.034279 0.216509, -0.583834 0.416263, 0.845464 0.12329), (-0.957662 -0.7314659999999999, -0.223564 0.77036, 0.129885 0.832514, 0.858968 -0.82641, 0.176431 -0.330944, -0.957662 -0.7314659999999999)), ((-0.08895 -0.040113, -0.796389 0.6663210000000001, -0.01944 0.289975, -0.054643 -0.637964, 0.082001 -0.680921, 0.704359 0.663208, -0.08895 -0.040113)), ((-0.863017 -0.213512, 0.906083 0.112281, -0.468947 -0.540703, -0.863017 -0.213512)), ((0.623727 -0.722733, 0.728123 0.645996, -0.726383 0.11745, 0.623727 -0.722733)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.510681, -0.019309}, {0.380844, 0.862479}, {0.119092, 0.749411}, {-0.313909, -0.804935}, {-0.989711, -0.5467}, {0.677174, -0.377009}, {0.510681, -0.019309}}, {{0.893809, 0.017957}, {-0.318257, -0.844997}, {0.147334, -0.547486}, {-0.265002, -0.237675}, {0.516369, -0.536743}, {0.893809, 0.017957}}}, {{{0.484776, -0.037761}, {0.760949, -0.281664}, {-0.23132, -0.741262}, {0.557112, -0.197615}, {0.000506, -0.058063}, {0.312364, -0.252123}, {0.831723, -0.136156}, {-0.281572, -0.198244}, {0.484776, -0.037761}}, {{0.73303, -0.040545}, {-0.417282, -0.108026}, {-0.311969, -0.512936}, {-0.626118, 0.911752}, {-0.00139, -0.780051}, {-0.232187, -0.222566}, {0.027069, 0.960083}, {0.953267, 0.131788}, {0.73303, -0.040545}}, {{0.351258, 0.004444}, {-0.026644, -0.370952}, {0.367844, -0.81621}, {-0.36571, 0.781958}, {-0.545244, 0.935165}, {0.96834, 0.150765}, {0.351258, 0.004444}}, {{-0.813044, -0.599397}, {-0.346377, -0.773784}, {0.594422, -0.271691}, {-0.813044, -0.599397}}}, {{{-0.912613, -0.234656}, {-0.990987, -0.767017}, {0.209291, 0.869891}, {-0.601268, 0.482123}, {-0.912613, -0.234656}}}, {{{0.793076, 0.692218}, {-0.866443, -0.64573}, {-0.531398, 0.856643}, {0.793076, 0.692218}}, {{-0.128373, -0.237511}, {0.530696, 0.231522}, {-0.461365, 0.165621}, {0.407706, 0.654157}, {0.354358, 0.281494}, {0.191805, -0.815899}, {0.890379, 0.429684}, {-0.128373, -0.237511}}}, {{{0.384702, 0.241635}, {0.317703, -0.242182}, {0.146352, 0.320055}, {-0.596688, 0.016024}, {0.384702, 0.241635}}}, {{{0.822122, -0.750906}, {0.786534, -0.060402}, {-0.090195, -0.32037}, {0.822122, -0.750906}}, {{0.129966, -0.328814}, {0.643952, -0.532877}, {-0.50306, -0.038897}, {0.870163, -0.952169}, {0.446827, -0.987987}, {0.129966, -0.328814}}, {{0.528415, -0.107842}, {-0.141022, -0.493567}, {-0.049809, -0.543481}, {-0.432958, 0.306587}, {0.198894, 0.859091}, {0.528415, -0.107842}}}, {{{0.04476, -0.824887}, {-0.400194, 0.03561}, {0.346326, 0.892395}, {-0.689785, -0.926631}, {0.740072, 0.610329}, {0.531497, -0.062798}, {0.355562, -0.177062}, {-0.615897, -0.218213}, {0.04476, -0.824887}}, {{0.922269, 0.775335}, {0.364169, 0.041824}, {0.447854, -0.633593}, {0.846169, 0.425153}, {0.188971, -0.131917}, {0.267083, 0.235358}, {0.797709, 0.141473}, {0.922269, 0.775335}}, {{-0.117241, -0.514063}, {0.809901, 0.687052}, {0.111638, -0.607217}, {-0.912916, -0.731661}, {-0.117241, -0.514063}}, {{0.348408, -0.552004}, {0.369041, 0.723899}, {0.514482, -0.148945}, {0.291456, 0.976735}, {0.770824, -0.323701}, {0.348408, -0.552004}}}}),
+		"01060000000700000001030000000200000007000000d10836ae7f57e03f3ae8120ebdc593bf452e3883bf5fd83fbc934f8f6d99eb3f72193735d07cbe3fae6708c72cfbe73f54e23ac61517d4bffcfb8c0b07c2e9bf02ba2f67b6abefbf742497ff907ee1bfb972f6ce68abe53fed0c535bea20d8bfd10836ae7f57e03f3ae8120ebdc593bf06000000d7dafb54159aec3f00e5efde5163923fd046ae9b525ed4bfc6fa0626370aebbfe55fcb2bd7dbc23f4792205c0185e1bf51f9d7f2caf5d0bf95d40968226ccebf1762f5471886e03ff46beba7ff2ce1bfd7dafb54159aec3f00e5efde5163923f010300000004000000090000003eaf78ea9106df3f2ae620e86855a3bf9f909db7b159e83f8e791d71c806d2bf799274cde49bcdbfc98ff8156bb8e7bf60b1868bdcd3e13f257a19c5724bc9bfc5cbd3b9a294403fa31d37fc6ebaadbfd6a71c93c5fdd33f2e71e481c822d0bf9e978a8d799dea3f0abc934f8f6dc1bf3509de904605d2bfa56950340f60c9bf3eaf78ea9106df3f2ae620e86855a3bf0900000085949f54fb74e73f185b087250c2a4bffd67cd8fbfb4dabf53211e8997a7bbbfa96917d34cf7d3bf211d1ec2f869e0bf50fbad9d2809e4bfc100c287122ded3ff5f3a62215c656bf7ec9c6832df6e8bf8b36c7b94db8cdbfefcb99ed0a7dccbffd12f1d6f9b79b3f1842cefbffb8ee3f9d64abcb2981ee3ff7ab00df6ddec03f85949f54fb74e73f185b087250c2a4bf07000000e7559dd5027bd63f7c629d2adf33723fe0da899290489bbfd1b01875adbdd7bfa3e8818fc18ad73f9161156f641eeabf807d74eaca67d7bfe50e9bc8cc05e93f1de38a8ba372e1bf94a46b26dfeced3f9413ed2aa4fcee3f07d3307c444cc33fe7559dd5027bd63f7c629d2adf33723f0400000053b0c6d97404eabfa2410a9e422ee3bfb98ac56f0a2bd6bfc860c5a9d6c2e8bfb2bb40498105e33fb685e7a56263d1bf53b0c6d97404eabfa2410a9e422ee3bf010300000001000000050000003df19c2d2034edbfd9b3e7323509cebf5b5b785e2ab6efbfa73b4f3c678be8bff46e2c280ccaca3f28b682a625d6eb3fd7338463963de3bfe78f696d1adbde3f3df19c2d2034edbfd9b3e7323509cebf0103000000020000000400000087c267ebe060e93f1c7bf65ca626e63fdd239babe6b9ebbf7d7901f6d1a9e4bf261de5603601e1bf501dab949e69eb3f87c267ebe060e93f1c7bf65ca626e63f08000000853e58c6866ec0bf4f5ab8acc266cebfc9c6832d76fbe03f40fa264d83a2cd3ff437a1100187ddbfc7f143a51133c53fae8218e8da17da3f13622ea9daeee43fa9dc442dcdadd63f5a4b0169ff03d23fb0c91af5108dc83ff1d93a38d81beabf2672c119fc7dec3f6153e751f17fdb3f853e58c6866ec0bf4f5ab8acc266cebf01030000000100000005000000b22b2d23f59ed83faed3484be5edce3f63d4b5f63e55d43f0c06d7dcd1ffcebff722da8ea9bbc23f40f67af7c77bd43ffd4cbd6e1118e3bf35eecd6f9868903fb22b2d23f59ed83faed3484be5edce3f01030000000300000004000000be4eeacbd24eea3f7bd80b056c07e8bf6325e659492be93f16da39cd02edaebf9e4143ff0417b7bf37a6272cf180d4bfbe4eeacbd24eea3f7bd80b056c07e8bf060000008fc4cbd3b9a2c03f69e21de0490bd5bf8b338639419be43f884cf910540de1bfd39ffd481119e0bfc634d3bd4eeaa3bf520e661360d8eb3f147b681f2b78eebf1211fe45d098dc3fdcf0bbe9969defbf8fc4cbd3b9a2c03f69e21de0490bd5bf0600000029e8f692c6e8e03f909e2287889bbbbf111d0247020dc2bf16a1d80a9a96dfbf21ca17b49080a9bf81ea1f443264e1bf0da9a27895b5dbbf793d98141f9fd33f9b70afcc5b75c93fc636a968ac7deb3f29e8f692c6e8e03f909e2287889bbbbf01030000000400000009000000ed815660c8eaa63f5da8fc6b7965eabf118c834bc79cd9bfec34d252793ba23faa49f086342ad63f3ca583f57f8eec3ffbae08feb712e6bf7cb60e0ef6a6edbf13ee9579abaee73fbf9cd9aed087e33ff1811dff0502e13fc713419c8713b0bf91d26c1e87c1d63f16a3aeb5f7a9c6bf5d8b16a06db5e3bf30f1475167eecbbfed815660c8eaa63f5da8fc6b7965eabf0800000000ab23473a83ed3f8f368e588bcfe83fb9e34d7e8b4ed73ff4346090f469a53f887fd8d2a3a9dc3f552fbfd36446e4bfe272bc02d113eb3f62f6b2edb435db3fb03a72a43330c83fcea96400a8e2c0bf0953944be317d13f4a0d6d003620ce3f16325706d586e93f512d228ac91bc23f00ab23473a83ed3f8f368e588bcfe83f050000000516c0948103bebf3fada23f3473e0bf20ed7f80b5eae93f34d8d47954fce53f37a8fdd64e94bc3f4d689258526ee3bf27d87f9d9b36edbfe9465854c469e7bf0516c0948103bebf3fada23f3473e0bf060000008f8b6a11514cd63f9f5be84a04aae1bf4d8578245e9ed73f656d533c2e2ae73f772e8cf4a276e03f548cf337a110c3bf3d450e1137a7d23f53793bc26941ef3fd619df1797aae83f04ae2b6684b7d4bf8f8b6a11514cd63f9f5be84a04aae1bf",
+		mustHexDecode("01060000000700000001030000000200000007000000D10836AE7F57E03F3AE8120EBDC593BF452E3883BF5FD83FBC934F8F6D99EB3F72193735D07CBE3FAE6708C72CFBE73F54E23AC61517D4BFFCFB8C0B07C2E9BF02BA2F67B6ABEFBF742497FF907EE1BFB972F6CE68ABE53FED0C535BEA20D8BFD10836AE7F57E03F3AE8120EBDC593BF06000000D7DAFB54159AEC3F00E5EFDE5163923FD046AE9B525ED4BFC6FA0626370AEBBFE55FCB2BD7DBC23F4792205C0185E1BF51F9D7F2CAF5D0BF95D40968226CCEBF1762F5471886E03FF46BEBA7FF2CE1BFD7DAFB54159AEC3F00E5EFDE5163923F010300000004000000090000003EAF78EA9106DF3F2AE620E86855A3BF9F909DB7B159E83F8E791D71C806D2BF799274CDE49BCDBFC98FF8156BB8E7BF60B1868BDCD3E13F257A19C5724BC9BFC5CBD3B9A294403FA31D37FC6EBAADBFD6A71C93C5FDD33F2E71E481C822D0BF9E978A8D799DEA3F0ABC934F8F6DC1BF3509DE904605D2BFA56950340F60C9BF3EAF78EA9106DF3F2AE620E86855A3BF0900000085949F54FB74E73F185B087250C2A4BFFD67CD8FBFB4DABF53211E8997A7BBBFA96917D34CF7D3BF211D1EC2F869E0BF50FBAD9D2809E4BFC100C287122DED3FF5F3A62215C656BF7EC9C6832DF6E8BF8B36C7B94DB8CDBFEFCB99ED0A7DCCBFFD12F1D6F9B79B3F1842CEFBFFB8EE3F9D64ABCB2981EE3FF7AB00DF6DDEC03F85949F54FB74E73F185B087250C2A4BF07000000E7559DD5027BD63F7C629D2ADF33723FE0DA899290489BBFD1B01875ADBDD7BFA3E8818FC18AD73F9161156F641EEABF807D74EACA67D7BFE50E9BC8CC05E93F1DE38A8BA372E1BF94A46B26DFECED3F9413ED2AA4FCEE3F07D3307C444CC33FE7559DD5027BD63F7C629D2ADF33723F0400000053B0C6D97404EABFA2410A9E422EE3BFB98AC56F0A2BD6BFC860C5A9D6C2E8BFB2BB40498105E33FB685E7A56263D1BF53B0C6D97404EABFA2410A9E422EE3BF010300000001000000050000003DF19C2D2034EDBFD9B3E7323509CEBF5B5B785E2AB6EFBFA73B4F3C678BE8BFF46E2C280CCACA3F28B682A625D6EB3FD7338463963DE3BFE78F696D1ADBDE3F3DF19C2D2034EDBFD9B3E7323509CEBF0103000000020000000400000087C267EBE060E93F1C7BF65CA626E63FDD239BABE6B9EBBF7D7901F6D1A9E4BF261DE5603601E1BF501DAB949E69EB3F87C267EBE060E93F1C7BF65CA626E63F08000000853E58C6866EC0BF4F5AB8ACC266CEBFC9C6832D76FBE03F40FA264D83A2CD3FF437A1100187DDBFC7F143A51133C53FAE8218E8DA17DA3F13622EA9DAEEE43FA9DC442DCDADD63F5A4B0169FF03D23FB0C91AF5108DC83FF1D93A38D81BEABF2672C119FC7DEC3F6153E751F17FDB3F853E58C6866EC0BF4F5AB8ACC266CEBF01030000000100000005000000B22B2D23F59ED83FAED3484BE5EDCE3F63D4B5F63E55D43F0C06D7DCD1FFCEBFF722DA8EA9BBC23F40F67AF7C77BD43FFD4CBD6E1118E3BF35EECD6F9868903FB22B2D23F59ED83FAED3484BE5EDCE3F01030000000300000004000000BE4EEACBD24EEA3F7BD80B056C07E8BF6325E659492BE93F16DA39CD02EDAEBF9E4143FF0417B7BF37A6272CF180D4BFBE4EEACBD24EEA3F7BD80B056C07E8BF060000008FC4CBD3B9A2C03F69E21DE0490BD5BF8B338639419BE43F884CF910540DE1BFD39FFD481119E0BFC634D3BD4EEAA3BF520E661360D8EB3F147B681F2B78EEBF1211FE45D098DC3FDCF0BBE9969DEFBF8FC4CBD3B9A2C03F69E21DE0490BD5BF0600000029E8F692C6E8E03F909E2287889BBBBF111D0247020DC2BF16A1D80A9A96DFBF21CA17B49080A9BF81EA1F443264E1BF0DA9A27895B5DBBF793D98141F9FD33F9B70AFCC5B75C93FC636A968AC7DEB3F29E8F692C6E8E03F909E2287889BBBBF01030000000400000009000000ED815660C8EAA63F5DA8FC6B7965EABF118C834BC79CD9BFEC34D252793BA23FAA49F086342AD63F3CA583F57F8EEC3FFBAE08FEB712E6BF7CB60E0EF6A6EDBF13EE9579ABAEE73FBF9CD9AED087E33FF1811DFF0502E13FC713419C8713B0BF91D26C1E87C1D63F16A3AEB5F7A9C6BF5D8B16A06DB5E3BF30F1475167EECBBFED815660C8EAA63F5DA8FC6B7965EABF0800000000AB23473A83ED3F8F368E588BCFE83FB9E34D7E8B4ED73FF4346090F469A53F887FD8D2A3A9DC3F552FBFD36446E4BFE272BC02D113EB3F62F6B2EDB435DB3FB03A72A43330C83FCEA96400A8E2C0BF0953944BE317D13F4A0D6D003620CE3F16325706D586E93F512D228AC91BC23F00AB23473A83ED3F8F368E588BCFE83F050000000516C0948103BEBF3FADA23F3473E0BF20ED7F80B5EAE93F34D8D47954FCE53F37A8FDD64E94BC3F4D689258526EE3BF27D87F9D9B36EDBFE9465854C469E7BF0516C0948103BEBF3FADA23F3473E0BF060000008F8B6A11514CD63F9F5BE84A04AAE1BF4D8578245E9ED73F656D533C2E2AE73F772E8CF4A276E03F548CF337A110C3BF3D450E1137A7D23F53793BC26941EF3FD619DF1797AAE83F04AE2B6684B7D4BF8F8B6A11514CD63F9F5BE84A04AAE1BF"),
+		"MULTIPOLYGON (((0.5106810000000001 -0.019309, 0.380844 0.862479, 0.119092 0.749411, -0.313909 -0.804935, -0.989711 -0.5467, 0.6771740000000001 -0.377009, 0.5106810000000001 -0.019309), (0.893809 0.017957, -0.318257 -0.844997, 0.147334 -0.547486, -0.265002 -0.237675, 0.516369 -0.536743, 0.893809 0.017957)), ((0.484776 -0.037761, 0.760949 -0.281664, -0.23132 -0.741262, 0.5571120000000001 -0.197615, 0.000506 -0.058063, 0.312364 -0.252123, 0.831723 -0.136156, -0.281572 -0.198244, 0.484776 -0.037761), (0.73303 -0.040545, -0.417282 -0.108026, -0.311969 -0.5129359999999999, -0.626118 0.911752, -0.00139 -0.780051, -0.232187 -0.222566, 0.027069 0.960083, 0.953267 0.131788, 0.73303 -0.040545), (0.351258 0.004444, -0.026644 -0.370952, 0.367844 -0.81621, -0.36571 0.781958, -0.545244 0.935165, 0.96834 0.150765, 0.351258 0.004444), (-0.813044 -0.599397, -0.346377 -0.773784, 0.594422 -0.271691, -0.813044 -0.599397)), ((-0.912613 -0.234656, -0.990987 -0.7670169999999999, 0.209291 0.869891, -0.601268 0.482123, -0.912613 -0.234656)), ((0.793076 0.692218, -0.866443 -0.64573, -0.531398 0.856643, 0.793076 0.692218), (-0.128373 -0.237511, 0.5306959999999999 0.231522, -0.461365 0.165621, 0.407706 0.654157, 0.354358 0.281494, 0.191805 -0.815899, 0.890379 0.429684, -0.128373 -0.237511)), ((0.384702 0.241635, 0.317703 -0.242182, 0.146352 0.320055, -0.596688 0.016024, 0.384702 0.241635)), ((0.822122 -0.750906, 0.786534 -0.060402, -0.090195 -0.32037, 0.822122 -0.750906), (0.129966 -0.328814, 0.643952 -0.532877, -0.50306 -0.038897, 0.870163 -0.952169, 0.446827 -0.9879869999999999, 0.129966 -0.328814), (0.528415 -0.107842, -0.141022 -0.493567, -0.049809 -0.543481, -0.432958 0.306587, 0.198894 0.859091, 0.528415 -0.107842)), ((0.04476 -0.824887, -0.400194 0.03561, 0.346326 0.892395, -0.689785 -0.926631, 0.740072 0.610329, 0.531497 -0.06279800000000001, 0.355562 -0.177062, -0.615897 -0.218213, 0.04476 -0.824887), (0.922269 0.775335, 0.364169 0.041824, 0.447854 -0.633593, 0.8461689999999999 0.425153, 0.188971 -0.131917, 0.267083 0.235358, 0.797709 0.141473, 0.922269 0.775335), (-0.117241 -0.514063, 0.809901 0.687052, 0.111638 -0.607217, -0.9129159999999999 -0.731661, -0.117241 -0.514063), (0.348408 -0.5520040000000001, 0.369041 0.723899, 0.514482 -0.148945, 0.291456 0.976735, 0.770824 -0.323701, 0.348408 -0.5520040000000001)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.114737, -0.286932}, {-0.123707, -0.122202}, {0.326466, 0.691992}, {0.114737, -0.286932}}, {{0.508309, 0.503286}, {0.907691, -0.211888}, {-0.072242, 0.081192}, {0.508309, 0.503286}}, {{0.408433, -0.957444}, {-0.585355, 0.70779}, {0.170948, 0.747817}, {-0.177202, -0.579065}, {-0.991719, 0.992102}, {-0.727237, 0.285938}, {-0.020582, -0.239701}, {0.074404, -0.843433}, {0.408433, -0.957444}}}, {{{-0.014526, -0.969421}, {-0.161313, 0.514404}, {-0.375833, 0.490045}, {0.534726, -0.521759}, {0.935945, -0.944223}, {0.727211, 0.025298}, {-0.693241, -0.483214}, {0.187035, -0.443086}, {-0.014526, -0.969421}}, {{-0.231878, 0.013626}, {-0.320454, 0.648286}, {-0.472236, -0.822046}, {-0.69043, 0.253891}, {-0.231878, 0.013626}}, {{-0.873404, 0.986099}, {-0.041119, -0.361126}, {0.458325, -0.951417}, {-0.131502, 0.328828}, {0.924273, 0.523276}, {0.770319, -0.762189}, {-0.873404, 0.986099}}, {{-0.93642, -0.456012}, {-0.231406, -0.312358}, {-0.252519, 0.60616}, {-0.620913, 0.648992}, {0.083842, -0.32251}, {-0.93642, -0.456012}}, {{-0.677154, -0.009091}, {-0.956094, 0.725951}, {-0.336838, -0.311914}, {0.990304, 0.226912}, {-0.164693, 0.581314}, {-0.864671, 0.141008}, {-0.677154, -0.009091}}}, {{{0.722457, 0.172401}, {-0.029455, 0.040452}, {0.563795, -0.305359}, {0.115579, 0.414781}, {0.991111, 0.387369}, {0.923743, -0.201935}, {0.722457, 0.172401}}, {{-0.303168, -0.46165}, {0.945667, -0.302932}, {0.999806, 0.704542}, {-0.567864, 0.656439}, {0.967255, -0.44636}, {0.328909, 0.539179}, {-0.833436, 0.638664}, {-0.303168, -0.46165}}, {{0.412764, 0.900277}, {-0.929782, 0.223426}, {-0.415191, -0.770683}, {0.42371, 0.958094}, {0.412764, 0.900277}}, {{-0.307312, -0.101821}, {-0.170764, 0.063804}, {-0.181648, -0.839255}, {0.958856, 0.993415}, {-0.651731, -0.51792}, {-0.126087, 0.397466}, {-0.307312, -0.101821}}}, {{{0.670995, 0.276867}, {-0.461413, 0.741735}, {0.322419, -0.366152}, {0.670995, 0.276867}}, {{-0.903135, 0.416924}, {0.698828, 0.384634}, {-0.719964, 0.194299}, {0.571911, -0.162808}, {0.164857, -0.493064}, {-0.374503, 0.617141}, {-0.021004, -0.102377}, {-0.754233, -0.251058}, {-0.903135, 0.416924}}, {{-0.537976, 0.615871}, {-0.232599, -0.523021}, {-0.383405, 0.648927}, {0.808287, 0.920596}, {-0.969612, 0.507789}, {0.050968, -0.75088}, {-0.537976, 0.615871}}}, {{{-0.436619, -0.191567}, {-0.058557, 0.873578}, {-0.88329, 0.418339}, {0.708213, -0.2854}, {-0.436619, -0.191567}}, {{-0.398322, -0.709404}, {0.103356, -0.499201}, {-0.945497, -0.534733}, {0.641265, -0.16526}, {-0.398322, -0.709404}}}, {{{0.887232, -0.513304}, {0.119945, 0.762134}, {0.162841, -0.664}, {-0.504094, 0.97525}, {-0.401226, 0.735406}, {0.590025, 0.48397}, {0.443885, 0.579964}, {0.694816, -0.875268}, {0.887232, -0.513304}}}}),
+		"010600000006000000010300000003000000040000008a22a46e675fbd3f7d410b09185dd2bf1f49490f43abbfbf967b8159a148bfbf416150a6d1e4d43f09ff2268cc24e63f8a22a46e675fbd3f7d410b09185dd2bf040000001b66683c1144e03fe71bd13deb1ae03f02f4fbfecd0bed3fbd19355f251fcbbf36cb65a3737eb2bf9563b2b8ffc8b43f1b66683c1144e03fe71bd13deb1ae03f09000000d7db662ac423da3faa0b789961a3eebf419ab1683abbe2bf67f2cd3637a6e63f9c86a8c29fe1c53f1492ccea1deee73fd594641d8eaec6bf8143a852b387e2bf624cfa7b29bcefbf687a89b14cbfef3feb1d6e878645e7bfe4c0abe5ce4cd23fe38a8ba3721395bf7ac4e8b985aecebfa60bb1fa230cb33fd7bfeb3367fdeabfd7db662ac423da3faa0b789961a3eebf01030000000500000009000000ea9788b7cebf8dbf9bc761307f05efbf07b7b585e7a5c4bf89cf9d60ff75e03ffe7bf0daa50dd8bf5b5f24b4e55cdf3fc8444ab3791ce13fe5982cee3fb2e0bfde59bbed42f3ed3f383124271337eebf9c53c9005045e73fb4e89d0ab8e7993ffce1e7bf072fe6bfbb09be69faecdebfc68a1a4cc3f0c73f6e30d461855bdcbfea9788b7cebf8dbf9bc761307f05efbf05000000bfb854a52daecdbf309b00c3f2e78b3f24d5777e5182d4bf8b8c0e48c2bee43f569bff571d39debffed7b969334eeabf47acc5a70018e6bf4aeb6f09c03fd03fbfb854a52daecdbf309b00c3f2e78b3f07000000394206f2ecf2ebbf25c9737d1f8eef3fee0a7db08c0da5bf170fef39b01cd7bfda1b7c613255dd3f46787b100272eebf58e6adba0ed5c0bfe6948098840bd53f056d72f8a493ed3fa703594fadbee03ffa980f0874a6e83fc6de8b2fda63e8bf394206f2ecf2ebbf25c9737d1f8eef3f0600000010406a1327f7edbfea58a5f44c2fddbf08910c39b69ecdbfc636a968acfdd3bf8997a7734529d0bf689604a8a965e33ff22895f084dee3bf42b3ebde8ac4e43fd2fe0758ab76b53f6b82a8fb00a4d4bf10406a1327f7edbfea58a5f44c2fddbf07000000f2608bdd3eabe5bf97e4805d4d9e82bfbedbbc715298eebfaef36f97fd3ae73ffd3383f8c08ed5bfe4874a2366f6d3bfaf22a30392b0ef3f30f488d1730bcd3f09a87004a914c5bf499f56d11f9ae23f7496598462abebbf17b83cd68c0cc23ff2608bdd3eabe5bf97e4805d4d9e82bf010300000004000000070000004d8578245e1ee73fe71a66683c11c63f7d91d09673299ebfea961de21fb6a43f0abfd4cf9b0ae23f4d83a279008bd3bfe9b81ad99596bd3fa723809bc58bda3f5f96766a2eb7ef3f87fd9e58a7cad83ff015dd7a4d8fed3f2979758e01d9c9bf4d8578245e1ee73fe71a66683c11c63f080000000a664cc11a67d3bf96b20c71ac8bddbf7e01bd70e742ee3f1c5c3ae63c63d3bfc4060b2769feef3fe01115aa9b8be63f7f6c921ff12be2bfca349a5c8c01e53f328ffcc1c0f3ee3f1a868f882991dcbf4a0b9755d80cd53f59147651f440e13fc9e369f981abeabfcd3d247cef6fe43f0a664cc11a67d3bf96b20c71ac8bddbf050000004ed53db2b96ada3f68e90ab611cfec3f651a4d2ec6c0edbf84bd89213999cc3f7efca5457d92dabf50a912656fa9e8bf033e3f8c101edb3f68cd8fbfb4a8ee3f4ed53db2b96ada3f68e90ab611cfec3f0700000047c66af3ffaad3bfb4c70be9f010babf3bc5aa4198dbc5bfb29e5a7d7555b03f9f20b1dd3d40c7bfb324404d2ddbeabf195932c7f2aeee3f0b630b410ecaef3f914259f8fadae4bfdf37bef6cc92e0bf3eb2b96a9e23c0bf6667d13b1570d93f47c66af3ffaad3bfb4c70be9f010babf01030000000300000004000000d4f19881ca78e53f4cdd955d30b8d13f7bc03c64ca87ddbfce8de9094bbce73f40fa264d83a2d43f075c57cc086fd7bfd4f19881ca78e53f4cdd955d30b8d13f090000004aef1b5f7be6ecbf63b83a00e2aeda3f4aeeb089cc5ce63f9d7fbbecd79dd83fd88349f1f109e7bfe09f5225cadec83fff1f274c184de23f3e7ac37de4d6c4bf4f22c2bf081ac53fb96fb54e5c8edfbfb33f506edbf7d7bfdfa9807b9ebfe33f793a5794128295bfd4d2dc0a6135babf070c923ead22e8bff88bd9925511d0bf4aef1b5f7be6ecbf63b83a00e2aeda3f070000007c0dc1711937e1bf0dc1711937b5e33ff0880ad5cdc5cdbf00e1438996bce0bf44dd0720b589d8bf7cb94f8e02c4e43fc005d9b27cdde93f62821abe8575ed3f93e4b9be0f07efbfea9788b7ce3fe03f6ef9484a7a18aa3f2c0e677e3507e8bf7c0dc1711937e1bf0dc1711937b5e33f010300000002000000050000007afb73d190f1dbbf1e15ff774485c8bfc45be7df2efbadbfae2990d959f4eb3f6b48dc63e943ecbfc80be9f010c6da3ffa43334faea9e63f4ed1915cfe43d2bf7afb73d190f1dbbf1e15ff774485c8bf050000007bbfd18e1b7ed9bfc7d9740470b3e6bf906ad8ef8975ba3f0c5a48c0e8f2dfbf64eaaeec8241eebf67f16261881ce1bfda38622d3e85e43f8121ab5b3d27c5bf7bbfd18e1b7ed9bfc7d9740470b3e6bf0103000000010000000900000098defe5c3464ec3fd2fd9c82fc6ce0bfa297512cb7b4be3fe36da5d76663e83ff855b950f9d7c43f736891ed7c3fe5bfae8383bd8921e0bf6891ed7c3f35ef3f6b8313d1afadd9bf9607e9297288e73f1a51da1b7ce1e23f0cb08f4e5df9de3f62d68ba19c68dc3f5d6f9ba9108fe23fe59997c3ee3be63fcf8767093202ecbf98defe5c3464ec3fd2fd9c82fc6ce0bf",
+		mustHexDecode("010600000006000000010300000003000000040000008A22A46E675FBD3F7D410B09185DD2BF1F49490F43ABBFBF967B8159A148BFBF416150A6D1E4D43F09FF2268CC24E63F8A22A46E675FBD3F7D410B09185DD2BF040000001B66683C1144E03FE71BD13DEB1AE03F02F4FBFECD0BED3FBD19355F251FCBBF36CB65A3737EB2BF9563B2B8FFC8B43F1B66683C1144E03FE71BD13DEB1AE03F09000000D7DB662AC423DA3FAA0B789961A3EEBF419AB1683ABBE2BF67F2CD3637A6E63F9C86A8C29FE1C53F1492CCEA1DEEE73FD594641D8EAEC6BF8143A852B387E2BF624CFA7B29BCEFBF687A89B14CBFEF3FEB1D6E878645E7BFE4C0ABE5CE4CD23FE38A8BA3721395BF7AC4E8B985AECEBFA60BB1FA230CB33FD7BFEB3367FDEABFD7DB662AC423DA3FAA0B789961A3EEBF01030000000500000009000000EA9788B7CEBF8DBF9BC761307F05EFBF07B7B585E7A5C4BF89CF9D60FF75E03FFE7BF0DAA50DD8BF5B5F24B4E55CDF3FC8444AB3791CE13FE5982CEE3FB2E0BFDE59BBED42F3ED3F383124271337EEBF9C53C9005045E73FB4E89D0AB8E7993FFCE1E7BF072FE6BFBB09BE69FAECDEBFC68A1A4CC3F0C73F6E30D461855BDCBFEA9788B7CEBF8DBF9BC761307F05EFBF05000000BFB854A52DAECDBF309B00C3F2E78B3F24D5777E5182D4BF8B8C0E48C2BEE43F569BFF571D39DEBFFED7B969334EEABF47ACC5A70018E6BF4AEB6F09C03FD03FBFB854A52DAECDBF309B00C3F2E78B3F07000000394206F2ECF2EBBF25C9737D1F8EEF3FEE0A7DB08C0DA5BF170FEF39B01CD7BFDA1B7C613255DD3F46787B100272EEBF58E6ADBA0ED5C0BFE6948098840BD53F056D72F8A493ED3FA703594FADBEE03FFA980F0874A6E83FC6DE8B2FDA63E8BF394206F2ECF2EBBF25C9737D1F8EEF3F0600000010406A1327F7EDBFEA58A5F44C2FDDBF08910C39B69ECDBFC636A968ACFDD3BF8997A7734529D0BF689604A8A965E33FF22895F084DEE3BF42B3EBDE8AC4E43FD2FE0758AB76B53F6B82A8FB00A4D4BF10406A1327F7EDBFEA58A5F44C2FDDBF07000000F2608BDD3EABE5BF97E4805D4D9E82BFBEDBBC715298EEBFAEF36F97FD3AE73FFD3383F8C08ED5BFE4874A2366F6D3BFAF22A30392B0EF3F30F488D1730BCD3F09A87004A914C5BF499F56D11F9AE23F7496598462ABEBBF17B83CD68C0CC23FF2608BDD3EABE5BF97E4805D4D9E82BF010300000004000000070000004D8578245E1EE73FE71A66683C11C63F7D91D09673299EBFEA961DE21FB6A43F0ABFD4CF9B0AE23F4D83A279008BD3BFE9B81AD99596BD3FA723809BC58BDA3F5F96766A2EB7EF3F87FD9E58A7CAD83FF015DD7A4D8FED3F2979758E01D9C9BF4D8578245E1EE73FE71A66683C11C63F080000000A664CC11A67D3BF96B20C71AC8BDDBF7E01BD70E742EE3F1C5C3AE63C63D3BFC4060B2769FEEF3FE01115AA9B8BE63F7F6C921FF12BE2BFCA349A5C8C01E53F328FFCC1C0F3EE3F1A868F882991DCBF4A0B9755D80CD53F59147651F440E13FC9E369F981ABEABFCD3D247CEF6FE43F0A664CC11A67D3BF96B20C71AC8BDDBF050000004ED53DB2B96ADA3F68E90AB611CFEC3F651A4D2EC6C0EDBF84BD89213999CC3F7EFCA5457D92DABF50A912656FA9E8BF033E3F8C101EDB3F68CD8FBFB4A8EE3F4ED53DB2B96ADA3F68E90AB611CFEC3F0700000047C66AF3FFAAD3BFB4C70BE9F010BABF3BC5AA4198DBC5BFB29E5A7D7555B03F9F20B1DD3D40C7BFB324404D2DDBEABF195932C7F2AEEE3F0B630B410ECAEF3F914259F8FADAE4BFDF37BEF6CC92E0BF3EB2B96A9E23C0BF6667D13B1570D93F47C66AF3FFAAD3BFB4C70BE9F010BABF01030000000300000004000000D4F19881CA78E53F4CDD955D30B8D13F7BC03C64CA87DDBFCE8DE9094BBCE73F40FA264D83A2D43F075C57CC086FD7BFD4F19881CA78E53F4CDD955D30B8D13F090000004AEF1B5F7BE6ECBF63B83A00E2AEDA3F4AEEB089CC5CE63F9D7FBBECD79DD83FD88349F1F109E7BFE09F5225CADEC83FFF1F274C184DE23F3E7AC37DE4D6C4BF4F22C2BF081AC53FB96FB54E5C8EDFBFB33F506EDBF7D7BFDFA9807B9EBFE33F793A5794128295BFD4D2DC0A6135BABF070C923EAD22E8BFF88BD9925511D0BF4AEF1B5F7BE6ECBF63B83A00E2AEDA3F070000007C0DC1711937E1BF0DC1711937B5E33FF0880AD5CDC5CDBF00E1438996BCE0BF44DD0720B589D8BF7CB94F8E02C4E43FC005D9B27CDDE93F62821ABE8575ED3F93E4B9BE0F07EFBFEA9788B7CE3FE03F6EF9484A7A18AA3F2C0E677E3507E8BF7C0DC1711937E1BF0DC1711937B5E33F010300000002000000050000007AFB73D190F1DBBF1E15FF774485C8BFC45BE7DF2EFBADBFAE2990D959F4EB3F6B48DC63E943ECBFC80BE9F010C6DA3FFA43334FAEA9E63F4ED1915CFE43D2BF7AFB73D190F1DBBF1E15FF774485C8BF050000007BBFD18E1B7ED9BFC7D9740470B3E6BF906AD8EF8975BA3F0C5A48C0E8F2DFBF64EAAEEC8241EEBF67F16261881CE1BFDA38622D3E85E43F8121AB5B3D27C5BF7BBFD18E1B7ED9BFC7D9740470B3E6BF0103000000010000000900000098DEFE5C3464EC3FD2FD9C82FC6CE0BFA297512CB7B4BE3FE36DA5D76663E83FF855B950F9D7C43F736891ED7C3FE5BFAE8383BD8921E0BF6891ED7C3F35EF3F6B8313D1AFADD9BF9607E9297288E73F1A51DA1B7CE1E23F0CB08F4E5DF9DE3F62D68BA19C68DC3F5D6F9BA9108FE23FE59997C3EE3BE63FCF8767093202ECBF98DEFE5C3464EC3FD2FD9C82FC6CE0BF"),
+		"MULTIPOLYGON (((0.114737 -0.286932, -0.123707 -0.122202, 0.326466 0.6919920000000001, 0.114737 -0.286932), (0.508309 0.503286, 0.907691 -0.211888, -0.072242 0.081192, 0.508309 0.503286), (0.408433 -0.957444, -0.585355 0.70779, 0.170948 0.747817, -0.177202 -0.5790650000000001, -0.991719 0.992102, -0.727237 0.285938, -0.020582 -0.239701, 0.074404 -0.843433, 0.408433 -0.957444)), ((-0.014526 -0.969421, -0.161313 0.514404, -0.375833 0.490045, 0.534726 -0.521759, 0.935945 -0.944223, 0.7272110000000001 0.025298, -0.693241 -0.483214, 0.187035 -0.443086, -0.014526 -0.969421), (-0.231878 0.013626, -0.320454 0.648286, -0.472236 -0.8220460000000001, -0.69043 0.253891, -0.231878 0.013626), (-0.873404 0.9860989999999999, -0.041119 -0.361126, 0.458325 -0.951417, -0.131502 0.328828, 0.924273 0.523276, 0.770319 -0.762189, -0.873404 0.9860989999999999), (-0.93642 -0.456012, -0.231406 -0.312358, -0.252519 0.60616, -0.620913 0.648992, 0.083842 -0.32251, -0.93642 -0.456012), (-0.677154 -0.009091, -0.956094 0.725951, -0.336838 -0.311914, 0.990304 0.226912, -0.164693 0.581314, -0.864671 0.141008, -0.677154 -0.009091)), ((0.722457 0.172401, -0.029455 0.040452, 0.563795 -0.305359, 0.115579 0.414781, 0.991111 0.387369, 0.923743 -0.201935, 0.722457 0.172401), (-0.303168 -0.46165, 0.945667 -0.302932, 0.999806 0.704542, -0.567864 0.656439, 0.967255 -0.44636, 0.328909 0.539179, -0.833436 0.638664, -0.303168 -0.46165), (0.412764 0.900277, -0.929782 0.223426, -0.415191 -0.770683, 0.42371 0.958094, 0.412764 0.900277), (-0.307312 -0.101821, -0.170764 0.063804, -0.181648 -0.839255, 0.958856 0.993415, -0.6517309999999999 -0.51792, -0.126087 0.397466, -0.307312 -0.101821)), ((0.670995 0.276867, -0.461413 0.741735, 0.322419 -0.366152, 0.670995 0.276867), (-0.903135 0.416924, 0.698828 0.384634, -0.719964 0.194299, 0.5719109999999999 -0.162808, 0.164857 -0.493064, -0.374503 0.6171410000000001, -0.021004 -0.102377, -0.754233 -0.251058, -0.903135 0.416924), (-0.537976 0.6158709999999999, -0.232599 -0.523021, -0.383405 0.648927, 0.808287 0.920596, -0.969612 0.507789, 0.050968 -0.75088, -0.537976 0.6158709999999999)), ((-0.436619 -0.191567, -0.058557 0.873578, -0.88329 0.418339, 0.708213 -0.2854, -0.436619 -0.191567), (-0.398322 -0.709404, 0.103356 -0.499201, -0.945497 -0.534733, 0.641265 -0.16526, -0.398322 -0.709404)), ((0.887232 -0.513304, 0.119945 0.762134, 0.162841 -0.664, -0.504094 0.97525, -0.401226 0.735406, 0.590025 0.48397, 0.443885 0.579964, 0.694816 -0.875268, 0.887232 -0.513304)))",
+	},
+	{
+		geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.066436, -0.013635}, {-0.746457, -0.828078}, {-0.976695, 0.650073}, {-0.836517, 0.923131}, {0.066436, -0.013635}}, {{-0.099233, -0.448423}, {-0.175095, -0.309414}, {-0.20741, 0.452392}, {0.785053, -0.68457}, {-0.514659, -0.580206}, {-0.909308, 0.708402}, {0.022551, -0.865931}, {-0.099233, -0.448423}}, {{-0.098778, 0.555912}, {0.522795, -0.731022}, {0.253751, 0.019373}, {-0.973017, -0.704529}, {0.333697, -0.265949}, {-0.098778, 0.555912}}, {{0.003501, 0.376567}, {-0.732765, -0.041103}, {0.468243, 0.666964}, {-0.600785, -0.206187}, {-0.052946, -0.119255}, {-0.049117, -0.4082}, {0.617442, 0.826156}, {-0.301999, 0.27572}, {0.003501, 0.376567}}, {{0.157504, 0.391078}, {0.003033, 0.349164}, {0.514292, 0.686592}, {-0.622381, -0.56723}, {0.028743, 0.019314}, {0.157504, 0.391078}}}, {{{0.034767, 0.800105}, {0.555206, 0.012632}, {0.652653, -0.048288}, {-0.31657, -0.133153}, {-0.087584, 0.301064}, {-0.895686, 0.459018}, {0.936466, -0.082365}, {0.034767, 0.800105}}}, {{{-0.793572, -0.487293}, {0.587815, -0.997902}, {0.747159, 0.879095}, {-0.629994, -0.652827}, {-0.793572, -0.487293}}, {{0.623553, -0.981979}, {0.981584, -0.96702}, {0.215141, 0.856901}, {0.662522, -0.379195}, {0.644161, -0.213905}, {0.623553, -0.981979}}, {{-0.273444, -0.290564}, {0.164151, 0.564136}, {0.398982, 0.536156}, {-0.971454, 0.063387}, {-0.294423, -0.582771}, {-0.273444, -0.290564}}, {{-0.606384, -0.63105}, {-0.642372, 0.31619}, {0.22348, 0.011267}, {0.173604, 0.881153}, {0.722421, 0.811869}, {-0.891685, 0.794826}, {-0.937299, 0.295286}, {0.861665, 0.004927}, {-0.606384, -0.63105}}, {{-0.336514, 0.832248}, {0.851939, 0.238251}, {0.428858, -0.321746}, {-0.723651, 0.958002}, {0.314044, -0.451225}, {-0.336514, 0.832248}}}, {{{0.21794, -0.33883}, {0.791626, -0.8442}, {0.608308, -0.680842}, {-0.784652, -0.482112}, {0.429651, 0.216017}, {-0.157443, -0.681908}, {0.847504, 0.532571}, {0.372533, 0.625818}, {0.21794, -0.33883}}, {{0.546709, 0.677451}, {0.493512, -0.035445}, {0.372891, -0.799985}, {0.546709, 0.677451}}, {{-0.475545, 0.570253}, {0.270568, 0.018157}, {0.072034, -0.850539}, {-0.918205, -0.970351}, {0.551085, -0.72301}, {-0.754267, -0.229875}, {0.955406, 0.771849}, {-0.475545, 0.570253}}, {{0.639597, -0.829845}, {-0.215919, 0.158412}, {0.972494, -0.902587}, {-0.175158, 0.839221}, {0.639597, -0.829845}}}, {{{0.198165, -0.201213}, {0.120537, 0.406699}, {-0.186609, 0.784105}, {0.198165, -0.201213}}, {{-0.890443, 0.673659}, {0.756745, -0.70919}, {0.882859, -0.745773}, {-0.585372, 0.911094}, {0.661567, 0.153102}, {-0.424384, -0.494569}, {-0.193723, -0.982013}, {0.272719, -0.8969}, {-0.890443, 0.673659}}, {{-0.859673, -0.979492}, {-0.430686, 0.545384}, {0.665274, 0.014606}, {0.876595, -0.770966}, {-0.335561, 0.480691}, {-0.35336, -0.709279}, {0.156722, -0.874852}, {-0.859673, -0.979492}}, {{-0.492263, -0.336375}, {-0.029636, 0.071405}, {-0.831286, -0.368944}, {-0.232607, -0.193391}, {-0.039929, -0.148256}, {-0.492263, -0.336375}}, {{-0.561351, 0.288572}, {0.657528, 0.022881}, {-0.703621, -0.859066}, {-0.561351, 0.288572}}}}),
+		"0106000000050000000103000000050000000500000024ed461ff301b13f5bcea5b8aaec8bbf45d95bcaf9e2e7bfd32f116f9d7feabfc55565df1541efbf496760e465cde43f7a89b14cbfc4eabf938fdd054a8aed3f24ed461ff301b13f5bcea5b8aaec8bbf080000008718af795567b9bfa08cf161f6b2dcbf28b858518369c6bfbb2bbb6070cdd3bf95826e2f698ccabfc6353e93fdf3dc3fd40d1478271fe93fb9533a58ffe7e5bf30f2b2261678e0bfdc2c5e2c0c91e2bfa6b73f170d19edbfc478cdab3aabe63f04e8f7fd9b17973f62f6b2edb4b5ebbf8718af795567b9bfa08cf161f6b2dcbf060000002e7590d78349b9bfc6866ef607cae13fed647094bcbae03f2502d53f8864e7bf6bf294d5743dd03f289eb30584d6933f0c772e8cf422efbfb8acc266808be6bff27d71a94a5bd53f90d959f44e05d1bf2e7590d78349b9bfc6866ef607cae13f0900000037001b1021ae6c3f662e7079ac19d83ffbe8d495cf72e7bf30f488d1730ba5bfed2de57cb1f7dd3fbf7ff3e2c457e53fefacdd76a139e3bf0420eeea5564cabfc974e8f4bc1babbf486de2e47e87bebf95f25a09dd25a9bf728a8ee4f21fdabf9ca8a5b915c2e33f179d2cb5de6fea3f5a2e1b9df353d3bf8599b67f65a5d13f37001b1021ae6c3f662e7079ac19d83f06000000959d7e501729c43f7bd80b056c07d93f6e6de179a9d8683fdf313cf6b358d63f9605137f1475e03f573f36c98ff8e53f59db148f8beae3bf2dec6987bf26e2bf8ba9f413ce6e9d3f71766b990cc7933f959d7e501729c43f7bd80b056c07d93f01030000000100000008000000c1c6f5effacca13fedb60bcd759ae93f0f60915f3fc4e13f0e881057cede893f795c548b88e2e43f5018946934b9a8bf184339d1ae42d4bffda02e52280bc1bf1822a7afe76bb6bf3c3080f0a144d33f9485afaf75a9ecbff99e91088d60dd3f261c7a8b87f7ed3f2ec55565df15b5bfc1c6f5effacca13fedb60bcd759ae93f0103000000050000000500000097ae601bf164e9bf6c76a4face2fdfbfc824236761cfe23fa19dd32cd0eeefbfa12fbdfdb9e8e73fc47762d68b21ec3fa1a3552de928e4bfee43de72f5e3e4bf97ae601bf164e9bf6c76a4face2fdfbf060000007653ca6b25f4e33f297b4b395f6cefbf12dc48d92269ef3f527e52edd3f1eebf9fad8383bd89cb3f3b1c5da5bb6beb3f282d5c566133e53f4da1f31abb44d8bf1c7a8b87f79ce43f70b6b9313d61cbbf7653ca6b25f4e33f297b4b395f6cefbf06000000286552431b80d1bfc34659bf9998d2bf72874d64e602c53f54e1cff0660de23fbd546ccceb88d93fcfbbb1a03028e13f3430f2b22616efbf5ad6fd63213ab03f5e2c0c91d3d7d2bfb7d4415e0fa6e2bf286552431b80d1bfc34659bf9998d2bf090000004e2a1a6b7f67e3bf6f8104c58f31e4bf31b77bb94f8ee4bf959f54fb743cd43fb4b0a71dfe9acc3fb03731242713873f5d363ae7a738c63f95b6b8c66732ec3f1a321ea5121ee73ff25b74b2d4fae93f29ae2afbae88ecbffc5580ef366fe93f255af2785afeedbfb1dd3d40f7e5d23f6d73637ac292eb3f6fd6e07d552e743f4e2a1a6b7f67e3bf6f8104c58f31e4bf060000006c5a29047289d5bf412ac58ec6a1ea3f72fbe5931543eb3f40a19e3e027fce3fa13028d36872db3fae9ae7887c97d4bf5ef756242628e7bf3c1570cff3a7ee3f505260014c19d43f70ce88d2dee0dcbf6c5a29047289d5bf412ac58ec6a1ea3f010300000004000000090000002fc03e3a75e5cb3fe5d5390664afd5bfb939950c0055e93ff90fe9b7af03ebbf37a5bc564277e33f8ec8772975c9e5bf35b6d782de1be9bff295404aecdadebf84656ce8667fdb3fe3a430ef71a6cb3fe8f7fd9b1727c4bf402fdcb930d2e5bf7a5567b5c01eeb3f59897956d20ae13fb491eba694d7d73faaf06778b306e43f2fc03e3a75e5cb3fe5d5390664afd5bf0400000041b96ddfa37ee13f548f34b8adade53f51bf0b5bb395df3f670a9dd7d825a2bf4e417e3672ddd73f444c89247a99e9bf41b96ddfa37ee13f548f34b8adade53f080000003aafb14b546fdebfb7442e38833fe23f3106d671fc50d13f861dc6a4bf97923f653733fad170b23f151f9f909d37ebbffdc1c073ef61edbf3882548a1d0defbffc1d8a027da2e13f6dca15dee522e7bf0c772e8cf422e8bf068195438b6ccdbfa0de8c9aaf92ee3fe4688eacfcb2e83f3aafb14b546fdebfb7442e38833fe23f05000000aed4b3209477e43fcbf8f719178eeabf11e2cad93ba3cbbf38a0a52bd846c43f96ccb1bcab1eef3f9c6ed921fee1ecbf8bfed0cc936bc6bfaeb9a3ffe5daea3faed4b3209477e43fcbf8f719178eeabf01030000000500000004000000931d1b81785dc93f9dd843fb58c1c9bf583cf54883dbbe3fc537143e5b07da3f67d311c0cde2c7bf9e29745e6317e93f931d1b81785dc93f9dd843fb58c1c9bf09000000d5777e51827eecbf7afeb4519d8ee53f2c2b4d4a4137e83fc4ce143aafb1e6bf21567f846140ec3f82aca7565fdde7bfc4cf7f0f5ebbe2bf4d9eb29aae27ed3f529ca38e8e2be53f6de179a9d898c33fc2853c821b29dbbf1b6327bc04a7dfbf35d3bd4eeacbc8bf2ee6e786a66cefbf59dc7f643a74d13f6c09f9a067b3ecbfd5777e51827eecbf7afeb4519d8ee53f08000000792288f37082ebbf3c32569bff57efbf661536035c90dbbf8d5f7825c973e13f9f211cb3ec49e53fbb5e9a22c0e98d3fb0c91af5100dec3f747e8ae3c0abe8bf1c0934d8d479d5bf7cd11e2fa4c3de3f5abbed42739dd6bfacaa97df69b2e6bfb727486c770fc43fdae21a9fc9feebbf792288f37082ebbf3c32569bff57efbf060000006af981ab3c81dfbfba490c022b87d5bf0114234be6589ebf6458c51b9947b23fccecf318e599eabf118c834bc79cd7bfc80be9f010c6cdbf3d9d2b4a09c1c8bf91ef52ea9271a4bf82c7b7770dfac2bf6af981ab3c81dfbfba490c022b87d5bf04000000ef75525f96f6e1bfdba4a2b1f677d23f87890629780ae53f1492ccea1d6e973f10ecf82f1084e6bf8d60e3fa777debbfef75525f96f6e1bfdba4a2b1f677d23f",
+		mustHexDecode("0106000000050000000103000000050000000500000024ED461FF301B13F5BCEA5B8AAEC8BBF45D95BCAF9E2E7BFD32F116F9D7FEABFC55565DF1541EFBF496760E465CDE43F7A89B14CBFC4EABF938FDD054A8AED3F24ED461FF301B13F5BCEA5B8AAEC8BBF080000008718AF795567B9BFA08CF161F6B2DCBF28B858518369C6BFBB2BBB6070CDD3BF95826E2F698CCABFC6353E93FDF3DC3FD40D1478271FE93FB9533A58FFE7E5BF30F2B2261678E0BFDC2C5E2C0C91E2BFA6B73F170D19EDBFC478CDAB3AABE63F04E8F7FD9B17973F62F6B2EDB4B5EBBF8718AF795567B9BFA08CF161F6B2DCBF060000002E7590D78349B9BFC6866EF607CAE13FED647094BCBAE03F2502D53F8864E7BF6BF294D5743DD03F289EB30584D6933F0C772E8CF422EFBFB8ACC266808BE6BFF27D71A94A5BD53F90D959F44E05D1BF2E7590D78349B9BFC6866EF607CAE13F0900000037001B1021AE6C3F662E7079AC19D83FFBE8D495CF72E7BF30F488D1730BA5BFED2DE57CB1F7DD3FBF7FF3E2C457E53FEFACDD76A139E3BF0420EEEA5564CABFC974E8F4BC1BABBF486DE2E47E87BEBF95F25A09DD25A9BF728A8EE4F21FDABF9CA8A5B915C2E33F179D2CB5DE6FEA3F5A2E1B9DF353D3BF8599B67F65A5D13F37001B1021AE6C3F662E7079AC19D83F06000000959D7E501729C43F7BD80B056C07D93F6E6DE179A9D8683FDF313CF6B358D63F9605137F1475E03F573F36C98FF8E53F59DB148F8BEAE3BF2DEC6987BF26E2BF8BA9F413CE6E9D3F71766B990CC7933F959D7E501729C43F7BD80B056C07D93F01030000000100000008000000C1C6F5EFFACCA13FEDB60BCD759AE93F0F60915F3FC4E13F0E881057CEDE893F795C548B88E2E43F5018946934B9A8BF184339D1AE42D4BFFDA02E52280BC1BF1822A7AFE76BB6BF3C3080F0A144D33F9485AFAF75A9ECBFF99E91088D60DD3F261C7A8B87F7ED3F2EC55565DF15B5BFC1C6F5EFFACCA13FEDB60BCD759AE93F0103000000050000000500000097AE601BF164E9BF6C76A4FACE2FDFBFC824236761CFE23FA19DD32CD0EEEFBFA12FBDFDB9E8E73FC47762D68B21EC3FA1A3552DE928E4BFEE43DE72F5E3E4BF97AE601BF164E9BF6C76A4FACE2FDFBF060000007653CA6B25F4E33F297B4B395F6CEFBF12DC48D92269EF3F527E52EDD3F1EEBF9FAD8383BD89CB3F3B1C5DA5BB6BEB3F282D5C566133E53F4DA1F31ABB44D8BF1C7A8B87F79CE43F70B6B9313D61CBBF7653CA6B25F4E33F297B4B395F6CEFBF06000000286552431B80D1BFC34659BF9998D2BF72874D64E602C53F54E1CFF0660DE23FBD546CCCEB88D93FCFBBB1A03028E13F3430F2B22616EFBF5AD6FD63213AB03F5E2C0C91D3D7D2BFB7D4415E0FA6E2BF286552431B80D1BFC34659BF9998D2BF090000004E2A1A6B7F67E3BF6F8104C58F31E4BF31B77BB94F8EE4BF959F54FB743CD43FB4B0A71DFE9ACC3FB03731242713873F5D363AE7A738C63F95B6B8C66732EC3F1A321EA5121EE73FF25B74B2D4FAE93F29AE2AFBAE88ECBFFC5580EF366FE93F255AF2785AFEEDBFB1DD3D40F7E5D23F6D73637AC292EB3F6FD6E07D552E743F4E2A1A6B7F67E3BF6F8104C58F31E4BF060000006C5A29047289D5BF412AC58EC6A1EA3F72FBE5931543EB3F40A19E3E027FCE3FA13028D36872DB3FAE9AE7887C97D4BF5EF756242628E7BF3C1570CFF3A7EE3F505260014C19D43F70CE88D2DEE0DCBF6C5A29047289D5BF412AC58EC6A1EA3F010300000004000000090000002FC03E3A75E5CB3FE5D5390664AFD5BFB939950C0055E93FF90FE9B7AF03EBBF37A5BC564277E33F8EC8772975C9E5BF35B6D782DE1BE9BFF295404AECDADEBF84656CE8667FDB3FE3A430EF71A6CB3FE8F7FD9B1727C4BF402FDCB930D2E5BF7A5567B5C01EEB3F59897956D20AE13FB491EBA694D7D73FAAF06778B306E43F2FC03E3A75E5CB3FE5D5390664AFD5BF0400000041B96DDFA37EE13F548F34B8ADADE53F51BF0B5BB395DF3F670A9DD7D825A2BF4E417E3672DDD73F444C89247A99E9BF41B96DDFA37EE13F548F34B8ADADE53F080000003AAFB14B546FDEBFB7442E38833FE23F3106D671FC50D13F861DC6A4BF97923F653733FAD170B23F151F9F909D37EBBFFDC1C073EF61EDBF3882548A1D0DEFBFFC1D8A027DA2E13F6DCA15DEE522E7BF0C772E8CF422E8BF068195438B6CCDBFA0DE8C9AAF92EE3FE4688EACFCB2E83F3AAFB14B546FDEBFB7442E38833FE23F05000000AED4B3209477E43FCBF8F719178EEABF11E2CAD93BA3CBBF38A0A52BD846C43F96CCB1BCAB1EEF3F9C6ED921FEE1ECBF8BFED0CC936BC6BFAEB9A3FFE5DAEA3FAED4B3209477E43FCBF8F719178EEABF01030000000500000004000000931D1B81785DC93F9DD843FB58C1C9BF583CF54883DBBE3FC537143E5B07DA3F67D311C0CDE2C7BF9E29745E6317E93F931D1B81785DC93F9DD843FB58C1C9BF09000000D5777E51827EECBF7AFEB4519D8EE53F2C2B4D4A4137E83FC4CE143AAFB1E6BF21567F846140EC3F82ACA7565FDDE7BFC4CF7F0F5EBBE2BF4D9EB29AAE27ED3F529CA38E8E2BE53F6DE179A9D898C33FC2853C821B29DBBF1B6327BC04A7DFBF35D3BD4EEACBC8BF2EE6E786A66CEFBF59DC7F643A74D13F6C09F9A067B3ECBFD5777E51827EECBF7AFEB4519D8EE53F08000000792288F37082EBBF3C32569BFF57EFBF661536035C90DBBF8D5F7825C973E13F9F211CB3EC49E53FBB5E9A22C0E98D3FB0C91AF5100DEC3F747E8AE3C0ABE8BF1C0934D8D479D5BF7CD11E2FA4C3DE3F5ABBED42739DD6BFACAA97DF69B2E6BFB727486C770FC43FDAE21A9FC9FEEBBF792288F37082EBBF3C32569BFF57EFBF060000006AF981AB3C81DFBFBA490C022B87D5BF0114234BE6589EBF6458C51B9947B23FCCECF318E599EABF118C834BC79CD7BFC80BE9F010C6CDBF3D9D2B4A09C1C8BF91EF52EA9271A4BF82C7B7770DFAC2BF6AF981AB3C81DFBFBA490C022B87D5BF04000000EF75525F96F6E1BFDBA4A2B1F677D23F87890629780AE53F1492CCEA1D6E973F10ECF82F1084E6BF8D60E3FA777DEBBFEF75525F96F6E1BFDBA4A2B1F677D23F"),
+		"MULTIPOLYGON (((0.066436 -0.013635, -0.746457 -0.828078, -0.976695 0.650073, -0.836517 0.923131, 0.066436 -0.013635), (-0.099233 -0.448423, -0.175095 -0.309414, -0.20741 0.452392, 0.785053 -0.68457, -0.514659 -0.580206, -0.909308 0.708402, 0.022551 -0.865931, -0.099233 -0.448423), (-0.098778 0.555912, 0.522795 -0.7310219999999999, 0.253751 0.019373, -0.973017 -0.704529, 0.333697 -0.265949, -0.098778 0.555912), (0.003501 0.376567, -0.732765 -0.041103, 0.468243 0.666964, -0.600785 -0.206187, -0.052946 -0.119255, -0.049117 -0.4082, 0.617442 0.826156, -0.301999 0.27572, 0.003501 0.376567), (0.157504 0.391078, 0.003033 0.349164, 0.514292 0.686592, -0.622381 -0.56723, 0.028743 0.019314, 0.157504 0.391078)), ((0.034767 0.800105, 0.555206 0.012632, 0.652653 -0.048288, -0.31657 -0.133153, -0.087584 0.301064, -0.895686 0.459018, 0.936466 -0.08236499999999999, 0.034767 0.800105)), ((-0.7935720000000001 -0.487293, 0.587815 -0.997902, 0.747159 0.879095, -0.6299940000000001 -0.652827, -0.7935720000000001 -0.487293), (0.623553 -0.981979, 0.981584 -0.96702, 0.215141 0.856901, 0.6625220000000001 -0.379195, 0.644161 -0.213905, 0.623553 -0.981979), (-0.273444 -0.290564, 0.164151 0.564136, 0.398982 0.536156, -0.971454 0.063387, -0.294423 -0.582771, -0.273444 -0.290564), (-0.606384 -0.63105, -0.6423720000000001 0.31619, 0.22348 0.011267, 0.173604 0.881153, 0.722421 0.811869, -0.8916849999999999 0.794826, -0.937299 0.295286, 0.861665 0.004927, -0.606384 -0.63105), (-0.336514 0.832248, 0.851939 0.238251, 0.428858 -0.321746, -0.723651 0.958002, 0.314044 -0.451225, -0.336514 0.832248)), ((0.21794 -0.33883, 0.7916260000000001 -0.8442, 0.608308 -0.6808419999999999, -0.784652 -0.482112, 0.429651 0.216017, -0.157443 -0.681908, 0.847504 0.532571, 0.372533 0.625818, 0.21794 -0.33883), (0.546709 0.677451, 0.493512 -0.035445, 0.372891 -0.7999849999999999, 0.546709 0.677451), (-0.475545 0.570253, 0.270568 0.018157, 0.072034 -0.850539, -0.918205 -0.970351, 0.551085 -0.72301, -0.754267 -0.229875, 0.955406 0.771849, -0.475545 0.570253), (0.639597 -0.8298450000000001, -0.215919 0.158412, 0.972494 -0.902587, -0.175158 0.839221, 0.639597 -0.8298450000000001)), ((0.198165 -0.201213, 0.120537 0.406699, -0.186609 0.7841050000000001, 0.198165 -0.201213), (-0.890443 0.673659, 0.756745 -0.70919, 0.8828589999999999 -0.745773, -0.585372 0.911094, 0.661567 0.153102, -0.424384 -0.494569, -0.193723 -0.982013, 0.272719 -0.8969, -0.890443 0.673659), (-0.859673 -0.979492, -0.430686 0.545384, 0.665274 0.014606, 0.876595 -0.770966, -0.335561 0.480691, -0.35336 -0.709279, 0.156722 -0.874852, -0.859673 -0.979492), (-0.492263 -0.336375, -0.029636 0.071405, -0.831286 -0.368944, -0.232607 -0.193391, -0.039929 -0.148256, -0.492263 -0.336375), (-0.561351 0.288572, 0.657528 0.022881, -0.7036210000000001 -0.859066, -0.561351 0.288572)))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.328515, 0.048719}, {0.130581, -0.295533}, {0.331194, 0.453721}, {-0.195749, 0.629825}, {0.487864, 0.809488}, {-0.066503, -0.309556}, {0.328515, 0.048719}}, {{-0.232248, 0.954387}, {-0.315472, 0.024645}, {-0.500461, -0.846106}, {-0.232248, 0.954387}}, {{-0.12958, 0.238005}, {0.091497, 0.037364}, {-0.776699, -0.919482}, {-0.12958, 0.238005}}, {{0.88497, -0.640365}, {-0.461477, -0.033718}, {0.828415, 0.893949}, {-0.997393, 0.295791}, {-0.527684, 0.308964}, {0.88497, -0.640365}}}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.69442, 0.56896}, {-0.678568, -0.912615}, {0.477558, 0.051838}, {0.995732, -0.670219}, {-0.22946, -0.424433}, {0.757395, -0.032608}}, {{0.41438, 0.997613}, {0.199558, 0.952319}, {-0.653188, -0.11664}, {0.156782, 0.956592}, {0.13576, 0.73053}, {0.257011, 0.024802}, {-0.217117, -0.262732}, {-0.409564, -0.57726}}, {{0.072922, 0.731739}, {0.769928, 0.8846}, {-0.523667, -0.324542}, {0.26628, -0.355892}, {-0.712144, 0.519719}, {0.100784, 0.073046}, {0.420942, -0.77052}, {0.843818, -0.040354}}, {{0.200649, 0.210466}, {0.419824, -0.822409}, {-0.006534, -0.579421}, {-0.220632, 0.023492}, {-0.292103, -0.186574}, {0.46174, -0.913346}}, {{0.207835, -0.672891}, {0.114438, -0.838129}, {0.002947, 0.377263}, {-0.160444, -0.371638}, {0.347428, 0.870571}, {0.747272, -0.229264}, {0.726805, -0.769932}, {-0.882559, 0.966374}}, {{0.229977, 0.117562}, {-0.382242, 0.798165}, {0.705501, -0.03657}, {-0.559235, 0.352791}, {0.452379, 0.991013}, {0.580757, -0.817507}, {0.979882, 0.709026}}, {{-0.338138, 0.465856}, {0.193243, -0.808469}, {0.127025, -0.959607}, {0.577394, 0.647172}, {0.460231, -0.817044}, {0.176188, -0.217209}}, {{0.784181, 0.884589}, {0.844568, 0.062436}}})),
+		"010700000002000000010300000004000000070000004ab54fc76306d53fe63c635fb2f1a83f164f3dd2e0b6c03f93e1783e03ead2bf4069a8514832d53fe38920cec309dd3f1ac39ca04d0ec9bf9d8026c28627e43fdf5339ed2939df3f7024d06053e7e93fc2fc15325706b1bff5f411f8c3cfd3bf4ab54fc76306d53fe63c635fb2f1a83f0400000038dc476e4dbacdbf1b48179b568aee3f0470b378b130d4bf78280af4893c993ff48c7dc9c603e0bf4a61dee34c13ebbf38dc476e4dbacdbf1b48179b568aee3f04000000679b1bd31396c0bfd769a4a5f276ce3fe49eaeee586cb73fe77118cc5f21a33fbabf7adcb7dae8bf6d57e883656cedbf679b1bd31396c0bfd769a4a5f276ce3f06000000a81dfe9aac51ec3fe71890bdde7de4bfdacbb6d3d688ddbfccb392567c43a1bfc381902c6082ea3f4757e9ee3a9bec3f6a4c88b9a4eaefbf6adfdc5f3deed23f3aeb538ec9e2e0bfc80be9f010c6d33fa81dfe9aac51ec3fe71890bdde7de4bf010500000008000000010200000006000000b806b64ab038e63fdb6d179aeb34e23f8d96033dd4b6e5bf6ad95a5f2434edbfdd5cfc6d4f90de3ff94d61a5828aaa3fdd94f25a09ddef3fe50cc51d6f72e5bf90bddefdf15ecdbf77f69507e929dbbfeaec6470943ce83f240a2debfeb1a0bf010200000008000000697407b13385da3ff50f221972ecef3f8cdcd3d51d8bc93f67800bb26579ee3fff774485eae6e4bfeaca67791edcbdbf09fd4cbd6e11c43ffbaf73d3669cee3f546f0d6c9560c13f71e657738060e73f9a95ed43de72d03fc366800bb265993fc0eb33677dcacbbf0536e7e099d0d0bfc79c67ec4b36dabf293fa9f6e978e2bf01020000000800000075ae282504abb23fd7a546e8676ae73f3eca880b40a3e83fca54c1a8a44eec3f63d2df4be1c1e0bf6e6b0bcf4bc5d4bf5f0ce544bb0ad13f321d3a3defc6d6bf2d5dc136e2c9e6bfae8383bd89a1e03fc1c6f5effaccb93f94861a8524b3b23f3dd2e0b6b6f0da3fd53e1d8f19a8e8bf0ad6389b8e00eb3fa08b868c47a9a4bf01020000000600000035d07cceddaec93f76c075c58cf0ca3f9cdb847b65deda3f3cf4ddad2c51eabfd236fe4465c37abf20b3b3e89d8ae2bfbabc395cab3dccbfecdade6e490e983f301004c8d0b1d2bff7562426a8e1c7bf9352d0ed258ddd3f5ad6fd63213aedbf0102000000080000009869fb57569aca3f41bad8b45288e5bf0d6e6b0bcf4bbd3fad889ae8f3d1eabfb4c9e1934e24683f0e6abfb51325d83f3f726bd26d89c4bfb2dafcbfeac8d7bf73bd6da6423cd63f9012bbb6b7dbeb3fabed26f8a6e9e73feb3713d38558cdbf8b37328ffc41e73f999a046f48a3e8bf7b4b395fec3decbfef8cb62a89ecee3f0102000000070000004485eae6e26fcd3f249a40118b18be3fa5164a26a776d8bf98fa7953918ae93f117349d57693e63f618907944db9a2bff7e978cc40e5e1bf43aed4b32094d63f766b990cc7f3dc3fab251de560b6ef3fce893db48f95e23fc808a8700429eabfe10cfe7e315bef3f3370404b57b0e63f010200000006000000f33ae2900da4d5bfd74d29af95d0dd3fa62897c62fbcc83feb8d5a61fadee9bf8a1f63ee5a42c03fcf6740bd19b5eebf10035dfb027ae23f9561dc0da2b5e43f7bbe66b96c74dd3fa7936c753925eabf884cf910548dc63f71ccb22781cdcbbf0102000000020000005ea0a4c00218e93fcfd72c978d4eec3faaf06778b306eb3f09a52f849cf7af3f",
+		mustHexDecode("010700000002000000010300000004000000070000004AB54FC76306D53FE63C635FB2F1A83F164F3DD2E0B6C03F93E1783E03EAD2BF4069A8514832D53FE38920CEC309DD3F1AC39CA04D0EC9BF9D8026C28627E43FDF5339ED2939DF3F7024D06053E7E93FC2FC15325706B1BFF5F411F8C3CFD3BF4AB54FC76306D53FE63C635FB2F1A83F0400000038DC476E4DBACDBF1B48179B568AEE3F0470B378B130D4BF78280AF4893C993FF48C7DC9C603E0BF4A61DEE34C13EBBF38DC476E4DBACDBF1B48179B568AEE3F04000000679B1BD31396C0BFD769A4A5F276CE3FE49EAEEE586CB73FE77118CC5F21A33FBABF7ADCB7DAE8BF6D57E883656CEDBF679B1BD31396C0BFD769A4A5F276CE3F06000000A81DFE9AAC51EC3FE71890BDDE7DE4BFDACBB6D3D688DDBFCCB392567C43A1BFC381902C6082EA3F4757E9EE3A9BEC3F6A4C88B9A4EAEFBF6ADFDC5F3DEED23F3AEB538EC9E2E0BFC80BE9F010C6D33FA81DFE9AAC51EC3FE71890BDDE7DE4BF010500000008000000010200000006000000B806B64AB038E63FDB6D179AEB34E23F8D96033DD4B6E5BF6AD95A5F2434EDBFDD5CFC6D4F90DE3FF94D61A5828AAA3FDD94F25A09DDEF3FE50CC51D6F72E5BF90BDDEFDF15ECDBF77F69507E929DBBFEAEC6470943CE83F240A2DEBFEB1A0BF010200000008000000697407B13385DA3FF50F221972ECEF3F8CDCD3D51D8BC93F67800BB26579EE3FFF774485EAE6E4BFEACA67791EDCBDBF09FD4CBD6E11C43FFBAF73D3669CEE3F546F0D6C9560C13F71E657738060E73F9A95ED43DE72D03FC366800BB265993FC0EB33677DCACBBF0536E7E099D0D0BFC79C67EC4B36DABF293FA9F6E978E2BF01020000000800000075AE282504ABB23FD7A546E8676AE73F3ECA880B40A3E83FCA54C1A8A44EEC3F63D2DF4BE1C1E0BF6E6B0BCF4BC5D4BF5F0CE544BB0AD13F321D3A3DEFC6D6BF2D5DC136E2C9E6BFAE8383BD89A1E03FC1C6F5EFFACCB93F94861A8524B3B23F3DD2E0B6B6F0DA3FD53E1D8F19A8E8BF0AD6389B8E00EB3FA08B868C47A9A4BF01020000000600000035D07CCEDDAEC93F76C075C58CF0CA3F9CDB847B65DEDA3F3CF4DDAD2C51EABFD236FE4465C37ABF20B3B3E89D8AE2BFBABC395CAB3DCCBFECDADE6E490E983F301004C8D0B1D2BFF7562426A8E1C7BF9352D0ED258DDD3F5AD6FD63213AEDBF0102000000080000009869FB57569ACA3F41BAD8B45288E5BF0D6E6B0BCF4BBD3FAD889AE8F3D1EABFB4C9E1934E24683F0E6ABFB51325D83F3F726BD26D89C4BFB2DAFCBFEAC8D7BF73BD6DA6423CD63F9012BBB6B7DBEB3FABED26F8A6E9E73FEB3713D38558CDBF8B37328FFC41E73F999A046F48A3E8BF7B4B395FEC3DECBFEF8CB62A89ECEE3F0102000000070000004485EAE6E26FCD3F249A40118B18BE3FA5164A26A776D8BF98FA7953918AE93F117349D57693E63F618907944DB9A2BFF7E978CC40E5E1BF43AED4B32094D63F766B990CC7F3DC3FAB251DE560B6EF3FCE893DB48F95E23FC808A8700429EABFE10CFE7E315BEF3F3370404B57B0E63F010200000006000000F33AE2900DA4D5BFD74D29AF95D0DD3FA62897C62FBCC83FEB8D5A61FADEE9BF8A1F63EE5A42C03FCF6740BD19B5EEBF10035DFB027AE23F9561DC0DA2B5E43F7BBE66B96C74DD3FA7936C753925EABF884CF910548DC63F71CCB22781CDCBBF0102000000020000005EA0A4C00218E93FCFD72C978D4EEC3FAAF06778B306EB3F09A52F849CF7AF3F"),
+		"GEOMETRYCOLLECTION (POLYGON ((0.328515 0.048719, 0.130581 -0.295533, 0.331194 0.453721, -0.195749 0.629825, 0.487864 0.809488, -0.06650300000000001 -0.309556, 0.328515 0.048719), (-0.232248 0.954387, -0.315472 0.024645, -0.500461 -0.846106, -0.232248 0.954387), (-0.12958 0.238005, 0.091497 0.037364, -0.776699 -0.919482, -0.12958 0.238005), (0.88497 -0.640365, -0.461477 -0.033718, 0.828415 0.893949, -0.997393 0.295791, -0.527684 0.308964, 0.88497 -0.640365)), MULTILINESTRING ((0.69442 0.56896, -0.6785679999999999 -0.912615, 0.477558 0.051838, 0.995732 -0.670219, -0.22946 -0.424433, 0.757395 -0.032608), (0.41438 0.997613, 0.199558 0.952319, -0.653188 -0.11664, 0.156782 0.956592, 0.13576 0.73053, 0.257011 0.024802, -0.217117 -0.262732, -0.409564 -0.57726), (0.072922 0.731739, 0.7699279999999999 0.8846000000000001, -0.523667 -0.324542, 0.26628 -0.355892, -0.712144 0.519719, 0.100784 0.073046, 0.420942 -0.77052, 0.843818 -0.040354), (0.200649 0.210466, 0.419824 -0.8224089999999999, -0.006534 -0.579421, -0.220632 0.023492, -0.292103 -0.186574, 0.46174 -0.913346), (0.207835 -0.672891, 0.114438 -0.838129, 0.002947 0.377263, -0.160444 -0.371638, 0.347428 0.870571, 0.747272 -0.229264, 0.726805 -0.7699319999999999, -0.882559 0.966374), (0.229977 0.117562, -0.382242 0.798165, 0.705501 -0.03657, -0.559235 0.352791, 0.452379 0.991013, 0.580757 -0.817507, 0.979882 0.709026), (-0.338138 0.465856, 0.193243 -0.808469, 0.127025 -0.959607, 0.577394 0.647172, 0.460231 -0.817044, 0.176188 -0.217209), (0.784181 0.884589, 0.844568 0.062436)))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.817423, 0.180748}, {-0.547969, -0.739805}, {-0.543376, -0.007986}, {-0.393022, 0.468844}}), geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.796426, 0.327679}, {0.948129, -0.636006}}), geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{-0.040515, -0.747608}, {0.630238, -0.458296}, {0.796869, 0.398827}, {0.704721, 0.732962}, {0.583566, 0.472977}, {-0.991859, -0.71314}, {-0.040515, -0.747608}}, {{-0.993251, -0.745687}, {-0.030067, -0.917872}, {-0.362507, -0.560024}, {-0.651186, -0.366789}, {0.762434, -0.537115}, {0.298318, 0.467997}, {-0.993251, -0.745687}}}}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{-0.455686, 0.077293}, {0.938071, -0.564255}, {0.104741, -0.869047}, {-0.248866, 0.908346}}, {{-0.809588, 0.705052}, {0.431413, 0.835847}, {-0.078369, -0.15489}, {0.792306, 0.072315}, {0.522735, -0.644716}, {-0.863134, -0.119012}, {-0.345897, 0.023747}, {-0.311353, 0.725176}}}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.419689, 0.082171}, {-0.69611, -0.930431}}, {{0.032496, 0.150911}, {-0.168278, -0.062576}, {-0.217148, -0.82455}, {0.07061, -0.756792}, {0.346977, 0.498837}, {-0.664142, -0.596977}}, {{0.19702, -0.187015}, {0.775061, 0.095934}, {0.051273, -0.563013}}, {{0.84942, -0.800722}, {-0.73955, -0.609384}}}), geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.136727, -0.210205}, {0.282055, -0.475811}, {0.600901, 0.2801}, {0.205725, -0.942247}, {-0.309252, 0.53772}, {-0.588185, 0.288988}}), geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{-0.57592, -0.985967}, {-0.526798, -0.05632}, {0.208543, 0.671859}, {-0.419963, -0.341911}, {0.441297, 0.328563}, {0.429045, 0.75457}, {-0.57592, -0.985967}}}, {{{-0.007315, 0.225209}, {0.308143, -0.539805}, {-0.727808, 0.842872}, {-0.007315, 0.225209}}, {{-0.434175, 0.0344}, {0.266708, 0.478418}, {-0.708823, 0.015752}, {-0.434175, 0.0344}}}, {{{0.449367, -0.281012}, {0.622287, -0.616761}, {0.989405, 0.042879}, {-0.15231, 0.451315}, {0.449367, -0.281012}}, {{-0.118247, -0.424412}, {0.322423, 0.053429}, {0.660197, -0.021442}, {-0.118247, -0.424412}}}, {{{-0.702792, 0.145246}, {-0.470252, -0.576802}, {0.883566, -0.721408}, {-0.702792, 0.145246}}, {{0.873817, 0.678067}, {-0.402244, -0.059607}, {-0.829434, -0.266657}, {0.853455, -0.798001}, {-0.508726, -0.914548}, {0.721657, 0.367175}, {0.873817, 0.678067}}, {{-0.068694, -0.479913}, {0.171687, 0.405431}, {0.586, -0.675052}, {0.250607, 0.357652}, {0.162348, 0.455509}, {0.035567, 0.909281}, {-0.068694, -0.479913}}, {{0.256095, -0.973685}, {-0.712917, 0.202143}, {0.533824, -0.711484}, {0.273657, -0.691308}, {0.526408, 0.642411}, {0.241228, -0.864173}, {0.256095, -0.973685}}, {{-0.460938, -0.064333}, {0.560131, 0.156636}, {0.983913, 0.416495}, {-0.717282, 0.958259}, {-0.460938, -0.064333}}}})),
+		"01070000000700000001020000000400000052f17f475428ea3fd3a0681ec022c73f2f19c748f688e1bf5c5a0d897bace7bf2dcdad105663e1bf645a9bc6f65a80bfdcf126bf4527d9bfb340bb438a01de3f0102000000020000001de4f560527ce93fc3802557b1f8d43f3274eca01257ee3fafe94141295ae4bf0106000000010000000103000000020000000700000075b0fecf61bea4bf844bc79c67ece7bf4d49d6e1e82ae43fb3cef8beb854ddbf7847c66af37fe93f33c170ae6186d93fc7bdf90d138de63f7bbe66b96c74e73f56d7a19a92ace23ffca6b0524145de3fd2c8e7154fbdefbfa7052ffa0ad2e6bf75b0fecf61bea4bf844bc79c67ece7bf0700000079043752b6c8efbfdeacc1fbaadce7bf2d5dc136e2c99ebf6840bd19355fedbf728c648f5033d7bf0d349f73b7ebe1bf289eb30584d6e4bf3315e2917879d7bf8978ebfcdb65e83f001de6cb0b30e1bf5fb87361a417d33f381268b0a9f3dd3f79043752b6c8efbfdeacc1fbaadce7bf010500000002000000010200000004000000ffaecf9cf529ddbfbcb0355b79c9b33fb96e4a79ad04ee3fe6577380600ee2bfd2e28c614ed0ba3f2ffb75a73bcfebbf0f0d8b51d7dacfbf317a6ea12b11ed3f010200000008000000527de71725e8e9bf2e573f36c98fe63f8f6e8445459cdb3ff6b52e3542bfea3f662d05a4fd0fb4bfc11c3d7e6fd3c3bf2096cd1c925ae93f179f02603c83b23f982f2fc03ebae03f69a7e67283a1e4bfeb19c231cb9eebbf98e0d4079277bebf7235b22b2d23d6bfcb2f83312251983f38bc202235edd3bfd6027b4ca434e73f010500000004000000010200000002000000a06b5f402fdcda3f50fbad9d2809b53fd8648d7a8846e6bf0ce8853b17c6edbf010200000006000000f46a80d250a3a03fe7a6cd380d51c33fe371512d228ac5bf03b68311fb04b0bfc426327381cbcbbf6d567daeb662eabf6c43c5387f13b23f59fb3bdba337e8bf52b5dd04df34d63f60394206f2ecdf3f10cd3cb9a640e5bfa9da6e826f1ae3bf010200000003000000b936548cf337c93faa436e861bf0c7bf38f6ecb94ccde83f13109370218fb83feeea55647440aa3f9221c7d63304e2bf010200000002000000ad2f12da722eeb3fbd0166be839fe9bfb537f8c264aae7bfcd94d6df1280e3bf0104000000060000000101000000ee76bd344580c1bfb9533a58ffe7cabf010100000005172b6a300dd23f7cee04fbaf73debf01010000003d47e4bb943ae33fb003e78c28edd13f0101000000da1b7c613255ca3faf21382ee326eebf0101000000f33e8ee6c8cad3bfbef6cc920035e13f0101000000a6ed5f5969d2e2bfc4ee3b86c77ed23f010600000004000000010300000001000000070000002098a3c7ef6de2bf6ced7daa0a8defbf8524b37a87dbe0bf4bb0389cf9d5acbf2ba5677a89b1ca3f94be1072de7fe53f4eeca17dace0dabf4721c9acdee1d5bf97aab4c5353edc3fd13deb1a2d07d53fdac9e0287975db3ff65d11fc6f25e83f2098a3c7ef6de2bf6ced7daa0a8defbf010300000002000000040000002ee7525c55f67dbf10e7e104a6d3cc3feb71df6a9db8d33ff5f3a6221546e1bfa48cb800344ae7bf01da56b3cef8ea3f2ee7525c55f67dbf10e7e104a6d3cc3f0400000044696ff085c9dbbf22fdf675e09ca13f6938656ebe11d13fa855f487669ede3f2ae27492adaee6bf03e962d34a21903f44696ff085c9dbbf22fdf675e09ca13f0103000000020000000500000057b439ce6dc2dc3fb72572c119fcd1bf003b376dc6e9e33f1d588e9081bce3bfd3f6afac34a9ef3f70d05e7d3cf4a53f02486de2e47ec3bf6e6e4c4f58e2dc3f57b439ce6dc2dc3fb72572c119fcd1bf04000000f1a0d9756f45bebfbcea01f39029dbbff69a1e1494a2d43f47e350bf0b5bab3f9f5a7d755520e53f8b170b43e4f495bff1a0d9756f45bebfbcea01f39029dbbf010300000005000000040000006b7efca5457de6bff8f9efc16b97c23fda3a38d89b18debf7a8ec8772975e2bfef703b342c46ec3f1e54e23ac615e7bf6b7efca5457de6bff8f9efc16b97c23f07000000e90ab6114ff6eb3f0ce6af90b9b2e53f47c8409e5dbed9bf25b03907cf84aebf4818062cb98aeabf59f78f85e810d1bf1d7233dc804feb3f069ca5643989e9bf26ff93bf7b47e0bf21e9d32afa43edbf3cbebd6bd017e73fc7293a92cb7fd73fe90ab6114ff6eb3f0ce6af90b9b2e53f07000000ce716e13ee95b1bf4337fb03e5b6debf33fd12f1d6f9c53f7e3672dd94f2d93f8d976e1283c0e23f382ee3a6069ae5bfd88349f1f109d03fe355d636c5e3d63fca1649bbd1c7c43f8d2782380f27dd3fce37a27bd635a23f40f9bb77d418ed3fce716e13ee95b1bf4337fb03e5b6debf07000000ddd26a48dc63d03f6362f3716d28efbfd865f84f37d0e6bf11c30e63d2dfc93fa73cba111615e13f8c12f4177ac4e6bfff9254a69883d13f46d26ef4311fe6bfe0490b9755d8e03fa7e67283a18ee43f109370218fe0ce3f37c2a2224ea7ebbfddd26a48dc63d03f6362f3716d28efbf0500000017f4de180280ddbfa2b60da32078b0bf8f39cfd897ece13f2829b000a60cc43ff67ea31d377cef3f2ba4fca4daa7da3f984d8061f9f3e6bf102043c70eaaee3f17f4de180280ddbfa2b60da32078b0bf",
+		mustHexDecode("01070000000700000001020000000400000052F17F475428EA3FD3A0681EC022C73F2F19C748F688E1BF5C5A0D897BACE7BF2DCDAD105663E1BF645A9BC6F65A80BFDCF126BF4527D9BFB340BB438A01DE3F0102000000020000001DE4F560527CE93FC3802557B1F8D43F3274ECA01257EE3FAFE94141295AE4BF0106000000010000000103000000020000000700000075B0FECF61BEA4BF844BC79C67ECE7BF4D49D6E1E82AE43FB3CEF8BEB854DDBF7847C66AF37FE93F33C170AE6186D93FC7BDF90D138DE63F7BBE66B96C74E73F56D7A19A92ACE23FFCA6B0524145DE3FD2C8E7154FBDEFBFA7052FFA0AD2E6BF75B0FECF61BEA4BF844BC79C67ECE7BF0700000079043752B6C8EFBFDEACC1FBAADCE7BF2D5DC136E2C99EBF6840BD19355FEDBF728C648F5033D7BF0D349F73B7EBE1BF289EB30584D6E4BF3315E2917879D7BF8978EBFCDB65E83F001DE6CB0B30E1BF5FB87361A417D33F381268B0A9F3DD3F79043752B6C8EFBFDEACC1FBAADCE7BF010500000002000000010200000004000000FFAECF9CF529DDBFBCB0355B79C9B33FB96E4A79AD04EE3FE6577380600EE2BFD2E28C614ED0BA3F2FFB75A73BCFEBBF0F0D8B51D7DACFBF317A6EA12B11ED3F010200000008000000527DE71725E8E9BF2E573F36C98FE63F8F6E8445459CDB3FF6B52E3542BFEA3F662D05A4FD0FB4BFC11C3D7E6FD3C3BF2096CD1C925AE93F179F02603C83B23F982F2FC03EBAE03F69A7E67283A1E4BFEB19C231CB9EEBBF98E0D4079277BEBF7235B22B2D23D6BFCB2F83312251983F38BC202235EDD3BFD6027B4CA434E73F010500000004000000010200000002000000A06B5F402FDCDA3F50FBAD9D2809B53FD8648D7A8846E6BF0CE8853B17C6EDBF010200000006000000F46A80D250A3A03FE7A6CD380D51C33FE371512D228AC5BF03B68311FB04B0BFC426327381CBCBBF6D567DAEB662EABF6C43C5387F13B23F59FB3BDBA337E8BF52B5DD04DF34D63F60394206F2ECDF3F10CD3CB9A640E5BFA9DA6E826F1AE3BF010200000003000000B936548CF337C93FAA436E861BF0C7BF38F6ECB94CCDE83F13109370218FB83FEEEA55647440AA3F9221C7D63304E2BF010200000002000000AD2F12DA722EEB3FBD0166BE839FE9BFB537F8C264AAE7BFCD94D6DF1280E3BF0104000000060000000101000000EE76BD344580C1BFB9533A58FFE7CABF010100000005172B6A300DD23F7CEE04FBAF73DEBF01010000003D47E4BB943AE33FB003E78C28EDD13F0101000000DA1B7C613255CA3FAF21382EE326EEBF0101000000F33E8EE6C8CAD3BFBEF6CC920035E13F0101000000A6ED5F5969D2E2BFC4EE3B86C77ED23F010600000004000000010300000001000000070000002098A3C7EF6DE2BF6CED7DAA0A8DEFBF8524B37A87DBE0BF4BB0389CF9D5ACBF2BA5677A89B1CA3F94BE1072DE7FE53F4EECA17DACE0DABF4721C9ACDEE1D5BF97AAB4C5353EDC3FD13DEB1A2D07D53FDAC9E0287975DB3FF65D11FC6F25E83F2098A3C7EF6DE2BF6CED7DAA0A8DEFBF010300000002000000040000002EE7525C55F67DBF10E7E104A6D3CC3FEB71DF6A9DB8D33FF5F3A6221546E1BFA48CB800344AE7BF01DA56B3CEF8EA3F2EE7525C55F67DBF10E7E104A6D3CC3F0400000044696FF085C9DBBF22FDF675E09CA13F6938656EBE11D13FA855F487669EDE3F2AE27492ADAEE6BF03E962D34A21903F44696FF085C9DBBF22FDF675E09CA13F0103000000020000000500000057B439CE6DC2DC3FB72572C119FCD1BF003B376DC6E9E33F1D588E9081BCE3BFD3F6AFAC34A9EF3F70D05E7D3CF4A53F02486DE2E47EC3BF6E6E4C4F58E2DC3F57B439CE6DC2DC3FB72572C119FCD1BF04000000F1A0D9756F45BEBFBCEA01F39029DBBFF69A1E1494A2D43F47E350BF0B5BAB3F9F5A7D755520E53F8B170B43E4F495BFF1A0D9756F45BEBFBCEA01F39029DBBF010300000005000000040000006B7EFCA5457DE6BFF8F9EFC16B97C23FDA3A38D89B18DEBF7A8EC8772975E2BFEF703B342C46EC3F1E54E23AC615E7BF6B7EFCA5457DE6BFF8F9EFC16B97C23F07000000E90AB6114FF6EB3F0CE6AF90B9B2E53F47C8409E5DBED9BF25B03907CF84AEBF4818062CB98AEABF59F78F85E810D1BF1D7233DC804FEB3F069CA5643989E9BF26FF93BF7B47E0BF21E9D32AFA43EDBF3CBEBD6BD017E73FC7293A92CB7FD73FE90AB6114FF6EB3F0CE6AF90B9B2E53F07000000CE716E13EE95B1BF4337FB03E5B6DEBF33FD12F1D6F9C53F7E3672DD94F2D93F8D976E1283C0E23F382EE3A6069AE5BFD88349F1F109D03FE355D636C5E3D63FCA1649BBD1C7C43F8D2782380F27DD3FCE37A27BD635A23F40F9BB77D418ED3FCE716E13EE95B1BF4337FB03E5B6DEBF07000000DDD26A48DC63D03F6362F3716D28EFBFD865F84F37D0E6BF11C30E63D2DFC93FA73CBA111615E13F8C12F4177AC4E6BFFF9254A69883D13F46D26EF4311FE6BFE0490B9755D8E03FA7E67283A18EE43F109370218FE0CE3F37C2A2224EA7EBBFDDD26A48DC63D03F6362F3716D28EFBF0500000017F4DE180280DDBFA2B60DA32078B0BF8F39CFD897ECE13F2829B000A60CC43FF67EA31D377CEF3F2BA4FCA4DAA7DA3F984D8061F9F3E6BF102043C70EAAEE3F17F4DE180280DDBFA2B60DA32078B0BF"),
+		"GEOMETRYCOLLECTION (LINESTRING (0.817423 0.180748, -0.547969 -0.739805, -0.543376 -0.007986, -0.393022 0.468844), LINESTRING (0.796426 0.327679, 0.948129 -0.636006), MULTIPOLYGON (((-0.040515 -0.7476080000000001, 0.630238 -0.458296, 0.796869 0.398827, 0.704721 0.732962, 0.583566 0.472977, -0.991859 -0.71314, -0.040515 -0.7476080000000001), (-0.993251 -0.745687, -0.030067 -0.917872, -0.362507 -0.560024, -0.651186 -0.366789, 0.7624339999999999 -0.537115, 0.298318 0.467997, -0.993251 -0.745687))), MULTILINESTRING ((-0.455686 0.077293, 0.938071 -0.564255, 0.104741 -0.869047, -0.248866 0.908346), (-0.809588 0.705052, 0.431413 0.835847, -0.07836899999999999 -0.15489, 0.792306 0.072315, 0.5227349999999999 -0.644716, -0.863134 -0.119012, -0.345897 0.023747, -0.311353 0.725176)), MULTILINESTRING ((0.419689 0.08217099999999999, -0.69611 -0.930431), (0.032496 0.150911, -0.168278 -0.06257600000000001, -0.217148 -0.82455, 0.07061000000000001 -0.756792, 0.346977 0.498837, -0.664142 -0.596977), (0.19702 -0.187015, 0.775061 0.09593400000000001, 0.051273 -0.563013), (0.84942 -0.800722, -0.73955 -0.609384)), MULTIPOINT (-0.136727 -0.210205, 0.282055 -0.475811, 0.600901 0.2801, 0.205725 -0.9422469999999999, -0.309252 0.53772, -0.588185 0.288988), MULTIPOLYGON (((-0.57592 -0.985967, -0.526798 -0.05632, 0.208543 0.671859, -0.419963 -0.341911, 0.441297 0.328563, 0.429045 0.75457, -0.57592 -0.985967)), ((-0.007315 0.225209, 0.308143 -0.539805, -0.727808 0.842872, -0.007315 0.225209), (-0.434175 0.0344, 0.266708 0.478418, -0.708823 0.015752, -0.434175 0.0344)), ((0.449367 -0.281012, 0.622287 -0.616761, 0.989405 0.042879, -0.15231 0.451315, 0.449367 -0.281012), (-0.118247 -0.424412, 0.322423 0.053429, 0.660197 -0.021442, -0.118247 -0.424412)), ((-0.702792 0.145246, -0.470252 -0.576802, 0.883566 -0.721408, -0.702792 0.145246), (0.873817 0.678067, -0.402244 -0.059607, -0.829434 -0.266657, 0.853455 -0.798001, -0.508726 -0.914548, 0.721657 0.367175, 0.873817 0.678067), (-0.06869400000000001 -0.479913, 0.171687 0.405431, 0.586 -0.675052, 0.250607 0.357652, 0.162348 0.455509, 0.035567 0.909281, -0.06869400000000001 -0.479913), (0.256095 -0.973685, -0.712917 0.202143, 0.533824 -0.711484, 0.273657 -0.691308, 0.526408 0.642411, 0.241228 -0.864173, 0.256095 -0.973685), (-0.460938 -0.064333, 0.560131 0.156636, 0.983913 0.416495, -0.717282 0.958259, -0.460938 -0.064333))))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-0.219027, -0.955655}, {-0.407031, -0.516267}, {0.552298, 0.185108}, {-0.712009, 0.745212}, {-0.574003, -0.360643}, {0.749447, 0.530433}})),
+		"0107000000010000000102000000060000006d72f8a41309ccbfbf4868cbb994eebfc1525dc0cb0cdabf0821205f4285e0bfbcadf4da6cace13f0e2e1d739eb1c73f2fa52e19c7c8e6bfacc612d6c6d8e73fd6c9198a3b5ee2bf4701a260c614d7bfe0ba624678fbe73f6d0377a04ef9e03f",
+		mustHexDecode("0107000000010000000102000000060000006D72F8A41309CCBFBF4868CBB994EEBFC1525DC0CB0CDABF0821205F4285E0BFBCADF4DA6CACE13F0E2E1D739EB1C73F2FA52E19C7C8E6BFACC612D6C6D8E73FD6C9198A3B5EE2BF4701A260C614D7BFE0BA624678FBE73F6D0377A04EF9E03F"),
+		"GEOMETRYCOLLECTION (LINESTRING (-0.219027 -0.955655, -0.407031 -0.516267, 0.552298 0.185108, -0.712009 0.745212, -0.574003 -0.360643, 0.749447 0.530433))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.421613, 0.431459}, {0.311565, 0.976477}, {0.848018, -0.403521}, {-0.109684, 0.271372}, {-0.526199, 0.294568}, {0.806546, -0.387449}, {-0.264155, -0.100121}, {-0.227365, 0.286645}}), geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.553472, -0.429316}), geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{0.222578, 0.137897}, {0.035116, -0.679354}, {-0.984955, -0.786047}, {-0.230213, -0.485805}}), geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.030777, -0.734581}, {-0.005182, 0.900974}, {-0.656026, -0.968914}, {-0.322223, 0.416999}, {0.721502, -0.781464}, {0.030777, -0.734581}}})),
+		"01070000000400000001040000000800000001010000007461a417b5fbda3fbb26a435069ddb3f0101000000e2016553aef0d33f687a89b14c3fef3f0101000000236b0da5f622eb3f28f38fbe49d3d9bf010100000098fbe4284014bcbf093543aa285ed13f01010000004f0306499fd6e0bf21ae9cbd33dad23f01010000001807978e39cfe93fbd8bf7e3f6cbd8bf0101000000d5ca845feae7d0bf978fa4a487a1b9bf010100000027a5a0db4b1acdbf7ff623456458d23f0101000000060e68e90ab6e13fff91e9d0e979dbbf0104000000040000000101000000329067976f7dcc3faab69be09ba6c13f0101000000cbf6216fb9faa13f60048d9944bde5bf010100000086032159c084efbf21cec3094c27e9bf010100000020990e9d9e77cdbf0feeceda6d17dfbf01030000000100000006000000703fe08101849f3f4d6a6803b081e7bfe9297288b83975bf537b116dc7d4ec3f1a6cea3c2afee4bf925ed4ee5701efbf6d37c1374d9fd4bfb6bddd921cb0da3f77f4bf5c8b16e73f030b60cac001e9bf703fe08101849f3f4d6a6803b081e7bf",
+		mustHexDecode("01070000000400000001040000000800000001010000007461A417B5FBDA3FBB26A435069DDB3F0101000000E2016553AEF0D33F687A89B14C3FEF3F0101000000236B0DA5F622EB3F28F38FBE49D3D9BF010100000098FBE4284014BCBF093543AA285ED13F01010000004F0306499FD6E0BF21AE9CBD33DAD23F01010000001807978E39CFE93FBD8BF7E3F6CBD8BF0101000000D5CA845FEAE7D0BF978FA4A487A1B9BF010100000027A5A0DB4B1ACDBF7FF623456458D23F0101000000060E68E90AB6E13FFF91E9D0E979DBBF0104000000040000000101000000329067976F7DCC3FAAB69BE09BA6C13F0101000000CBF6216FB9FAA13F60048D9944BDE5BF010100000086032159C084EFBF21CEC3094C27E9BF010100000020990E9D9E77CDBF0FEECEDA6D17DFBF01030000000100000006000000703FE08101849F3F4D6A6803B081E7BFE9297288B83975BF537B116DC7D4EC3F1A6CEA3C2AFEE4BF925ED4EE5701EFBF6D37C1374D9FD4BFB6BDDD921CB0DA3F77F4BF5C8B16E73F030B60CAC001E9BF703FE08101849F3F4D6A6803B081E7BF"),
+		"GEOMETRYCOLLECTION (MULTIPOINT (0.421613 0.431459, 0.311565 0.976477, 0.848018 -0.403521, -0.109684 0.271372, -0.526199 0.294568, 0.806546 -0.387449, -0.264155 -0.100121, -0.227365 0.286645), POINT (0.553472 -0.429316), MULTIPOINT (0.222578 0.137897, 0.035116 -0.679354, -0.984955 -0.7860470000000001, -0.230213 -0.485805), POLYGON ((0.030777 -0.734581, -0.005182 0.9009740000000001, -0.656026 -0.9689140000000001, -0.322223 0.416999, 0.721502 -0.781464, 0.030777 -0.734581)))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{-0.339153, 0.558725}, {-0.744967, 0.281945}, {-0.500073, 0.522357}, {0.824252, -0.117187}, {0.374437, -0.291925}, {0.698145, -0.179733}, {0.168156, 0.972909}, {0.115274, -0.09434}}), geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.899242, 0.049799}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.274141, -0.806743}, {-0.885424, 0.681827}, {0.201564, -0.397255}}, {{0.115805, 0.355557}, {-0.999732, -0.711104}, {-0.813661, 0.506172}, {-0.095331, -0.603207}, {-0.251028, 0.338909}}, {{0.091718, 0.875067}, {-0.199354, -0.793493}, {-0.785723, 0.449435}, {-0.375061, -0.769924}, {0.556363, 0.77764}}, {{0.233028, 0.480977}, {-0.508331, 0.673338}}, {{-0.11086, -0.668569}, {-0.484335, 0.658937}, {-0.664385, 0.409267}, {0.141885, 0.121436}, {-0.967337, -0.754598}, {-0.380391, 0.260659}}, {{-0.487017, -0.232318}, {-0.073769, 0.189166}, {0.119495, -0.263964}, {-0.149076, 0.612479}}})),
+		"010700000003000000010400000008000000010100000047c7d5c8aeb4d5bfa9a44e4013e1e13f0101000000e92cb308c5d6e7bf7a53910a630bd23f01010000007c9a93179900e0bf04c6fa0626b7e03f0101000000f433f5ba4560ea3fa52f849cf7ffbdbf0101000000fa635a9bc6f6d73f90a0f831e6aed2bf01010000009eb5db2e3457e63f2b8881ae7d01c7bf0101000000892650c42286c53f747d1f0e1222ef3f0101000000294014cc9882bd3f4963b48eaa26b8bf01010000007711a62897c6ec3feb3bbf28417fa93f010500000006000000010200000003000000fd885fb1868bd13f98dc28b2d6d0e9bffcfd62b66455ecbf0ef450db86d1e53f55850662d9ccc93fea094b3ca06cd9bf0102000000050000008599b67f65a5bd3fae49b72572c1d63f317898f6cdfdefbfcac0012d5dc1e6bf22fb20cb8209eabf45d4449f8f32e03f8c834bc79c67b8bf16fc36c4784de3bfa35698bed710d0bfee7bd45fafb0d53f010200000005000000f25b74b2d47ab73ff4e159828c00ec3f0fd4298f6e84c9bf925b936e4b64e9bf58e1968fa424e9bf6b60ab048bc3dc3fd65240daff00d8bfe4f90ca837a3e8bfd78a36c7b9cde13f51f701486de2e83f01020000000200000060b1868bdcd3cd3f4c3448c153c8de3f0f60915f3f44e0bff6ed2422fc8be53f010200000006000000533f6f2a5261bcbf52d2c3d0ea64e5bfe5b8533a58ffdebfb1fa230c0316e53fa67ede54a442e5bf034015376e31da3fb77f65a54929c23f399b8e006e16bf3f7bbe66b96cf4eebf731074b4aa25e8bfc9552c7e5358d8bfb81d1a16a3aed03f0102000000040000006325e659492bdfbf17d522a298bccdbf6268757286e2b2bffaefc16b9736c83fd717096d3997be3fe690d442c9e4d0bfe02a4f20ec14c3bfbc934f8f6d99e33f",
+		mustHexDecode("010700000003000000010400000008000000010100000047C7D5C8AEB4D5BFA9A44E4013E1E13F0101000000E92CB308C5D6E7BF7A53910A630BD23F01010000007C9A93179900E0BF04C6FA0626B7E03F0101000000F433F5BA4560EA3FA52F849CF7FFBDBF0101000000FA635A9BC6F6D73F90A0F831E6AED2BF01010000009EB5DB2E3457E63F2B8881AE7D01C7BF0101000000892650C42286C53F747D1F0E1222EF3F0101000000294014CC9882BD3F4963B48EAA26B8BF01010000007711A62897C6EC3FEB3BBF28417FA93F010500000006000000010200000003000000FD885FB1868BD13F98DC28B2D6D0E9BFFCFD62B66455ECBF0EF450DB86D1E53F55850662D9CCC93FEA094B3CA06CD9BF0102000000050000008599B67F65A5BD3FAE49B72572C1D63F317898F6CDFDEFBFCAC0012D5DC1E6BF22FB20CB8209EABF45D4449F8F32E03F8C834BC79C67B8BF16FC36C4784DE3BFA35698BED710D0BFEE7BD45FAFB0D53F010200000005000000F25B74B2D47AB73FF4E159828C00EC3F0FD4298F6E84C9BF925B936E4B64E9BF58E1968FA424E9BF6B60AB048BC3DC3FD65240DAFF00D8BFE4F90CA837A3E8BFD78A36C7B9CDE13F51F701486DE2E83F01020000000200000060B1868BDCD3CD3F4C3448C153C8DE3F0F60915F3F44E0BFF6ED2422FC8BE53F010200000006000000533F6F2A5261BCBF52D2C3D0EA64E5BFE5B8533A58FFDEBFB1FA230C0316E53FA67EDE54A442E5BF034015376E31DA3FB77F65A54929C23F399B8E006E16BF3F7BBE66B96CF4EEBF731074B4AA25E8BFC9552C7E5358D8BFB81D1A16A3AED03F0102000000040000006325E659492BDFBF17D522A298BCCDBF6268757286E2B2BFFAEFC16B9736C83FD717096D3997BE3FE690D442C9E4D0BFE02A4F20EC14C3BFBC934F8F6D99E33F"),
+		"GEOMETRYCOLLECTION (MULTIPOINT (-0.339153 0.558725, -0.744967 0.281945, -0.500073 0.522357, 0.824252 -0.117187, 0.374437 -0.291925, 0.698145 -0.179733, 0.168156 0.972909, 0.115274 -0.09433999999999999), POINT (0.899242 0.049799), MULTILINESTRING ((0.274141 -0.806743, -0.885424 0.681827, 0.201564 -0.397255), (0.115805 0.355557, -0.999732 -0.711104, -0.813661 0.506172, -0.095331 -0.603207, -0.251028 0.338909), (0.09171799999999999 0.875067, -0.199354 -0.793493, -0.7857229999999999 0.449435, -0.375061 -0.7699240000000001, 0.5563630000000001 0.77764), (0.233028 0.480977, -0.508331 0.673338), (-0.11086 -0.668569, -0.484335 0.658937, -0.664385 0.409267, 0.141885 0.121436, -0.967337 -0.754598, -0.380391 0.260659), (-0.487017 -0.232318, -0.073769 0.189166, 0.119495 -0.263964, -0.149076 0.612479)))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{{{{0.026231, -0.054196}, {0.705853, 0.474999}, {0.761176, 0.435659}, {-0.493449, -0.45437}, {0.026231, -0.054196}}}, {{{0.800166, -0.352871}, {-0.953553, -0.051465}, {0.576759, 0.405913}, {0.351208, -0.957971}, {-0.796169, 0.458432}, {0.637103, -0.635565}, {0.632761, 0.902561}, {0.20327, 0.10344}, {0.800166, -0.352871}}}, {{{-0.066009, 0.906909}, {-0.118278, -0.976179}, {0.1342, -0.863759}, {0.98404, 0.319385}, {0.438179, 0.386975}, {-0.066009, 0.906909}}, {{-0.442119, -0.842571}, {-0.954358, -0.045649}, {0.487404, 0.478483}, {-0.994251, 0.23332}, {0.663565, 0.7339}, {-0.442119, -0.842571}}, {{-0.162239, 0.407915}, {0.403067, -0.871594}, {-0.922224, -0.307735}, {0.286712, -0.238068}, {0.28998, 0.523026}, {0.542755, -0.433634}, {0.943475, 0.107589}, {-0.162239, 0.407915}}, {{0.270417, 0.346693}, {-0.691984, 0.34916}, {-0.136925, 0.93738}, {0.428301, 0.947981}, {0.982121, 0.668}, {0.172386, 0.201352}, {0.270417, 0.346693}}, {{-0.260651, -0.162519}, {0.826986, 0.29261}, {-0.660146, -0.925479}, {-0.121698, -0.118268}, {-0.868351, -0.546486}, {-0.260651, -0.162519}}}, {{{-0.246534, 0.249808}, {-0.688018, 0.643574}, {-0.005777, -0.86175}, {-0.800827, 0.886348}, {-0.246534, 0.249808}}}, {{{-0.642026, 0.308411}, {0.975188, 0.836409}, {-0.125487, -0.136993}, {-0.421121, -0.119172}, {0.915848, -0.924281}, {-0.042329, 0.791452}, {-0.642026, 0.308411}}}}), geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.629542, -0.434348}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.639668, -0.336361}, {-0.077389, -0.880297}}, {{0.657906, -0.560488}, {0.866413, 0.26719}, {0.096395, -0.588298}, {0.752137, -0.152102}, {-0.914677, 0.851557}, {-0.262305, -0.41082}}, {{-0.933809, -0.913793}, {0.749922, 0.735761}}}), geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.721494, 0.895046}, {0.121422, 0.591184}, {-0.846336, -0.869362}, {-0.721494, 0.895046}}, {{0.641403, 0.868899}, {-0.134338, -0.769739}, {0.435245, -0.108628}, {0.641403, 0.868899}}, {{0.775974, 0.079713}, {-0.723607, -0.254977}, {0.699323, 0.022892}, {-0.838507, 0.013087}, {-0.92981, 0.758614}, {-0.503771, 0.458356}, {0.775974, 0.079713}}, {{0.79273, 0.057258}, {-0.351706, 0.397496}, {0.093223, 0.817605}, {-0.51565, 0.38691}, {-0.69503, -0.189816}, {0.554782, -0.2713}, {-0.432752, -0.294196}, {-0.252692, -0.984046}, {0.79273, 0.057258}}}), geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0.481153, 0.385575})),
+		"01070000000500000001060000000500000001030000000100000005000000dfc4909c4cdc9a3f6de525ff93bfabbf5512d9075996e63f397ea8346266de3fc90050c58d5be83fec504d49d6e1db3f209c4f1dab94dfbf312592e86514ddbfdfc4909c4cdc9a3f6de525ff93bfabbf0103000000010000000900000059e02bbaf59ae93f793c2d3f7095d6bf0516c0948183eebfd2fbc6d79e59aabfa88e554acf74e23f215c01857afad93f05fd851e317ad63f7b8670ccb2a7eebf49d92269377ae9bfdd26dc2bf356dd3f22dfa5d42563e43f836e2f698c56e4bf6de525ff933fe43f4ca4349bc7e1ec3f86032159c004ca3f422619390b7bba3f59e02bbaf59ae93f793c2d3f7095d6bf01030000000500000006000000b1dd3d40f7e5b0bf8a56ee056605ed3ff816d68d7747bebfef5701bedb3cefbf05c58f31772dc13f710514eae9a3ebbf3e963e74417def3f374f75c8cd70d43fa2d0b2ee1f0bdc3f87a757ca32c4d83fb1dd3d40f7e5b0bf8a56ee056605ed3f06000000a12c7c7dad4bdcbf44db317557f6eabf88a1d5c9198aeebf79b130444e5fa7bf2522fc8ba031df3f34492c29779fde3f4e7d2079e7d0efbf2159c0046eddcd3fcea5b8aaec3be53fce1951da1b7ce73fa12c7c7dad4bdcbf44db317557f6eabf080000000f60915f3fc4c4bfd00f2384471bda3f7f32c687d9cbd93f7079ac1919e4ebbf01c3f2e7db82edbf6e693524eeb1d3bf67bad7497d59d23f3ab01c210379cebf029f1f46088fd23f72a59e05a1bce03f03b2d7bb3f5ee13f27c11bd2a8c0dbbfc5feb27bf230ee3fc5ca68e4f38abb3f0f60915f3fc4c4bfd00f2384471bda3f070000005e13d21a834ed13fdd2230d63730d63f535e2ba1bb24e6bf2a91442fa358d63f499d8026c286c1bf57957d5704ffed3fab05f6984869db3f0c570740dc55ee3fc6dff604896def3fc74b37894160e53f93e52494be10c63f01fa7dffe6c5c93f5e13d21a834ed13fdd2230d63730d63f060000004ddc2a8881aed0bf8d43fd2e6ccdc4bfd2fe0758ab76ea3f43e21e4b1fbad23f17ba1281ea1fe5bf2750c422869dedbf6a15fda19927bfbfddcf29c8cf46bebf5b5d4e0988c9ebbf72193735d07ce1bf4ddc2a8881aed0bf8d43fd2e6ccdc4bf010300000001000000050000006f10ad156d8ecfbfc7bb2363b5f9cf3f04e621533e04e6bff7c951802898e43f5b971aa19fa977bffa7e6abc7493ebbf101fd8f15fa0e9bf11001c7bf65cec3f6f10ad156d8ecfbfc7bb2363b5f9cf3f0103000000010000000700000074d0251c7a8be4bf8881ae7d01bdd33fe773ee76bd34ef3fe28fa2cedcc3ea3f0b5d8940f50fc0bf73f56393fc88c1bf0b2aaa7ea5f3dabf4c5299620e82bebf9c6c0377a04eed3fbb0d6abfb593edbfb742588d25aca5bf44520b259353e93f74d0251c7a8be4bf8881ae7d01bdd33f01010000007aabae433525e43f0150c58d5bccdbbf010500000003000000010200000002000000fd8689062978e43f3e97a949f086d5bff5f411f8c3cfb3bf8b8a389d642becbf0102000000060000001bf33ae2900de53f459db98784efe1bf33897ac1a7b9eb3f0b5ef415a419d13faf777fbc57adb83fb0abc95356d3e2bfd591239d8111e83f19fed30d1478c3bf96e82cb30845edbf83c13577f43feb3f28f224e99ac9d0bfedbb22f8df4adabf0102000000020000001fbc7669c3e1edbf0f0a4ad1ca3dedbf12a1116c5cffe73f1f8315a75a8be73f01030000000400000004000000c153c8957a16e7bfba4c4d8237a4ec3f46d1031f8315bf3f0f643db5faeae23fb8ad2d3c2f15ebbf2a53cc41d0d1ebbfc153c8957a16e7bfba4c4d8237a4ec3f040000001ccd91955f86e43f09de904605ceeb3f0e164ed2fc31c1bf7495eeaeb3a1e8bf5ed72fd80ddbdb3f240d6e6b0bcfbbbf1ccd91955f86e43f09de904605ceeb3f07000000537b116dc7d4e83f86e810381268b43f750305dec927e7bf3cdc0e0d8b51d0bf43e6caa0da60e63f59315c1d0071973f41f2cea10cd5eabfd7a205685bcd8a3fe2ccafe600c1edbf333509de9046e83ffc8a355ce41ee0bf5c397b67b455dd3f537b116dc7d4e83f86e810381268b43f09000000cbdb114e0b5ee93f7c65deaaeb50ad3f7fa5f3e15982d6bfbb9c12109370d93f7c293c6876ddb73f7d7901f6d129ea3f39d6c56d3480e0bffab31f2922c3d83fe8a4f78daf3de6bff1f62004e44bc8bf651a4d2ec6c0e13f3ee8d9acfa5cd1bf74d4d17135b2dbbf0a4ca7751bd4d2bf467efd101b2cd0bfc64e78094e7defbfcbdb114e0b5ee93f7c65deaaeb50ad3f010100000091d3d7f335cbde3fcceec9c342add83f",
+		mustHexDecode("01070000000500000001060000000500000001030000000100000005000000DFC4909C4CDC9A3F6DE525FF93BFABBF5512D9075996E63F397EA8346266DE3FC90050C58D5BE83FEC504D49D6E1DB3F209C4F1DAB94DFBF312592E86514DDBFDFC4909C4CDC9A3F6DE525FF93BFABBF0103000000010000000900000059E02BBAF59AE93F793C2D3F7095D6BF0516C0948183EEBFD2FBC6D79E59AABFA88E554ACF74E23F215C01857AFAD93F05FD851E317AD63F7B8670CCB2A7EEBF49D92269377AE9BFDD26DC2BF356DD3F22DFA5D42563E43F836E2F698C56E4BF6DE525FF933FE43F4CA4349BC7E1EC3F86032159C004CA3F422619390B7BBA3F59E02BBAF59AE93F793C2D3F7095D6BF01030000000500000006000000B1DD3D40F7E5B0BF8A56EE056605ED3FF816D68D7747BEBFEF5701BEDB3CEFBF05C58F31772DC13F710514EAE9A3EBBF3E963E74417DEF3F374F75C8CD70D43FA2D0B2EE1F0BDC3F87A757CA32C4D83FB1DD3D40F7E5B0BF8A56EE056605ED3F06000000A12C7C7DAD4BDCBF44DB317557F6EABF88A1D5C9198AEEBF79B130444E5FA7BF2522FC8BA031DF3F34492C29779FDE3F4E7D2079E7D0EFBF2159C0046EDDCD3FCEA5B8AAEC3BE53FCE1951DA1B7CE73FA12C7C7DAD4BDCBF44DB317557F6EABF080000000F60915F3FC4C4BFD00F2384471BDA3F7F32C687D9CBD93F7079AC1919E4EBBF01C3F2E7DB82EDBF6E693524EEB1D3BF67BAD7497D59D23F3AB01C210379CEBF029F1F46088FD23F72A59E05A1BCE03F03B2D7BB3F5EE13F27C11BD2A8C0DBBFC5FEB27BF230EE3FC5CA68E4F38ABB3F0F60915F3FC4C4BFD00F2384471BDA3F070000005E13D21A834ED13FDD2230D63730D63F535E2BA1BB24E6BF2A91442FA358D63F499D8026C286C1BF57957D5704FFED3FAB05F6984869DB3F0C570740DC55EE3FC6DFF604896DEF3FC74B37894160E53F93E52494BE10C63F01FA7DFFE6C5C93F5E13D21A834ED13FDD2230D63730D63F060000004DDC2A8881AED0BF8D43FD2E6CCDC4BFD2FE0758AB76EA3F43E21E4B1FBAD23F17BA1281EA1FE5BF2750C422869DEDBF6A15FDA19927BFBFDDCF29C8CF46BEBF5B5D4E0988C9EBBF72193735D07CE1BF4DDC2A8881AED0BF8D43FD2E6CCDC4BF010300000001000000050000006F10AD156D8ECFBFC7BB2363B5F9CF3F04E621533E04E6BFF7C951802898E43F5B971AA19FA977BFFA7E6ABC7493EBBF101FD8F15FA0E9BF11001C7BF65CEC3F6F10AD156D8ECFBFC7BB2363B5F9CF3F0103000000010000000700000074D0251C7A8BE4BF8881AE7D01BDD33FE773EE76BD34EF3FE28FA2CEDCC3EA3F0B5D8940F50FC0BF73F56393FC88C1BF0B2AAA7EA5F3DABF4C5299620E82BEBF9C6C0377A04EED3FBB0D6ABFB593EDBFB742588D25ACA5BF44520B259353E93F74D0251C7A8BE4BF8881AE7D01BDD33F01010000007AABAE433525E43F0150C58D5BCCDBBF010500000003000000010200000002000000FD8689062978E43F3E97A949F086D5BFF5F411F8C3CFB3BF8B8A389D642BECBF0102000000060000001BF33AE2900DE53F459DB98784EFE1BF33897AC1A7B9EB3F0B5EF415A419D13FAF777FBC57ADB83FB0ABC95356D3E2BFD591239D8111E83F19FED30D1478C3BF96E82CB30845EDBF83C13577F43FEB3F28F224E99AC9D0BFEDBB22F8DF4ADABF0102000000020000001FBC7669C3E1EDBF0F0A4AD1CA3DEDBF12A1116C5CFFE73F1F8315A75A8BE73F01030000000400000004000000C153C8957A16E7BFBA4C4D8237A4EC3F46D1031F8315BF3F0F643DB5FAEAE23FB8AD2D3C2F15EBBF2A53CC41D0D1EBBFC153C8957A16E7BFBA4C4D8237A4EC3F040000001CCD91955F86E43F09DE904605CEEB3F0E164ED2FC31C1BF7495EEAEB3A1E8BF5ED72FD80DDBDB3F240D6E6B0BCFBBBF1CCD91955F86E43F09DE904605CEEB3F07000000537B116DC7D4E83F86E810381268B43F750305DEC927E7BF3CDC0E0D8B51D0BF43E6CAA0DA60E63F59315C1D0071973F41F2CEA10CD5EABFD7A205685BCD8A3FE2CCAFE600C1EDBF333509DE9046E83FFC8A355CE41EE0BF5C397B67B455DD3F537B116DC7D4E83F86E810381268B43F09000000CBDB114E0B5EE93F7C65DEAAEB50AD3F7FA5F3E15982D6BFBB9C12109370D93F7C293C6876DDB73F7D7901F6D129EA3F39D6C56D3480E0BFFAB31F2922C3D83FE8A4F78DAF3DE6BFF1F62004E44BC8BF651A4D2EC6C0E13F3EE8D9ACFA5CD1BF74D4D17135B2DBBF0A4CA7751BD4D2BF467EFD101B2CD0BFC64E78094E7DEFBFCBDB114E0B5EE93F7C65DEAAEB50AD3F010100000091D3D7F335CBDE3FCCEEC9C342ADD83F"),
+		"GEOMETRYCOLLECTION (MULTIPOLYGON (((0.026231 -0.054196, 0.705853 0.474999, 0.761176 0.435659, -0.493449 -0.45437, 0.026231 -0.054196)), ((0.800166 -0.352871, -0.953553 -0.051465, 0.576759 0.405913, 0.351208 -0.957971, -0.796169 0.458432, 0.637103 -0.635565, 0.632761 0.9025609999999999, 0.20327 0.10344, 0.800166 -0.352871)), ((-0.066009 0.906909, -0.118278 -0.976179, 0.1342 -0.8637590000000001, 0.98404 0.319385, 0.438179 0.386975, -0.066009 0.906909), (-0.442119 -0.842571, -0.954358 -0.045649, 0.487404 0.478483, -0.994251 0.23332, 0.663565 0.7339, -0.442119 -0.842571), (-0.162239 0.407915, 0.403067 -0.871594, -0.922224 -0.307735, 0.286712 -0.238068, 0.28998 0.523026, 0.542755 -0.433634, 0.943475 0.107589, -0.162239 0.407915), (0.270417 0.346693, -0.691984 0.34916, -0.136925 0.93738, 0.428301 0.947981, 0.982121 0.668, 0.172386 0.201352, 0.270417 0.346693), (-0.260651 -0.162519, 0.826986 0.29261, -0.660146 -0.9254790000000001, -0.121698 -0.118268, -0.868351 -0.546486, -0.260651 -0.162519)), ((-0.246534 0.249808, -0.688018 0.643574, -0.005777 -0.86175, -0.800827 0.886348, -0.246534 0.249808)), ((-0.642026 0.308411, 0.9751880000000001 0.836409, -0.125487 -0.136993, -0.421121 -0.119172, 0.915848 -0.924281, -0.042329 0.791452, -0.642026 0.308411))), POINT (0.629542 -0.434348), MULTILINESTRING ((0.639668 -0.336361, -0.077389 -0.880297), (0.657906 -0.560488, 0.866413 0.26719, 0.09639499999999999 -0.588298, 0.7521369999999999 -0.152102, -0.914677 0.851557, -0.262305 -0.41082), (-0.933809 -0.913793, 0.749922 0.735761)), POLYGON ((-0.721494 0.895046, 0.121422 0.591184, -0.846336 -0.869362, -0.721494 0.895046), (0.6414029999999999 0.868899, -0.134338 -0.769739, 0.435245 -0.108628, 0.6414029999999999 0.868899), (0.7759740000000001 0.07971300000000001, -0.723607 -0.254977, 0.699323 0.022892, -0.838507 0.013087, -0.92981 0.758614, -0.503771 0.458356, 0.7759740000000001 0.07971300000000001), (0.79273 0.057258, -0.351706 0.397496, 0.093223 0.817605, -0.5156500000000001 0.38691, -0.69503 -0.189816, 0.554782 -0.2713, -0.432752 -0.294196, -0.252692 -0.984046, 0.79273 0.057258)), POINT (0.481153 0.385575))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-0.768336, -0.020896}), geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{0.931646, 0.634689}, {0.744434, -0.655616}, {0.313073, 0.626462}, {0.931646, 0.634689}}, {{-0.809851, 0.586458}, {-0.710778, -0.497374}, {-0.620963, -0.768521}, {0.341785, -0.514082}, {0.598506, 0.53889}, {0.914844, 0.549384}, {0.980062, 0.114652}, {-0.936019, -0.335983}, {-0.809851, 0.586458}}}), geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{-0.15774, -0.459658}, {0.599493, 0.839565}, {-0.906651, 0.961944}, {0.445362, 0.991505}, {0.297115, -0.984431}, {0.35665, -0.54654}, {0.976929, 0.864168}, {0.721517, 0.417321}, {-0.15774, -0.459658}}, {{0.64523, 0.267857}, {-0.658095, -0.601506}, {0.133839, 0.1866}, {-0.183978, 0.627038}, {0.356755, -0.483889}, {-0.939912, 0.213575}, {-0.341204, 0.779672}, {-0.531591, 0.097267}, {0.64523, 0.267857}}}), geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0.285879, 0.506167}, {0.781713, -0.092237}, {0.093481, -0.100722}, {0.238156, -0.330492}, {0.643928, -0.269565}, {0.542766, -0.966603}, {-0.27263, -0.040211}, {0.802104, 0.444733}}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{0.44984, 0.262949}, {0.841712, 0.528391}, {-0.970423, 0.737239}, {-0.623615, 0.49675}, {-0.173236, -0.21539}, {-0.692292, -0.142271}, {0.998888, 0.654581}, {0.473483, 0.285875}}, {{-0.873663, 0.865442}, {0.817095, -0.995563}, {0.822173, 0.497721}}, {{-0.691555, -0.647261}, {-0.486784, 0.805648}}, {{0.076954, -0.151313}, {-0.071805, -0.498387}, {-0.369758, -0.495101}, {-0.430205, 0.215842}}, {{0.874574, 0.027482}, {0.004246, -0.156244}}, {{0.52261, 0.44474}, {0.930794, -0.171006}, {-0.828714, 0.596672}, {0.424946, -0.9491}, {-0.014359, 0.619549}, {0.896539, -0.300639}, {-0.0565, -0.110191}}}), geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{{{-0.629964, 0.174282}, {-0.95002, -0.288506}, {-0.384277, -0.866631}, {-0.805213, 0.576633}, {-0.093687, -0.74257}}, {{-0.368705, -0.119546}, {0.389629, -0.690938}, {0.564995, 0.690641}, {-0.017496, 0.418913}, {0.446758, 0.983739}, {0.550496, -0.27846}, {0.021382, -0.212322}, {0.070211, 0.791531}}, {{0.788426, 0.007816}, {-0.709198, 0.867783}}, {{-0.946045, -0.058259}, {0.147339, -0.898919}, {0.257747, 0.126241}}, {{-0.838099, -0.286505}, {-0.738843, -0.064167}, {-0.258758, -0.340331}, {0.763597, 0.24849}, {-0.779888, 0.314737}}, {{-0.269416, 0.541047}, {0.314297, -0.049896}, {-0.190797, -0.572102}, {-0.164478, -0.466375}, {-0.027388, 0.338751}, {0.773734, -0.70915}, {0.619557, 0.523922}, {0.256139, -0.615851}}})),
+		"0107000000060000000101000000d3dc0a613596e8bf790778d2c26595bf01030000000200000004000000fa5fae450bd0ed3fb130444e5f4fe43f90f9804067d2e73fae7fd767cefae4bfcdad10566309d43fdff94509fa0be43ffa5fae450bd0ed3fb130444e5f4fe43f09000000af40f4a44ceae9bf3d484f9143c4e23fd5eb1681b1bee6bf745df8c1f9d4dfbf63d520cceddee3bf4241295ab997e8bfe4da5031cedfd53fefca2e185c73e0bf7cb60e0ef626e33fad86c43d963ee13f6c239eec6646ed3fe1421ec18d94e13fdeacc1fbaa5cef3f22c66b5ed559bd3f71e82d1edef3edbf15c440d7be80d5bfaf40f4a44ceae9bf3d484f9143c4e23f0103000000020000000900000070b1a206d330c4bfae105663096bddbf2acaa5f10b2fe33f3db83b6bb7ddea3f9f573cf54803edbf69ab92c83ec8ee3fcb64389ecf80dc3f5f419ab168baef3fa4aa09a2ee03d33ff964c5707580efbfde9387855ad3d63f3e963e74417de1bf8f72309b0043ef3fc6fd47a643a7eb3fcc41d0d1aa16e73febc6bb2363b5da3f70b1a206d330c4bfae105663096bddbf0900000012bd8c62b9a5e43f8c4cc0af9124d13fe527d53e1d0fe5bffb20cb82893fe3bfbff4f6e7a221c13f3480b74082e2c73f897c9752978cc7bf0a2debfeb110e43f85ce6bec12d5d63fa839799109f8debf43c6a354c213eebf2d211ff46c56cb3fabeb504d49d6d5bfd36bb3b112f3e83f4b22fb20cb02e1bf61e3fa777de6b83f12bd8c62b9a5e43f8c4cc0af9124d13f010200000008000000683ee76ed74bd23fd40fea228532e03f21753bfbca03e93fc72c7b12d89cb7bfd520cced5eeeb73fb2dafcbfeac8b9bf7f4fac53e57bce3f88bce5eac726d5bf69519fe40e9be43fff5bc98e8d40d1bffd2e6ccd565ee13f47e5266a69eeeebf89247a19c572d1bf6000e1438996a4bf81b4ff01d6aae93f0bed9c668176dc3f01050000000600000001020000000800000060b01bb62dcadc3f9204e10a28d4d03ff6d214014eefea3f0806103e94e8e03f9e280989b40defbf6abe4a3e7697e73ff870c971a7f4e3bf986e1283c0cadf3f9ab33ee5982cc6bf1956f146e691cbbfaf09698d4127e6bfdfa815a6ef35c2bf38bd8bf7e3f6ef3fbda772da53f2e43fe2900da48b4dde3fb29defa7c64bd23f0102000000030000003b35971b0cf5ebbff2b6d26bb3b1eb3f2f34d769a425ea3fda71c3efa6dbefbf46ef54c03d4fea3f1b13622ea9dadf3f01020000000200000037548cf33721e6bf7d3d5fb35cb6e4bffed30d147827dfbf53cf8250dec7e93f010200000004000000d2df4be141b3b33fbfd53a71395ec3bfa774b0fecf61b2bf6e19709692e5dfbfafcc5b751daad7bfa0e1cd1abcafdfbff1d7648d7a88dbbfb5368dedb5a0cb3f01020000000200000028d2fd9c82fceb3ffe28eacc3d249c3f209738f24064713fde1d19abcdffc3bf0102000000070000007e00529b38b9e03f4a46cec29e76dc3f4b04aa7f10c9ed3f38bbb54c86e3c5bf5298f738d384eabf910bcee0ef17e33f9b3924b55032db3f744694f6065feebf7ae2395b40688dbfc79fa86c58d3e33f5ad5928e72b0ec3fbabc395cab3dd3bf21b0726891edacbfe54350357a35bcbf010500000006000000010200000005000000f6083543aa28e4bf46072461df4ec63f2d78d1579066eebf21c9acdee176d2bf30b8e68efe97d8bf916456ef70bbebbfae0caa0d4ec4e9bf766b990cc773e23f3b730f09dffbb7bffab31f2922c3e7bf0102000000080000009bc937dbdc98d7bf151c5e10919abebf0caf2479aeefd83f680932022a1ce6bfa3e9ec647014e23f05db8827bb19e63f8d463eaf78ea91bfc2a1b77878cfda3fd07cceddae97dc3f81971936ca7aef3fa98592c9a99de13f51a04fe449d2d1bffd6ce4ba29e5953ff4531c075e2dcbbfdec7d11c59f9b13f48a5d8d13854e93f010200000002000000751daa29c93ae93fe69315c3d501803f7a6f0c01c0b1e6bfe6caa0dae0c4eb3f010200000003000000126bf1290046eebf373465a71fd4adbfac71361d01dcc23fc61858c7f1c3ecbf5d18e945ed7ed03ff6083543aa28c03f01020000000500000003ee79feb4d1eabfa0fd48111956d2bfe71c3c139aa4e7bfaa807b9e3f6db0bffb03e5b67d8fd0bf9128b4acfbc7d5bfd95bcaf9626fe83f7407b13385cecf3f035fd1add7f4e8bf6fd575a8a624d43f0102000000080000008639419b1c3ed1bf492a53cc4150e13f43c70e2a711dd43fc90567f0f78ba9bf8463963d096cc8bff73c7fdaa84ee2bfa4ab74779d0dc5bf0c022b8716d9ddbfc91d3691990b9cbfdc2f9fac18aed53f57b439ce6dc2e83f36ab3e575bb1e6bf7d40a03369d3e33f0af5f411f8c3e03faeba0ed59464d03f47af06280db5e3bf",
+		mustHexDecode("0107000000060000000101000000D3DC0A613596E8BF790778D2C26595BF01030000000200000004000000FA5FAE450BD0ED3FB130444E5F4FE43F90F9804067D2E73FAE7FD767CEFAE4BFCDAD10566309D43FDFF94509FA0BE43FFA5FAE450BD0ED3FB130444E5F4FE43F09000000AF40F4A44CEAE9BF3D484F9143C4E23FD5EB1681B1BEE6BF745DF8C1F9D4DFBF63D520CCEDDEE3BF4241295AB997E8BFE4DA5031CEDFD53FEFCA2E185C73E0BF7CB60E0EF626E33FAD86C43D963EE13F6C239EEC6646ED3FE1421EC18D94E13FDEACC1FBAA5CEF3F22C66B5ED559BD3F71E82D1EDEF3EDBF15C440D7BE80D5BFAF40F4A44CEAE9BF3D484F9143C4E23F0103000000020000000900000070B1A206D330C4BFAE105663096BDDBF2ACAA5F10B2FE33F3DB83B6BB7DDEA3F9F573CF54803EDBF69AB92C83EC8EE3FCB64389ECF80DC3F5F419AB168BAEF3FA4AA09A2EE03D33FF964C5707580EFBFDE9387855AD3D63F3E963E74417DE1BF8F72309B0043EF3FC6FD47A643A7EB3FCC41D0D1AA16E73FEBC6BB2363B5DA3F70B1A206D330C4BFAE105663096BDDBF0900000012BD8C62B9A5E43F8C4CC0AF9124D13FE527D53E1D0FE5BFFB20CB82893FE3BFBFF4F6E7A221C13F3480B74082E2C73F897C9752978CC7BF0A2DEBFEB110E43F85CE6BEC12D5D63FA839799109F8DEBF43C6A354C213EEBF2D211FF46C56CB3FABEB504D49D6D5BFD36BB3B112F3E83F4B22FB20CB02E1BF61E3FA777DE6B83F12BD8C62B9A5E43F8C4CC0AF9124D13F010200000008000000683EE76ED74BD23FD40FEA228532E03F21753BFBCA03E93FC72C7B12D89CB7BFD520CCED5EEEB73FB2DAFCBFEAC8B9BF7F4FAC53E57BCE3F88BCE5EAC726D5BF69519FE40E9BE43FFF5BC98E8D40D1BFFD2E6CCD565EE13F47E5266A69EEEEBF89247A19C572D1BF6000E1438996A4BF81B4FF01D6AAE93F0BED9C668176DC3F01050000000600000001020000000800000060B01BB62DCADC3F9204E10A28D4D03FF6D214014EEFEA3F0806103E94E8E03F9E280989B40DEFBF6ABE4A3E7697E73FF870C971A7F4E3BF986E1283C0CADF3F9AB33EE5982CC6BF1956F146E691CBBFAF09698D4127E6BFDFA815A6EF35C2BF38BD8BF7E3F6EF3FBDA772DA53F2E43FE2900DA48B4DDE3FB29DEFA7C64BD23F0102000000030000003B35971B0CF5EBBFF2B6D26BB3B1EB3F2F34D769A425EA3FDA71C3EFA6DBEFBF46EF54C03D4FEA3F1B13622EA9DADF3F01020000000200000037548CF33721E6BF7D3D5FB35CB6E4BFFED30D147827DFBF53CF8250DEC7E93F010200000004000000D2DF4BE141B3B33FBFD53A71395EC3BFA774B0FECF61B2BF6E19709692E5DFBFAFCC5B751DAAD7BFA0E1CD1ABCAFDFBFF1D7648D7A88DBBFB5368DEDB5A0CB3F01020000000200000028D2FD9C82FCEB3FFE28EACC3D249C3F209738F24064713FDE1D19ABCDFFC3BF0102000000070000007E00529B38B9E03F4A46CEC29E76DC3F4B04AA7F10C9ED3F38BBB54C86E3C5BF5298F738D384EABF910BCEE0EF17E33F9B3924B55032DB3F744694F6065FEEBF7AE2395B40688DBFC79FA86C58D3E33F5AD5928E72B0EC3FBABC395CAB3DD3BF21B0726891EDACBFE54350357A35BCBF010500000006000000010200000005000000F6083543AA28E4BF46072461DF4EC63F2D78D1579066EEBF21C9ACDEE176D2BF30B8E68EFE97D8BF916456EF70BBEBBFAE0CAA0D4EC4E9BF766B990CC773E23F3B730F09DFFBB7BFFAB31F2922C3E7BF0102000000080000009BC937DBDC98D7BF151C5E10919ABEBF0CAF2479AEEFD83F680932022A1CE6BFA3E9EC647014E23F05DB8827BB19E63F8D463EAF78EA91BFC2A1B77878CFDA3FD07CCEDDAE97DC3F81971936CA7AEF3FA98592C9A99DE13F51A04FE449D2D1BFFD6CE4BA29E5953FF4531C075E2DCBBFDEC7D11C59F9B13F48A5D8D13854E93F010200000002000000751DAA29C93AE93FE69315C3D501803F7A6F0C01C0B1E6BFE6CAA0DAE0C4EB3F010200000003000000126BF1290046EEBF373465A71FD4ADBFAC71361D01DCC23FC61858C7F1C3ECBF5D18E945ED7ED03FF6083543AA28C03F01020000000500000003EE79FEB4D1EABFA0FD48111956D2BFE71C3C139AA4E7BFAA807B9E3F6DB0BFFB03E5B67D8FD0BF9128B4ACFBC7D5BFD95BCAF9626FE83F7407B13385CECF3F035FD1ADD7F4E8BF6FD575A8A624D43F0102000000080000008639419B1C3ED1BF492A53CC4150E13F43C70E2A711DD43FC90567F0F78BA9BF8463963D096CC8BFF73C7FDAA84EE2BFA4AB74779D0DC5BF0C022B8716D9DDBFC91D3691990B9CBFDC2F9FAC18AED53F57B439CE6DC2E83F36AB3E575BB1E6BF7D40A03369D3E33F0AF5F411F8C3E03FAEBA0ED59464D03F47AF06280DB5E3BF"),
+		"GEOMETRYCOLLECTION (POINT (-0.768336 -0.020896), POLYGON ((0.931646 0.6346889999999999, 0.744434 -0.655616, 0.313073 0.626462, 0.931646 0.6346889999999999), (-0.809851 0.586458, -0.710778 -0.497374, -0.620963 -0.768521, 0.341785 -0.514082, 0.598506 0.53889, 0.914844 0.549384, 0.980062 0.114652, -0.936019 -0.335983, -0.809851 0.586458)), POLYGON ((-0.15774 -0.459658, 0.5994930000000001 0.839565, -0.906651 0.961944, 0.445362 0.991505, 0.297115 -0.9844309999999999, 0.35665 -0.54654, 0.976929 0.864168, 0.721517 0.417321, -0.15774 -0.459658), (0.64523 0.267857, -0.658095 -0.601506, 0.133839 0.1866, -0.183978 0.627038, 0.356755 -0.483889, -0.939912 0.213575, -0.341204 0.779672, -0.531591 0.09726700000000001, 0.64523 0.267857)), LINESTRING (0.285879 0.506167, 0.781713 -0.092237, 0.09348099999999999 -0.100722, 0.238156 -0.330492, 0.6439279999999999 -0.269565, 0.542766 -0.966603, -0.27263 -0.040211, 0.802104 0.444733), MULTILINESTRING ((0.44984 0.262949, 0.841712 0.5283910000000001, -0.970423 0.737239, -0.623615 0.49675, -0.173236 -0.21539, -0.692292 -0.142271, 0.998888 0.654581, 0.473483 0.285875), (-0.873663 0.865442, 0.817095 -0.995563, 0.822173 0.497721), (-0.691555 -0.647261, -0.486784 0.805648), (0.07695399999999999 -0.151313, -0.07180499999999999 -0.498387, -0.369758 -0.495101, -0.430205 0.215842), (0.874574 0.027482, 0.004246 -0.156244), (0.52261 0.44474, 0.930794 -0.171006, -0.828714 0.596672, 0.424946 -0.9491000000000001, -0.014359 0.619549, 0.896539 -0.300639, -0.0565 -0.110191)), MULTILINESTRING ((-0.629964 0.174282, -0.95002 -0.288506, -0.384277 -0.866631, -0.805213 0.576633, -0.09368700000000001 -0.74257), (-0.368705 -0.119546, 0.389629 -0.6909380000000001, 0.564995 0.6906409999999999, -0.017496 0.418913, 0.446758 0.983739, 0.550496 -0.27846, 0.021382 -0.212322, 0.070211 0.791531), (0.788426 0.007816, -0.709198 0.867783), (-0.946045 -0.058259, 0.147339 -0.898919, 0.257747 0.126241), (-0.838099 -0.286505, -0.738843 -0.064167, -0.258758 -0.340331, 0.763597 0.24849, -0.779888 0.314737), (-0.269416 0.5410469999999999, 0.314297 -0.049896, -0.190797 -0.572102, -0.164478 -0.466375, -0.027388 0.338751, 0.773734 -0.7091499999999999, 0.619557 0.523922, 0.256139 -0.615851)))",
+	},
+	{
+		geom.NewGeometryCollection().MustPush(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-0.356299, -0.417255})),
+		"0107000000010000000101000000813d26529acdd6bf31ebc5504eb4dabf",
+		mustHexDecode("0107000000010000000101000000813D26529ACDD6BF31EBC5504EB4DABF"),
+		"GEOMETRYCOLLECTION (POINT (-0.356299 -0.417255))",
+	},
+}
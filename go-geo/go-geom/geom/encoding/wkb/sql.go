@@ -1,7 +1,9 @@
 package wkb
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -18,6 +20,27 @@ func (e ErrExpectedByteSlice) Error() string {
 	return fmt.Sprintf("wkb: want []byte, got %T", e.Value)
 }
 
+// A Geom is a WKB-encoded geometry of any type that implements the sql.Scanner
+// and driver.Valuer interfaces.
+//
+// Scan accepts WKB or EWKB, either raw or hex-encoded, and retains any embedded
+// SRID. Value returns the EWKB encoding, so that a non-zero SRID is preserved.
+//
+// A NULL scans to a nil geometry, and a nil geometry is valued as NULL: use Nullable
+// to tell NULL apart explicitly.
+type Geom struct {
+	geom.T
+}
+
+// A Nullable is a WKB-encoded geometry of any type that may be NULL. It
+// implements the sql.Scanner and driver.Valuer interfaces.
+//
+// Valid is false when the value is NULL.
+type Nullable struct {
+	geom.T
+	Valid bool
+}
+
 // A Point is a WKB-encoded Point that implements the sql.Scanner and
 // driver.Valuer interfaces.
 type Point struct {
@@ -221,6 +244,88 @@ func (gc *GeometryCollection) Value() (driver.Value, error) {
 	return value(gc.GeometryCollection)
 }
 
+// Scan scans from a []byte, a string or NULL.
+func (g *Geom) Scan(src interface{}) error {
+	if src == nil {
+		g.T = nil
+		return nil
+	}
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	got, err := Unmarshal(b)
+	if err != nil {
+		return err
+	}
+	g.T = got
+	return nil
+}
+
+// Value returns the EWKB encoding of g.
+func (g *Geom) Value() (driver.Value, error) {
+	if g.T == nil {
+		return nil, nil
+	}
+	return ewkbValue(g.T)
+}
+
+// Scan scans from a []byte, a string or NULL.
+func (n *Nullable) Scan(src interface{}) error {
+	if src == nil {
+		n.T, n.Valid = nil, false
+		return nil
+	}
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	got, err := Unmarshal(b)
+	if err != nil {
+		return err
+	}
+	n.T, n.Valid = got, true
+	return nil
+}
+
+// Value returns the EWKB encoding of n, or nil if n is not valid.
+func (n *Nullable) Value() (driver.Value, error) {
+	if !n.Valid || n.T == nil {
+		return nil, nil
+	}
+	return ewkbValue(n.T)
+}
+
+// scanBytes returns the WKB held by src, decoding it first when some driver
+// has returned it as hexadecimal text.
+func scanBytes(src interface{}) ([]byte, error) {
+	var b []byte
+	switch src := src.(type) {
+	case []byte:
+		b = src
+	case string:
+		b = []byte(src)
+	default:
+		return nil, ErrExpectedByteSlice{Value: src}
+	}
+	// raw WKB starts with a byte order mark of 0 or 1, hex text starts with
+	// the ASCII digit '0' or the "\x" prefix of PostgreSQL bytea output.
+	if bytes.HasPrefix(b, []byte(`\x`)) {
+		b = b[2:]
+	} else if len(b) == 0 || b[0] != '0' {
+		return b, nil
+	}
+	decoded := make([]byte, hex.DecodedLen(len(b)))
+	if _, err := hex.Decode(decoded, b); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func ewkbValue(g geom.T) (driver.Value, error) {
+	return MarshalEWKB(g, NDR)
+}
+
 func value(g geom.T) (driver.Value, error) {
 	sb := &strings.Builder{}
 	if err := Write(sb, NDR, g); err != nil {
@@ -0,0 +1,87 @@
+package wkb
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestGeomScan(t *testing.T) {
+	const (
+		hexWKB  = "010100000052B81E85EB51C03F45F0BF95ECC04940"
+		hexEWKB = "0101000020E610000052B81E85EB51C03F45F0BF95ECC04940"
+	)
+	rawWKB, err := hex.DecodeString(hexWKB)
+	require.NoError(t, err)
+	rawEWKB, err := hex.DecodeString(hexEWKB)
+	require.NoError(t, err)
+	point := geom.NewPointFlat(geom.XY, []float64{0.1275, 51.50722})
+
+	for _, tc := range []struct {
+		name string
+		src  interface{}
+		srid int
+	}{
+		{name: "raw WKB", src: rawWKB},
+		{name: "raw EWKB", src: rawEWKB, srid: 4326},
+		{name: "hex WKB string", src: hexWKB},
+		{name: "hex EWKB bytes", src: []byte(hexEWKB), srid: 4326},
+		{name: "bytea hex output", src: `\x` + hexEWKB, srid: 4326},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var g Geom
+			require.NoError(t, g.Scan(tc.src))
+			assert.Equal(t, point.FlatCoords(), g.FlatCoords())
+			assert.Equal(t, tc.srid, g.SRID())
+
+			var n Nullable
+			require.NoError(t, n.Scan(tc.src))
+			assert.True(t, n.Valid)
+			assert.Equal(t, g.T, n.T)
+		})
+	}
+
+	g := Geom{T: point}
+	require.NoError(t, g.Scan(nil))
+	assert.Nil(t, g.T)
+	assert.Error(t, g.Scan(42))
+	assert.Error(t, g.Scan("0zz1"))
+
+	require.NoError(t, g.Scan(geomMustMarshal(t, geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4}))))
+	assert.IsType(t, &geom.LineString{}, g.T)
+}
+
+func TestNullableScanNull(t *testing.T) {
+	n := Nullable{T: geom.NewPointFlat(geom.XY, []float64{1, 2}), Valid: true}
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	assert.Nil(t, n.T)
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestGeomValue(t *testing.T) {
+	g := Geom{T: geom.NewPointFlat(geom.XY, []float64{0.1275, 51.50722}).SetSRID(4326)}
+	v, err := g.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "0101000020e610000052b81e85eb51c03f45f0bf95ecc04940", hex.EncodeToString(v.([]byte)))
+
+	var got Geom
+	require.NoError(t, got.Scan(v))
+	assert.Equal(t, g.T, got.T)
+
+	var _ driver.Valuer = &Nullable{}
+}
+
+func geomMustMarshal(t *testing.T, g geom.T) []byte {
+	b, err := Marshal(g, NDR)
+	require.NoError(t, err)
+	return b
+}
@@ -1,6 +1,7 @@
 package wkb_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"log"
 
@@ -8,7 +9,6 @@ import (
 
 	"github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/wkb"
-	"github.com/twpayne/go-geom/internal/geomtest"
 )
 
 func Example_scan() {
@@ -27,7 +27,7 @@ func Example_scan() {
 		WithArgs("London").
 		WillReturnRows(
 			sqlmock.NewRows([]string{"name", "location"}).
-				AddRow("London", geomtest.MustHexDecode("010100000052B81E85EB51C03F45F0BF95ECC04940")),
+				AddRow("London", mustHexDecode("010100000052B81E85EB51C03F45F0BF95ECC04940")),
 		)
 
 	var c City
@@ -56,7 +56,7 @@ func Example_value() {
 	defer db.Close()
 
 	mock.ExpectExec(`INSERT INTO cities \(name, location\) VALUES \(\?, \?\);`).
-		WithArgs("London", geomtest.MustHexDecode("010100000052B81E85EB51C03F45F0BF95ECC04940")).
+		WithArgs("London", mustHexDecode("010100000052B81E85EB51C03F45F0BF95ECC04940")).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	c := City{
@@ -74,3 +74,11 @@ func Example_value() {
 	// Output:
 	// 1 rows affected
 }
+
+func mustHexDecode(s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
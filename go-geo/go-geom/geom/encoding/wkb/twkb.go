@@ -0,0 +1,727 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+// TWKB (Tiny WKB) is a compact binary encoding, as produced by PostGIS ST_AsTWKB.
+//
+// Coordinates are stored as variable-length integers, scaled by a power of ten (the precision)
+// and delta-encoded against the previous coordinate.
+//
+// See https://github.com/TWKB/Specification/blob/master/twkb.md
+const (
+	twkbBBox     = 0x01
+	twkbSize     = 0x02
+	twkbIDList   = 0x04
+	twkbExtended = 0x08
+	twkbEmpty    = 0x10
+
+	twkbHasZ = 0x01
+	twkbHasM = 0x02
+
+	twkbMinPrecision   = -8
+	twkbMaxPrecision   = 7
+	twkbMaxZMPrecision = 7
+)
+
+// ErrTWKBPrecision is returned when the requested precision can't be represented in TWKB.
+type ErrTWKBPrecision int
+
+func (e ErrTWKBPrecision) Error() string {
+	return fmt.Sprintf("twkb: precision out of range: %d", int(e))
+}
+
+// ErrTWKBIDList is returned when the list of IDs does not match the number of
+// elements in a multi-geometry.
+type ErrTWKBIDList struct {
+	N   int
+	IDs int
+}
+
+func (e ErrTWKBIDList) Error() string {
+	return fmt.Sprintf("twkb: id list has %d elements, but geometry has %d", e.IDs, e.N)
+}
+
+type twkbOptions struct {
+	precision  int
+	zPrecision int
+	mPrecision int
+	bbox       bool
+	size       bool
+	ids        []int64
+}
+
+// A TWKBOption is a possible parameter to the TWKB encoder.
+type TWKBOption func(*twkbOptions)
+
+// WithTWKBPrecision sets the number of decimal digits retained for X and Y coordinates.
+//
+// Negative values round coordinates to tens, hundreds, etc. The precision must lie in [-8, 7].
+// The default is 0, like with ST_AsTWKB.
+func WithTWKBPrecision(precision int) TWKBOption {
+	return func(o *twkbOptions) {
+		o.precision = precision
+	}
+}
+
+// WithTWKBZPrecision sets the number of decimal digits retained for Z coordinates, in [0, 7].
+func WithTWKBZPrecision(precision int) TWKBOption {
+	return func(o *twkbOptions) {
+		o.zPrecision = precision
+	}
+}
+
+// WithTWKBMPrecision sets the number of decimal digits retained for M coordinates, in [0, 7].
+func WithTWKBMPrecision(precision int) TWKBOption {
+	return func(o *twkbOptions) {
+		o.mPrecision = precision
+	}
+}
+
+// WithTWKBBBox includes the bounding box of the geometry in the header.
+func WithTWKBBBox(yes bool) TWKBOption {
+	return func(o *twkbOptions) {
+		o.bbox = yes
+	}
+}
+
+// WithTWKBSize includes the size in bytes of the geometry in the header.
+func WithTWKBSize(yes bool) TWKBOption {
+	return func(o *twkbOptions) {
+		o.size = yes
+	}
+}
+
+// WithTWKBIDs attaches a list of identifiers to the elements of a multi-geometry or
+// geometry collection.
+//
+// The number of identifiers must match the number of elements. This option is ignored for
+// single geometries.
+func WithTWKBIDs(ids []int64) TWKBOption {
+	return func(o *twkbOptions) {
+		o.ids = ids
+	}
+}
+
+// WriteTWKB writes an arbitrary geometry to w, using the TWKB encoding.
+func WriteTWKB(w io.Writer, g geom.T, opts ...TWKBOption) error {
+	b, err := MarshalTWKB(g, opts...)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// MarshalTWKB marshals an arbitrary geometry to a []byte, using the TWKB encoding.
+func MarshalTWKB(g geom.T, opts ...TWKBOption) ([]byte, error) {
+	var o twkbOptions
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if o.precision < twkbMinPrecision || o.precision > twkbMaxPrecision {
+		return nil, ErrTWKBPrecision(o.precision)
+	}
+	if o.zPrecision < 0 || o.zPrecision > twkbMaxZMPrecision {
+		return nil, ErrTWKBPrecision(o.zPrecision)
+	}
+	if o.mPrecision < 0 || o.mPrecision > twkbMaxZMPrecision {
+		return nil, ErrTWKBPrecision(o.mPrecision)
+	}
+
+	e, err := newTWKBEncoder(g.Layout(), &o)
+	if err != nil {
+		return nil, err
+	}
+	return e.encode(g, true)
+}
+
+// ReadTWKB reads an arbitrary geometry from r, using the TWKB encoding.
+//
+// The reader does not consume more bytes than needed to decode the geometry.
+func ReadTWKB(r io.Reader) (geom.T, error) {
+	g, _, err := ReadTWKBWithIDs(r)
+	return g, err
+}
+
+// ReadTWKBWithIDs reads an arbitrary geometry from r, using the TWKB encoding,
+// and returns the list of identifiers of its elements, if any.
+func ReadTWKBWithIDs(r io.Reader) (geom.T, []int64, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = byteReader{r}
+	}
+	return readTWKB(br)
+}
+
+// UnmarshalTWKB unmarshals an arbitrary geometry from a []byte, using the TWKB encoding.
+func UnmarshalTWKB(data []byte) (geom.T, error) {
+	return ReadTWKB(bytes.NewReader(data))
+}
+
+type twkbEncoder struct {
+	opts    *twkbOptions
+	layout  geom.Layout
+	stride  int
+	factors [4]float64
+	prev    [4]int64
+
+	// bounding box of the scaled coordinates
+	hasBounds bool
+	min, max  [4]int64
+}
+
+func newTWKBEncoder(layout geom.Layout, o *twkbOptions) (*twkbEncoder, error) {
+	e := &twkbEncoder{opts: o, layout: layout}
+	e.factors[0] = math.Pow10(o.precision)
+	e.factors[1] = e.factors[0]
+	switch layout {
+	case geom.NoLayout, geom.XY:
+		e.stride = 2
+	case geom.XYZ:
+		e.stride = 3
+		e.factors[2] = math.Pow10(o.zPrecision)
+	case geom.XYM:
+		e.stride = 3
+		e.factors[2] = math.Pow10(o.mPrecision)
+	case geom.XYZM:
+		e.stride = 4
+		e.factors[2] = math.Pow10(o.zPrecision)
+		e.factors[3] = math.Pow10(o.mPrecision)
+	default:
+		return nil, geom.ErrUnsupportedLayout(layout)
+	}
+	return e, nil
+}
+
+func (e *twkbEncoder) encode(g geom.T, top bool) ([]byte, error) {
+	typ, err := geometryTypeID(g)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		body  []byte
+		empty = g.Empty()
+		ids   []int64
+	)
+	if g, ok := g.(*geom.GeometryCollection); ok {
+		// a collection of empty members is not empty: its members are kept
+		empty = g.NumGeoms() == 0
+	}
+	if top {
+		ids = e.opts.ids
+	}
+
+	if !empty {
+		switch g := g.(type) {
+		case *geom.Point:
+			body = e.appendCoords(body, g.FlatCoords())
+		case *geom.LineString:
+			body = e.appendCoords1(body, g.FlatCoords())
+		case *geom.Polygon:
+			body = e.appendCoords2(body, g.FlatCoords(), 0, g.Ends())
+		case *geom.MultiPoint:
+			n := g.NumPoints()
+			if body, err = appendIDs(body, ids, n); err != nil {
+				return nil, err
+			}
+			body = e.appendCoords(body, g.FlatCoords())
+		case *geom.MultiLineString:
+			ends := g.Ends()
+			if body, err = appendIDs(body, ids, len(ends)); err != nil {
+				return nil, err
+			}
+			offset := 0
+			for _, end := range ends {
+				body = e.appendCoords1(body, g.FlatCoords()[offset:end])
+				offset = end
+			}
+		case *geom.MultiPolygon:
+			endss := g.Endss()
+			if body, err = appendIDs(body, ids, len(endss)); err != nil {
+				return nil, err
+			}
+			offset := 0
+			for _, ends := range endss {
+				body = e.appendCoords2(body, g.FlatCoords(), offset, ends)
+				if len(ends) > 0 {
+					offset = ends[len(ends)-1]
+				}
+			}
+		case *geom.GeometryCollection:
+			if body, err = appendIDs(body, ids, g.NumGeoms()); err != nil {
+				return nil, err
+			}
+			for _, child := range g.Geoms() {
+				ce, err := newTWKBEncoder(child.Layout(), e.opts)
+				if err != nil {
+					return nil, err
+				}
+				b, err := ce.encode(child, false)
+				if err != nil {
+					return nil, err
+				}
+				body = append(body, b...)
+				e.extendBounds(ce)
+			}
+		}
+	}
+
+	var meta byte
+	if empty {
+		meta |= twkbEmpty
+	}
+	if len(ids) > 0 && !empty && typ >= wkbcommon.MultiPointID {
+		meta |= twkbIDList
+	}
+	withBBox := top && e.opts.bbox && !empty && e.hasBounds
+	if withBBox {
+		meta |= twkbBBox
+	}
+	withSize := top && e.opts.size
+	if withSize {
+		meta |= twkbSize
+	}
+
+	var ext byte
+	switch e.layout {
+	case geom.XYZ:
+		ext = twkbHasZ | byte(e.opts.zPrecision)<<2
+	case geom.XYM:
+		ext = twkbHasM | byte(e.opts.mPrecision)<<5
+	case geom.XYZM:
+		ext = twkbHasZ | twkbHasM | byte(e.opts.zPrecision)<<2 | byte(e.opts.mPrecision)<<5
+	}
+	if ext != 0 {
+		meta |= twkbExtended
+	}
+
+	header := make([]byte, 0, 3+binary.MaxVarintLen64)
+	header = append(header, byte(zigzag(int64(e.opts.precision))<<4)|byte(typ), meta)
+	if ext != 0 {
+		header = append(header, ext)
+	}
+
+	var bbox []byte
+	if withBBox {
+		for i := 0; i < e.stride; i++ {
+			bbox = appendVarint(bbox, e.min[i])
+			bbox = appendVarint(bbox, e.max[i]-e.min[i])
+		}
+	}
+	if withSize {
+		header = appendUvarint(header, uint64(len(bbox)+len(body)))
+	}
+
+	return append(append(header, bbox...), body...), nil
+}
+
+// appendCoords appends delta-encoded coordinates.
+func (e *twkbEncoder) appendCoords(b []byte, flatCoords []float64) []byte {
+	for i := 0; i < len(flatCoords); i += e.stride {
+		for j := 0; j < e.stride; j++ {
+			v := int64(math.Round(flatCoords[i+j] * e.factors[j]))
+			b = appendVarint(b, v-e.prev[j])
+			e.prev[j] = v
+			if !e.hasBounds || v < e.min[j] {
+				e.min[j] = v
+			}
+			if !e.hasBounds || v > e.max[j] {
+				e.max[j] = v
+			}
+		}
+		e.hasBounds = true
+	}
+	return b
+}
+
+// appendCoords1 appends a point array prefixed by its number of points.
+func (e *twkbEncoder) appendCoords1(b []byte, flatCoords []float64) []byte {
+	b = appendUvarint(b, uint64(len(flatCoords)/e.stride))
+	return e.appendCoords(b, flatCoords)
+}
+
+// appendCoords2 appends a set of rings prefixed by the number of rings.
+func (e *twkbEncoder) appendCoords2(b []byte, flatCoords []float64, offset int, ends []int) []byte {
+	b = appendUvarint(b, uint64(len(ends)))
+	for _, end := range ends {
+		b = e.appendCoords1(b, flatCoords[offset:end])
+		offset = end
+	}
+	return b
+}
+
+func (e *twkbEncoder) extendBounds(child *twkbEncoder) {
+	if !child.hasBounds {
+		return
+	}
+	for i := 0; i < e.stride && i < child.stride; i++ {
+		if !e.hasBounds || child.min[i] < e.min[i] {
+			e.min[i] = child.min[i]
+		}
+		if !e.hasBounds || child.max[i] > e.max[i] {
+			e.max[i] = child.max[i]
+		}
+	}
+	e.hasBounds = true
+}
+
+func appendIDs(b []byte, ids []int64, n int) ([]byte, error) {
+	b = appendUvarint(b, uint64(n))
+	if len(ids) == 0 {
+		return b, nil
+	}
+	if len(ids) != n {
+		return nil, ErrTWKBIDList{N: n, IDs: len(ids)}
+	}
+	for _, id := range ids {
+		b = appendVarint(b, id)
+	}
+	return b, nil
+}
+
+func geometryTypeID(g geom.T) (uint32, error) {
+	switch g.(type) {
+	case *geom.Point:
+		return wkbcommon.PointID, nil
+	case *geom.LineString:
+		return wkbcommon.LineStringID, nil
+	case *geom.Polygon:
+		return wkbcommon.PolygonID, nil
+	case *geom.MultiPoint:
+		return wkbcommon.MultiPointID, nil
+	case *geom.MultiLineString:
+		return wkbcommon.MultiLineStringID, nil
+	case *geom.MultiPolygon:
+		return wkbcommon.MultiPolygonID, nil
+	case *geom.GeometryCollection:
+		return wkbcommon.GeometryCollectionID, nil
+	default:
+		return 0, geom.ErrUnsupportedType{Value: g}
+	}
+}
+
+type twkbDecoder struct {
+	r          io.ByteReader
+	stride     int
+	precisions [4]int
+	prev       [4]int64
+}
+
+func readTWKB(r io.ByteReader) (geom.T, []int64, error) {
+	typePrecision, err := r.ReadByte()
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, err := r.ReadByte()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typ := uint32(typePrecision & 0x0f)
+	precision := unzigzag(uint64(typePrecision >> 4))
+
+	d := &twkbDecoder{r: r, stride: 2}
+	d.precisions[0] = int(precision)
+	d.precisions[1] = int(precision)
+
+	layout := geom.XY
+	if meta&twkbExtended != 0 {
+		ext, err := r.ReadByte()
+		if err != nil {
+			return nil, nil, err
+		}
+		zPrecision := int((ext >> 2) & 0x07)
+		mPrecision := int((ext >> 5) & 0x07)
+		switch ext & (twkbHasZ | twkbHasM) {
+		case twkbHasZ:
+			layout, d.stride = geom.XYZ, 3
+			d.precisions[2] = zPrecision
+		case twkbHasM:
+			layout, d.stride = geom.XYM, 3
+			d.precisions[2] = mPrecision
+		case twkbHasZ | twkbHasM:
+			layout, d.stride = geom.XYZM, 4
+			d.precisions[2] = zPrecision
+			d.precisions[3] = mPrecision
+		}
+	}
+
+	if meta&twkbSize != 0 {
+		if _, err := binary.ReadUvarint(r); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if meta&twkbBBox != 0 {
+		for i := 0; i < 2*d.stride; i++ {
+			if _, err := binary.ReadVarint(r); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if meta&twkbEmpty != 0 {
+		g, err := emptyGeometry(typ, layout)
+		return g, nil, err
+	}
+
+	withIDs := meta&twkbIDList != 0
+
+	switch typ {
+	case wkbcommon.PointID:
+		flatCoords, err := d.readCoords(1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return geom.NewPointFlat(layout, flatCoords), nil, nil
+
+	case wkbcommon.LineStringID:
+		flatCoords, err := d.readCoords1(nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return geom.NewLineStringFlat(layout, flatCoords), nil, nil
+
+	case wkbcommon.PolygonID:
+		flatCoords, ends, err := d.readCoords2(nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return geom.NewPolygonFlat(layout, flatCoords, ends), nil, nil
+
+	case wkbcommon.MultiPointID:
+		n, ids, err := d.readIDs(1, withIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		flatCoords, err := d.readCoords(n)
+		if err != nil {
+			return nil, nil, err
+		}
+		return geom.NewMultiPointFlat(layout, flatCoords), ids, nil
+
+	case wkbcommon.MultiLineStringID:
+		n, ids, err := d.readIDs(2, withIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		var (
+			flatCoords []float64
+			ends       []int
+		)
+		for i := 0; i < n; i++ {
+			if flatCoords, err = d.readCoords1(flatCoords); err != nil {
+				return nil, nil, err
+			}
+			ends = append(ends, len(flatCoords))
+		}
+		return geom.NewMultiLineStringFlat(layout, flatCoords, ends), ids, nil
+
+	case wkbcommon.MultiPolygonID:
+		n, ids, err := d.readIDs(3, withIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		var (
+			flatCoords []float64
+			endss      [][]int
+		)
+		for i := 0; i < n; i++ {
+			var ends []int
+			if flatCoords, ends, err = d.readCoords2(flatCoords, nil); err != nil {
+				return nil, nil, err
+			}
+			endss = append(endss, ends)
+		}
+		return geom.NewMultiPolygonFlat(layout, flatCoords, endss), ids, nil
+
+	case wkbcommon.GeometryCollectionID:
+		n, ids, err := d.readIDs(3, withIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		gc := geom.NewGeometryCollection()
+		for i := 0; i < n; i++ {
+			g, _, err := readTWKB(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := gc.Push(g); err != nil {
+				return nil, nil, err
+			}
+		}
+		return gc, ids, nil
+
+	default:
+		return nil, nil, wkbcommon.ErrUnknownType(typ)
+	}
+}
+
+func emptyGeometry(typ uint32, layout geom.Layout) (geom.T, error) {
+	switch typ {
+	case wkbcommon.PointID:
+		return geom.NewPointEmpty(layout), nil
+	case wkbcommon.LineStringID:
+		return geom.NewLineString(layout), nil
+	case wkbcommon.PolygonID:
+		return geom.NewPolygon(layout), nil
+	case wkbcommon.MultiPointID:
+		return geom.NewMultiPoint(layout), nil
+	case wkbcommon.MultiLineStringID:
+		return geom.NewMultiLineString(layout), nil
+	case wkbcommon.MultiPolygonID:
+		return geom.NewMultiPolygon(layout), nil
+	case wkbcommon.GeometryCollectionID:
+		return geom.NewGeometryCollection(), nil
+	default:
+		return nil, wkbcommon.ErrUnknownType(typ)
+	}
+}
+
+// maxInt is the largest int, reported for counts which overflow an int
+const maxInt = int(^uint(0) >> 1)
+
+// readCount reads a number of elements, checking it against the limits set in wkbcommon.
+func (d *twkbDecoder) readCount(level int) (int, error) {
+	n, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt32 {
+		count := maxInt
+		if n < uint64(maxInt) {
+			count = int(n)
+		}
+		return 0, wkbcommon.ErrGeometryTooLarge{Level: level, N: count, Limit: math.MaxInt32}
+	}
+	if limit := wkbcommon.MaxGeometryElements[level]; limit >= 0 && int(n) > limit {
+		return 0, wkbcommon.ErrGeometryTooLarge{Level: level, N: int(n), Limit: limit}
+	}
+	return int(n), nil
+}
+
+func (d *twkbDecoder) readIDs(level int, withIDs bool) (int, []int64, error) {
+	n, err := d.readCount(level)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !withIDs {
+		return n, nil, nil
+	}
+	ids := make([]int64, 0, capHint(n))
+	for i := 0; i < n; i++ {
+		id, err := binary.ReadVarint(d.r)
+		if err != nil {
+			return 0, nil, err
+		}
+		ids = append(ids, id)
+	}
+	return n, ids, nil
+}
+
+// readCoords reads n delta-encoded coordinates.
+func (d *twkbDecoder) readCoords(n int) ([]float64, error) {
+	flatCoords := make([]float64, 0, capHint(n*d.stride))
+	return d.appendCoords(flatCoords, n)
+}
+
+func (d *twkbDecoder) appendCoords(flatCoords []float64, n int) ([]float64, error) {
+	for i := 0; i < n; i++ {
+		for j := 0; j < d.stride; j++ {
+			delta, err := binary.ReadVarint(d.r)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+			d.prev[j] += delta
+			flatCoords = append(flatCoords, descale(d.prev[j], d.precisions[j]))
+		}
+	}
+	return flatCoords, nil
+}
+
+func (d *twkbDecoder) readCoords1(flatCoords []float64) ([]float64, error) {
+	n, err := d.readCount(1)
+	if err != nil {
+		return nil, err
+	}
+	return d.appendCoords(flatCoords, n)
+}
+
+func (d *twkbDecoder) readCoords2(flatCoords []float64, ends []int) ([]float64, []int, error) {
+	n, err := d.readCount(2)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := 0; i < n; i++ {
+		if flatCoords, err = d.readCoords1(flatCoords); err != nil {
+			return nil, nil, err
+		}
+		ends = append(ends, len(flatCoords))
+	}
+	return flatCoords, ends, nil
+}
+
+// capHint limits preallocations driven by untrusted counts.
+func capHint(n int) int {
+	const maxHint = 1 << 16
+	if n > maxHint {
+		return maxHint
+	}
+	return n
+}
+
+// descale converts a scaled integer back to a float, dividing rather than multiplying
+// by a negative power of ten to avoid rounding artifacts (e.g. 1234 with precision 2 yields 12.34).
+func descale(v int64, precision int) float64 {
+	if precision >= 0 {
+		return float64(v) / math.Pow10(precision)
+	}
+	return float64(v) * math.Pow10(-precision)
+}
+
+func appendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
+
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// byteReader reads one byte at a time from an io.Reader, without reading ahead.
+type byteReader struct {
+	io.Reader
+}
+
+func (b byteReader) ReadByte() (byte, error) {
+	var buf [1]byte
+	if _, err := io.ReadFull(b.Reader, buf[:]); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
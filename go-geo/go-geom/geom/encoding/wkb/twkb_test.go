@@ -0,0 +1,136 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/wkbcommon"
+)
+
+func TestTWKB(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		g    geom.T
+		opts []TWKBOption
+		twkb string
+	}{
+		{
+			name: "point",
+			g:    geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			twkb: "01000204",
+		},
+		{
+			name: "linestring",
+			g:    geom.NewLineStringFlat(geom.XY, []float64{1, 1, 5, 5}),
+			twkb: "02000202020808",
+		},
+		{
+			name: "linestring with bbox and size",
+			g:    geom.NewLineStringFlat(geom.XY, []float64{1, 1, 5, 5}),
+			opts: []TWKBOption{WithTWKBBBox(true), WithTWKBSize(true)},
+			twkb: "020309020802080202020808",
+		},
+		{
+			name: "point with precision",
+			g:    geom.NewPointFlat(geom.XY, []float64{12.34, -5.67}),
+			opts: []TWKBOption{WithTWKBPrecision(2)},
+			twkb: "4100a413ed08",
+		},
+		{
+			name: "empty linestring",
+			g:    geom.NewLineString(geom.XY),
+			twkb: "0210",
+		},
+		{
+			name: "point Z",
+			g:    geom.NewPointFlat(geom.XYZ, []float64{1, 2, 3.5}),
+			opts: []TWKBOption{WithTWKBZPrecision(1)},
+			twkb: "010805020446",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := MarshalTWKB(tc.g, tc.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.twkb, hex.EncodeToString(got))
+
+			g, err := UnmarshalTWKB(got)
+			require.NoError(t, err)
+			assert.Equal(t, tc.g, g)
+		})
+	}
+}
+
+func TestTWKBRoundTrip(t *testing.T) {
+	for _, g := range []geom.T{
+		geom.NewPolygonFlat(geom.XY, []float64{
+			0, 0, 10, 0, 10, 10, 0, 10, 0, 0,
+			1, 1, 2, 1, 2, 2, 1, 1,
+		}, []int{10, 18}),
+		geom.NewMultiPointFlat(geom.XYM, []float64{1, 2, 3, 4, 5, 6}),
+		geom.NewMultiLineStringFlat(geom.XYZM, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []int{4, 8}),
+		geom.NewMultiPolygonFlat(geom.XY, []float64{
+			0, 0, 1, 0, 1, 1, 0, 0,
+			5, 5, 6, 5, 6, 6, 5, 5,
+		}, [][]int{{8}, {16}}),
+		geom.NewGeometryCollection().MustPush(
+			geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			geom.NewLineStringFlat(geom.XYZ, []float64{3, 4, 5, 6, 7, 8}),
+		),
+		geom.NewGeometryCollection(),
+		geom.NewGeometryCollection().MustPush(
+			geom.NewPointEmpty(geom.XY),
+			geom.NewLineString(geom.XY),
+		),
+	} {
+		got, err := MarshalTWKB(g, WithTWKBBBox(true), WithTWKBSize(true))
+		require.NoError(t, err)
+
+		// streaming from a plain reader must not read ahead
+		r := bytes.NewBuffer(append(got, 0xff))
+		decoded, err := ReadTWKB(struct{ *bytes.Buffer }{r})
+		require.NoError(t, err)
+		assert.Equal(t, g, decoded)
+		assert.Equal(t, 1, r.Len())
+	}
+}
+
+func TestTWKBIDs(t *testing.T) {
+	g := geom.NewMultiPointFlat(geom.XY, []float64{1, 2, 3, 4})
+	ids := []int64{10, -20}
+
+	got, err := MarshalTWKB(g, WithTWKBIDs(ids))
+	require.NoError(t, err)
+
+	decoded, gotIDs, err := ReadTWKBWithIDs(bytes.NewReader(got))
+	require.NoError(t, err)
+	assert.Equal(t, g, decoded)
+	assert.Equal(t, ids, gotIDs)
+
+	_, err = MarshalTWKB(g, WithTWKBIDs([]int64{1}))
+	assert.Equal(t, ErrTWKBIDList{N: 2, IDs: 1}, err)
+}
+
+func TestTWKBErrors(t *testing.T) {
+	_, err := MarshalTWKB(geom.NewPointFlat(geom.XY, []float64{1, 2}), WithTWKBPrecision(8))
+	assert.Equal(t, ErrTWKBPrecision(8), err)
+
+	_, err = UnmarshalTWKB([]byte{0x02, 0x00, 0x02, 0x02})
+	assert.Error(t, err)
+
+	_, err = UnmarshalTWKB([]byte{0x09, 0x00})
+	assert.Error(t, err)
+
+	// a linestring of 1<<32 points
+	_, err = UnmarshalTWKB([]byte{0x02, 0x00, 0x80, 0x80, 0x80, 0x80, 0x10})
+	var tooLarge wkbcommon.ErrGeometryTooLarge
+	require.True(t, errors.As(err, &tooLarge), "got %v", err)
+	assert.EqualValues(t, uint64(1)<<32, tooLarge.N)
+	assert.EqualValues(t, math.MaxInt32, tooLarge.Limit)
+}
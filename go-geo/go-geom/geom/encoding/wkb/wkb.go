@@ -1,5 +1,10 @@
 // Package wkb implements Well Known Binary encoding and decoding.
 //
+// The PostGIS extensions EWKB (with an embedded SRID) and TWKB (Tiny WKB) are supported as well.
+//
+// This package works with github.com/twpayne/go-geom geometries: the geometries of the
+// geom package are encoded by the native sub-package.
+//
 // If you are encoding geometries in WKB to send to PostgreSQL/PostGIS, then
 // you must specify binary_parameters=yes in the data source name that you pass
 // to sql.Open.
@@ -29,11 +34,31 @@ const (
 )
 
 // Read reads an arbitrary geometry from r.
+//
+// Both ISO WKB and PostGIS EWKB type codes are supported. When the input is EWKB with
+// an embedded SRID, the SRID is set on the returned geometry.
 func Read(r io.Reader) (geom.T, error) {
-	wkbByteOrder, err := wkbcommon.ReadByte(r)
+	byteOrder, t, layout, srid, err := readHeader(r)
 	if err != nil {
 		return nil, err
 	}
+	g, err := readBody(r, byteOrder, t, layout)
+	if err != nil {
+		return nil, err
+	}
+	if srid != 0 {
+		return setSRID(g, srid), nil
+	}
+	return g, nil
+}
+
+// readHeader reads the byte order and the geometry type of a WKB or EWKB geometry,
+// as well as the SRID for EWKB.
+func readHeader(r io.Reader) (binary.ByteOrder, uint32, geom.Layout, int, error) {
+	wkbByteOrder, err := wkbcommon.ReadByte(r)
+	if err != nil {
+		return nil, 0, geom.NoLayout, 0, err
+	}
 	var byteOrder binary.ByteOrder
 	switch wkbByteOrder {
 	case wkbcommon.XDRID:
@@ -41,13 +66,39 @@ func Read(r io.Reader) (geom.T, error) {
 	case wkbcommon.NDRID:
 		byteOrder = NDR
 	default:
-		return nil, wkbcommon.ErrUnknownByteOrder(wkbByteOrder)
+		return nil, 0, geom.NoLayout, 0, wkbcommon.ErrUnknownByteOrder(wkbByteOrder)
 	}
 
 	wkbGeometryType, err := wkbcommon.ReadUInt32(r, byteOrder)
 	if err != nil {
-		return nil, err
+		return nil, 0, geom.NoLayout, 0, err
+	}
+
+	t, layout, hasSRID, err := decodeType(wkbGeometryType)
+	if err != nil {
+		return nil, 0, geom.NoLayout, 0, err
+	}
+
+	var srid int
+	if hasSRID {
+		s, err := wkbcommon.ReadUInt32(r, byteOrder)
+		if err != nil {
+			return nil, 0, geom.NoLayout, 0, err
+		}
+		srid = int(int32(s))
 	}
+
+	return byteOrder, t, layout, srid, nil
+}
+
+// decodeType determines the base geometry type and the layout from a WKB or EWKB geometry type code.
+//
+// For EWKB, it also tells if an SRID follows.
+func decodeType(wkbGeometryType uint32) (uint32, geom.Layout, bool, error) {
+	if wkbGeometryType&ewkbFlags != 0 {
+		return decodeEWKBType(wkbGeometryType)
+	}
+
 	t := wkbcommon.Type(wkbGeometryType)
 
 	layout := geom.NoLayout
@@ -61,10 +112,15 @@ func Read(r io.Reader) (geom.T, error) {
 	case wkbXYZMID:
 		layout = geom.XYZM
 	default:
-		return nil, wkbcommon.ErrUnknownType(t)
+		return 0, geom.NoLayout, false, wkbcommon.ErrUnknownType(t)
 	}
 
-	switch t % 1000 {
+	return wkbGeometryType % 1000, layout, false, nil
+}
+
+// readBody reads the geometry which type has been determined from the header.
+func readBody(r io.Reader, byteOrder binary.ByteOrder, t uint32, layout geom.Layout) (geom.T, error) {
+	switch t {
 	case wkbcommon.PointID:
 		flatCoords, err := wkbcommon.ReadFlatCoords0(r, byteOrder, layout.Stride())
 		if err != nil {
@@ -169,7 +225,7 @@ func Read(r io.Reader) (geom.T, error) {
 		}
 		return gc, nil
 	default:
-		return nil, wkbcommon.ErrUnsupportedType(wkbGeometryType)
+		return nil, wkbcommon.ErrUnsupportedType(t)
 	}
 }
 
@@ -180,6 +236,14 @@ func Unmarshal(data []byte) (geom.T, error) {
 
 // Write writes an arbitrary geometry to w.
 func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
+	return write(w, byteOrder, g, isoDialect, 0)
+}
+
+// write writes an arbitrary geometry to w, with geometry type codes
+// following the ISO or the EWKB dialect.
+//
+// The SRID is only written for EWKB when not zero.
+func write(w io.Writer, byteOrder binary.ByteOrder, g geom.T, d dialect, srid int) error {
 	var wkbByteOrder byte
 	switch byteOrder {
 	case XDR:
@@ -212,25 +276,34 @@ func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
 	default:
 		return geom.ErrUnsupportedType{Value: g}
 	}
-	switch g.Layout() {
-	case geom.NoLayout:
+	if g.Layout() == geom.NoLayout {
 		// Special case for empty GeometryCollections
 		if g, ok := g.(*geom.GeometryCollection); !ok || !g.Empty() {
 			return geom.ErrUnsupportedLayout(g.Layout())
 		}
-	case geom.XY:
-		wkbGeometryType += wkbXYID
-	case geom.XYZ:
-		wkbGeometryType += wkbXYZID
-	case geom.XYM:
-		wkbGeometryType += wkbXYMID
-	case geom.XYZM:
-		wkbGeometryType += wkbXYZMID
-	default:
-		return geom.ErrUnsupportedLayout(g.Layout())
 	}
-	if err := wkbcommon.WriteUInt32(w, byteOrder, wkbGeometryType); err != nil {
-		return err
+	switch d {
+	case ewkbDialect:
+		if err := writeEWKBHeader(w, byteOrder, wkbGeometryType, g.Layout(), srid); err != nil {
+			return err
+		}
+	default:
+		switch g.Layout() {
+		case geom.NoLayout:
+		case geom.XY:
+			wkbGeometryType += wkbXYID
+		case geom.XYZ:
+			wkbGeometryType += wkbXYZID
+		case geom.XYM:
+			wkbGeometryType += wkbXYMID
+		case geom.XYZM:
+			wkbGeometryType += wkbXYZMID
+		default:
+			return geom.ErrUnsupportedLayout(g.Layout())
+		}
+		if err := wkbcommon.WriteUInt32(w, byteOrder, wkbGeometryType); err != nil {
+			return err
+		}
 	}
 
 	switch g := g.(type) {
@@ -246,7 +319,7 @@ func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
 			return err
 		}
 		for i := 0; i < n; i++ {
-			if err := Write(w, byteOrder, g.Point(i)); err != nil {
+			if err := write(w, byteOrder, g.Point(i), d, 0); err != nil {
 				return err
 			}
 		}
@@ -257,7 +330,7 @@ func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
 			return err
 		}
 		for i := 0; i < n; i++ {
-			if err := Write(w, byteOrder, g.LineString(i)); err != nil {
+			if err := write(w, byteOrder, g.LineString(i), d, 0); err != nil {
 				return err
 			}
 		}
@@ -268,7 +341,7 @@ func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
 			return err
 		}
 		for i := 0; i < n; i++ {
-			if err := Write(w, byteOrder, g.Polygon(i)); err != nil {
+			if err := write(w, byteOrder, g.Polygon(i), d, 0); err != nil {
 				return err
 			}
 		}
@@ -279,7 +352,7 @@ func Write(w io.Writer, byteOrder binary.ByteOrder, g geom.T) error {
 			return err
 		}
 		for i := 0; i < n; i++ {
-			if err := Write(w, byteOrder, g.Geom(i)); err != nil {
+			if err := write(w, byteOrder, g.Geom(i), d, 0); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,632 @@
+package wkt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+)
+
+type tokenKind uint8
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokNumber
+	tokLeftParen
+	tokRightParen
+	tokComma
+	tokSemicolon
+	tokEqual
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokEOF:
+		return "end of input"
+	case tokWord:
+		return "keyword"
+	case tokNumber:
+		return "number"
+	case tokLeftParen:
+		return "'('"
+	case tokRightParen:
+		return "')'"
+	case tokComma:
+		return "','"
+	case tokSemicolon:
+		return "';'"
+	case tokEqual:
+		return "'='"
+	default:
+		return "unknown token"
+	}
+}
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokWord, tokNumber:
+		return fmt.Sprintf("%s %q", t.kind, t.text)
+	default:
+		return t.kind.String()
+	}
+}
+
+// parser is a recursive descent parser for (E)WKT, with a one-token lookahead.
+type parser struct {
+	input string
+	pos   int
+	tok   token
+	err   error
+}
+
+func newParser(input string) *parser {
+	p := &parser{input: input}
+	p.next()
+	return p
+}
+
+func (p *parser) parse() (geom.T, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	srid, err := p.parseSRID()
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := p.parseGeometry(geom.NoLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != tokEOF {
+		return nil, p.unexpected("end of input")
+	}
+
+	if srid != 0 {
+		return setSRID(g, srid), nil
+	}
+	return g, nil
+}
+
+// parseSRID parses the optional EWKT "SRID=...;" prefix.
+func (p *parser) parseSRID() (int, error) {
+	if p.tok.kind != tokWord || !strings.EqualFold(p.tok.text, tSRID) {
+		return 0, nil
+	}
+	if err := p.advance(); err != nil {
+		return 0, err
+	}
+	if err := p.expect(tokEqual); err != nil {
+		return 0, err
+	}
+	if p.tok.kind != tokNumber {
+		return 0, p.unexpected("SRID value")
+	}
+	srid, err := strconv.Atoi(p.tok.text)
+	if err != nil {
+		return 0, p.errorf(p.tok.pos, "invalid SRID %q", p.tok.text)
+	}
+	if err := p.advance(); err != nil {
+		return 0, err
+	}
+	if err := p.expect(tokSemicolon); err != nil {
+		return 0, err
+	}
+	return srid, nil
+}
+
+// parseGeometry parses a tagged geometry.
+//
+// The parent layout is used when parsing the children of a geometry collection:
+// children must agree with the layout declared by their parent.
+func (p *parser) parseGeometry(parent geom.Layout) (geom.T, error) {
+	if p.tok.kind != tokWord {
+		return nil, p.unexpected("geometry type")
+	}
+	start := p.tok.pos
+	typ, layout, ok := splitTag(strings.ToUpper(p.tok.text))
+	if !ok {
+		return nil, p.errorf(start, "unknown geometry type %q", p.tok.text)
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	// optional dimension qualifier
+	if p.tok.kind == tokWord && layout == geom.NoLayout {
+		switch strings.ToUpper(p.tok.text) {
+		case tZ:
+			layout = geom.XYZ
+		case tM:
+			layout = geom.XYM
+		case tZM:
+			layout = geom.XYZM
+		}
+		if layout != geom.NoLayout {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if parent != geom.NoLayout {
+		if layout == geom.NoLayout {
+			layout = parent
+		} else if layout != parent {
+			return nil, p.errorf(start, "layout %s does not match enclosing layout %s", layout, parent)
+		}
+	}
+
+	empty, err := p.parseEmpty()
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case tPoint:
+		if empty {
+			return geom.NewPointEmpty(defaultLayout(layout)), nil
+		}
+		if err := p.expect(tokLeftParen); err != nil {
+			return nil, err
+		}
+		flatCoords, err := p.parseCoord(&layout)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRightParen); err != nil {
+			return nil, err
+		}
+		return geom.NewPointFlat(layout, flatCoords), nil
+
+	case tLineString:
+		if empty {
+			return geom.NewLineString(defaultLayout(layout)), nil
+		}
+		flatCoords, err := p.parseCoords1(&layout, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewLineStringFlat(layout, flatCoords), nil
+
+	case tPolygon:
+		if empty {
+			return geom.NewPolygon(defaultLayout(layout)), nil
+		}
+		flatCoords, ends, err := p.parseCoords2(&layout, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewPolygonFlat(layout, flatCoords, ends), nil
+
+	case tMultiPoint:
+		if empty {
+			return geom.NewMultiPoint(defaultLayout(layout)), nil
+		}
+		flatCoords, err := p.parseMultiPoint(&layout)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPointFlat(layout, flatCoords), nil
+
+	case tMultiLineString:
+		if empty {
+			return geom.NewMultiLineString(defaultLayout(layout)), nil
+		}
+		flatCoords, ends, err := p.parseCoords2(&layout, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiLineStringFlat(layout, flatCoords, ends), nil
+
+	case tMultiPolygon:
+		if empty {
+			return geom.NewMultiPolygon(defaultLayout(layout)), nil
+		}
+		flatCoords, endss, err := p.parseCoords3(&layout)
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPolygonFlat(layout, flatCoords, endss), nil
+
+	case tGeometryCollection:
+		gc := geom.NewGeometryCollection()
+		if empty {
+			if layout != geom.NoLayout && layout != geom.XY {
+				return &EmptyGeometryCollection{GeometryCollection: gc, layout: layout}, nil
+			}
+			return gc, nil
+		}
+		if err := p.expect(tokLeftParen); err != nil {
+			return nil, err
+		}
+		for {
+			child, err := p.parseGeometry(layout)
+			if err != nil {
+				return nil, err
+			}
+			if err := gc.Push(child); err != nil {
+				return nil, err
+			}
+			if p.tok.kind != tokComma {
+				break
+			}
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expect(tokRightParen); err != nil {
+			return nil, err
+		}
+		return gc, nil
+
+	default:
+		return nil, p.errorf(start, "unknown geometry type %q", typ)
+	}
+}
+
+// parseEmpty consumes the EMPTY keyword, if present.
+func (p *parser) parseEmpty() (bool, error) {
+	if p.tok.kind != tokWord {
+		return false, nil
+	}
+	if !strings.EqualFold(p.tok.text, tEmpty) {
+		return false, p.unexpected("EMPTY or '('")
+	}
+	return true, p.advance()
+}
+
+// parseCoord parses a single coordinate, e.g. "1 2 3".
+//
+// If the layout is not known yet, it is inferred from the number of ordinates.
+func (p *parser) parseCoord(layout *geom.Layout) ([]float64, error) {
+	start := p.tok.pos
+	if p.tok.kind != tokNumber {
+		return nil, p.unexpected("coordinate")
+	}
+	coord := make([]float64, 0, 4)
+	for p.tok.kind == tokNumber {
+		coord = append(coord, p.tok.value)
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if *layout == geom.NoLayout {
+		switch len(coord) {
+		case 2:
+			*layout = geom.XY
+		case 3:
+			*layout = geom.XYZ
+		case 4:
+			*layout = geom.XYZM
+		default:
+			return nil, p.errorf(start, "invalid number of ordinates: %d", len(coord))
+		}
+	}
+	if len(coord) != layout.Stride() {
+		return nil, p.errorf(start, "expected %d ordinates for layout %s, got %d", layout.Stride(), *layout, len(coord))
+	}
+	return coord, nil
+}
+
+// parseCoords1 parses a parenthesized list of coordinates, e.g. "(1 2, 3 4)".
+func (p *parser) parseCoords1(layout *geom.Layout, flatCoords []float64) ([]float64, error) {
+	if err := p.expect(tokLeftParen); err != nil {
+		return nil, err
+	}
+	for {
+		coord, err := p.parseCoord(layout)
+		if err != nil {
+			return nil, err
+		}
+		flatCoords = append(flatCoords, coord...)
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect(tokRightParen); err != nil {
+		return nil, err
+	}
+	return flatCoords, nil
+}
+
+// parseCoords2 parses a parenthesized list of lists of coordinates, e.g. "((1 2, 3 4),(5 6, 7 8))".
+//
+// Inner lists may be EMPTY.
+func (p *parser) parseCoords2(layout *geom.Layout, flatCoords []float64, ends []int) ([]float64, []int, error) {
+	if err := p.expect(tokLeftParen); err != nil {
+		return nil, nil, err
+	}
+	for {
+		empty, err := p.parseEmpty()
+		if err != nil {
+			return nil, nil, err
+		}
+		if !empty {
+			if flatCoords, err = p.parseCoords1(layout, flatCoords); err != nil {
+				return nil, nil, err
+			}
+		}
+		ends = append(ends, len(flatCoords))
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := p.expect(tokRightParen); err != nil {
+		return nil, nil, err
+	}
+	return flatCoords, ends, nil
+}
+
+// parseCoords3 parses a parenthesized list of polygons.
+func (p *parser) parseCoords3(layout *geom.Layout) ([]float64, [][]int, error) {
+	if err := p.expect(tokLeftParen); err != nil {
+		return nil, nil, err
+	}
+	var (
+		flatCoords []float64
+		endss      [][]int
+	)
+	for {
+		empty, err := p.parseEmpty()
+		if err != nil {
+			return nil, nil, err
+		}
+		var ends []int
+		if !empty {
+			if flatCoords, ends, err = p.parseCoords2(layout, flatCoords, nil); err != nil {
+				return nil, nil, err
+			}
+		}
+		endss = append(endss, ends)
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := p.expect(tokRightParen); err != nil {
+		return nil, nil, err
+	}
+	return flatCoords, endss, nil
+}
+
+// parseMultiPoint parses the points of a MULTIPOINT, with or without parentheses
+// around each point, e.g. "((1 2),(3 4))" or "(1 2, 3 4)".
+func (p *parser) parseMultiPoint(layout *geom.Layout) ([]float64, error) {
+	if err := p.expect(tokLeftParen); err != nil {
+		return nil, err
+	}
+	var flatCoords []float64
+	for {
+		switch p.tok.kind {
+		case tokLeftParen:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			coord, err := p.parseCoord(layout)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(tokRightParen); err != nil {
+				return nil, err
+			}
+			flatCoords = append(flatCoords, coord...)
+		case tokWord:
+			if strings.EqualFold(p.tok.text, tEmpty) {
+				return nil, p.errorf(p.tok.pos, "empty points are not supported in MULTIPOINT")
+			}
+			return nil, p.unexpected("point")
+		default:
+			coord, err := p.parseCoord(layout)
+			if err != nil {
+				return nil, err
+			}
+			flatCoords = append(flatCoords, coord...)
+		}
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect(tokRightParen); err != nil {
+		return nil, err
+	}
+	return flatCoords, nil
+}
+
+// expect consumes a token of the given kind, or fails.
+func (p *parser) expect(kind tokenKind) error {
+	if p.tok.kind != kind {
+		return p.unexpected(kind.String())
+	}
+	return p.advance()
+}
+
+// advance moves to the next token and reports any lexing error.
+func (p *parser) advance() error {
+	p.next()
+	return p.err
+}
+
+// next scans the next token from the input.
+func (p *parser) next() {
+	for p.pos < len(p.input) && isSpace(p.input[p.pos]) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.tok = token{kind: tokEOF, pos: start}
+		return
+	}
+
+	c := p.input[p.pos]
+	switch {
+	case c == '(':
+		p.pos++
+		p.tok = token{kind: tokLeftParen, text: "(", pos: start}
+	case c == ')':
+		p.pos++
+		p.tok = token{kind: tokRightParen, text: ")", pos: start}
+	case c == ',':
+		p.pos++
+		p.tok = token{kind: tokComma, text: ",", pos: start}
+	case c == ';':
+		p.pos++
+		p.tok = token{kind: tokSemicolon, text: ";", pos: start}
+	case c == '=':
+		p.pos++
+		p.tok = token{kind: tokEqual, text: "=", pos: start}
+	case isLetter(c):
+		for p.pos < len(p.input) && isLetter(p.input[p.pos]) {
+			p.pos++
+		}
+		p.tok = token{kind: tokWord, text: p.input[start:p.pos], pos: start}
+	case isNumberStart(c):
+		p.pos++
+		for p.pos < len(p.input) && isNumberPart(p.input[p.pos], p.input[p.pos-1]) {
+			p.pos++
+		}
+		text := p.input[start:p.pos]
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			p.tok = token{kind: tokEOF, pos: start}
+			p.err = p.errorf(start, "invalid number %q", text)
+			return
+		}
+		p.tok = token{kind: tokNumber, text: text, value: value, pos: start}
+	default:
+		p.tok = token{kind: tokEOF, pos: start}
+		p.err = p.errorf(start, "unexpected character %q", c)
+	}
+}
+
+func (p *parser) unexpected(want string) error {
+	if p.err != nil {
+		return p.err
+	}
+	return p.errorf(p.tok.pos, "expected %s, got %s", want, p.tok)
+}
+
+// errorf builds an ErrSyntax, computing the line and column from the byte offset.
+func (p *parser) errorf(offset int, format string, args ...interface{}) error {
+	line, column := 1, 1
+	for _, c := range p.input[:offset] {
+		if c == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return ErrSyntax{
+		Offset: offset,
+		Line:   line,
+		Column: column,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// splitTag splits a geometry type from an attached dimension qualifier,
+// as found in EWKT (e.g. "POINTM", "LINESTRINGZ").
+func splitTag(word string) (string, geom.Layout, bool) {
+	for _, typ := range []string{
+		tGeometryCollection,
+		tMultiLineString,
+		tMultiPolygon,
+		tMultiPoint,
+		tLineString,
+		tPolygon,
+		tPoint,
+	} {
+		if !strings.HasPrefix(word, typ) {
+			continue
+		}
+		switch word[len(typ):] {
+		case "":
+			return typ, geom.NoLayout, true
+		case tZ:
+			return typ, geom.XYZ, true
+		case tM:
+			return typ, geom.XYM, true
+		case tZM:
+			return typ, geom.XYZM, true
+		default:
+			return "", geom.NoLayout, false
+		}
+	}
+	return "", geom.NoLayout, false
+}
+
+func defaultLayout(layout geom.Layout) geom.Layout {
+	if layout == geom.NoLayout {
+		return geom.XY
+	}
+	return layout
+}
+
+func setSRID(g geom.T, srid int) geom.T {
+	switch g := g.(type) {
+	case *geom.Point:
+		return g.SetSRID(srid)
+	case *geom.LineString:
+		return g.SetSRID(srid)
+	case *geom.Polygon:
+		return g.SetSRID(srid)
+	case *geom.MultiPoint:
+		return g.SetSRID(srid)
+	case *geom.MultiLineString:
+		return g.SetSRID(srid)
+	case *geom.MultiPolygon:
+		return g.SetSRID(srid)
+	case *geom.GeometryCollection:
+		return g.SetSRID(srid)
+	case *EmptyGeometryCollection:
+		g.GeometryCollection.SetSRID(srid)
+		return g
+	default:
+		return g
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNumberStart(c byte) bool {
+	return (c >= '0' && c <= '9') || c == '-' || c == '+' || c == '.'
+}
+
+// isNumberPart tells if c continues a number after prev: a sign only continues an exponent,
+// so that "1-2" is scanned as two numbers.
+func isNumberPart(c, prev byte) bool {
+	if c == '-' || c == '+' {
+		return prev == 'e' || prev == 'E'
+	}
+	return (c >= '0' && c <= '9') || c == '.' || c == 'e' || c == 'E'
+}
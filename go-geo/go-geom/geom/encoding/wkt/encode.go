@@ -0,0 +1,170 @@
+package wkt
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+)
+
+func encode(sb *strings.Builder, g geom.T) error {
+	switch g := g.(type) {
+	case *geom.Point:
+		if err := writeTag(sb, tPoint, g.Layout()); err != nil {
+			return err
+		}
+		if g.Empty() {
+			sb.WriteString(tEmpty)
+			return nil
+		}
+		sb.WriteByte('(')
+		writeFlatCoords0(sb, g.FlatCoords())
+		sb.WriteByte(')')
+	case *geom.LineString:
+		if err := writeTag(sb, tLineString, g.Layout()); err != nil {
+			return err
+		}
+		writeFlatCoords1(sb, g.FlatCoords(), g.Stride())
+	case *geom.LinearRing:
+		// a linear ring has no WKT representation on its own: encode it as a line string
+		if err := writeTag(sb, tLineString, g.Layout()); err != nil {
+			return err
+		}
+		writeFlatCoords1(sb, g.FlatCoords(), g.Stride())
+	case *geom.Polygon:
+		if err := writeTag(sb, tPolygon, g.Layout()); err != nil {
+			return err
+		}
+		writeFlatCoords2(sb, g.FlatCoords(), 0, g.Ends(), g.Stride())
+	case *geom.MultiPoint:
+		if err := writeTag(sb, tMultiPoint, g.Layout()); err != nil {
+			return err
+		}
+		if g.Empty() {
+			sb.WriteString(tEmpty)
+			return nil
+		}
+		flatCoords, stride := g.FlatCoords(), g.Stride()
+		sb.WriteByte('(')
+		for i := 0; i < len(flatCoords); i += stride {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteByte('(')
+			writeFlatCoords0(sb, flatCoords[i:i+stride])
+			sb.WriteByte(')')
+		}
+		sb.WriteByte(')')
+	case *geom.MultiLineString:
+		if err := writeTag(sb, tMultiLineString, g.Layout()); err != nil {
+			return err
+		}
+		writeFlatCoords2(sb, g.FlatCoords(), 0, g.Ends(), g.Stride())
+	case *geom.MultiPolygon:
+		if err := writeTag(sb, tMultiPolygon, g.Layout()); err != nil {
+			return err
+		}
+		endss := g.Endss()
+		if len(endss) == 0 {
+			sb.WriteString(tEmpty)
+			return nil
+		}
+		flatCoords, stride := g.FlatCoords(), g.Stride()
+		sb.WriteByte('(')
+		offset := 0
+		for i, ends := range endss {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeFlatCoords2(sb, flatCoords, offset, ends, stride)
+			if len(ends) > 0 {
+				offset = ends[len(ends)-1]
+			}
+		}
+		sb.WriteByte(')')
+	case *geom.GeometryCollection:
+		if err := writeTag(sb, tGeometryCollection, g.Layout()); err != nil {
+			return err
+		}
+		if g.NumGeoms() == 0 {
+			sb.WriteString(tEmpty)
+			return nil
+		}
+		sb.WriteByte('(')
+		for i, child := range g.Geoms() {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			if err := encode(sb, child); err != nil {
+				return err
+			}
+		}
+		sb.WriteByte(')')
+	case *EmptyGeometryCollection:
+		if err := writeTag(sb, tGeometryCollection, g.Layout()); err != nil {
+			return err
+		}
+		sb.WriteString(tEmpty)
+	default:
+		return geom.ErrUnsupportedType{Value: g}
+	}
+	return nil
+}
+
+// writeTag writes the geometry type, followed by its dimension qualifier if any.
+func writeTag(sb *strings.Builder, typ string, layout geom.Layout) error {
+	sb.WriteString(typ)
+	switch layout {
+	case geom.NoLayout, geom.XY:
+	case geom.XYZ:
+		sb.WriteString(" " + tZ)
+	case geom.XYM:
+		sb.WriteString(" " + tM)
+	case geom.XYZM:
+		sb.WriteString(" " + tZM)
+	default:
+		return ErrUnsupportedLayout(layout)
+	}
+	sb.WriteByte(' ')
+	return nil
+}
+
+func writeFlatCoords0(sb *strings.Builder, coord []float64) {
+	for i, x := range coord {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(strconv.FormatFloat(x, 'f', -1, 64))
+	}
+}
+
+func writeFlatCoords1(sb *strings.Builder, flatCoords []float64, stride int) {
+	if len(flatCoords) == 0 {
+		sb.WriteString(tEmpty)
+		return
+	}
+	sb.WriteByte('(')
+	for i := 0; i < len(flatCoords); i += stride {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		writeFlatCoords0(sb, flatCoords[i:i+stride])
+	}
+	sb.WriteByte(')')
+}
+
+func writeFlatCoords2(sb *strings.Builder, flatCoords []float64, offset int, ends []int, stride int) {
+	if len(ends) == 0 {
+		sb.WriteString(tEmpty)
+		return
+	}
+	sb.WriteByte('(')
+	for i, end := range ends {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		writeFlatCoords1(sb, flatCoords[offset:end], stride)
+		offset = end
+	}
+	sb.WriteByte(')')
+}
@@ -0,0 +1,97 @@
+// Package wkt implements Well Known Text encoding and decoding.
+//
+// Both the ISO flavor (e.g. "POINT Z (1 2 3)") and the PostGIS extended flavor
+// (EWKT, e.g. "SRID=4326;POINTM(1 2 3)") are supported by the decoder.
+//
+// Empty geometries are represented with the EMPTY keyword, e.g. "LINESTRING EMPTY".
+package wkt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+)
+
+const (
+	tPoint              = "POINT"
+	tMultiPoint         = "MULTIPOINT"
+	tLineString         = "LINESTRING"
+	tMultiLineString    = "MULTILINESTRING"
+	tPolygon            = "POLYGON"
+	tMultiPolygon       = "MULTIPOLYGON"
+	tGeometryCollection = "GEOMETRYCOLLECTION"
+	tZ                  = "Z"
+	tM                  = "M"
+	tZM                 = "ZM"
+	tEmpty              = "EMPTY"
+	tSRID               = "SRID"
+)
+
+// ErrSyntax is returned when a WKT string cannot be parsed.
+//
+// Offset is the 0-based byte offset in the input where the error was detected.
+// Line and Column are 1-based.
+type ErrSyntax struct {
+	Offset int
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e ErrSyntax) Error() string {
+	return fmt.Sprintf("wkt: syntax error at line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+// ErrUnsupportedLayout is returned when a geometry layout can't be represented in WKT.
+type ErrUnsupportedLayout geom.Layout
+
+func (e ErrUnsupportedLayout) Error() string {
+	return fmt.Sprintf("wkt: unsupported layout %s", geom.Layout(e))
+}
+
+// EmptyGeometryCollection is an empty geometry collection which retains its layout,
+// e.g. "GEOMETRYCOLLECTION Z EMPTY".
+//
+// The layout of a geom.GeometryCollection is the one of its geometries: an empty one has no layout.
+type EmptyGeometryCollection struct {
+	*geom.GeometryCollection
+	layout geom.Layout
+}
+
+// Layout returns the layout of the collection.
+func (g *EmptyGeometryCollection) Layout() geom.Layout { return g.layout }
+
+// Stride returns the stride of the layout of the collection.
+func (g *EmptyGeometryCollection) Stride() int { return g.layout.Stride() }
+
+// Marshal translates a geometry to the corresponding WKT.
+//
+// The SRID of the geometry is ignored: use MarshalEWKT to retain it.
+func Marshal(g geom.T) (string, error) {
+	sb := &strings.Builder{}
+	if err := encode(sb, g); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// MarshalEWKT translates a geometry to the corresponding EWKT,
+// prefixing the WKT with "SRID=...;" whenever the geometry has a non-zero SRID.
+func MarshalEWKT(g geom.T) (string, error) {
+	sb := &strings.Builder{}
+	if srid := g.SRID(); srid != 0 {
+		fmt.Fprintf(sb, "%s=%d;", tSRID, srid)
+	}
+	if err := encode(sb, g); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// Unmarshal translates a WKT or EWKT string to the corresponding geometry.
+//
+// If the input carries an EWKT "SRID=...;" prefix, the SRID is set on the returned geometry.
+func Unmarshal(wkt string) (geom.T, error) {
+	return newParser(wkt).parse()
+}
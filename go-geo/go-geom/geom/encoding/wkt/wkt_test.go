@@ -0,0 +1,205 @@
+package wkt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestWKT(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		g    geom.T
+		wkt  string
+	}{
+		{
+			name: "point",
+			g:    geom.NewPointFlat(geom.XY, []float64{1, 2}),
+			wkt:  "POINT (1 2)",
+		},
+		{
+			name: "point Z",
+			g:    geom.NewPointFlat(geom.XYZ, []float64{1, 2, 3}),
+			wkt:  "POINT Z (1 2 3)",
+		},
+		{
+			name: "point M",
+			g:    geom.NewPointFlat(geom.XYM, []float64{1, 2, 3}),
+			wkt:  "POINT M (1 2 3)",
+		},
+		{
+			name: "point ZM",
+			g:    geom.NewPointFlat(geom.XYZM, []float64{1, 2, 3, 4.5}),
+			wkt:  "POINT ZM (1 2 3 4.5)",
+		},
+		{
+			name: "empty point",
+			g:    geom.NewPointEmpty(geom.XY),
+			wkt:  "POINT EMPTY",
+		},
+		{
+			name: "linestring",
+			g:    geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4}),
+			wkt:  "LINESTRING (1 2,3 4)",
+		},
+		{
+			name: "empty linestring Z",
+			g:    geom.NewLineString(geom.XYZ),
+			wkt:  "LINESTRING Z EMPTY",
+		},
+		{
+			name: "polygon with hole",
+			g: geom.NewPolygonFlat(geom.XY, []float64{
+				0, 0, 10, 0, 10, 10, 0, 10, 0, 0,
+				1, 1, 2, 1, 2, 2, 1, 1,
+			}, []int{10, 18}),
+			wkt: "POLYGON ((0 0,10 0,10 10,0 10,0 0),(1 1,2 1,2 2,1 1))",
+		},
+		{
+			name: "multipoint",
+			g:    geom.NewMultiPointFlat(geom.XYM, []float64{1, 2, 3, 4, 5, 6}),
+			wkt:  "MULTIPOINT M ((1 2 3),(4 5 6))",
+		},
+		{
+			name: "multilinestring",
+			g:    geom.NewMultiLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []int{4, 8}),
+			wkt:  "MULTILINESTRING ((1 2,3 4),(5 6,7 8))",
+		},
+		{
+			name: "multipolygon with empty member",
+			g: geom.NewMultiPolygonFlat(geom.XY, []float64{
+				0, 0, 1, 0, 1, 1, 0, 0,
+			}, [][]int{{8}, nil}),
+			wkt: "MULTIPOLYGON (((0 0,1 0,1 1,0 0)),EMPTY)",
+		},
+		{
+			name: "geometry collection",
+			g: geom.NewGeometryCollection().MustPush(
+				geom.NewPointFlat(geom.XY, []float64{1, 2}),
+				geom.NewLineStringFlat(geom.XY, []float64{3, 4, 5, 6}),
+			),
+			wkt: "GEOMETRYCOLLECTION (POINT (1 2),LINESTRING (3 4,5 6))",
+		},
+		{
+			name: "empty geometry collection",
+			g:    geom.NewGeometryCollection(),
+			wkt:  "GEOMETRYCOLLECTION EMPTY",
+		},
+		{
+			name: "empty geometry collection Z",
+			g:    &EmptyGeometryCollection{GeometryCollection: geom.NewGeometryCollection(), layout: geom.XYZ},
+			wkt:  "GEOMETRYCOLLECTION Z EMPTY",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Marshal(tc.g)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wkt, got)
+
+			g, err := Unmarshal(tc.wkt)
+			require.NoError(t, err)
+			assert.Equal(t, tc.g, g)
+		})
+	}
+}
+
+func TestUnmarshalVariants(t *testing.T) {
+	for _, tc := range []struct {
+		wkt  string
+		want geom.T
+	}{
+		{
+			wkt:  "point(1 2 3)",
+			want: geom.NewPointFlat(geom.XYZ, []float64{1, 2, 3}),
+		},
+		{
+			wkt:  "POINTM(1 2 3)",
+			want: geom.NewPointFlat(geom.XYM, []float64{1, 2, 3}),
+		},
+		{
+			wkt:  "MULTIPOINT (1 2, 3 4)",
+			want: geom.NewMultiPointFlat(geom.XY, []float64{1, 2, 3, 4}),
+		},
+		{
+			wkt:  "  LineString\n( -1.5e2 2 ,\t3 +4 ) ",
+			want: geom.NewLineStringFlat(geom.XY, []float64{-150, 2, 3, 4}),
+		},
+		{
+			wkt:  "POINT (1-2)",
+			want: geom.NewPointFlat(geom.XY, []float64{1, -2}),
+		},
+		{
+			wkt:  "POINT (1e-2+3)",
+			want: geom.NewPointFlat(geom.XY, []float64{0.01, 3}),
+		},
+		{
+			wkt: "GEOMETRYCOLLECTION Z (POINT (1 2 3), POINT EMPTY)",
+			want: geom.NewGeometryCollection().MustPush(
+				geom.NewPointFlat(geom.XYZ, []float64{1, 2, 3}),
+				geom.NewPointEmpty(geom.XYZ),
+			),
+		},
+	} {
+		g, err := Unmarshal(tc.wkt)
+		require.NoErrorf(t, err, "Unmarshal(%q)", tc.wkt)
+		assert.Equalf(t, tc.want, g, "Unmarshal(%q)", tc.wkt)
+	}
+}
+
+func TestEWKT(t *testing.T) {
+	g, err := Unmarshal("SRID=4326;POINT(0.1275 51.50722)")
+	require.NoError(t, err)
+	assert.Equal(t, 4326, g.SRID())
+	assert.Equal(t, []float64{0.1275, 51.50722}, g.FlatCoords())
+
+	s, err := MarshalEWKT(g)
+	require.NoError(t, err)
+	assert.Equal(t, "SRID=4326;POINT (0.1275 51.50722)", s)
+
+	s, err = Marshal(g)
+	require.NoError(t, err)
+	assert.Equal(t, "POINT (0.1275 51.50722)", s)
+
+	s, err = MarshalEWKT(geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4}))
+	require.NoError(t, err)
+	assert.Equal(t, "LINESTRING (1 2,3 4)", s)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	for _, tc := range []struct {
+		wkt    string
+		line   int
+		column int
+		msg    string
+	}{
+		{wkt: "", line: 1, column: 1, msg: "expected geometry type, got end of input"},
+		{wkt: "CIRCLE (1 2)", line: 1, column: 1, msg: `unknown geometry type "CIRCLE"`},
+		{wkt: "POINT (1 2", line: 1, column: 11, msg: "expected ')', got end of input"},
+		{wkt: "POINT (1)", line: 1, column: 8, msg: "invalid number of ordinates: 1"},
+		{wkt: "LINESTRING (1 2, 3 4 5)", line: 1, column: 18, msg: "expected 2 ordinates for layout XY, got 3"},
+		{wkt: "POINT Z (1 2)", line: 1, column: 10, msg: "expected 3 ordinates for layout XYZ, got 2"},
+		{wkt: "POLYGON (\n(0 0, 1 0, 1 1, 0 0),\n(0 0 # 1))", line: 3, column: 6, msg: "unexpected character '#'"},
+		{wkt: "POINT (1 2) POINT", line: 1, column: 13, msg: `expected end of input, got keyword "POINT"`},
+		{wkt: "SRID=abc;POINT(1 2)", line: 1, column: 6, msg: `expected SRID value, got keyword "abc"`},
+		{wkt: "MULTIPOINT (EMPTY)", line: 1, column: 13, msg: "empty points are not supported in MULTIPOINT"},
+		{wkt: "GEOMETRYCOLLECTION Z (POINT M (1 2 3))", line: 1, column: 23, msg: "layout XYM does not match enclosing layout XYZ"},
+		{wkt: "POINT (1 2..3)", line: 1, column: 10, msg: `invalid number "2..3"`},
+		{wkt: "LINESTRING (1 2, 3 4-5)", line: 1, column: 18, msg: "expected 2 ordinates for layout XY, got 3"},
+	} {
+		_, err := Unmarshal(tc.wkt)
+		require.Errorf(t, err, "Unmarshal(%q)", tc.wkt)
+		e, ok := err.(ErrSyntax)
+		require.Truef(t, ok, "Unmarshal(%q): expected ErrSyntax, got %T: %v", tc.wkt, err, err)
+		assert.Equalf(t, tc.line, e.Line, "Unmarshal(%q): %v", tc.wkt, err)
+		assert.Equalf(t, tc.column, e.Column, "Unmarshal(%q): %v", tc.wkt, err)
+		assert.Equalf(t, tc.msg, e.Msg, "Unmarshal(%q)", tc.wkt)
+	}
+}
+
+func TestMarshalUnsupported(t *testing.T) {
+	_, err := Marshal(geom.NewPointFlat(geom.Layout(5), []float64{1, 2, 3, 4, 5}))
+	assert.Error(t, err)
+}
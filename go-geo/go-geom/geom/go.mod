@@ -6,7 +6,7 @@ require (
 	github.com/DATA-DOG/go-sqlmock v1.3.2
 	github.com/d4l3k/messagediff v1.2.1
 	github.com/go-openapi/swag v0.19.9
-	github.com/json-iterator/go v1.1.9
+	github.com/json-iterator/go v1.1.12
 	github.com/stretchr/testify v1.6.0
 	github.com/twpayne/go-geom v1.1.0
 )
@@ -0,0 +1,122 @@
+package flat
+
+import (
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+	"github.com/fredbi/go-geom/geom/internal/layouts/stub"
+)
+
+// Bounds is a bounding box, known by its min and max corners. Bounds with no corners are empty.
+type Bounds struct {
+	base
+	coords [][]float64
+
+	notImplemented
+}
+
+// NewBounds builds a bounding box from its min and max corners.
+//
+// Bounds with no corners are empty.
+func NewBounds(min, max []float64, opts ...geom.LayoutOption) (*Bounds, error) {
+	b := &Bounds{base: newBase(opts)}
+	if min == nil && max == nil {
+		return b, nil
+	}
+	if err := b.SetMinMax(min, max); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Bounds) IsEmpty() bool { return len(b.coords) == 0 }
+func (b *Bounds) Clone() geom.T { return &Bounds{base: b.base, coords: cloneCoords(b.coords)} }
+
+func (b *Bounds) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*Bounds)
+	return ok && o.layout == b.layout && equalCoords(b.coords, o.coords)
+}
+
+func (b *Bounds) Round(opts ...geom.RoundingOption) { roundCoords(b.coords, opts) }
+func (b *Bounds) Bounds() geom.Bounds               { return b.Clone().(*Bounds) }
+func (b *Bounds) Centroid() geom.Point              { return b.centroid(b.coords) }
+
+// Vertices yields the min and max corners
+func (b *Bounds) Vertices() []geom.Point { return b.vertices(b.coords) }
+
+// Edges yields no edges, since only the min and max corners of a bounding box are known
+func (b *Bounds) Edges() []geom.Line { return nil }
+
+// FlatCoords yields the min and max corners
+func (b *Bounds) FlatCoords() [][]float64 { return b.coords }
+
+func (b *Bounds) SetFlatCoords(coords [][]float64) error {
+	switch len(coords) {
+	case 0:
+		b.coords = nil
+		return nil
+	case 2:
+		return b.SetMinMax(coords[0], coords[1])
+	default:
+		return codes.ErrInconsistentLayout
+	}
+}
+
+func (b *Bounds) WithFlatCoords(coords [][]float64, callbacks ...func(error)) geom.Bounds {
+	if err := b.SetFlatCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return b
+}
+
+// Extends yields new bounds, covering both these bounds and the vertices of a geometry.
+//
+// Empty bounds take the layout of the geometry.
+func (b *Bounds) Extends(g geom.T) geom.Bounds {
+	extended := b.Clone().(*Bounds)
+	if g == nil || g.IsEmpty() {
+		return extended
+	}
+	if extended.IsEmpty() {
+		extended.layout = g.Layout()
+	}
+	for _, c := range g.Bounds().FlatCoords() {
+		if checkCoords(extended.layout, c) == nil {
+			extended.extend(c)
+		}
+	}
+	return extended
+}
+
+func (b *Bounds) SetMinMax(min, max []float64) error {
+	if err := checkCoords(b.layout, min, max); err != nil {
+		return err
+	}
+	b.coords = cloneCoords([][]float64{min, max})
+	return nil
+}
+
+func (b *Bounds) WithMinMax(min, max []float64, callbacks ...func(error)) geom.Bounds {
+	if err := b.SetMinMax(min, max); err != nil {
+		onError(callbacks)(err)
+	}
+	return b
+}
+
+// AsRectangle is not supported by flat bounds
+func (b *Bounds) AsRectangle() geom.Rectangle { panic(stub.ErrNotImplemented) }
+
+func (b *Bounds) extend(c []float64) {
+	if b.IsEmpty() {
+		b.coords = cloneCoords([][]float64{c, c})
+		return
+	}
+	min, max := b.coords[0], b.coords[1]
+	for i, v := range c {
+		if v < min[i] {
+			min[i] = v
+		}
+		if v > max[i] {
+			max[i] = v
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Package flat implements the base geometries for any layout, as plain coordinates.
+//
+// Flat geometries know about their layout, SRID, coordinates and features: this is all
+// encoders need. Measures, operators and topological predicates are not implemented.
+package flat
+
+import (
+	"math"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+	"github.com/fredbi/go-geom/geom/internal/layouts/stub"
+	"github.com/fredbi/go-geom/geom/internal/options"
+)
+
+var (
+	_ geom.Point      = &Point{}
+	_ geom.Line       = &Line{}
+	_ geom.LineString = &LineString{}
+	_ geom.Ring       = &Ring{}
+	_ geom.Polygon    = &Polygon{}
+	_ geom.Bounds     = &Bounds{}
+)
+
+type (
+	// base holds what all flat geometries know about, besides their coordinates
+	base struct {
+		layout   geom.Layout
+		srid     uint32
+		features interface{}
+	}
+
+	// notImplemented gathers the capabilities not supported by flat geometries
+	notImplemented struct {
+		stub.NotImplementedSorter
+		stub.NotImplementedSurveyor
+		stub.NotImplementedTopologist
+		stub.NotImplementedOperator
+		stub.NotImplementedProjector
+		stub.NotImplementedClusterizer
+	}
+)
+
+// Config yields the layout and SRID resulting from some options. The default layout is XY.
+func Config(opts ...geom.LayoutOption) (geom.Layout, uint32) {
+	cfg := options.NewLayout(uint8(geom.XY))
+	for _, apply := range opts {
+		apply(cfg)
+	}
+	return geom.Layout(cfg.Layout()), cfg.SRID()
+}
+
+func newBase(opts []geom.LayoutOption) base {
+	layout, srid := Config(opts...)
+	return base{layout: layout, srid: srid}
+}
+
+func (b *base) Layout() geom.Layout { return b.layout }
+
+// SRID yields the spatial reference system identifier of the geometry, or 0 when it is not known
+func (b *base) SRID() uint32 { return b.srid }
+
+func (b *base) Features() interface{}     { return b.features }
+func (b *base) SetFeatures(f interface{}) { b.features = f }
+
+// onError yields the error callback of a With... method, which panics if none is provided
+func onError(callbacks []func(error)) func(error) {
+	if len(callbacks) == 0 || callbacks[0] == nil {
+		return func(err error) { panic(err) }
+	}
+	return callbacks[0]
+}
+
+func checkCoords(layout geom.Layout, coords ...[]float64) error {
+	dims := layout.Dimensions()
+	for _, c := range coords {
+		if len(c) != dims {
+			return codes.ErrInconsistentLayout
+		}
+	}
+	return nil
+}
+
+func cloneCoords(coords [][]float64) [][]float64 {
+	if coords == nil {
+		return nil
+	}
+	cloned := make([][]float64, len(coords))
+	for i, c := range coords {
+		cloned[i] = append([]float64(nil), c...)
+	}
+	return cloned
+}
+
+func equalCoords(a, b [][]float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j] != b[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func roundCoords(coords [][]float64, opts []geom.RoundingOption) {
+	cfg := options.NewRounding()
+	for _, apply := range opts {
+		apply(cfg)
+	}
+	scale := math.Pow10(int(cfg.Precision()))
+	for _, c := range coords {
+		for i, v := range c {
+			c[i] = math.Round(v*scale) / scale
+		}
+	}
+}
+
+// coordsOfPoints yields the coordinates of some points, which must all fit the layout
+func coordsOfPoints(layout geom.Layout, points []geom.Point) ([][]float64, error) {
+	coords := make([][]float64, len(points))
+	for i, p := range points {
+		c := p.Coords()
+		if err := checkCoords(layout, c); err != nil {
+			return nil, err
+		}
+		coords[i] = append([]float64(nil), c...)
+	}
+	return coords, nil
+}
+
+func closeRing(coords [][]float64) [][]float64 {
+	if len(coords) == 0 || equalCoords(coords[:1], coords[len(coords)-1:]) {
+		return coords
+	}
+	return append(coords, append([]float64(nil), coords[0]...))
+}
+
+func (b base) point(coords []float64) *Point {
+	return &Point{base: base{layout: b.layout, srid: b.srid}, coords: coords}
+}
+
+func (b base) vertices(coords [][]float64) []geom.Point {
+	if len(coords) == 0 {
+		return nil
+	}
+	points := make([]geom.Point, len(coords))
+	for i, c := range coords {
+		points[i] = b.point(c)
+	}
+	return points
+}
+
+// edges yields the lines joining consecutive vertices
+func (b base) edges(coords [][]float64) []geom.Line {
+	if len(coords) < 2 {
+		return nil
+	}
+	lines := make([]geom.Line, len(coords)-1)
+	for i := range lines {
+		lines[i] = &Line{base: base{layout: b.layout, srid: b.srid}, coords: [][]float64{coords[i], coords[i+1]}}
+	}
+	return lines
+}
+
+// centroid yields the mean of the vertices
+func (b base) centroid(coords [][]float64) geom.Point {
+	if len(coords) == 0 {
+		return b.point(nil)
+	}
+	mean := make([]float64, len(coords[0]))
+	for _, c := range coords {
+		for i := range mean {
+			mean[i] += c[i]
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(coords))
+	}
+	return b.point(mean)
+}
+
+// bounds yields the bounding box of some coordinates
+func (b base) bounds(coords [][]float64) *Bounds {
+	bb := &Bounds{base: base{layout: b.layout, srid: b.srid}}
+	for _, c := range coords {
+		bb.extend(c)
+	}
+	return bb
+}
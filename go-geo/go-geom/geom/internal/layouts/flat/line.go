@@ -0,0 +1,81 @@
+package flat
+
+import (
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+)
+
+// Line is a segment between two positions. A Line with no coordinates is empty.
+type Line struct {
+	base
+	coords [][]float64
+
+	notImplemented
+}
+
+// NewLine builds a Line from its ends
+func NewLine(p1, p2 geom.Point, opts ...geom.LayoutOption) (*Line, error) {
+	l := &Line{base: newBase(opts)}
+	coords, err := coordsOfPoints(l.layout, []geom.Point{p1, p2})
+	if err != nil {
+		return nil, err
+	}
+	l.coords = coords
+	return l, nil
+}
+
+func (l *Line) IsEmpty() bool { return len(l.coords) == 0 }
+func (l *Line) Clone() geom.T { return &Line{base: l.base, coords: cloneCoords(l.coords)} }
+
+func (l *Line) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*Line)
+	return ok && o.layout == l.layout && equalCoords(l.coords, o.coords)
+}
+
+func (l *Line) Round(opts ...geom.RoundingOption) { roundCoords(l.coords, opts) }
+func (l *Line) Bounds() geom.Bounds               { return l.bounds(l.coords) }
+func (l *Line) Centroid() geom.Point              { return l.centroid(l.coords) }
+func (l *Line) Vertices() []geom.Point            { return l.vertices(l.coords) }
+func (l *Line) Edges() []geom.Line                { return l.edges(l.coords) }
+func (l *Line) FlatCoords() [][]float64           { return l.coords }
+
+func (l *Line) SetFlatCoords(coords [][]float64) error {
+	switch len(coords) {
+	case 0:
+		l.coords = nil
+		return nil
+	case 2:
+		return l.SetEnds(coords[0], coords[1])
+	default:
+		return codes.ErrInconsistentLayout
+	}
+}
+
+func (l *Line) WithFlatCoords(coords [][]float64, callbacks ...func(error)) geom.Line {
+	if err := l.SetFlatCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return l
+}
+
+func (l *Line) Ends() [2][]float64 {
+	if l.IsEmpty() {
+		return [2][]float64{}
+	}
+	return [2][]float64{l.coords[0], l.coords[1]}
+}
+
+func (l *Line) SetEnds(start, end []float64) error {
+	if err := checkCoords(l.layout, start, end); err != nil {
+		return err
+	}
+	l.coords = cloneCoords([][]float64{start, end})
+	return nil
+}
+
+func (l *Line) WithEnds(start, end []float64, callbacks ...func(error)) geom.Line {
+	if err := l.SetEnds(start, end); err != nil {
+		onError(callbacks)(err)
+	}
+	return l
+}
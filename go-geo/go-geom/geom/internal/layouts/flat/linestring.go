@@ -0,0 +1,141 @@
+package flat
+
+import (
+	"github.com/fredbi/go-geom/geom"
+)
+
+type (
+	// LineString is a sequence of positions. A LineString with no coordinates is empty.
+	LineString struct {
+		base
+		coords [][]float64
+
+		notImplemented
+	}
+
+	// Ring is a closed sequence of positions: the last position is the same as the first one.
+	Ring struct {
+		base
+		coords [][]float64
+
+		notImplemented
+	}
+)
+
+// NewLineString builds a LineString from its vertices
+func NewLineString(points []geom.Point, opts ...geom.LayoutOption) (*LineString, error) {
+	ls := &LineString{base: newBase(opts)}
+	coords, err := coordsOfPoints(ls.layout, points)
+	if err != nil {
+		return nil, err
+	}
+	ls.coords = coords
+	return ls, nil
+}
+
+// NewRing builds a Ring from its vertices, closing it if needed
+func NewRing(points []geom.Point, opts ...geom.LayoutOption) (*Ring, error) {
+	r := &Ring{base: newBase(opts)}
+	coords, err := coordsOfPoints(r.layout, points)
+	if err != nil {
+		return nil, err
+	}
+	r.coords = closeRing(coords)
+	return r, nil
+}
+
+func (ls *LineString) IsEmpty() bool { return len(ls.coords) == 0 }
+
+func (ls *LineString) Clone() geom.T {
+	return &LineString{base: ls.base, coords: cloneCoords(ls.coords)}
+}
+
+func (ls *LineString) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*LineString)
+	return ok && o.layout == ls.layout && equalCoords(ls.coords, o.coords)
+}
+
+func (ls *LineString) Round(opts ...geom.RoundingOption) { roundCoords(ls.coords, opts) }
+func (ls *LineString) Bounds() geom.Bounds               { return ls.bounds(ls.coords) }
+func (ls *LineString) Centroid() geom.Point              { return ls.centroid(ls.coords) }
+func (ls *LineString) Vertices() []geom.Point            { return ls.vertices(ls.coords) }
+func (ls *LineString) Edges() []geom.Line                { return ls.edges(ls.coords) }
+func (ls *LineString) FlatCoords() [][]float64           { return ls.coords }
+
+func (ls *LineString) SetFlatCoords(coords [][]float64) error {
+	if err := checkCoords(ls.layout, coords...); err != nil {
+		return err
+	}
+	ls.coords = cloneCoords(coords)
+	return nil
+}
+
+func (ls *LineString) WithFlatCoords(coords [][]float64, callbacks ...func(error)) geom.LineString {
+	if err := ls.SetFlatCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return ls
+}
+
+// AddPoints appends vertices to the LineString.
+//
+// Panics if the points don't fit the layout of the LineString.
+func (ls *LineString) AddPoints(points ...geom.Point) {
+	coords, err := coordsOfPoints(ls.layout, points)
+	if err != nil {
+		panic(err)
+	}
+	ls.coords = append(ls.coords, coords...)
+}
+
+func (ls *LineString) WithPoints(points ...geom.Point) geom.LineString {
+	ls.AddPoints(points...)
+	return ls
+}
+
+func (ls *LineString) IsRing() bool {
+	return len(ls.coords) >= 4 && equalCoords(ls.coords[:1], ls.coords[len(ls.coords)-1:])
+}
+
+func (ls *LineString) AsRing() geom.Ring {
+	return &Ring{base: ls.base, coords: closeRing(cloneCoords(ls.coords))}
+}
+
+func (r *Ring) IsEmpty() bool { return len(r.coords) == 0 }
+func (r *Ring) Clone() geom.T { return &Ring{base: r.base, coords: cloneCoords(r.coords)} }
+
+func (r *Ring) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*Ring)
+	return ok && o.layout == r.layout && equalCoords(r.coords, o.coords)
+}
+
+func (r *Ring) Round(opts ...geom.RoundingOption) { roundCoords(r.coords, opts) }
+func (r *Ring) Bounds() geom.Bounds               { return r.bounds(r.coords) }
+func (r *Ring) Vertices() []geom.Point            { return r.vertices(r.coords) }
+func (r *Ring) Edges() []geom.Line                { return r.edges(r.coords) }
+func (r *Ring) FlatCoords() [][]float64           { return r.coords }
+
+// Centroid yields the mean of the vertices of the ring, not counting the closing one
+func (r *Ring) Centroid() geom.Point {
+	if len(r.coords) < 2 {
+		return r.centroid(r.coords)
+	}
+	return r.centroid(r.coords[:len(r.coords)-1])
+}
+
+// SetFlatCoords sets the vertices of the ring, closing it if needed
+func (r *Ring) SetFlatCoords(coords [][]float64) error {
+	if err := checkCoords(r.layout, coords...); err != nil {
+		return err
+	}
+	r.coords = closeRing(cloneCoords(coords))
+	return nil
+}
+
+func (r *Ring) AsPolygon() geom.Polygon {
+	p := &Polygon{base: r.base}
+	if !r.IsEmpty() {
+		p.rings = [][][]float64{cloneCoords(r.coords)}
+	}
+	return p
+}
@@ -0,0 +1,79 @@
+package flat
+
+import (
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+)
+
+// Point is a single position. A Point with no coordinates is empty.
+type Point struct {
+	base
+	coords []float64
+
+	notImplemented
+}
+
+// NewPoint builds an empty Point
+func NewPoint(opts ...geom.LayoutOption) *Point {
+	return &Point{base: newBase(opts)}
+}
+
+func (p *Point) IsEmpty() bool { return len(p.coords) == 0 }
+
+func (p *Point) Clone() geom.T {
+	return &Point{base: p.base, coords: append([]float64(nil), p.coords...)}
+}
+
+func (p *Point) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*Point)
+	return ok && o.layout == p.layout && equalCoords(p.FlatCoords(), o.FlatCoords())
+}
+
+func (p *Point) Round(opts ...geom.RoundingOption) { roundCoords(p.FlatCoords(), opts) }
+func (p *Point) Bounds() geom.Bounds               { return p.bounds(p.FlatCoords()) }
+func (p *Point) Centroid() geom.Point              { return p.Clone().(*Point) }
+func (p *Point) Vertices() []geom.Point            { return p.vertices(p.FlatCoords()) }
+func (p *Point) Edges() []geom.Line                { return nil }
+
+func (p *Point) FlatCoords() [][]float64 {
+	if p.IsEmpty() {
+		return [][]float64{}
+	}
+	return [][]float64{p.coords}
+}
+
+func (p *Point) SetFlatCoords(coords [][]float64) error {
+	switch len(coords) {
+	case 0:
+		p.coords = nil
+		return nil
+	case 1:
+		return p.SetCoords(coords[0])
+	default:
+		return codes.ErrInconsistentLayout
+	}
+}
+
+func (p *Point) WithFlatCoords(coords [][]float64, callbacks ...func(error)) geom.Point {
+	if err := p.SetFlatCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return p
+}
+
+func (p *Point) Coords() []float64 { return p.coords }
+
+func (p *Point) SetCoords(coords []float64) error {
+	if err := checkCoords(p.layout, coords); err != nil {
+		return err
+	}
+	p.coords = append([]float64(nil), coords...)
+	return nil
+}
+
+func (p *Point) WithCoords(coords []float64, callbacks ...func(error)) geom.Point {
+	if err := p.SetCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return p
+}
@@ -0,0 +1,138 @@
+package flat
+
+import (
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
+)
+
+// Polygon is an exterior ring, with some holes. A Polygon with no rings is empty.
+type Polygon struct {
+	base
+	rings [][][]float64
+
+	notImplemented
+}
+
+// NewPolygon builds a Polygon from the vertices of its exterior ring and of its holes.
+//
+// Rings are closed if needed.
+func NewPolygon(exterior []geom.Point, holes [][]geom.Point, opts ...geom.LayoutOption) (*Polygon, error) {
+	p := &Polygon{base: newBase(opts)}
+	if len(exterior) == 0 {
+		return p, nil
+	}
+	p.rings = make([][][]float64, 0, 1+len(holes))
+	for _, points := range append([][]geom.Point{exterior}, holes...) {
+		coords, err := coordsOfPoints(p.layout, points)
+		if err != nil {
+			return nil, err
+		}
+		p.rings = append(p.rings, closeRing(coords))
+	}
+	return p, nil
+}
+
+func (p *Polygon) IsEmpty() bool { return len(p.rings) == 0 }
+
+func (p *Polygon) Clone() geom.T {
+	cloned := &Polygon{base: p.base}
+	for _, ring := range p.rings {
+		cloned.rings = append(cloned.rings, cloneCoords(ring))
+	}
+	return cloned
+}
+
+func (p *Polygon) Equals(other geom.T, _ ...geom.EqualityOption) bool {
+	o, ok := other.(*Polygon)
+	if !ok || o.layout != p.layout || len(o.rings) != len(p.rings) {
+		return false
+	}
+	for i := range p.rings {
+		if !equalCoords(p.rings[i], o.rings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Polygon) Round(opts ...geom.RoundingOption) {
+	for _, ring := range p.rings {
+		roundCoords(ring, opts)
+	}
+}
+
+func (p *Polygon) Bounds() geom.Bounds    { return p.bounds(p.FlatCoords()) }
+func (p *Polygon) Centroid() geom.Point   { return p.ExteriorRing().Centroid() }
+func (p *Polygon) Vertices() []geom.Point { return p.vertices(p.FlatCoords()) }
+
+func (p *Polygon) Edges() []geom.Line {
+	var edges []geom.Line
+	for _, ring := range p.rings {
+		edges = append(edges, p.edges(ring)...)
+	}
+	return edges
+}
+
+// FlatCoords yields the vertices of all rings, starting with the exterior ring
+func (p *Polygon) FlatCoords() [][]float64 {
+	var coords [][]float64
+	for _, ring := range p.rings {
+		coords = append(coords, ring...)
+	}
+	return coords
+}
+
+// SetFlatCoords sets the vertices of all rings, which keep their number of vertices.
+//
+// An empty Polygon gets a single exterior ring.
+func (p *Polygon) SetFlatCoords(coords [][]float64) error {
+	if err := checkCoords(p.layout, coords...); err != nil {
+		return err
+	}
+	coords = cloneCoords(coords)
+	if p.IsEmpty() {
+		if len(coords) > 0 {
+			p.rings = [][][]float64{closeRing(coords)}
+		}
+		return nil
+	}
+	if len(coords) != len(p.FlatCoords()) {
+		return codes.ErrInconsistentLayout
+	}
+	for i, ring := range p.rings {
+		p.rings[i], coords = coords[:len(ring)], coords[len(ring):]
+	}
+	return nil
+}
+
+func (p *Polygon) WithFlatCoords(coords [][]float64, callbacks ...func(error)) geom.Polygon {
+	if err := p.SetFlatCoords(coords); err != nil {
+		onError(callbacks)(err)
+	}
+	return p
+}
+
+func (p *Polygon) ExteriorRing() geom.Ring {
+	if p.IsEmpty() {
+		return &Ring{base: base{layout: p.layout, srid: p.srid}}
+	}
+	return p.ring(0)
+}
+
+func (p *Polygon) InteriorRings() []geom.Ring {
+	if len(p.rings) < 2 {
+		return nil
+	}
+	holes := make([]geom.Ring, len(p.rings)-1)
+	for i := range holes {
+		holes[i] = p.ring(i + 1)
+	}
+	return holes
+}
+
+// InteriorRing yields the i-th hole of the polygon
+func (p *Polygon) InteriorRing(i int) geom.Ring { return p.ring(i + 1) }
+
+func (p *Polygon) ring(i int) *Ring {
+	return &Ring{base: base{layout: p.layout, srid: p.srid}, coords: p.rings[i]}
+}
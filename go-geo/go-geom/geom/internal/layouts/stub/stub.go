@@ -1,16 +1,15 @@
 package stub
 
 import (
-	"errors"
-
 	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/codes"
 )
 
 var (
 	_ geom.EmptyGeometry = &EmptyGeometry{}
 	_ geom.T             = &NotImplementedGeometry{}
 
-	ErrNotImplemented = errors.New("feature not implemented")
+	ErrNotImplemented = codes.ErrNotImplemented
 )
 
 type (
@@ -53,7 +52,7 @@ type (
 	NotImplementedClusterizer struct{}
 )
 
-func NewEmptyGeometry(_ geom.LayoutOption) *EmptyGeometry {
+func NewEmptyGeometry(_ ...geom.LayoutOption) *EmptyGeometry {
 	return &EmptyGeometry{}
 }
 
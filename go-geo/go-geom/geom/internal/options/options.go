@@ -3,6 +3,7 @@ package options
 type (
 	Layout interface {
 		SRID() uint32
+		Layout() uint8
 		set(*layout)
 	}
 
@@ -11,6 +12,7 @@ type (
 	}
 
 	Rounding interface {
+		Precision() uint32
 		set(*rounding)
 	}
 
@@ -23,7 +25,9 @@ type (
 	}
 
 	layout struct {
-		srid uint32
+		srid      uint32
+		layout    uint8
+		hasLayout bool
 	}
 
 	equality struct{}
@@ -37,11 +41,23 @@ type (
 	tesselator struct{}
 )
 
-func (*layout) set(*layout)    {}
-func (l *layout) SRID() uint32 { return l.srid }
+func (l *layout) SRID() uint32  { return l.srid }
+func (l *layout) Layout() uint8 { return l.layout }
 
-func defaultLayout() *layout {
-	return &layout{}
+func (l *layout) set(o *layout) {
+	if o.srid != 0 {
+		l.srid = o.srid
+	}
+	if o.hasLayout {
+		l.layout = o.layout
+	}
+}
+
+// NewLayout yields a layout configuration, with some default layout.
+//
+// Options are applied to the returned configuration.
+func NewLayout(defaultLayout uint8) Layout {
+	return &layout{layout: defaultLayout}
 }
 
 func (*equality) set(*equality) {}
@@ -62,9 +78,16 @@ func defaultTopology() *topology {
 	return &topology{}
 }
 
-func (*rounding) set(*rounding) {}
+func (r *rounding) Precision() uint32 { return r.precision }
+
+func (r *rounding) set(o *rounding) {
+	r.precision = o.precision
+}
 
-func defaultRounding() *rounding {
+// NewRounding yields a rounding configuration, with a default precision of 6 decimals.
+//
+// Options are applied to the returned configuration.
+func NewRounding() Rounding {
 	return &rounding{precision: 6}
 }
 
@@ -74,6 +97,12 @@ func WithSRID(srid uint32) func(Layout) {
 	}
 }
 
+func WithLayout(l uint8) func(Layout) {
+	return func(cfg Layout) {
+		cfg.set(&layout{layout: l, hasLayout: true})
+	}
+}
+
 func WithPrecision(precision uint32) func(Rounding) {
 	return func(cfg Rounding) {
 		cfg.set(&rounding{precision: precision})
@@ -37,6 +37,12 @@ const (
 
 	// S3 is a 3-dimensional spherical geometry, adding a Z dimension to S2 definitions
 	S3
+
+	// XYM is a planar 2-dimensional euclidian geometry, with a measure M attached to each point
+	XYM
+
+	// XYZM is a 3-dimensional euclidian geometry, with a measure M attached to each point
+	XYZM
 )
 
 func (l Layout) String() string {
@@ -61,6 +67,10 @@ func (l Layout) String() string {
 		return "S2"
 	case S3:
 		return "S3"
+	case XYM:
+		return "XYM"
+	case XYZM:
+		return "XYZM"
 	default:
 		panic("dev error: invalid layout")
 	}
@@ -75,8 +85,10 @@ func (l Layout) Dimensions() int {
 		return 1
 	case XY, XYSpherical, XYEarth, S2:
 		return 2
-	case XYZ, XYZSpherical, XYZEarth, S3:
+	case XYZ, XYZSpherical, XYZEarth, S3, XYM:
 		return 3
+	case XYZM:
+		return 4
 	default:
 		panic("dev error: invalid layout")
 	}
@@ -27,6 +27,11 @@ func WithSRID(srid uint32) LayoutOption {
 	return options.WithSRID(srid)
 }
 
+// WithLayout sets the layout of the geometry to build
+func WithLayout(layout Layout) LayoutOption {
+	return options.WithLayout(uint8(layout))
+}
+
 func WithPrecision(precision uint32) RoundingOption {
 	return options.WithPrecision(precision)
 }
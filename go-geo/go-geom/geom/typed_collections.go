@@ -0,0 +1,617 @@
+package geom
+
+// Typed collections hold geometries of a single type.
+//
+// They behave like a Collection. Operators which yield geometries of the same type yield a
+// typed collection, and a Collection otherwise.
+
+// retype yields a typed collection of the same type as "as", whenever all geometries have the
+// type of its elements. Otherwise, the collection is returned as is.
+func retype(c Collection, as T) T {
+	switch as.(type) {
+	case PointCollection:
+		typed := make(PointCollection, len(c))
+		for i, g := range c {
+			elem, ok := g.(Point)
+			if !ok {
+				return c
+			}
+			typed[i] = elem
+		}
+		return typed
+	case LineCollection:
+		typed := make(LineCollection, len(c))
+		for i, g := range c {
+			elem, ok := g.(Line)
+			if !ok {
+				return c
+			}
+			typed[i] = elem
+		}
+		return typed
+	case LineStringCollection:
+		typed := make(LineStringCollection, len(c))
+		for i, g := range c {
+			elem, ok := g.(LineString)
+			if !ok {
+				return c
+			}
+			typed[i] = elem
+		}
+		return typed
+	case RingCollection:
+		typed := make(RingCollection, len(c))
+		for i, g := range c {
+			elem, ok := g.(Ring)
+			if !ok {
+				return c
+			}
+			typed[i] = elem
+		}
+		return typed
+	case PolygonCollection:
+		typed := make(PolygonCollection, len(c))
+		for i, g := range c {
+			elem, ok := g.(Polygon)
+			if !ok {
+				return c
+			}
+			typed[i] = elem
+		}
+		return typed
+	default:
+		return c
+	}
+}
+
+// AsCollection yields the geometries of the PointCollection as a Collection
+func (c PointCollection) AsCollection() Collection {
+	geometries := make(Collection, len(c))
+	for i, g := range c {
+		geometries[i] = g
+	}
+	return geometries
+}
+
+func (c PointCollection) Layout() Layout               { return c.AsCollection().Layout() }
+func (c PointCollection) SRID() uint32                 { return c.AsCollection().SRID() }
+func (c PointCollection) IsEmpty() bool                { return c.AsCollection().IsEmpty() }
+func (c PointCollection) Clone() T                     { return retype(c.AsCollection().Clone().(Collection), c) }
+func (c PointCollection) Round(opts ...RoundingOption) { c.AsCollection().Round(opts...) }
+func (c PointCollection) Bounds() Bounds               { return c.AsCollection().Bounds() }
+func (c PointCollection) FlatCoords() [][]float64      { return c.AsCollection().FlatCoords() }
+func (c PointCollection) SetFlatCoords(coords [][]float64) error {
+	return c.AsCollection().SetFlatCoords(coords)
+}
+func (c PointCollection) Centroid() Point   { return c.AsCollection().Centroid() }
+func (c PointCollection) Vertices() []Point { return c.AsCollection().Vertices() }
+func (c PointCollection) Edges() []Line     { return c.AsCollection().Edges() }
+
+func (c PointCollection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(PointCollection)
+	return ok && c.AsCollection().Equals(o.AsCollection(), opts...)
+}
+
+func (c PointCollection) Sort(strats ...SortStrategy) {
+	sorted := c.AsCollection()
+	sorted.Sort(strats...)
+	for i, g := range sorted {
+		c[i] = g.(Point)
+	}
+}
+
+func (c PointCollection) Clusterize(s ClusteringStrategy) T { return c.AsCollection().Clusterize(s) }
+func (c PointCollection) Area() float64                     { return c.AsCollection().Area() }
+func (c PointCollection) SignedArea() float64               { return c.AsCollection().SignedArea() }
+func (c PointCollection) Length() float64                   { return c.AsCollection().Length() }
+func (c PointCollection) Volume() float64                   { return c.AsCollection().Volume() }
+func (c PointCollection) SignedVolume() float64             { return c.AsCollection().SignedVolume() }
+func (c PointCollection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	return c.AsCollection().DistanceTo(other, strats...)
+}
+func (c PointCollection) Angle(other T) float64 { return c.AsCollection().Angle(other) }
+func (c PointCollection) ProjectOn(s ProjectionStrategy) T {
+	return retype(c.AsCollection().ProjectOn(s).(Collection), c)
+}
+func (c PointCollection) ConvexHull() T { return c.AsCollection().ConvexHull() }
+func (c PointCollection) Simplify(strats ...SimplificationStrategy) T {
+	return retype(c.AsCollection().Simplify(strats...).(Collection), c)
+}
+func (c PointCollection) Clip(other T) T { return retype(c.AsCollection().Clip(other).(Collection), c) }
+func (c PointCollection) Buffer(d float64) T {
+	return retype(c.AsCollection().Buffer(d).(Collection), c)
+}
+func (c PointCollection) Affine(a, f float64, v Line) T {
+	return retype(c.AsCollection().Affine(a, f, v).(Collection), c)
+}
+func (c PointCollection) Rotate(a float64) T {
+	return retype(c.AsCollection().Rotate(a).(Collection), c)
+}
+func (c PointCollection) Translate(v Line) T {
+	return retype(c.AsCollection().Translate(v).(Collection), c)
+}
+func (c PointCollection) Scale(f float64) T { return retype(c.AsCollection().Scale(f).(Collection), c) }
+func (c PointCollection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return retype(c.AsCollection().Symmetrical(other, strats...).(Collection), c)
+}
+func (c PointCollection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	return c.AsCollection().Tesselate(t, opts...)
+}
+
+func (c PointCollection) Interior() T { return retype(c.AsCollection().Interior().(Collection), c) }
+func (c PointCollection) Border() T   { return retype(c.AsCollection().Border().(Collection), c) }
+func (c PointCollection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().Intersects(other, opts...)
+}
+func (c PointCollection) PointClosestTo(other T) Point { return c.AsCollection().PointClosestTo(other) }
+func (c PointCollection) ShortestLineTo(other T) Line  { return c.AsCollection().ShortestLineTo(other) }
+func (c PointCollection) IsInside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsInside(other, opts...)
+}
+func (c PointCollection) IsOutside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOutside(other, opts...)
+}
+func (c PointCollection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOn(other, opts...)
+}
+func (c PointCollection) Intersection(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Intersection(other, opts...).(Collection), c)
+}
+func (c PointCollection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().IntersectionWith(others, opts...).(Collection), c)
+}
+func (c PointCollection) Union(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Union(other, opts...).(Collection), c)
+}
+func (c PointCollection) UnionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().UnionWith(others, opts...).(Collection), c)
+}
+
+func (c PointCollection) Features() interface{}            { return c.AsCollection().Features() }
+func (c PointCollection) SetFeatures(features interface{}) { c.AsCollection().SetFeatures(features) }
+
+// AsCollection yields the geometries of the LineCollection as a Collection
+func (c LineCollection) AsCollection() Collection {
+	geometries := make(Collection, len(c))
+	for i, g := range c {
+		geometries[i] = g
+	}
+	return geometries
+}
+
+func (c LineCollection) Layout() Layout               { return c.AsCollection().Layout() }
+func (c LineCollection) SRID() uint32                 { return c.AsCollection().SRID() }
+func (c LineCollection) IsEmpty() bool                { return c.AsCollection().IsEmpty() }
+func (c LineCollection) Clone() T                     { return retype(c.AsCollection().Clone().(Collection), c) }
+func (c LineCollection) Round(opts ...RoundingOption) { c.AsCollection().Round(opts...) }
+func (c LineCollection) Bounds() Bounds               { return c.AsCollection().Bounds() }
+func (c LineCollection) FlatCoords() [][]float64      { return c.AsCollection().FlatCoords() }
+func (c LineCollection) SetFlatCoords(coords [][]float64) error {
+	return c.AsCollection().SetFlatCoords(coords)
+}
+func (c LineCollection) Centroid() Point   { return c.AsCollection().Centroid() }
+func (c LineCollection) Vertices() []Point { return c.AsCollection().Vertices() }
+func (c LineCollection) Edges() []Line     { return c.AsCollection().Edges() }
+
+func (c LineCollection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(LineCollection)
+	return ok && c.AsCollection().Equals(o.AsCollection(), opts...)
+}
+
+func (c LineCollection) Sort(strats ...SortStrategy) {
+	sorted := c.AsCollection()
+	sorted.Sort(strats...)
+	for i, g := range sorted {
+		c[i] = g.(Line)
+	}
+}
+
+func (c LineCollection) Clusterize(s ClusteringStrategy) T { return c.AsCollection().Clusterize(s) }
+func (c LineCollection) Area() float64                     { return c.AsCollection().Area() }
+func (c LineCollection) SignedArea() float64               { return c.AsCollection().SignedArea() }
+func (c LineCollection) Length() float64                   { return c.AsCollection().Length() }
+func (c LineCollection) Volume() float64                   { return c.AsCollection().Volume() }
+func (c LineCollection) SignedVolume() float64             { return c.AsCollection().SignedVolume() }
+func (c LineCollection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	return c.AsCollection().DistanceTo(other, strats...)
+}
+func (c LineCollection) Angle(other T) float64 { return c.AsCollection().Angle(other) }
+func (c LineCollection) ProjectOn(s ProjectionStrategy) T {
+	return retype(c.AsCollection().ProjectOn(s).(Collection), c)
+}
+func (c LineCollection) ConvexHull() T { return c.AsCollection().ConvexHull() }
+func (c LineCollection) Simplify(strats ...SimplificationStrategy) T {
+	return retype(c.AsCollection().Simplify(strats...).(Collection), c)
+}
+func (c LineCollection) Clip(other T) T { return retype(c.AsCollection().Clip(other).(Collection), c) }
+func (c LineCollection) Buffer(d float64) T {
+	return retype(c.AsCollection().Buffer(d).(Collection), c)
+}
+func (c LineCollection) Affine(a, f float64, v Line) T {
+	return retype(c.AsCollection().Affine(a, f, v).(Collection), c)
+}
+func (c LineCollection) Rotate(a float64) T {
+	return retype(c.AsCollection().Rotate(a).(Collection), c)
+}
+func (c LineCollection) Translate(v Line) T {
+	return retype(c.AsCollection().Translate(v).(Collection), c)
+}
+func (c LineCollection) Scale(f float64) T { return retype(c.AsCollection().Scale(f).(Collection), c) }
+func (c LineCollection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return retype(c.AsCollection().Symmetrical(other, strats...).(Collection), c)
+}
+func (c LineCollection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	return c.AsCollection().Tesselate(t, opts...)
+}
+
+func (c LineCollection) Interior() T { return retype(c.AsCollection().Interior().(Collection), c) }
+func (c LineCollection) Border() T   { return retype(c.AsCollection().Border().(Collection), c) }
+func (c LineCollection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().Intersects(other, opts...)
+}
+func (c LineCollection) PointClosestTo(other T) Point { return c.AsCollection().PointClosestTo(other) }
+func (c LineCollection) ShortestLineTo(other T) Line  { return c.AsCollection().ShortestLineTo(other) }
+func (c LineCollection) IsInside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsInside(other, opts...)
+}
+func (c LineCollection) IsOutside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOutside(other, opts...)
+}
+func (c LineCollection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOn(other, opts...)
+}
+func (c LineCollection) Intersection(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Intersection(other, opts...).(Collection), c)
+}
+func (c LineCollection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().IntersectionWith(others, opts...).(Collection), c)
+}
+func (c LineCollection) Union(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Union(other, opts...).(Collection), c)
+}
+func (c LineCollection) UnionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().UnionWith(others, opts...).(Collection), c)
+}
+
+func (c LineCollection) Features() interface{}            { return c.AsCollection().Features() }
+func (c LineCollection) SetFeatures(features interface{}) { c.AsCollection().SetFeatures(features) }
+
+// AsCollection yields the geometries of the LineStringCollection as a Collection
+func (c LineStringCollection) AsCollection() Collection {
+	geometries := make(Collection, len(c))
+	for i, g := range c {
+		geometries[i] = g
+	}
+	return geometries
+}
+
+func (c LineStringCollection) Layout() Layout               { return c.AsCollection().Layout() }
+func (c LineStringCollection) SRID() uint32                 { return c.AsCollection().SRID() }
+func (c LineStringCollection) IsEmpty() bool                { return c.AsCollection().IsEmpty() }
+func (c LineStringCollection) Clone() T                     { return retype(c.AsCollection().Clone().(Collection), c) }
+func (c LineStringCollection) Round(opts ...RoundingOption) { c.AsCollection().Round(opts...) }
+func (c LineStringCollection) Bounds() Bounds               { return c.AsCollection().Bounds() }
+func (c LineStringCollection) FlatCoords() [][]float64      { return c.AsCollection().FlatCoords() }
+func (c LineStringCollection) SetFlatCoords(coords [][]float64) error {
+	return c.AsCollection().SetFlatCoords(coords)
+}
+func (c LineStringCollection) Centroid() Point   { return c.AsCollection().Centroid() }
+func (c LineStringCollection) Vertices() []Point { return c.AsCollection().Vertices() }
+func (c LineStringCollection) Edges() []Line     { return c.AsCollection().Edges() }
+
+func (c LineStringCollection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(LineStringCollection)
+	return ok && c.AsCollection().Equals(o.AsCollection(), opts...)
+}
+
+func (c LineStringCollection) Sort(strats ...SortStrategy) {
+	sorted := c.AsCollection()
+	sorted.Sort(strats...)
+	for i, g := range sorted {
+		c[i] = g.(LineString)
+	}
+}
+
+func (c LineStringCollection) Clusterize(s ClusteringStrategy) T {
+	return c.AsCollection().Clusterize(s)
+}
+func (c LineStringCollection) Area() float64         { return c.AsCollection().Area() }
+func (c LineStringCollection) SignedArea() float64   { return c.AsCollection().SignedArea() }
+func (c LineStringCollection) Length() float64       { return c.AsCollection().Length() }
+func (c LineStringCollection) Volume() float64       { return c.AsCollection().Volume() }
+func (c LineStringCollection) SignedVolume() float64 { return c.AsCollection().SignedVolume() }
+func (c LineStringCollection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	return c.AsCollection().DistanceTo(other, strats...)
+}
+func (c LineStringCollection) Angle(other T) float64 { return c.AsCollection().Angle(other) }
+func (c LineStringCollection) ProjectOn(s ProjectionStrategy) T {
+	return retype(c.AsCollection().ProjectOn(s).(Collection), c)
+}
+func (c LineStringCollection) ConvexHull() T { return c.AsCollection().ConvexHull() }
+func (c LineStringCollection) Simplify(strats ...SimplificationStrategy) T {
+	return retype(c.AsCollection().Simplify(strats...).(Collection), c)
+}
+func (c LineStringCollection) Clip(other T) T {
+	return retype(c.AsCollection().Clip(other).(Collection), c)
+}
+func (c LineStringCollection) Buffer(d float64) T {
+	return retype(c.AsCollection().Buffer(d).(Collection), c)
+}
+func (c LineStringCollection) Affine(a, f float64, v Line) T {
+	return retype(c.AsCollection().Affine(a, f, v).(Collection), c)
+}
+func (c LineStringCollection) Rotate(a float64) T {
+	return retype(c.AsCollection().Rotate(a).(Collection), c)
+}
+func (c LineStringCollection) Translate(v Line) T {
+	return retype(c.AsCollection().Translate(v).(Collection), c)
+}
+func (c LineStringCollection) Scale(f float64) T {
+	return retype(c.AsCollection().Scale(f).(Collection), c)
+}
+func (c LineStringCollection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return retype(c.AsCollection().Symmetrical(other, strats...).(Collection), c)
+}
+func (c LineStringCollection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	return c.AsCollection().Tesselate(t, opts...)
+}
+
+func (c LineStringCollection) Interior() T {
+	return retype(c.AsCollection().Interior().(Collection), c)
+}
+func (c LineStringCollection) Border() T { return retype(c.AsCollection().Border().(Collection), c) }
+func (c LineStringCollection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().Intersects(other, opts...)
+}
+func (c LineStringCollection) PointClosestTo(other T) Point {
+	return c.AsCollection().PointClosestTo(other)
+}
+func (c LineStringCollection) ShortestLineTo(other T) Line {
+	return c.AsCollection().ShortestLineTo(other)
+}
+func (c LineStringCollection) IsInside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsInside(other, opts...)
+}
+func (c LineStringCollection) IsOutside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOutside(other, opts...)
+}
+func (c LineStringCollection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOn(other, opts...)
+}
+func (c LineStringCollection) Intersection(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Intersection(other, opts...).(Collection), c)
+}
+func (c LineStringCollection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().IntersectionWith(others, opts...).(Collection), c)
+}
+func (c LineStringCollection) Union(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Union(other, opts...).(Collection), c)
+}
+func (c LineStringCollection) UnionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().UnionWith(others, opts...).(Collection), c)
+}
+
+func (c LineStringCollection) Features() interface{} { return c.AsCollection().Features() }
+func (c LineStringCollection) SetFeatures(features interface{}) {
+	c.AsCollection().SetFeatures(features)
+}
+
+// AsCollection yields the geometries of the RingCollection as a Collection
+func (c RingCollection) AsCollection() Collection {
+	geometries := make(Collection, len(c))
+	for i, g := range c {
+		geometries[i] = g
+	}
+	return geometries
+}
+
+func (c RingCollection) Layout() Layout               { return c.AsCollection().Layout() }
+func (c RingCollection) SRID() uint32                 { return c.AsCollection().SRID() }
+func (c RingCollection) IsEmpty() bool                { return c.AsCollection().IsEmpty() }
+func (c RingCollection) Clone() T                     { return retype(c.AsCollection().Clone().(Collection), c) }
+func (c RingCollection) Round(opts ...RoundingOption) { c.AsCollection().Round(opts...) }
+func (c RingCollection) Bounds() Bounds               { return c.AsCollection().Bounds() }
+func (c RingCollection) FlatCoords() [][]float64      { return c.AsCollection().FlatCoords() }
+func (c RingCollection) SetFlatCoords(coords [][]float64) error {
+	return c.AsCollection().SetFlatCoords(coords)
+}
+func (c RingCollection) Centroid() Point   { return c.AsCollection().Centroid() }
+func (c RingCollection) Vertices() []Point { return c.AsCollection().Vertices() }
+func (c RingCollection) Edges() []Line     { return c.AsCollection().Edges() }
+
+func (c RingCollection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(RingCollection)
+	return ok && c.AsCollection().Equals(o.AsCollection(), opts...)
+}
+
+func (c RingCollection) Sort(strats ...SortStrategy) {
+	sorted := c.AsCollection()
+	sorted.Sort(strats...)
+	for i, g := range sorted {
+		c[i] = g.(Ring)
+	}
+}
+
+func (c RingCollection) Clusterize(s ClusteringStrategy) T { return c.AsCollection().Clusterize(s) }
+func (c RingCollection) Area() float64                     { return c.AsCollection().Area() }
+func (c RingCollection) SignedArea() float64               { return c.AsCollection().SignedArea() }
+func (c RingCollection) Length() float64                   { return c.AsCollection().Length() }
+func (c RingCollection) Volume() float64                   { return c.AsCollection().Volume() }
+func (c RingCollection) SignedVolume() float64             { return c.AsCollection().SignedVolume() }
+func (c RingCollection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	return c.AsCollection().DistanceTo(other, strats...)
+}
+func (c RingCollection) Angle(other T) float64 { return c.AsCollection().Angle(other) }
+func (c RingCollection) ProjectOn(s ProjectionStrategy) T {
+	return retype(c.AsCollection().ProjectOn(s).(Collection), c)
+}
+func (c RingCollection) ConvexHull() T { return c.AsCollection().ConvexHull() }
+func (c RingCollection) Simplify(strats ...SimplificationStrategy) T {
+	return retype(c.AsCollection().Simplify(strats...).(Collection), c)
+}
+func (c RingCollection) Clip(other T) T { return retype(c.AsCollection().Clip(other).(Collection), c) }
+func (c RingCollection) Buffer(d float64) T {
+	return retype(c.AsCollection().Buffer(d).(Collection), c)
+}
+func (c RingCollection) Affine(a, f float64, v Line) T {
+	return retype(c.AsCollection().Affine(a, f, v).(Collection), c)
+}
+func (c RingCollection) Rotate(a float64) T {
+	return retype(c.AsCollection().Rotate(a).(Collection), c)
+}
+func (c RingCollection) Translate(v Line) T {
+	return retype(c.AsCollection().Translate(v).(Collection), c)
+}
+func (c RingCollection) Scale(f float64) T { return retype(c.AsCollection().Scale(f).(Collection), c) }
+func (c RingCollection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return retype(c.AsCollection().Symmetrical(other, strats...).(Collection), c)
+}
+func (c RingCollection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	return c.AsCollection().Tesselate(t, opts...)
+}
+
+func (c RingCollection) Interior() T { return retype(c.AsCollection().Interior().(Collection), c) }
+func (c RingCollection) Border() T   { return retype(c.AsCollection().Border().(Collection), c) }
+func (c RingCollection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().Intersects(other, opts...)
+}
+func (c RingCollection) PointClosestTo(other T) Point { return c.AsCollection().PointClosestTo(other) }
+func (c RingCollection) ShortestLineTo(other T) Line  { return c.AsCollection().ShortestLineTo(other) }
+func (c RingCollection) IsInside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsInside(other, opts...)
+}
+func (c RingCollection) IsOutside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOutside(other, opts...)
+}
+func (c RingCollection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOn(other, opts...)
+}
+func (c RingCollection) Intersection(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Intersection(other, opts...).(Collection), c)
+}
+func (c RingCollection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().IntersectionWith(others, opts...).(Collection), c)
+}
+func (c RingCollection) Union(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Union(other, opts...).(Collection), c)
+}
+func (c RingCollection) UnionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().UnionWith(others, opts...).(Collection), c)
+}
+
+func (c RingCollection) Features() interface{}            { return c.AsCollection().Features() }
+func (c RingCollection) SetFeatures(features interface{}) { c.AsCollection().SetFeatures(features) }
+
+// AsCollection yields the geometries of the PolygonCollection as a Collection
+func (c PolygonCollection) AsCollection() Collection {
+	geometries := make(Collection, len(c))
+	for i, g := range c {
+		geometries[i] = g
+	}
+	return geometries
+}
+
+func (c PolygonCollection) Layout() Layout               { return c.AsCollection().Layout() }
+func (c PolygonCollection) SRID() uint32                 { return c.AsCollection().SRID() }
+func (c PolygonCollection) IsEmpty() bool                { return c.AsCollection().IsEmpty() }
+func (c PolygonCollection) Clone() T                     { return retype(c.AsCollection().Clone().(Collection), c) }
+func (c PolygonCollection) Round(opts ...RoundingOption) { c.AsCollection().Round(opts...) }
+func (c PolygonCollection) Bounds() Bounds               { return c.AsCollection().Bounds() }
+func (c PolygonCollection) FlatCoords() [][]float64      { return c.AsCollection().FlatCoords() }
+func (c PolygonCollection) SetFlatCoords(coords [][]float64) error {
+	return c.AsCollection().SetFlatCoords(coords)
+}
+func (c PolygonCollection) Centroid() Point   { return c.AsCollection().Centroid() }
+func (c PolygonCollection) Vertices() []Point { return c.AsCollection().Vertices() }
+func (c PolygonCollection) Edges() []Line     { return c.AsCollection().Edges() }
+
+func (c PolygonCollection) Equals(other T, opts ...EqualityOption) bool {
+	o, ok := other.(PolygonCollection)
+	return ok && c.AsCollection().Equals(o.AsCollection(), opts...)
+}
+
+func (c PolygonCollection) Sort(strats ...SortStrategy) {
+	sorted := c.AsCollection()
+	sorted.Sort(strats...)
+	for i, g := range sorted {
+		c[i] = g.(Polygon)
+	}
+}
+
+func (c PolygonCollection) Clusterize(s ClusteringStrategy) T { return c.AsCollection().Clusterize(s) }
+func (c PolygonCollection) Area() float64                     { return c.AsCollection().Area() }
+func (c PolygonCollection) SignedArea() float64               { return c.AsCollection().SignedArea() }
+func (c PolygonCollection) Length() float64                   { return c.AsCollection().Length() }
+func (c PolygonCollection) Volume() float64                   { return c.AsCollection().Volume() }
+func (c PolygonCollection) SignedVolume() float64             { return c.AsCollection().SignedVolume() }
+func (c PolygonCollection) DistanceTo(other T, strats ...DistanceStrategy) float64 {
+	return c.AsCollection().DistanceTo(other, strats...)
+}
+func (c PolygonCollection) Angle(other T) float64 { return c.AsCollection().Angle(other) }
+func (c PolygonCollection) ProjectOn(s ProjectionStrategy) T {
+	return retype(c.AsCollection().ProjectOn(s).(Collection), c)
+}
+func (c PolygonCollection) ConvexHull() T { return c.AsCollection().ConvexHull() }
+func (c PolygonCollection) Simplify(strats ...SimplificationStrategy) T {
+	return retype(c.AsCollection().Simplify(strats...).(Collection), c)
+}
+func (c PolygonCollection) Clip(other T) T {
+	return retype(c.AsCollection().Clip(other).(Collection), c)
+}
+func (c PolygonCollection) Buffer(d float64) T {
+	return retype(c.AsCollection().Buffer(d).(Collection), c)
+}
+func (c PolygonCollection) Affine(a, f float64, v Line) T {
+	return retype(c.AsCollection().Affine(a, f, v).(Collection), c)
+}
+func (c PolygonCollection) Rotate(a float64) T {
+	return retype(c.AsCollection().Rotate(a).(Collection), c)
+}
+func (c PolygonCollection) Translate(v Line) T {
+	return retype(c.AsCollection().Translate(v).(Collection), c)
+}
+func (c PolygonCollection) Scale(f float64) T {
+	return retype(c.AsCollection().Scale(f).(Collection), c)
+}
+func (c PolygonCollection) Symmetrical(other T, strats ...SymmetryStrategy) T {
+	return retype(c.AsCollection().Symmetrical(other, strats...).(Collection), c)
+}
+func (c PolygonCollection) Tesselate(t Tesselator, opts ...TesselateOption) PolygonCollection {
+	return c.AsCollection().Tesselate(t, opts...)
+}
+
+func (c PolygonCollection) Interior() T { return retype(c.AsCollection().Interior().(Collection), c) }
+func (c PolygonCollection) Border() T   { return retype(c.AsCollection().Border().(Collection), c) }
+func (c PolygonCollection) Intersects(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().Intersects(other, opts...)
+}
+func (c PolygonCollection) PointClosestTo(other T) Point {
+	return c.AsCollection().PointClosestTo(other)
+}
+func (c PolygonCollection) ShortestLineTo(other T) Line {
+	return c.AsCollection().ShortestLineTo(other)
+}
+func (c PolygonCollection) IsInside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsInside(other, opts...)
+}
+func (c PolygonCollection) IsOutside(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOutside(other, opts...)
+}
+func (c PolygonCollection) IsOn(other T, opts ...TopologyOption) bool {
+	return c.AsCollection().IsOn(other, opts...)
+}
+func (c PolygonCollection) Intersection(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Intersection(other, opts...).(Collection), c)
+}
+func (c PolygonCollection) IntersectionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().IntersectionWith(others, opts...).(Collection), c)
+}
+func (c PolygonCollection) Union(other T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().Union(other, opts...).(Collection), c)
+}
+func (c PolygonCollection) UnionWith(others []T, opts ...TopologyOption) T {
+	return retype(c.AsCollection().UnionWith(others, opts...).(Collection), c)
+}
+
+func (c PolygonCollection) Features() interface{}            { return c.AsCollection().Features() }
+func (c PolygonCollection) SetFeatures(features interface{}) { c.AsCollection().SetFeatures(features) }
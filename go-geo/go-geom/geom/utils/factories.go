@@ -1,223 +1,73 @@
 package utils
 
 import (
-	"github.com/fredbi/go-geom/geom/codes"
-	//"github.com/fredbi/go-geom/geom/internal/layouts/s2"
-	//"github.com/fredbi/go-geom/geom/internal/layouts/s3"
-	"github.com/fredbi/go-geom/geom/internal/layouts/stub"
-	"github.com/fredbi/go-geom/geom/internal/layouts/x"
-	//"github.com/fredbi/go-geom/geom/internal/layouts/x"
-	//"github.com/fredbi/go-geom/geom/internal/layouts/xy"
-	//"github.com/fredbi/go-geom/geom/internal/layouts/xyz"
 	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/internal/layouts/flat"
+	"github.com/fredbi/go-geom/geom/internal/layouts/stub"
 )
 
-var factory Factory
-
-func init() {
-	factory = defaultFactory()
-}
-
-type Factory struct {
-	defaults int
-}
-
-func (f factory) NewEmptyGeometry(opts ...geom.LayoutOption) geom.EmptyGeometry {
-	return stub.EmptyGeometry(opts...)
-}
-
-func (f factory) NewPoint(opts ...geom.LayoutOption) geom.Point {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewPoint(opts...)
-	case XY, XYEarth:
-		return xy.NewPoint(opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewPoint(opts...)
-	case S2:
-		return s2.NewPoint(opts...)
-	case S3:
-		return s3.NewPoint(opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
-	}
-}
-
-func (f factory) NewLine(p1, p2 geom.Point, opts ...geom.LayoutOption) Line {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	//if p1.Layout() != cfg.Layout || p2.Layout() != cfg.Layout {
-	//return NewEmptyGeometry(append(opts, WithCause(codes.ErrInconsistentLayout))...)
-	//}
-	switch cfg.layout {
-	case X:
-		return x.NewLine(p1, p2, opts...)
-	case XY, XYEarth:
-		return xy.NewLine(p1, p2, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewLine(p1, p2, opts...)
-	case S2:
-		return s2.NewLine(p1, p2, opts...)
-	case S3:
-		return s3.NewLine(p1, p2, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
-	}
-}
+// Factories build geometries of any layout, with the layout and SRID specified as options.
+// The default layout is XY.
+//
+// Factories building geometries from points panic if the points don't fit the layout.
 
-func (f factory) NewRing(pt []geom.Point, opts ...geom.LayoutOption) geom.Ring {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewRing(pt, opts...)
-	case XY, XYEarth:
-		return xy.NewRing(pt, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewRing(pt, opts...)
-	case S2:
-		return s2.NewRing(pt, opts...)
-	case S3:
-		return s3.NewRing(pt, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
-	}
+func NewEmptyGeometry(opts ...geom.LayoutOption) geom.EmptyGeometry {
+	return stub.NewEmptyGeometry(opts...)
 }
 
-func (f factory) NewLineString(pt []geom.Point, opts ...geom.LayoutOption) geom.LineString {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewLineString(pt, opts...)
-	case XY, XYEarth:
-		return xy.NewLineString(pt, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewLineString(pt, opts...)
-	case S2:
-		return s2.NewLineString(pt, opts...)
-	case S3:
-		return s3.NewLineString(pt, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
-	}
+// NewPoint builds an empty Point, with the layout and SRID specified as options
+func NewPoint(opts ...geom.LayoutOption) geom.Point {
+	return flat.NewPoint(opts...)
 }
 
-func (f factory) NewPolygon(pt []geom.Point, opts ...geom.LayoutOption) geom.Polygon {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewPolygon(pt, opts...)
-	case XY, XYEarth:
-		return xy.NewPolygon(pt, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewPolygon(pt, opts...)
-	case S2:
-		return s2.NewPolygon(pt, opts...)
-	case S3:
-		return s3.NewPolygon(pt, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
+// NewLine builds a Line from its ends
+func NewLine(p1, p2 geom.Point, opts ...geom.LayoutOption) geom.Line {
+	l, err := flat.NewLine(p1, p2, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return l
 }
 
-func (f factory) NewRectangle(a, b geom.Point, opts ...geom.LayoutOption) geom.Rectangle {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewRectangle(a, b, opts...)
-	case XY, XYEarth:
-		return xy.NewRectangle(a, b, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewRectangle(a, b, opts...)
-	case S2:
-		return s2.NewRectangle(a, b, opts...)
-	case S3:
-		return s3.NewRectangle(a, b, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
+// NewLineString builds a LineString from its vertices
+func NewLineString(pt []geom.Point, opts ...geom.LayoutOption) geom.LineString {
+	ls, err := flat.NewLineString(pt, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return ls
 }
 
-func (f factory) NewSquare(o geom.Point, c float64, opts ...geom.LayoutOption) geom.Square {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewSquare(o, c, opts...)
-	case XY, XYEarth:
-		return xy.NewSquare(o, c, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewSquare(o, c, opts...)
-	case S2:
-		return s2.NewSquare(o, c, opts...)
-	case S3:
-		return s3.NewSquare(o, c, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
+// NewRing builds a Ring from its vertices
+func NewRing(pt []geom.Point, opts ...geom.LayoutOption) geom.Ring {
+	r, err := flat.NewRing(pt, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return r
 }
 
-func (f factory) NewTriangle(a, b, c geom.Point, opts ...geom.LayoutOption) geom.Triangle {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewTriangle(a, b, c, opts...)
-	case XY, XYEarth:
-		return xy.NewTriangle(a, b, c, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewTriangle(a, b, c, opts...)
-	case S2:
-		return s2.NewTriangle(a, b, c, opts...)
-	case S3:
-		return s3.NewTriangle(a, b, c, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
-	}
+// NewPolygon builds a Polygon with no holes from the vertices of its exterior ring
+func NewPolygon(pt []geom.Point, opts ...geom.LayoutOption) geom.Polygon {
+	return NewPolygonWithHoles(pt, nil, opts...)
 }
 
-func (f factory) NewHexagon(o geom.Point, c float64, opts ...geom.LayoutOption) geom.Hexagon {
-	cfg := defautLayoutConfig()
-	for _, apply := range opts {
-		apply(cfg)
-	}
-	switch cfg.layout {
-	case X:
-		return x.NewHexagon(o, c, opts...)
-	case XY, XYEarth:
-		return xy.NewHexagon(o, c, opts...)
-	case XYZ, XYZEarth:
-		return xyz.NewHexagon(o, c, opts...)
-	case S2:
-		return s2.NewHexagon(o, c, opts...)
-	case S3:
-		return s3.NewHexagon(o, c, opts...)
-	default:
-		return stub.NewEmptyGeometry(opts...).WithCause(codes.ErrUnsupportedLayout)
+// NewPolygonWithHoles builds a Polygon from the vertices of its exterior ring and of its holes
+func NewPolygonWithHoles(exterior []geom.Point, holes [][]geom.Point, opts ...geom.LayoutOption) geom.Polygon {
+	p, err := flat.NewPolygon(exterior, holes, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return p
 }
 
-func NewEmptyGeometry(opts ...geom.LayoutOption) geom.EmptyGeometry {
-	return factory.NewEmptyGeometry(opts...)
+// NewBounds builds a bounding box from its min and max corners. Bounds with nil corners are empty.
+//
+// Panics if the corners don't fit the layout.
+func NewBounds(min, max []float64, opts ...geom.LayoutOption) geom.Bounds {
+	b, err := flat.NewBounds(min, max, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return b
 }
@@ -10,53 +10,55 @@ func Centroid(geometries ...geom.T) geom.Point {
 	return collectionCentroid(geometries...)
 }
 
-func collectionCentroid(geometries ...T) Point {
+func collectionCentroid(geometries ...geom.T) geom.Point {
 	type weightedCentroid struct {
 		weight   float64
-		centroid Point
+		centroid geom.Point
 	}
 	// TODO(fred): use async
 	var hasMass bool
 	m := make([]weightedCentroid, 0, len(geometries))
 	for _, g := range geometries {
-		var a float64
-		d, measurable := g.(Measurer)
-		if measurable {
-			if hasMass {
-				continue
-			}
-			a = d.Area() // volume?
-		}
+		a := g.Area() // volume?
 		hasMass = hasMass || a > 0
 		m = append(m, weightedCentroid{
 			weight:   a,
 			centroid: g.Centroid(),
 		})
 	}
-	// TODO
-	return NewPoint()
+	if len(m) == 0 {
+		return NewPoint()
+	}
+
+	var total float64
+	mean := make([]float64, len(m[0].centroid.Coords()))
+	for _, c := range m {
+		weight := c.weight
+		if !hasMass {
+			weight = 1
+		}
+		if weight == 0 {
+			continue
+		}
+		total += weight
+		for i, x := range c.centroid.Coords() {
+			mean[i] += weight * x
+		}
+	}
+	for i := range mean {
+		mean[i] /= total
+	}
+	return m[0].centroid.Clone().(geom.Point).WithCoords(mean, func(error) {})
 }
 
 // Distance between two geometries, according to the DistanceStrategy.
 func Distance(g1, g2 geom.T, strats ...geom.DistanceStrategy) float64 {
-	return d.DistanceTo(g2, strats...)
+	return g1.DistanceTo(g2, strats...)
 }
 
 // Area of one or several geometries. If some geometries are not measurable, they are ignored.
 func Area(geometries ...geom.T) float64 {
-	switch len(geometries) {
-	case 0:
-		return 0
-	case 1:
-		return d.Area()
-	default:
-		var a float64
-		// TODO(fred): async
-		for _, g := range geometries {
-			a += g.Area()
-		}
-		return a
-	}
+	return geom.Collection(geometries).Area()
 }
 
 // Intersection compute the intersection of several geometries, with default options
@@ -67,7 +69,7 @@ func Intersection(geometries ...geom.T) geom.T {
 	case 1:
 		return geometries[0]
 	default:
-		return geometries[0].Intersection(geometries[1:])
+		return geometries[0].IntersectionWith(geometries[1:])
 	}
 }
 
@@ -79,6 +81,57 @@ func Union(geometries ...geom.T) geom.T {
 	case 1:
 		return geometries[0]
 	default:
-		return geometries[0].Union(geometries[1:])
+		return geometries[0].UnionWith(geometries[1:])
+	}
+}
+
+// RingCoords yields the vertices of a ring, closed: the last vertex is equal to the first one.
+//
+// This is the representation of rings expected by most encodings (e.g. WKB, GeoJSON).
+func RingCoords(r geom.Ring) [][]float64 {
+	coords := r.FlatCoords()
+	if len(coords) == 0 {
+		return coords
+	}
+	first, last := coords[0], coords[len(coords)-1]
+	if len(first) == len(last) {
+		closed := true
+		for i := range first {
+			if first[i] != last[i] {
+				closed = false
+				break
+			}
+		}
+		if closed {
+			return coords
+		}
+	}
+	return append(coords[:len(coords):len(coords)], first)
+}
+
+// PolygonCoords yields the closed rings of a polygon, starting with its exterior ring.
+func PolygonCoords(p geom.Polygon) [][][]float64 {
+	holes := p.InteriorRings()
+	coords := make([][][]float64, 0, 1+len(holes))
+	coords = append(coords, RingCoords(p.ExteriorRing()))
+	for _, hole := range holes {
+		coords = append(coords, RingCoords(hole))
+	}
+	return coords
+}
+
+// BoundsCoords yields the planar rectangle covered by some bounds, as a closed ring.
+func BoundsCoords(b geom.Bounds) [][]float64 {
+	corners := b.FlatCoords()
+	if len(corners) != 2 || len(corners[0]) < 2 || len(corners[1]) < 2 {
+		return [][]float64{}
+	}
+	min, max := corners[0], corners[1]
+	return [][]float64{
+		{min[0], min[1]},
+		{min[0], max[1]},
+		{max[0], max[1]},
+		{max[0], min[1]},
+		{min[0], min[1]},
 	}
 }
@@ -1,25 +1,27 @@
 package utils
 
-func NewSimplificationStrategy() SimplificationStrategy {
+import "github.com/fredbi/go-geom/geom"
+
+func NewSimplificationStrategy() geom.SimplificationStrategy {
 	return nil
 }
 
-func NewClusteringStrategy() ClusteringStrategy {
+func NewClusteringStrategy() geom.ClusteringStrategy {
 	return nil
 }
 
-func NewDistanceStrategy() DistanceStrategy {
+func NewDistanceStrategy() geom.DistanceStrategy {
 	return nil
 }
 
-func NewSortStrategy() SortStrategy {
+func NewSortStrategy() geom.SortStrategy {
 	return nil
 }
 
-func NewSymmetryStrategy() SymmetryStrategy {
+func NewSymmetryStrategy() geom.SymmetryStrategy {
 	return nil
 }
 
-func NewProjectionStrategy() ProjectionStrategy {
+func NewProjectionStrategy() geom.ProjectionStrategy {
 	return nil
 }
@@ -0,0 +1,154 @@
+package geoparquet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+	"github.com/fredbi/go-geom/geom/encoding/wkb/native"
+)
+
+// binaryValuer is implemented by the binary and large binary arrays.
+type binaryValuer interface {
+	arrow.Array
+	Value(int) []byte
+}
+
+// Decode converts a record batch with GeoParquet metadata to features.
+//
+// Columns other than the geometry and id columns become properties: null values are omitted,
+// integers are decoded as int64 and floats as float64. Secondary geometry columns are decoded
+// as geometries.
+func Decode(rec arrow.Record, opts ...Option) ([]*geojson.Feature, error) {
+	o := optionsWithDefaults(opts)
+	meta, err := metadataOf(rec.Schema())
+	switch {
+	case err == ErrNoMetadata && o.geometryColSet:
+		meta = nil
+	case err != nil:
+		return nil, err
+	}
+	return decodeRecord(rec, meta, o)
+}
+
+func decodeRecord(rec arrow.Record, meta *Metadata, o *options) ([]*geojson.Feature, error) {
+	primary := o.geometryColumn
+	geometryColumns := map[string]bool{}
+	if meta != nil {
+		if !o.geometryColSet {
+			primary = meta.PrimaryColumn
+		}
+		for name, column := range meta.Columns {
+			if column.Encoding != EncodingWKB {
+				return nil, ErrUnsupportedEncoding(column.Encoding)
+			}
+			geometryColumns[name] = true
+		}
+	}
+	geometryColumns[primary] = true
+
+	schema := rec.Schema()
+	indices := schema.FieldIndices(primary)
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("geoparquet: no geometry column %q", primary)
+	}
+	geometries, ok := rec.Column(indices[0]).(binaryValuer)
+	if !ok {
+		return nil, fmt.Errorf("geoparquet: the geometry column %q is not binary, but %s", primary, rec.Column(indices[0]).DataType())
+	}
+
+	n := int(rec.NumRows())
+	features := make([]*geojson.Feature, n)
+	for i := range features {
+		f := &geojson.Feature{Properties: make(geojson.Properties)}
+		if !geometries.IsNull(i) {
+			g, err := native.Unmarshal(geometries.Value(i))
+			if err != nil {
+				return nil, &FeatureError{Index: i, Err: err}
+			}
+			f.Geometry = g
+		}
+		features[i] = f
+	}
+
+	for j, field := range schema.Fields() {
+		if field.Name == primary {
+			continue
+		}
+		arr := rec.Column(j)
+		for i, f := range features {
+			if arr.IsNull(i) {
+				continue
+			}
+			value, err := valueAt(arr, i, field, geometryColumns[field.Name])
+			if err != nil {
+				return nil, &FeatureError{Index: i, Err: &geojson.PropertyError{Name: field.Name, Err: err}}
+			}
+			if field.Name == o.idColumn {
+				f.ID = value
+				continue
+			}
+			f.Properties[field.Name] = value
+		}
+	}
+	return features, nil
+}
+
+// valueAt decodes the value of a property.
+func valueAt(arr arrow.Array, i int, field arrow.Field, isGeometry bool) (interface{}, error) {
+	isJSON := false
+	if k := field.Metadata.FindKey(extensionNameKey); k >= 0 {
+		isJSON = field.Metadata.Values()[k] == jsonExtensionName
+	}
+	if ext, ok := arr.(array.ExtensionArray); ok {
+		isJSON = isJSON || ext.ExtensionType().ExtensionName() == jsonExtensionName
+		arr = ext.Storage()
+	}
+
+	switch a := arr.(type) {
+	case *array.String:
+		return stringValue(a.Value(i), isJSON)
+	case *array.LargeString:
+		return stringValue(a.Value(i), isJSON)
+	case binaryValuer:
+		if isGeometry {
+			return native.Unmarshal(a.Value(i))
+		}
+		return append([]byte(nil), a.Value(i)...), nil
+	case *array.Boolean:
+		return a.Value(i), nil
+	case *array.Int64:
+		return a.Value(i), nil
+	case *array.Int32:
+		return int64(a.Value(i)), nil
+	case *array.Int16:
+		return int64(a.Value(i)), nil
+	case *array.Int8:
+		return int64(a.Value(i)), nil
+	case *array.Uint32:
+		return int64(a.Value(i)), nil
+	case *array.Uint16:
+		return int64(a.Value(i)), nil
+	case *array.Uint8:
+		return int64(a.Value(i)), nil
+	case *array.Float64:
+		return a.Value(i), nil
+	case *array.Float32:
+		return float64(a.Value(i)), nil
+	default:
+		return arr.ValueStr(i), nil
+	}
+}
+
+func stringValue(s string, isJSON bool) (interface{}, error) {
+	if !isJSON {
+		return s, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,21 @@
+// Package geoparquet converts GeoJSON feature collections to and from Apache Arrow record
+// batches and GeoParquet files.
+//
+// Geometries are stored as ISO WKB in a binary column, described by the "geo" metadata of the
+// GeoParquet specification (https://geoparquet.org, version 1.1). Properties are stored as typed
+// columns, after the schema inferred from the features by geojson.InferSchema:
+//
+//   - strings, integers, numbers and booleans map to utf8, int64, float64 and bool columns
+//   - objects, arrays and properties of mixed types are stored as JSON text, in utf8 columns
+//     tagged with the arrow.json canonical extension name
+//
+// A collection is written to a Parquet file with WriteParquet, and read back with ReadParquet:
+//
+//	err := geoparquet.WriteParquet(w, fc, geoparquet.WithBatchSize(10000))
+//
+// Arrow record batches are produced by Encode, for consumers such as Flight or IPC streams,
+// and decoded by Decode.
+//
+// Unlike the other go-geom modules, which build with go 1.14, this module requires go 1.22.7,
+// after its Apache Arrow dependency (github.com/apache/arrow-go/v18).
+package geoparquet
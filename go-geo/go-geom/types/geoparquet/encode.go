@@ -0,0 +1,283 @@
+package geoparquet
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+	"github.com/fredbi/go-geom/geom/encoding/wkb/native"
+)
+
+const (
+	// extensionNameKey is the field metadata key of the name of an Arrow extension type.
+	extensionNameKey = "ARROW:extension:name"
+
+	// jsonExtensionName is the name of the canonical Arrow extension type for JSON text.
+	jsonExtensionName = "arrow.json"
+)
+
+// A FeatureError reports an error on a feature of a collection.
+type FeatureError struct {
+	Index int
+	Err   error
+}
+
+func (e *FeatureError) Error() string {
+	return fmt.Sprintf("geoparquet: feature %d: %v", e.Index, e.Err)
+}
+
+// Unwrap yields the reason why the feature is in error
+func (e *FeatureError) Unwrap() error {
+	return e.Err
+}
+
+// column describes a property column.
+type column struct {
+	name string
+	typ  geojson.PropertyType
+}
+
+// encoder holds the layout of the records of a collection.
+type encoder struct {
+	o        *options
+	schema   *arrow.Schema
+	props    *geojson.Schema
+	columns  []column
+	idsAsInt bool
+}
+
+// Encode converts a feature collection to Arrow record batches, with GeoParquet metadata
+// in the metadata of their schema.
+//
+// The caller is responsible for releasing the records.
+func Encode(fc *geojson.FeatureCollection, opts ...Option) (*arrow.Schema, []arrow.Record, error) {
+	o := optionsWithDefaults(opts)
+	enc, wkbs, err := newEncoder(fc, o)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []arrow.Record
+	for start := 0; start < len(fc.Features); start += o.batchSize {
+		end := start + o.batchSize
+		if end > len(fc.Features) {
+			end = len(fc.Features)
+		}
+		rec, err := enc.record(fc.Features[start:end], wkbs[start:end], start)
+		if err != nil {
+			for _, r := range records {
+				r.Release()
+			}
+			return nil, nil, err
+		}
+		records = append(records, rec)
+	}
+	return enc.schema, records, nil
+}
+
+// newEncoder encodes the geometries of a collection, and infers the schema of its records.
+func newEncoder(fc *geojson.FeatureCollection, o *options) (*encoder, [][]byte, error) {
+	enc := &encoder{o: o, props: o.schema, idsAsInt: true}
+	if enc.props == nil {
+		enc.props = geojson.InferSchema(fc)
+	}
+
+	stats := newGeometryStats()
+	wkbs := make([][]byte, len(fc.Features))
+	for i, f := range fc.Features {
+		if f == nil {
+			return nil, nil, &FeatureError{Index: i, Err: fmt.Errorf("null feature")}
+		}
+		if f.ID != nil && !isInteger(f.ID) {
+			enc.idsAsInt = false
+		}
+		if f.Geometry == nil {
+			continue
+		}
+		wkb, err := native.Marshal(f.Geometry, binary.LittleEndian)
+		if err != nil {
+			return nil, nil, &FeatureError{Index: i, Err: err}
+		}
+		wkbs[i] = wkb
+		if f.Geometry.IsEmpty() {
+			stats.add(wkb, nil, nil)
+			continue
+		}
+		corners := f.Geometry.Bounds().FlatCoords()
+		if len(corners) == 2 {
+			stats.add(wkb, corners[0], corners[1])
+		}
+	}
+
+	meta := Metadata{
+		Version:       Version,
+		PrimaryColumn: o.geometryColumn,
+		Columns:       map[string]*ColumnMetadata{o.geometryColumn: stats.column(o.crs)},
+	}
+	encodedMeta, err := json.Marshal(meta)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := []arrow.Field{{Name: o.geometryColumn, Type: arrow.BinaryTypes.Binary, Nullable: true}}
+	names := map[string]bool{o.geometryColumn: true}
+	if o.idColumn != "" {
+		if names[o.idColumn] {
+			return nil, nil, fmt.Errorf("geoparquet: the id column has the name of the geometry column: %q", o.idColumn)
+		}
+		names[o.idColumn] = true
+		idType := arrow.DataType(arrow.BinaryTypes.String)
+		if enc.idsAsInt {
+			idType = arrow.PrimitiveTypes.Int64
+		}
+		fields = append(fields, arrow.Field{Name: o.idColumn, Type: idType, Nullable: true})
+	}
+	for _, ps := range enc.props.Properties {
+		if names[ps.Name] {
+			return nil, nil, fmt.Errorf("geoparquet: the property %q conflicts with another column", ps.Name)
+		}
+		names[ps.Name] = true
+		enc.columns = append(enc.columns, column{name: ps.Name, typ: ps.Type})
+		fields = append(fields, propertyField(ps))
+	}
+
+	md := arrow.NewMetadata([]string{MetadataKey}, []string{string(encodedMeta)})
+	enc.schema = arrow.NewSchema(fields, &md)
+	return enc, wkbs, nil
+}
+
+// propertyField yields the Arrow field of a property.
+func propertyField(ps geojson.PropertySchema) arrow.Field {
+	field := arrow.Field{Name: ps.Name, Nullable: true}
+	switch ps.Type {
+	case geojson.PropertyTypeString:
+		field.Type = arrow.BinaryTypes.String
+	case geojson.PropertyTypeInteger:
+		field.Type = arrow.PrimitiveTypes.Int64
+	case geojson.PropertyTypeNumber:
+		field.Type = arrow.PrimitiveTypes.Float64
+	case geojson.PropertyTypeBoolean:
+		field.Type = arrow.FixedWidthTypes.Boolean
+	default:
+		field.Type = arrow.BinaryTypes.String
+		field.Metadata = arrow.NewMetadata([]string{extensionNameKey}, []string{jsonExtensionName})
+	}
+	return field
+}
+
+func isInteger(v interface{}) bool {
+	switch n := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32:
+		return true
+	case float64:
+		return n == math.Trunc(n) && math.Abs(n) < 1<<53
+	default:
+		return false
+	}
+}
+
+// record builds the record batch of some features.
+func (enc *encoder) record(features []*geojson.Feature, wkbs [][]byte, offset int) (arrow.Record, error) {
+	b := array.NewRecordBuilder(enc.o.allocator, enc.schema)
+	defer b.Release()
+	b.Reserve(len(features))
+
+	geometries := b.Field(0).(*array.BinaryBuilder)
+	for _, wkb := range wkbs {
+		if wkb == nil {
+			geometries.AppendNull()
+			continue
+		}
+		geometries.Append(wkb)
+	}
+
+	first := 1
+	if enc.o.idColumn != "" {
+		first = 2
+		for _, f := range features {
+			appendID(b.Field(1), f.ID, enc.idsAsInt)
+		}
+	}
+
+	for i, f := range features {
+		properties, err := enc.props.Coerce(f.Properties)
+		if err != nil {
+			return nil, &FeatureError{Index: offset + i, Err: err}
+		}
+		for j, c := range enc.columns {
+			if err := appendProperty(b.Field(first+j), c, properties[c.name]); err != nil {
+				return nil, &FeatureError{Index: offset + i, Err: err}
+			}
+		}
+	}
+
+	return b.NewRecord(), nil
+}
+
+func appendID(b array.Builder, id interface{}, asInt bool) {
+	if id == nil {
+		b.AppendNull()
+		return
+	}
+	if asInt {
+		b.(*array.Int64Builder).Append(toInt64(id))
+		return
+	}
+	b.(*array.StringBuilder).Append(fmt.Sprint(id))
+}
+
+// toInt64 converts the integers accepted by isInteger.
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int8:
+		return int64(n)
+	case int16:
+		return int64(n)
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case uint:
+		return int64(n)
+	case uint8:
+		return int64(n)
+	case uint16:
+		return int64(n)
+	case uint32:
+		return int64(n)
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+func appendProperty(b array.Builder, c column, value interface{}) error {
+	if value == nil {
+		b.AppendNull()
+		return nil
+	}
+	switch c.typ {
+	case geojson.PropertyTypeString:
+		b.(*array.StringBuilder).Append(value.(string))
+	case geojson.PropertyTypeInteger:
+		b.(*array.Int64Builder).Append(value.(int64))
+	case geojson.PropertyTypeNumber:
+		b.(*array.Float64Builder).Append(value.(float64))
+	case geojson.PropertyTypeBoolean:
+		b.(*array.BooleanBuilder).Append(value.(bool))
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return &geojson.PropertyError{Name: c.name, Err: err}
+		}
+		b.(*array.StringBuilder).Append(string(data))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package geoparquet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCollection() *geojson.FeatureCollection {
+	return &geojson.FeatureCollection{Features: []*geojson.Feature{
+		{
+			ID:         1.0,
+			Geometry:   utils.NewPoint(geom.WithLayout(geom.XY)).WithCoords([]float64{1, 2}),
+			Properties: geojson.Properties{"name": "a", "rank": 3.0, "score": 1.5, "open": true, "tags": []interface{}{"u", 2.0}},
+		},
+		{
+			ID:         2.0,
+			Geometry:   utils.NewPoint(geom.WithLayout(geom.XY)).WithCoords([]float64{-3, 5}),
+			Properties: geojson.Properties{"name": "b", "rank": 4.0, "score": 2.0},
+		},
+		{
+			ID:         3.0,
+			Properties: geojson.Properties{"name": "c"},
+		},
+	}}
+}
+
+func TestEncode(t *testing.T) {
+	schema, records, err := Encode(testCollection(), WithIDColumn("id"), WithBatchSize(2))
+	require.NoError(t, err)
+	defer func() {
+		for _, rec := range records {
+			rec.Release()
+		}
+	}()
+	require.Len(t, records, 2)
+	assert.EqualValues(t, 2, records[0].NumRows())
+	assert.EqualValues(t, 1, records[1].NumRows())
+
+	types := map[string]arrow.DataType{}
+	for _, field := range schema.Fields() {
+		types[field.Name] = field.Type
+	}
+	assert.Equal(t, arrow.BinaryTypes.Binary, types["geometry"])
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, types["id"])
+	assert.Equal(t, arrow.BinaryTypes.String, types["name"])
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, types["rank"])
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, types["score"])
+	assert.Equal(t, arrow.FixedWidthTypes.Boolean, types["open"])
+	assert.Equal(t, arrow.BinaryTypes.String, types["tags"])
+
+	meta, err := metadataOf(schema)
+	require.NoError(t, err)
+	assert.Equal(t, Version, meta.Version)
+	assert.Equal(t, "geometry", meta.PrimaryColumn)
+	column := meta.Columns["geometry"]
+	require.NotNil(t, column)
+	assert.Equal(t, EncodingWKB, column.Encoding)
+	assert.Equal(t, []string{"Point"}, column.GeometryTypes)
+	assert.Equal(t, []float64{-3, 2, 1, 5}, column.BBox)
+
+	features, err := Decode(records[0], WithIDColumn("id"))
+	require.NoError(t, err)
+	require.Len(t, features, 2)
+	assert.Equal(t, int64(1), features[0].ID)
+	assert.Equal(t, []float64{1, 2}, features[0].Geometry.(geom.Point).Coords())
+	assert.Equal(t, geojson.Properties{
+		"name": "a", "rank": int64(3), "score": 1.5, "open": true, "tags": []interface{}{"u", 2.0},
+	}, features[0].Properties)
+	assert.Equal(t, geojson.Properties{"name": "b", "rank": int64(4), "score": 2.0}, features[1].Properties)
+
+	features, err = Decode(records[1], WithIDColumn("id"))
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	assert.Nil(t, features[0].Geometry)
+	assert.Equal(t, geojson.Properties{"name": "c"}, features[0].Properties)
+}
+
+func TestEncodeErrors(t *testing.T) {
+	t.Run("column conflict", func(t *testing.T) {
+		_, _, err := Encode(testCollection(), WithIDColumn("name"))
+		assert.Error(t, err)
+	})
+
+	t.Run("coercion", func(t *testing.T) {
+		schema := &geojson.Schema{Properties: []geojson.PropertySchema{{Name: "name", Type: geojson.PropertyTypeInteger}}}
+		_, _, err := Encode(testCollection(), WithSchema(schema))
+		var fe *FeatureError
+		require.ErrorAs(t, err, &fe)
+		assert.Equal(t, 0, fe.Index)
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		_, records, err := Encode(testCollection())
+		require.NoError(t, err)
+		defer records[0].Release()
+		bare := array.NewRecord(arrow.NewSchema(records[0].Schema().Fields(), nil), records[0].Columns(), records[0].NumRows())
+		defer bare.Release()
+
+		_, err = Decode(bare)
+		assert.Equal(t, ErrNoMetadata, err)
+
+		features, err := Decode(bare, WithGeometryColumn("geometry"))
+		require.NoError(t, err)
+		assert.Len(t, features, 3)
+	})
+}
+
+func TestParquet(t *testing.T) {
+	crs := []byte(`{"id":{"authority":"EPSG","code":4326}}`)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteParquet(&buf, testCollection(), WithIDColumn("id"), WithBatchSize(2), WithCRS(crs)))
+
+	meta, err := ReadMetadata(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, "geometry", meta.PrimaryColumn)
+	assert.Equal(t, []string{"Point"}, meta.Columns["geometry"].GeometryTypes)
+	assert.JSONEq(t, string(crs), string(meta.Columns["geometry"].CRS))
+
+	fc, err := ReadParquet(context.Background(), bytes.NewReader(buf.Bytes()), WithIDColumn("id"))
+	require.NoError(t, err)
+	require.Len(t, fc.Features, 3)
+	assert.Equal(t, int64(3), fc.Features[2].ID)
+	assert.Equal(t, []float64{-3, 5}, fc.Features[1].Geometry.(geom.Point).Coords())
+	assert.Equal(t, []interface{}{"u", 2.0}, fc.Features[0].Properties["tags"])
+
+	var batches int
+	require.NoError(t, ReadParquetFunc(context.Background(), bytes.NewReader(buf.Bytes()), func(features []*geojson.Feature) error {
+		batches++
+		return nil
+	}, WithBatchSize(2)))
+	assert.Equal(t, 2, batches)
+
+	_, err = ReadMetadata(bytes.NewReader([]byte("not parquet")))
+	assert.Error(t, err)
+}
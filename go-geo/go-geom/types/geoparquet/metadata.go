@@ -0,0 +1,162 @@
+package geoparquet
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const (
+	// MetadataKey is the key of the GeoParquet metadata, in the metadata of Arrow schemas
+	// and in the key-value metadata of Parquet files.
+	MetadataKey = "geo"
+
+	// Version is the version of the GeoParquet specification of the metadata produced.
+	Version = "1.1.0"
+
+	// EncodingWKB is the only geometry encoding supported by this package.
+	EncodingWKB = "WKB"
+
+	defaultGeometryColumn = "geometry"
+)
+
+// ErrNoMetadata is returned when decoding data without GeoParquet metadata.
+var ErrNoMetadata = errors.New("geoparquet: no geo metadata")
+
+// ErrUnsupportedEncoding is returned when decoding geometries with another encoding than WKB.
+type ErrUnsupportedEncoding string
+
+func (e ErrUnsupportedEncoding) Error() string {
+	return fmt.Sprintf("geoparquet: unsupported geometry encoding: %s", string(e))
+}
+
+// Metadata is the GeoParquet file metadata.
+type Metadata struct {
+	Version       string                     `json:"version"`
+	PrimaryColumn string                     `json:"primary_column"`
+	Columns       map[string]*ColumnMetadata `json:"columns"`
+}
+
+// ColumnMetadata describes a geometry column.
+type ColumnMetadata struct {
+	Encoding string `json:"encoding"`
+
+	// GeometryTypes lists the types of the geometries of the column, e.g. "Polygon" or "Point Z".
+	// An empty list means that any type may be found.
+	GeometryTypes []string `json:"geometry_types"`
+
+	// BBox is the bounding box of the column: xmin, ymin, xmax, ymax.
+	BBox []float64 `json:"bbox,omitempty"`
+
+	// CRS is a PROJJSON document. Without CRS, coordinates are longitudes and latitudes (OGC:CRS84).
+	CRS json.RawMessage `json:"crs,omitempty"`
+
+	Orientation string `json:"orientation,omitempty"`
+	Edges       string `json:"edges,omitempty"`
+}
+
+// metadataOf yields the GeoParquet metadata of an Arrow schema.
+func metadataOf(schema *arrow.Schema) (*Metadata, error) {
+	md := schema.Metadata()
+	i := md.FindKey(MetadataKey)
+	if i < 0 {
+		return nil, ErrNoMetadata
+	}
+	return parseMetadata(md.Values()[i])
+}
+
+func parseMetadata(value string) (*Metadata, error) {
+	var meta Metadata
+	if err := json.Unmarshal([]byte(value), &meta); err != nil {
+		return nil, fmt.Errorf("geoparquet: invalid geo metadata: %w", err)
+	}
+	if meta.PrimaryColumn == "" {
+		meta.PrimaryColumn = defaultGeometryColumn
+	}
+	column, ok := meta.Columns[meta.PrimaryColumn]
+	if !ok {
+		return nil, fmt.Errorf("geoparquet: no metadata for the primary column %q", meta.PrimaryColumn)
+	}
+	if column.Encoding != EncodingWKB {
+		return nil, ErrUnsupportedEncoding(column.Encoding)
+	}
+	return &meta, nil
+}
+
+// geometryStats accumulates the types and the bounding box of the geometries of a column.
+type geometryStats struct {
+	types map[string]bool
+	bbox  []float64
+}
+
+func newGeometryStats() *geometryStats {
+	return &geometryStats{
+		types: make(map[string]bool),
+		bbox:  []float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)},
+	}
+}
+
+func (s *geometryStats) add(wkb []byte, min, max []float64) {
+	if name, ok := wkbTypeName(wkb); ok {
+		s.types[name] = true
+	}
+	if len(min) < 2 || len(max) < 2 {
+		return
+	}
+	s.bbox[0] = math.Min(s.bbox[0], min[0])
+	s.bbox[1] = math.Min(s.bbox[1], min[1])
+	s.bbox[2] = math.Max(s.bbox[2], max[0])
+	s.bbox[3] = math.Max(s.bbox[3], max[1])
+}
+
+func (s *geometryStats) column(crs json.RawMessage) *ColumnMetadata {
+	column := &ColumnMetadata{
+		Encoding:      EncodingWKB,
+		GeometryTypes: make([]string, 0, len(s.types)),
+		CRS:           crs,
+	}
+	for name := range s.types {
+		column.GeometryTypes = append(column.GeometryTypes, name)
+	}
+	sort.Strings(column.GeometryTypes)
+	if s.bbox[0] <= s.bbox[2] {
+		column.BBox = s.bbox
+	}
+	return column
+}
+
+var wkbTypeNames = map[uint32]string{
+	1: "Point",
+	2: "LineString",
+	3: "Polygon",
+	4: "MultiPoint",
+	5: "MultiLineString",
+	6: "MultiPolygon",
+	7: "GeometryCollection",
+}
+
+// wkbTypeName yields the GeoParquet name of the type of an ISO WKB geometry.
+func wkbTypeName(wkb []byte) (string, bool) {
+	if len(wkb) < 5 {
+		return "", false
+	}
+	var code uint32
+	if wkb[0] == 0 {
+		code = binary.BigEndian.Uint32(wkb[1:])
+	} else {
+		code = binary.LittleEndian.Uint32(wkb[1:])
+	}
+	name, ok := wkbTypeNames[code%1000]
+	if !ok {
+		return "", false
+	}
+	if code/1000 == 1 {
+		name += " Z"
+	}
+	return name, true
+}
@@ -0,0 +1,90 @@
+package geoparquet
+
+import (
+	"encoding/json"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+)
+
+const defaultBatchSize = 65536
+
+// Option configures encoding and decoding.
+type Option func(*options)
+
+type options struct {
+	geometryColumn string
+	geometryColSet bool
+	idColumn       string
+	batchSize      int
+	crs            json.RawMessage
+	schema         *geojson.Schema
+	allocator      memory.Allocator
+}
+
+func optionsWithDefaults(opts []Option) *options {
+	o := &options{
+		geometryColumn: defaultGeometryColumn,
+		batchSize:      defaultBatchSize,
+		allocator:      memory.DefaultAllocator,
+	}
+	for _, apply := range opts {
+		apply(o)
+	}
+	return o
+}
+
+// WithGeometryColumn sets the name of the geometry column. The default is "geometry".
+//
+// When decoding, it overrides the primary column of the metadata.
+func WithGeometryColumn(name string) Option {
+	return func(o *options) {
+		o.geometryColumn = name
+		o.geometryColSet = true
+	}
+}
+
+// WithIDColumn stores the ids of the features in a column.
+//
+// By default, ids are not stored. Ids are stored as int64 when they are all integers,
+// as strings otherwise.
+func WithIDColumn(name string) Option {
+	return func(o *options) {
+		o.idColumn = name
+	}
+}
+
+// WithBatchSize sets the maximum number of rows of a record batch, which is also the
+// size of the row groups of Parquet files. The default is 65536.
+func WithBatchSize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithCRS sets the coordinate reference system of the geometries, as a PROJJSON document.
+//
+// Without CRS, coordinates are longitudes and latitudes (OGC:CRS84).
+func WithCRS(projjson json.RawMessage) Option {
+	return func(o *options) {
+		o.crs = projjson
+	}
+}
+
+// WithSchema sets the schema of the properties, rather than inferring it from the features.
+//
+// Properties are coerced to the types of the schema before being encoded.
+func WithSchema(schema *geojson.Schema) Option {
+	return func(o *options) {
+		o.schema = schema
+	}
+}
+
+// WithAllocator sets the memory allocator of Arrow arrays.
+func WithAllocator(allocator memory.Allocator) Option {
+	return func(o *options) {
+		o.allocator = allocator
+	}
+}
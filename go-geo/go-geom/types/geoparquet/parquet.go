@@ -0,0 +1,131 @@
+package geoparquet
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+)
+
+// WriteParquet writes a feature collection as a GeoParquet file, compressed with snappy.
+//
+// Each record batch is written as a row group.
+func WriteParquet(w io.Writer, fc *geojson.FeatureCollection, opts ...Option) error {
+	o := optionsWithDefaults(opts)
+	schema, records, err := Encode(fc, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rec := range records {
+			rec.Release()
+		}
+	}()
+
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(int64(o.batchSize)),
+		parquet.WithAllocator(o.allocator),
+	)
+	// the metadata of the Arrow schema, with the geo key, is stored in the key-value metadata of the file
+	fw, err := pqarrow.NewFileWriter(schema, w, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return err
+	}
+	for _, rec := range records {
+		if err := fw.Write(rec); err != nil {
+			_ = fw.Close()
+			return err
+		}
+	}
+	return fw.Close()
+}
+
+// ReadMetadata reads the GeoParquet metadata of a Parquet file.
+func ReadMetadata(r parquet.ReaderAtSeeker) (*Metadata, error) {
+	pf, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+	return fileMetadata(pf)
+}
+
+func fileMetadata(pf *file.Reader) (*Metadata, error) {
+	value := pf.MetaData().KeyValueMetadata().FindValue(MetadataKey)
+	if value == nil {
+		return nil, ErrNoMetadata
+	}
+	return parseMetadata(*value)
+}
+
+// ReadParquet reads the features of a GeoParquet file.
+func ReadParquet(ctx context.Context, r parquet.ReaderAtSeeker, opts ...Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	err := ReadParquetFunc(ctx, r, func(features []*geojson.Feature) error {
+		fc.Features = append(fc.Features, features...)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
+
+// ReadParquetFunc reads the features of a GeoParquet file by batches, without loading the whole file.
+// It stops at the first error returned by fn.
+func ReadParquetFunc(ctx context.Context, r parquet.ReaderAtSeeker, fn func([]*geojson.Feature) error, opts ...Option) error {
+	o := optionsWithDefaults(opts)
+
+	pf, err := file.NewParquetReader(r)
+	if err != nil {
+		return err
+	}
+	defer pf.Close()
+
+	meta, err := fileMetadata(pf)
+	switch {
+	case err == ErrNoMetadata && o.geometryColSet:
+		meta = nil
+	case err != nil:
+		return err
+	}
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: int64(o.batchSize)}, o.allocator)
+	if err != nil {
+		return err
+	}
+	rr, err := fr.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+
+	offset := 0
+	for rr.Next() {
+		features, err := decodeRecord(rr.Record(), meta, o)
+		if err != nil {
+			return shiftFeatureError(err, offset)
+		}
+		offset += len(features)
+		if err := fn(features); err != nil {
+			return err
+		}
+	}
+	if err := rr.Err(); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// shiftFeatureError reports errors with the index of the feature in the file, rather than in its batch.
+func shiftFeatureError(err error, offset int) error {
+	if fe, ok := err.(*FeatureError); ok {
+		return &FeatureError{Index: fe.Index + offset, Err: fe.Err}
+	}
+	return err
+}
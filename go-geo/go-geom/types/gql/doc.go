@@ -0,0 +1,12 @@
+// Package gql exposes the GeoJSON types of the geojson package to GraphQL.
+//
+// It ships:
+//
+//   * schema.graphql: the SDL of the GeoJSON types, also available as the Schema constant
+//   * gqlgen.yml: the gqlgen model bindings of these types, to be merged into the configuration of a project
+//   * resolvers for the fields which are not mapped to struct fields, to be embedded in generated resolvers
+//   * input models, with the validation of geometry and bbox arguments
+//
+// Geometries, features and feature collections are available both as opaque JSON scalars
+// (e.g. GeoJSONFeature) and as object types (e.g. Feature) which may be queried field by field.
+package gql
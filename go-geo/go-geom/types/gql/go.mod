@@ -1,3 +1,10 @@
 module github.com/fredbi/go-geom/types/gql
 
 go 1.14
+
+require (
+	github.com/fredbi/go-geom/geom v0.0.0
+	github.com/stretchr/testify v1.6.0
+)
+
+replace github.com/fredbi/go-geom/geom => ../../geom
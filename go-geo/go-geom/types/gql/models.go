@@ -0,0 +1,71 @@
+package gql
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+)
+
+// Coordinates are the raw JSON coordinates of a GeoJSON geometry.
+//
+// As an input, they may be given either as nested lists of floats or as a JSON string.
+type Coordinates []byte
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (c *Coordinates) UnmarshalGQL(v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		if !stdjson.Valid([]byte(v)) {
+			return fmt.Errorf("coordinates must be valid JSON, got %q", v)
+		}
+		*c = Coordinates(v)
+		return nil
+	case []interface{}:
+		data, err := stdjson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		*c = data
+		return nil
+	default:
+		return fmt.Errorf("coordinates must be lists or strings, got %T", v)
+	}
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (c Coordinates) MarshalGQL(w io.Writer) {
+	if c == nil {
+		_, _ = w.Write([]byte("null"))
+		return
+	}
+	_, _ = w.Write(c)
+}
+
+// MarshalJSON yields the raw coordinates
+func (c Coordinates) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	return c, nil
+}
+
+// UnmarshalJSON retains a copy of the raw coordinates
+func (c *Coordinates) UnmarshalJSON(data []byte) error {
+	*c = append((*c)[:0], data...)
+	return nil
+}
+
+// GeometryInput is a GeoJSON geometry argument
+type GeometryInput struct {
+	Type        geojson.ObjectType `json:"type"`
+	Coordinates Coordinates        `json:"coordinates,omitempty"`
+	Geometries  []*GeometryInput   `json:"geometries,omitempty"`
+}
+
+// BBoxInput is a bounding box argument
+type BBoxInput struct {
+	Min []float64 `json:"min"`
+	Max []float64 `json:"max"`
+}
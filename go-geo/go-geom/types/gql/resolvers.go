@@ -0,0 +1,83 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+)
+
+// Resolvers for the fields of the GeoJSON types which are not mapped to a struct field.
+//
+// They are meant to be embedded in the root resolver generated by gqlgen, e.g.:
+//
+//   type bboxResolver struct{ gql.BBoxResolver }
+//
+//   func (r *Resolver) BBox() generated.BBoxResolver { return &bboxResolver{} }
+
+// BBoxResolver resolves the fields of BBox
+type BBoxResolver struct{}
+
+// Min yields all axes of the most southwesterly point
+func (BBoxResolver) Min(_ context.Context, obj *geojson.BBox) ([]float64, error) {
+	return corner(obj, 0), nil
+}
+
+// Max yields all axes of the most northeasterly point
+func (BBoxResolver) Max(_ context.Context, obj *geojson.BBox) ([]float64, error) {
+	return corner(obj, 1), nil
+}
+
+// Center yields the center of the bbox
+func (BBoxResolver) Center(_ context.Context, obj *geojson.BBox) ([]float64, error) {
+	return obj.Center().Coords(), nil
+}
+
+func corner(bbox *geojson.BBox, i int) []float64 {
+	corners := bbox.FlatCoords()
+	if len(corners) != 2 {
+		return []float64{}
+	}
+	return corners[i]
+}
+
+// GeometryResolver resolves the fields of Geometry
+type GeometryResolver struct{}
+
+// Coordinates yields the raw coordinates of the geometry
+func (GeometryResolver) Coordinates(_ context.Context, obj *geojson.Geometry) (Coordinates, error) {
+	if obj.Coordinates == nil {
+		return nil, nil
+	}
+	return Coordinates(*obj.Coordinates), nil
+}
+
+// FeatureResolver resolves the fields of Feature
+type FeatureResolver struct{}
+
+// Type yields the GeoJSON type of a feature
+func (FeatureResolver) Type(_ context.Context, _ *geojson.Feature) (geojson.ObjectType, error) {
+	return geojson.TypeFeature, nil
+}
+
+// ID yields the id of the feature as a string
+func (FeatureResolver) ID(_ context.Context, obj *geojson.Feature) (*string, error) {
+	if obj.ID == nil {
+		return nil, nil
+	}
+	id := fmt.Sprint(obj.ID)
+	return &id, nil
+}
+
+// Geometry converts the geometry of the feature to GeoJSON
+func (FeatureResolver) Geometry(_ context.Context, obj *geojson.Feature) (*geojson.Geometry, error) {
+	return geojson.NewGeometry(obj.Geometry)
+}
+
+// FeatureCollectionResolver resolves the fields of FeatureCollection
+type FeatureCollectionResolver struct{}
+
+// Type yields the GeoJSON type of a feature collection
+func (FeatureCollectionResolver) Type(_ context.Context, _ *geojson.FeatureCollection) (geojson.ObjectType, error) {
+	return geojson.TypeFeatureCollection, nil
+}
@@ -0,0 +1,91 @@
+package gql
+
+// Schema is the GraphQL SDL of the GeoJSON types, as in schema.graphql.
+//
+// It may be used with GraphQL servers which load their schema at runtime.
+const Schema = `# GraphQL types for GeoJSON objects (RFC 7946).
+#
+# Include this schema in a gqlgen project, along with the models of gqlgen.yml.
+
+"The type of a GeoJSON object"
+enum GeoJSONType {
+  Point
+  MultiPoint
+  LineString
+  MultiLineString
+  Polygon
+  MultiPolygon
+  GeometryCollection
+  Feature
+  FeatureCollection
+}
+
+"A GeoJSON geometry, as a JSON object"
+scalar GeoJSONGeometry
+
+"A GeoJSON feature, as a JSON object"
+scalar GeoJSONFeature
+
+"A GeoJSON feature collection, as a JSON object"
+scalar GeoJSONFeatureCollection
+
+"The properties of a GeoJSON feature, as a JSON object"
+scalar GeoJSONProperties
+
+"The coordinates of a GeoJSON geometry: a position, or nested arrays of positions"
+scalar Coordinates
+
+"A GeoJSON object"
+interface GeoJSONInterface {
+  type: GeoJSONType!
+  bbox: BBox
+}
+
+"A bounding box"
+type BBox {
+  "All axes of the most southwesterly point"
+  min: [Float!]!
+  "All axes of the most northeasterly point"
+  max: [Float!]!
+  center: [Float!]!
+}
+
+"A GeoJSON geometry"
+type Geometry implements GeoJSONInterface {
+  type: GeoJSONType!
+  bbox: BBox
+  "The coordinates of all geometries but GeometryCollection"
+  coordinates: Coordinates
+  "The geometries of a GeometryCollection"
+  geometries: [Geometry!]
+}
+
+"A GeoJSON feature"
+type Feature implements GeoJSONInterface {
+  type: GeoJSONType!
+  id: ID
+  bbox: BBox
+  geometry: Geometry
+  properties: GeoJSONProperties
+}
+
+"A GeoJSON feature collection"
+type FeatureCollection implements GeoJSONInterface {
+  type: GeoJSONType!
+  bbox: BBox
+  features: [Feature!]!
+}
+
+"A GeoJSON geometry argument"
+input GeometryInput {
+  type: GeoJSONType!
+  coordinates: Coordinates
+  geometries: [GeometryInput!]
+}
+
+"A bounding box argument"
+input BBoxInput {
+  min: [Float!]!
+  max: [Float!]!
+}
+`
@@ -0,0 +1,15 @@
+package gql
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaInSync(t *testing.T) {
+	sdl, err := ioutil.ReadFile("schema.graphql")
+	require.NoError(t, err)
+	assert.Equal(t, string(sdl), Schema, "schema.go must be kept in sync with schema.graphql")
+}
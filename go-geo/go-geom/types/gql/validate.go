@@ -0,0 +1,168 @@
+package gql
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+)
+
+const defaultMaxPositions = 100000
+
+// An InputError reports an invalid argument, with the path of the offending input field.
+type InputError struct {
+	Path string
+	Err  error
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("invalid input %s: %v", e.Path, e.Err)
+}
+
+// Unwrap yields the reason why the input is invalid
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateOption configures the validation of geometry arguments
+type ValidateOption func(*validateOptions)
+
+type validateOptions struct {
+	rfc7946      bool
+	maxPositions int
+	allowed      map[geojson.ObjectType]bool
+}
+
+// WithRFC7946 requires geometry arguments to comply with RFC 7946
+func WithRFC7946() ValidateOption {
+	return func(o *validateOptions) {
+		o.rfc7946 = true
+	}
+}
+
+// WithMaxPositions limits the number of positions of a geometry argument.
+// The default is 100000. A negative value disables the limit.
+func WithMaxPositions(n int) ValidateOption {
+	return func(o *validateOptions) {
+		o.maxPositions = n
+	}
+}
+
+// WithAllowedTypes restricts the types of geometry arguments.
+// The members of geometry collections are checked as well.
+func WithAllowedTypes(types ...geojson.ObjectType) ValidateOption {
+	return func(o *validateOptions) {
+		o.allowed = make(map[geojson.ObjectType]bool, len(types))
+		for _, typ := range types {
+			o.allowed[typ] = true
+		}
+	}
+}
+
+// Geometry validates a geometry argument and converts it to a geometry.
+//
+// Invalid arguments yield an *InputError.
+func (in *GeometryInput) Geometry(opts ...ValidateOption) (geom.T, error) {
+	o := &validateOptions{maxPositions: defaultMaxPositions}
+	for _, apply := range opts {
+		apply(o)
+	}
+
+	positions := 0
+	if err := in.check("geometry", o, &positions); err != nil {
+		return nil, err
+	}
+
+	data, err := stdjson.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	var decodeOpts []geojson.DecodeOption
+	if o.rfc7946 {
+		decodeOpts = append(decodeOpts, geojson.WithRFC7946Validation())
+	}
+	var g geom.T
+	if err := geojson.NewDecoder(decodeOpts...).Unmarshal(data, &g); err != nil {
+		return nil, &InputError{Path: "geometry", Err: err}
+	}
+	return g, nil
+}
+
+// check validates the structure of the input, before it is decoded
+func (in *GeometryInput) check(path string, o *validateOptions, positions *int) error {
+	if !in.Type.IsValid() || in.Type == geojson.TypeFeature || in.Type == geojson.TypeFeatureCollection {
+		return &InputError{Path: path + ".type", Err: geojson.ErrUnsupportedType(in.Type)}
+	}
+	if o.allowed != nil && !o.allowed[in.Type] {
+		return &InputError{Path: path + ".type", Err: fmt.Errorf("geometry type %s is not allowed", in.Type)}
+	}
+
+	if in.Type == geojson.TypeGeometryCollection {
+		if in.Coordinates != nil {
+			return &InputError{Path: path + ".coordinates", Err: fmt.Errorf("unexpected coordinates for a %s", in.Type)}
+		}
+		for i, member := range in.Geometries {
+			if member == nil {
+				return &InputError{Path: fmt.Sprintf("%s.geometries[%d]", path, i), Err: fmt.Errorf("null geometry")}
+			}
+			if err := member.check(fmt.Sprintf("%s.geometries[%d]", path, i), o, positions); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(in.Geometries) > 0 {
+		return &InputError{Path: path + ".geometries", Err: fmt.Errorf("unexpected geometries for a %s", in.Type)}
+	}
+	if in.Coordinates == nil {
+		return nil
+	}
+	var coords interface{}
+	if err := stdjson.Unmarshal(in.Coordinates, &coords); err != nil {
+		return &InputError{Path: path + ".coordinates", Err: err}
+	}
+	*positions += countPositions(coords)
+	if o.maxPositions >= 0 && *positions > o.maxPositions {
+		return &InputError{Path: path + ".coordinates", Err: fmt.Errorf("more than %d positions", o.maxPositions)}
+	}
+	return nil
+}
+
+// countPositions counts the arrays of numbers found in nested arrays
+func countPositions(coords interface{}) int {
+	array, ok := coords.([]interface{})
+	if !ok {
+		return 0
+	}
+	if len(array) > 0 {
+		if _, isNumber := array[0].(float64); isNumber {
+			return 1
+		}
+	}
+	n := 0
+	for _, item := range array {
+		n += countPositions(item)
+	}
+	return n
+}
+
+// BBox validates a bounding box argument and converts it to a geojson.BBox.
+//
+// Invalid arguments yield an *InputError.
+func (in *BBoxInput) BBox() (*geojson.BBox, error) {
+	if len(in.Min) != len(in.Max) {
+		return nil, &InputError{Path: "bbox", Err: fmt.Errorf("min and max have different dimensions: %d and %d", len(in.Min), len(in.Max))}
+	}
+	for i := range in.Min {
+		if in.Min[i] > in.Max[i] {
+			return nil, &InputError{Path: fmt.Sprintf("bbox.min[%d]", i), Err: fmt.Errorf("min %v exceeds max %v", in.Min[i], in.Max[i])}
+		}
+	}
+	bbox, err := geojson.NewBBox(append(append([]float64{}, in.Min...), in.Max...))
+	if err != nil {
+		return nil, &InputError{Path: "bbox", Err: err}
+	}
+	return bbox, nil
+}
@@ -0,0 +1,112 @@
+package gql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/encoding/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustCoordinates(t testing.TB, v interface{}) Coordinates {
+	var c Coordinates
+	require.NoError(t, c.UnmarshalGQL(v))
+	return c
+}
+
+func TestCoordinates(t *testing.T) {
+	c := mustCoordinates(t, []interface{}{1.0, 2.0})
+	assert.Equal(t, `[1,2]`, string(c))
+
+	c = mustCoordinates(t, `[[1,2],[3,4]]`)
+	var buf bytes.Buffer
+	c.MarshalGQL(&buf)
+	assert.Equal(t, `[[1,2],[3,4]]`, buf.String())
+
+	assert.Error(t, c.UnmarshalGQL(`[1,`))
+	assert.Error(t, c.UnmarshalGQL(1.0))
+}
+
+func TestGeometryInput(t *testing.T) {
+	in := &GeometryInput{
+		Type:        geojson.TypePolygon,
+		Coordinates: mustCoordinates(t, `[[[0,0],[1,0],[1,1],[0,0]]]`),
+	}
+	g, err := in.Geometry(WithRFC7946())
+	require.NoError(t, err)
+	assert.Implements(t, (*geom.Polygon)(nil), g)
+
+	in.Coordinates = mustCoordinates(t, `[[[0,0],[1,1],[1,0],[0,0]]]`)
+	_, err = in.Geometry(WithRFC7946())
+	var inputErr *InputError
+	require.True(t, errors.As(err, &inputErr))
+	assert.Equal(t, "geometry", inputErr.Path)
+	assert.Equal(t, geojson.ErrRFC7946("exterior ring is not counter-clockwise"), inputErr.Err)
+
+	_, err = in.Geometry()
+	assert.NoError(t, err)
+}
+
+func TestGeometryInputErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   *GeometryInput
+		opts []ValidateOption
+		path string
+	}{
+		{
+			name: "feature is not a geometry",
+			in:   &GeometryInput{Type: geojson.TypeFeature},
+			path: "geometry.type",
+		},
+		{
+			name: "type not allowed",
+			in: &GeometryInput{Type: geojson.TypeGeometryCollection, Geometries: []*GeometryInput{
+				{Type: geojson.TypePoint, Coordinates: mustCoordinates(t, `[1,2]`)},
+				{Type: geojson.TypeLineString, Coordinates: mustCoordinates(t, `[[1,2],[3,4]]`)},
+			}},
+			opts: []ValidateOption{WithAllowedTypes(geojson.TypeGeometryCollection, geojson.TypePoint)},
+			path: "geometry.geometries[1].type",
+		},
+		{
+			name: "too many positions",
+			in:   &GeometryInput{Type: geojson.TypeMultiPoint, Coordinates: mustCoordinates(t, `[[1,2],[3,4],[5,6]]`)},
+			opts: []ValidateOption{WithMaxPositions(2)},
+			path: "geometry.coordinates",
+		},
+		{
+			name: "geometries of a point",
+			in:   &GeometryInput{Type: geojson.TypePoint, Geometries: []*GeometryInput{{Type: geojson.TypePoint}}},
+			path: "geometry.geometries",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.in.Geometry(tc.opts...)
+			var inputErr *InputError
+			require.True(t, errors.As(err, &inputErr), "got %v", err)
+			assert.Equal(t, tc.path, inputErr.Path)
+		})
+	}
+}
+
+func TestBBoxInput(t *testing.T) {
+	bbox, err := (&BBoxInput{Min: []float64{1, 2}, Max: []float64{3, 4}}).BBox()
+	require.NoError(t, err)
+	min, err := BBoxResolver{}.Min(context.Background(), bbox)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, min)
+	center, err := BBoxResolver{}.Center(context.Background(), bbox)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2, 3}, center)
+
+	_, err = (&BBoxInput{Min: []float64{1, 2}, Max: []float64{3}}).BBox()
+	assert.Error(t, err)
+	_, err = (&BBoxInput{Min: []float64{1, 5}, Max: []float64{3, 4}}).BBox()
+	var inputErr *InputError
+	require.True(t, errors.As(err, &inputErr))
+	assert.Equal(t, "bbox.min[1]", inputErr.Path)
+}
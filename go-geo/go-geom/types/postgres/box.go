@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/jackc/pgtype"
+)
+
+// ErrBoxBinaryFormat is returned when encoding or decoding a box in the binary format.
+//
+// In particular, boxes can't be copied with pgx.Conn.CopyFrom, which uses the binary format.
+var ErrBoxBinaryFormat = errors.New("postgres: box types only support the text format")
+
+// Box2D is the pgtype codec of the PostGIS box2d type, e.g. BOX(1 2,3 4).
+//
+// PostGIS boxes only support the text format.
+type Box2D struct {
+	Bounds geom.Bounds
+	Status pgtype.Status
+}
+
+// Box3D is the pgtype codec of the PostGIS box3d type, e.g. BOX3D(1 2 3,4 5 6).
+//
+// PostGIS boxes only support the text format.
+type Box3D struct {
+	Bounds geom.Bounds
+	Status pgtype.Status
+}
+
+// Set assigns a geom.Bounds, a Box2D or nil.
+func (dst *Box2D) Set(src interface{}) error {
+	bounds, status, err := setBox(src, 2)
+	if err != nil {
+		return err
+	}
+	*dst = Box2D{Bounds: bounds, Status: status}
+	return nil
+}
+
+// Set assigns a geom.Bounds, a Box3D or nil.
+func (dst *Box3D) Set(src interface{}) error {
+	bounds, status, err := setBox(src, 3)
+	if err != nil {
+		return err
+	}
+	*dst = Box3D{Bounds: bounds, Status: status}
+	return nil
+}
+
+func setBox(src interface{}, dims int) (geom.Bounds, pgtype.Status, error) {
+	switch value := src.(type) {
+	case nil:
+		return nil, pgtype.Null, nil
+	case Box2D:
+		return value.Bounds, value.Status, nil
+	case *Box2D:
+		if value == nil {
+			return nil, pgtype.Null, nil
+		}
+		return value.Bounds, value.Status, nil
+	case Box3D:
+		return value.Bounds, value.Status, nil
+	case *Box3D:
+		if value == nil {
+			return nil, pgtype.Null, nil
+		}
+		return value.Bounds, value.Status, nil
+	case geom.Bounds:
+		return value, pgtype.Present, nil
+	default:
+		return nil, pgtype.Undefined, fmt.Errorf("cannot convert %v to a box with %d dimensions", value, dims)
+	}
+}
+
+// Get yields the bounds, nil or the status.
+func (dst Box2D) Get() interface{} {
+	return getBox(dst.Bounds, dst.Status)
+}
+
+// Get yields the bounds, nil or the status.
+func (dst Box3D) Get() interface{} {
+	return getBox(dst.Bounds, dst.Status)
+}
+
+func getBox(bounds geom.Bounds, status pgtype.Status) interface{} {
+	switch status {
+	case pgtype.Present:
+		return bounds
+	case pgtype.Null:
+		return nil
+	default:
+		return status
+	}
+}
+
+// AssignTo assigns the box to a *geom.Bounds or a *Box2D.
+func (src *Box2D) AssignTo(dst interface{}) error {
+	if v, ok := dst.(*Box2D); ok {
+		*v = *src
+		return nil
+	}
+	return assignBox(src.Bounds, src.Status, dst)
+}
+
+// AssignTo assigns the box to a *geom.Bounds or a *Box3D.
+func (src *Box3D) AssignTo(dst interface{}) error {
+	if v, ok := dst.(*Box3D); ok {
+		*v = *src
+		return nil
+	}
+	return assignBox(src.Bounds, src.Status, dst)
+}
+
+func assignBox(bounds geom.Bounds, status pgtype.Status, dst interface{}) error {
+	switch status {
+	case pgtype.Present:
+		if v, ok := dst.(*geom.Bounds); ok {
+			*v = bounds
+			return nil
+		}
+	case pgtype.Null:
+		return assignNull(dst)
+	}
+	return fmt.Errorf("cannot decode box with status %v into %T", status, dst)
+}
+
+// DecodeText decodes a box such as BOX(1 2,3 4).
+func (dst *Box2D) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	bounds, status, err := decodeBox(src, "BOX", 2)
+	if err != nil {
+		return err
+	}
+	*dst = Box2D{Bounds: bounds, Status: status}
+	return nil
+}
+
+// DecodeText decodes a box such as BOX3D(1 2 3,4 5 6).
+func (dst *Box3D) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	bounds, status, err := decodeBox(src, "BOX3D", 3)
+	if err != nil {
+		return err
+	}
+	*dst = Box3D{Bounds: bounds, Status: status}
+	return nil
+}
+
+func decodeBox(src []byte, prefix string, dims int) (geom.Bounds, pgtype.Status, error) {
+	if src == nil {
+		return nil, pgtype.Null, nil
+	}
+
+	text := strings.TrimSpace(string(src))
+	if !strings.HasPrefix(text, prefix+"(") || !strings.HasSuffix(text, ")") {
+		return nil, pgtype.Undefined, fmt.Errorf("invalid %s: %q", prefix, text)
+	}
+	corners := strings.Split(text[len(prefix)+1:len(text)-1], ",")
+	if len(corners) != 2 {
+		return nil, pgtype.Undefined, fmt.Errorf("invalid %s: %q", prefix, text)
+	}
+
+	coords := make([][]float64, 2)
+	for i, corner := range corners {
+		fields := strings.Fields(corner)
+		if len(fields) != dims {
+			return nil, pgtype.Undefined, fmt.Errorf("invalid %s: %q", prefix, text)
+		}
+		coords[i] = make([]float64, dims)
+		for j, field := range fields {
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, pgtype.Undefined, fmt.Errorf("invalid %s: %q: %v", prefix, text, err)
+			}
+			coords[i][j] = v
+		}
+	}
+
+	layout := geom.XY
+	if dims == 3 {
+		layout = geom.XYZ
+	}
+	return utils.NewBounds(coords[0], coords[1], geom.WithLayout(layout)), pgtype.Present, nil
+}
+
+// EncodeText encodes the box, e.g. BOX(1 2,3 4).
+func (src Box2D) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return encodeBox(buf, src.Bounds, src.Status, "BOX", 2)
+}
+
+// EncodeText encodes the box, e.g. BOX3D(1 2 3,4 5 6).
+func (src Box3D) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	return encodeBox(buf, src.Bounds, src.Status, "BOX3D", 3)
+}
+
+func encodeBox(buf []byte, bounds geom.Bounds, status pgtype.Status, prefix string, dims int) ([]byte, error) {
+	switch status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+
+	corners := bounds.FlatCoords()
+	if len(corners) != 2 || len(corners[0]) < dims || len(corners[1]) < dims {
+		return nil, fmt.Errorf("cannot encode bounds with layout %v as %s", bounds.Layout(), prefix)
+	}
+	buf = append(buf, prefix...)
+	buf = append(buf, '(')
+	for i, corner := range corners {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		for j := 0; j < dims; j++ {
+			if j > 0 {
+				buf = append(buf, ' ')
+			}
+			buf = strconv.AppendFloat(buf, corner[j], 'g', -1, 64)
+		}
+	}
+	return append(buf, ')'), nil
+}
+
+// PreferredParamFormat yields the text format, which is the only format of boxes.
+func (Box2D) PreferredParamFormat() int16 {
+	return pgtype.TextFormatCode
+}
+
+// PreferredParamFormat yields the text format, which is the only format of boxes.
+func (Box3D) PreferredParamFormat() int16 {
+	return pgtype.TextFormatCode
+}
+
+// PreferredResultFormat yields the text format, which is the only format of boxes.
+func (Box2D) PreferredResultFormat() int16 {
+	return pgtype.TextFormatCode
+}
+
+// PreferredResultFormat yields the text format, which is the only format of boxes.
+func (Box3D) PreferredResultFormat() int16 {
+	return pgtype.TextFormatCode
+}
+
+// EncodeBinary fails with ErrBoxBinaryFormat.
+//
+// It is only defined so that pgx reports an error rather than panicking when boxes are copied.
+func (Box2D) EncodeBinary(_ *pgtype.ConnInfo, _ []byte) ([]byte, error) {
+	return nil, ErrBoxBinaryFormat
+}
+
+// EncodeBinary fails with ErrBoxBinaryFormat.
+//
+// It is only defined so that pgx reports an error rather than panicking when boxes are copied.
+func (Box3D) EncodeBinary(_ *pgtype.ConnInfo, _ []byte) ([]byte, error) {
+	return nil, ErrBoxBinaryFormat
+}
+
+// DecodeBinary fails with ErrBoxBinaryFormat.
+func (*Box2D) DecodeBinary(_ *pgtype.ConnInfo, _ []byte) error {
+	return ErrBoxBinaryFormat
+}
+
+// DecodeBinary fails with ErrBoxBinaryFormat.
+func (*Box3D) DecodeBinary(_ *pgtype.ConnInfo, _ []byte) error {
+	return ErrBoxBinaryFormat
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Box2D) Scan(src interface{}) error {
+	return scanText(dst, src)
+}
+
+// Scan implements the database/sql Scanner interface.
+func (dst *Box3D) Scan(src interface{}) error {
+	return scanText(dst, src)
+}
+
+func scanText(dst pgtype.TextDecoder, src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		return dst.DecodeText(nil, nil)
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		return dst.DecodeText(nil, srcCopy)
+	}
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Box2D) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Box3D) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryCodec(t *testing.T) {
+	p := utils.NewPoint(geom.WithLayout(geom.XY)).WithCoords([]float64{1, 2})
+
+	var src Geometry
+	require.NoError(t, src.Set(p))
+	assert.Equal(t, pgtype.Present, src.Status)
+
+	binary, err := src.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	text, err := src.EncodeText(nil, nil)
+	require.NoError(t, err)
+	// little-endian point
+	assert.Equal(t, "0101000000000000000000f03f0000000000000040", string(text))
+
+	for _, decode := range []func(*Geometry) error{
+		func(dst *Geometry) error { return dst.DecodeBinary(nil, binary) },
+		func(dst *Geometry) error { return dst.DecodeText(nil, text) },
+		func(dst *Geometry) error { return dst.Scan(string(text)) },
+		func(dst *Geometry) error { return dst.Scan(text) },
+		func(dst *Geometry) error { return dst.Scan(binary) },
+	} {
+		var dst Geometry
+		require.NoError(t, decode(&dst))
+		var g geom.T
+		require.NoError(t, dst.AssignTo(&g))
+		assert.Equal(t, []float64{1, 2}, g.(geom.Point).Coords())
+
+		var point geom.Point
+		require.NoError(t, dst.AssignTo(&point))
+		assert.Equal(t, []float64{1, 2}, point.Coords())
+
+		var polygon geom.Polygon
+		assert.Error(t, dst.AssignTo(&polygon))
+	}
+}
+
+func TestGeometryNull(t *testing.T) {
+	var src Geometry
+	require.NoError(t, src.Set(nil))
+	assert.Nil(t, src.Get())
+	binary, err := src.EncodeBinary(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, binary)
+
+	var dst Geometry
+	require.NoError(t, dst.DecodeBinary(nil, nil))
+	assert.Equal(t, pgtype.Null, dst.Status)
+	g := geom.T(utils.NewPoint())
+	require.NoError(t, dst.AssignTo(&g))
+	assert.Nil(t, g)
+	polygon := utils.NewPolygon(nil)
+	require.NoError(t, dst.AssignTo(&polygon))
+	assert.Nil(t, polygon)
+	other := Geometry{Geom: utils.NewPoint(), Status: pgtype.Present}
+	require.NoError(t, dst.AssignTo(&other))
+	assert.Equal(t, Geometry{Status: pgtype.Null}, other)
+	var bounds geom.Bounds
+	require.NoError(t, (&Box2D{Status: pgtype.Null}).AssignTo(&bounds))
+	assert.Nil(t, bounds)
+
+	var undefined Geometry
+	_, err = undefined.EncodeBinary(nil, nil)
+	assert.Error(t, err)
+}
+
+func TestGeographySet(t *testing.T) {
+	p := utils.NewPoint(geom.WithLayout(geom.XY)).WithCoords([]float64{1, 2})
+	var g Geography
+	require.NoError(t, g.Set(p))
+	assert.Equal(t, pgtype.Present, g.Status)
+
+	var other Geography
+	require.NoError(t, other.Set(&g))
+	assert.Equal(t, g, other)
+}
+
+func TestBoxCodec(t *testing.T) {
+	var box2d Box2D
+	require.NoError(t, box2d.DecodeText(nil, []byte("BOX(1 2,3.5 4)")))
+	text, err := box2d.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "BOX(1 2,3.5 4)", string(text))
+
+	var bounds geom.Bounds
+	require.NoError(t, box2d.AssignTo(&bounds))
+	assert.Equal(t, [][]float64{{1, 2}, {3.5, 4}}, bounds.FlatCoords())
+
+	var box3d Box3D
+	require.NoError(t, box3d.Scan("BOX3D(1 2 3,4 5 6)"))
+	text, err = box3d.EncodeText(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "BOX3D(1 2 3,4 5 6)", string(text))
+
+	require.NoError(t, box3d.Scan(nil))
+	assert.Equal(t, pgtype.Null, box3d.Status)
+
+	for _, invalid := range []string{"BOX(1 2,3)", "BOX3D(1 2,3 4)", "BOX(1 2)", "BOX(a 2,3 4)"} {
+		assert.Errorf(t, box2d.DecodeText(nil, []byte(invalid)), "DecodeText(%s)", invalid)
+	}
+}
+
+func TestBoxBinaryFormat(t *testing.T) {
+	_, err := Box2D{Status: pgtype.Present}.EncodeBinary(nil, nil)
+	assert.Equal(t, ErrBoxBinaryFormat, err)
+	_, err = Box3D{Status: pgtype.Present}.EncodeBinary(nil, nil)
+	assert.Equal(t, ErrBoxBinaryFormat, err)
+	assert.Equal(t, ErrBoxBinaryFormat, (&Box2D{}).DecodeBinary(nil, []byte{0}))
+	assert.Equal(t, ErrBoxBinaryFormat, (&Box3D{}).DecodeBinary(nil, []byte{0}))
+
+	// boxes are still sent and received as text once registered
+	ci := pgtype.NewConnInfo()
+	RegisterOIDs(ci, OIDs{Geometry: 1, Box2D: 2, Box3D: 3})
+	for _, oid := range []uint32{2, 3} {
+		assert.Equal(t, int16(pgtype.TextFormatCode), ci.ParamFormatCodeForOID(oid))
+		assert.Equal(t, int16(pgtype.TextFormatCode), ci.ResultFormatCodeForOID(oid))
+	}
+	assert.Equal(t, int16(pgtype.BinaryFormatCode), ci.ParamFormatCodeForOID(1))
+}
@@ -0,0 +1,20 @@
+// Package postgres provides pgx codecs for the PostGIS types, with the geometries of the geom package.
+//
+// The geometry and geography types are transferred as EWKB in the binary format, so that
+// geometries may be used with batches and COPY. Boxes (box2d, box3d), arrays of geometries
+// and NULLs are supported as well. Boxes only support the text format: they can't be copied,
+// since COPY uses the binary format.
+//
+// Since PostGIS is an extension, the OIDs of its types must be looked up on each database:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		return postgres.Register(ctx, conn)
+//	}
+//
+// Once registered, a geom.T may be passed as a query argument or scanned from a result:
+//
+//	var g geom.T
+//	err := conn.QueryRow(ctx, "select geom from roads where id = $1", id).Scan(&g)
+//
+// Use a Geometry to scan nullable columns, or a []geom.T to scan arrays of geometries.
+package postgres
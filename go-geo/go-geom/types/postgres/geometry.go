@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/encoding/wkb/native"
+	"github.com/jackc/pgtype"
+)
+
+var errUndefined = errors.New("cannot encode status undefined")
+
+// Geometry is the pgtype codec of the PostGIS geometry type.
+//
+// It is transferred as EWKB in the binary format, and as hex-encoded EWKB in the text format.
+type Geometry struct {
+	Geom   geom.T
+	Status pgtype.Status
+}
+
+// Set assigns a geom.T, a Geometry or nil.
+func (dst *Geometry) Set(src interface{}) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Geometry:
+		*dst = value
+	case *Geometry:
+		if value == nil {
+			*dst = Geometry{Status: pgtype.Null}
+			return nil
+		}
+		*dst = *value
+	case geom.T:
+		*dst = Geometry{Geom: value, Status: pgtype.Present}
+	case []byte:
+		if value == nil {
+			*dst = Geometry{Status: pgtype.Null}
+			return nil
+		}
+		return dst.DecodeBinary(nil, value)
+	default:
+		return fmt.Errorf("cannot convert %v to %T", value, dst)
+	}
+	return nil
+}
+
+// Get yields the geometry, nil or the status.
+func (dst Geometry) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Geom
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+// AssignTo assigns the geometry to a *geom.T, a *Geometry, or a pointer to a more specific
+// geometry type such as *geom.Polygon.
+func (src *Geometry) AssignTo(dst interface{}) error {
+	return assignTo(src.Geom, src.Status, dst)
+}
+
+func assignTo(g geom.T, status pgtype.Status, dst interface{}) error {
+	switch v := dst.(type) {
+	case *Geometry:
+		*v = Geometry{Geom: g, Status: status}
+		return nil
+	case *Geography:
+		*v = Geography{Geometry{Geom: g, Status: status}}
+		return nil
+	}
+
+	switch status {
+	case pgtype.Present:
+		if v, ok := dst.(*geom.T); ok {
+			*v = g
+			return nil
+		}
+
+		ptr := reflect.ValueOf(dst)
+		if ptr.Kind() == reflect.Ptr && !ptr.IsNil() {
+			target := ptr.Elem()
+			if value := reflect.ValueOf(g); value.IsValid() && value.Type().AssignableTo(target.Type()) {
+				target.Set(value)
+				return nil
+			}
+		}
+	case pgtype.Null:
+		return assignNull(dst)
+	}
+	return fmt.Errorf("cannot decode %T with status %v into %T", g, status, dst)
+}
+
+// assignNull assigns NULL to a pointer to a nilable value, such as a *geom.T or a *geom.Polygon.
+//
+// Other destinations are handled by pgtype.
+func assignNull(dst interface{}) error {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() == reflect.Ptr && !ptr.IsNil() {
+		switch target := ptr.Elem(); target.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map:
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+	}
+	return pgtype.NullAssignTo(dst)
+}
+
+// DecodeBinary decodes EWKB.
+func (dst *Geometry) DecodeBinary(_ *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+	g, err := native.Unmarshal(src)
+	if err != nil {
+		return err
+	}
+	*dst = Geometry{Geom: g, Status: pgtype.Present}
+	return nil
+}
+
+// DecodeText decodes hex-encoded EWKB.
+func (dst *Geometry) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+	data := make([]byte, hex.DecodedLen(len(src)))
+	if _, err := hex.Decode(data, src); err != nil {
+		return err
+	}
+	return dst.DecodeBinary(ci, data)
+}
+
+// EncodeBinary encodes the geometry as little-endian EWKB.
+func (src Geometry) EncodeBinary(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errUndefined
+	}
+	data, err := native.MarshalEWKB(src.Geom, binary.LittleEndian)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, data...), nil
+}
+
+// EncodeText encodes the geometry as hex-encoded little-endian EWKB.
+func (src Geometry) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	data, err := src.EncodeBinary(ci, nil)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	encoded := make([]byte, hex.EncodedLen(len(data)))
+	hex.Encode(encoded, data)
+	return append(buf, encoded...), nil
+}
+
+// Scan implements the database/sql Scanner interface.
+//
+// It accepts hex-encoded EWKB, as a string or a []byte, as well as raw EWKB.
+func (dst *Geometry) Scan(src interface{}) error {
+	if src == nil {
+		*dst = Geometry{Status: pgtype.Null}
+		return nil
+	}
+	switch src := src.(type) {
+	case string:
+		return dst.DecodeText(nil, []byte(src))
+	case []byte:
+		srcCopy := make([]byte, len(src))
+		copy(srcCopy, src)
+		if isRawEWKB(srcCopy) {
+			return dst.DecodeBinary(nil, srcCopy)
+		}
+		return dst.DecodeText(nil, srcCopy)
+	}
+	return fmt.Errorf("cannot scan %T", src)
+}
+
+// isRawEWKB tells raw EWKB from hex-encoded EWKB, after the leading byte order marker:
+// 0x00 or 0x01 when raw, the '0' hex digit when encoded.
+func isRawEWKB(src []byte) bool {
+	return len(src) > 0 && (src[0] == 0x00 || src[0] == 0x01)
+}
+
+// Value implements the database/sql/driver Valuer interface.
+func (src Geometry) Value() (driver.Value, error) {
+	return pgtype.EncodeValueText(src)
+}
+
+// Geography is the pgtype codec of the PostGIS geography type.
+//
+// Its transfer formats are the same as for geometries.
+type Geography struct {
+	Geometry
+}
+
+// Set assigns a geom.T, a Geography, a Geometry or nil.
+func (dst *Geography) Set(src interface{}) error {
+	switch value := src.(type) {
+	case Geography:
+		*dst = value
+		return nil
+	case *Geography:
+		if value == nil {
+			*dst = Geography{Geometry{Status: pgtype.Null}}
+			return nil
+		}
+		*dst = *value
+		return nil
+	default:
+		return dst.Geometry.Set(src)
+	}
+}
+
+// AssignTo assigns the geography to a *geom.T, a *Geography, or a pointer to a more specific
+// geometry type such as *geom.Polygon.
+func (src *Geography) AssignTo(dst interface{}) error {
+	return assignTo(src.Geom, src.Status, dst)
+}
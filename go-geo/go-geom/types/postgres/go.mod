@@ -1,3 +1,12 @@
 module github.com/fredbi/go-geom/types/postgres
 
 go 1.14
+
+require (
+	github.com/fredbi/go-geom/geom v0.0.0
+	github.com/jackc/pgtype v1.4.2
+	github.com/jackc/pgx/v4 v4.8.1
+	github.com/stretchr/testify v1.6.0
+)
+
+replace github.com/fredbi/go-geom/geom => ../../geom
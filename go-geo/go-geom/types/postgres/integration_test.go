@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/fredbi/go-geom/geom"
+	"github.com/fredbi/go-geom/geom/utils"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConn connects to the PostGIS database of the POSTGIS_TEST_DATABASE environment variable.
+func testConn(t *testing.T) *pgx.Conn {
+	dsn := os.Getenv("POSTGIS_TEST_DATABASE")
+	if dsn == "" {
+		t.Skip("POSTGIS_TEST_DATABASE is not set")
+	}
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close(ctx) })
+	require.NoError(t, Register(ctx, conn))
+	return conn
+}
+
+func TestIntegration(t *testing.T) {
+	conn := testConn(t)
+	ctx := context.Background()
+	p := utils.NewPoint(geom.WithLayout(geom.XY), geom.WithSRID(4326)).WithCoords([]float64{1, 2})
+
+	t.Run("round trip", func(t *testing.T) {
+		var text string
+		var g geom.T
+		require.NoError(t, conn.QueryRow(ctx, "select st_astext($1::geometry), $1::geometry", p).Scan(&text, &g))
+		assert.Equal(t, "POINT(1 2)", text)
+		assert.Equal(t, []float64{1, 2}, g.(geom.Point).Coords())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var g Geometry
+		require.NoError(t, conn.QueryRow(ctx, "select null::geometry").Scan(&g))
+		assert.Nil(t, g.Get())
+	})
+
+	t.Run("array", func(t *testing.T) {
+		var gs []geom.T
+		require.NoError(t, conn.QueryRow(ctx, "select array[$1::geometry, $1::geometry]", p).Scan(&gs))
+		assert.Len(t, gs, 2)
+	})
+
+	t.Run("box2d", func(t *testing.T) {
+		var bounds geom.Bounds
+		require.NoError(t, conn.QueryRow(ctx, "select box2d(st_makeenvelope(1, 2, 3, 4))").Scan(&bounds))
+		assert.Equal(t, [][]float64{{1, 2}, {3, 4}}, bounds.FlatCoords())
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "create temporary table geoms (g geometry)")
+		require.NoError(t, err)
+		n, err := conn.CopyFrom(ctx, pgx.Identifier{"geoms"}, []string{"g"}, pgx.CopyFromRows([][]interface{}{{p}, {nil}}))
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, n)
+	})
+}
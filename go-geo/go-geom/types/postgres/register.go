@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// ErrPostGISNotInstalled is returned when registering types on a database without the PostGIS extension.
+var ErrPostGISNotInstalled = errors.New("postgres: the postgis extension is not installed")
+
+// OIDs holds the OIDs of the PostGIS types.
+//
+// Since PostGIS is an extension, these OIDs differ from one database to another.
+// A zero OID means that the type is not available.
+type OIDs struct {
+	Geometry       uint32
+	Geography      uint32
+	Box2D          uint32
+	Box3D          uint32
+	GeometryArray  uint32
+	GeographyArray uint32
+}
+
+const oidsQuery = `select
+	coalesce(to_regtype('geometry')::oid, 0),
+	coalesce(to_regtype('geography')::oid, 0),
+	coalesce(to_regtype('box2d')::oid, 0),
+	coalesce(to_regtype('box3d')::oid, 0),
+	coalesce(to_regtype('geometry[]')::oid, 0),
+	coalesce(to_regtype('geography[]')::oid, 0)`
+
+// LookupOIDs yields the OIDs of the PostGIS types, as resolved with the search path of the connection.
+func LookupOIDs(ctx context.Context, conn *pgx.Conn) (OIDs, error) {
+	var oids OIDs
+	err := conn.QueryRow(ctx, oidsQuery).Scan(
+		&oids.Geometry,
+		&oids.Geography,
+		&oids.Box2D,
+		&oids.Box3D,
+		&oids.GeometryArray,
+		&oids.GeographyArray,
+	)
+	if err != nil {
+		return OIDs{}, err
+	}
+	if oids.Geometry == 0 {
+		return OIDs{}, ErrPostGISNotInstalled
+	}
+	return oids, nil
+}
+
+// Register registers the PostGIS types with a connection, so that geometries may be passed
+// as query arguments, scanned, batched and copied natively.
+//
+// With a connection pool, Register is typically called by pgxpool.Config.AfterConnect.
+func Register(ctx context.Context, conn *pgx.Conn) error {
+	oids, err := LookupOIDs(ctx, conn)
+	if err != nil {
+		return err
+	}
+	RegisterOIDs(conn.ConnInfo(), oids)
+	return nil
+}
+
+// RegisterOIDs registers the PostGIS types with some known OIDs.
+func RegisterOIDs(ci *pgtype.ConnInfo, oids OIDs) {
+	if oids.Geometry != 0 {
+		ci.RegisterDataType(pgtype.DataType{Value: &Geometry{}, Name: "geometry", OID: oids.Geometry})
+	}
+	if oids.Geography != 0 {
+		ci.RegisterDataType(pgtype.DataType{Value: &Geography{}, Name: "geography", OID: oids.Geography})
+	}
+	if oids.Box2D != 0 {
+		ci.RegisterDataType(pgtype.DataType{Value: &Box2D{}, Name: "box2d", OID: oids.Box2D})
+	}
+	if oids.Box3D != 0 {
+		ci.RegisterDataType(pgtype.DataType{Value: &Box3D{}, Name: "box3d", OID: oids.Box3D})
+	}
+	if oids.GeometryArray != 0 && oids.Geometry != 0 {
+		ci.RegisterDataType(pgtype.DataType{
+			Value: pgtype.NewArrayType("_geometry", oids.Geometry, func() pgtype.ValueTranscoder { return &Geometry{} }),
+			Name:  "_geometry",
+			OID:   oids.GeometryArray,
+		})
+	}
+	if oids.GeographyArray != 0 && oids.Geography != 0 {
+		ci.RegisterDataType(pgtype.DataType{
+			Value: pgtype.NewArrayType("_geography", oids.Geography, func() pgtype.ValueTranscoder { return &Geography{} }),
+			Name:  "_geography",
+			OID:   oids.GeographyArray,
+		})
+	}
+}
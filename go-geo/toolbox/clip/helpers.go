@@ -96,7 +96,7 @@ func Geometry(b bound.Bound, g geom.T) geom.T {
 		// }
 
 		//panic(fmt.Sprintf("geometry type not supported: %T", g))
-		return nil
+		return c
 
 	}
 	return nil
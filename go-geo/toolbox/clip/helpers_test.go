@@ -38,6 +38,18 @@ func TestGeometry(t *testing.T) {
 			}),
 			output: toPolygon(orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}),
 		},
+		{
+			name: "collection partially in bound",
+			input: geom.NewGeometryCollection().MustPush(
+				toPoint(orb.Point{0, 0}),
+				toLineString(orb.LineString{{0, 0}, {5, 5}}),
+				toPolygon(orb.Polygon{{{2, 2}, {3, 2}, {3, 3}, {2, 3}, {2, 2}}}),
+			),
+			output: geom.NewGeometryCollection().MustPush(
+				toPoint(orb.Point{0, 0}),
+				toLineString(orb.LineString{{0, 0}, {1, 1}}),
+			),
+		},
 	}
 
 	for _, tc := range cases {
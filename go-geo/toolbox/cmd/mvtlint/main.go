@@ -0,0 +1,72 @@
+// Command mvtlint reports the violations of the MVT specification, version 2, by vector tiles:
+// ring winding, zero area rings, unclosed paths, duplicate keys and values, out of range tags,
+// duplicate layer names and coordinates out of the extent and its buffer, among others.
+//
+// Tiles are read from files, gzipped or not. The exit status is 1 when a tile has violations.
+//
+// Usage:
+//
+//	mvtlint [-buffer 64] tile.mvt...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/fredbi/geo/pkg/mvt"
+)
+
+const usage = `Usage: mvtlint [flags] tile...
+
+Reports the violations of the MVT specification by vector tiles, gzipped or not.
+`
+
+// gzipMagic starts gzipped data.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	buffer := flag.Float64("buffer", mvt.DefaultValidationBuffer, "the buffer around tiles where coordinates are valid, in extent units")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	invalid := false
+	for _, path := range flag.Args() {
+		violations, err := lint(path, *buffer)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			invalid = true
+			continue
+		}
+		for _, v := range violations {
+			fmt.Printf("%s: %s\n", path, v)
+		}
+		if len(violations) > 0 {
+			invalid = true
+		}
+	}
+	if invalid {
+		os.Exit(1)
+	}
+}
+
+// lint validates the tile of a file.
+func lint(path string, buffer float64) ([]mvt.Violation, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		return mvt.ValidateGzipped(data, mvt.WithBuffer(buffer))
+	}
+	return mvt.Validate(data, mvt.WithBuffer(buffer))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+)
+
+// decodeGeometry decodes a GeoJSON geometry, or the geometry of a GeoJSON feature.
+func decodeGeometry(b []byte) (geom.T, error) {
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &probe); err != nil {
+		return nil, err
+	}
+	if probe.Type == "Feature" {
+		var feature geojson.Feature
+		if err := json.Unmarshal(b, &feature); err != nil {
+			return nil, err
+		}
+		if feature.Geometry == nil {
+			return nil, errors.New("the feature has no geometry")
+		}
+		return feature.Geometry, nil
+	}
+	var g geom.T
+	if err := geojson.Unmarshal(b, &g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// parseFloats parses comma separated numbers.
+func parseFloats(s string) ([]float64, error) {
+	var values []float64
+	for _, part := range strings.Split(s, ",") {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// newBound creates a bound from west, south, east and north values.
+func newBound(values []float64) (bound.Bound, error) {
+	if len(values) != 4 || values[0] > values[2] || values[1] > values[3] {
+		return bound.Bound{}, errors.New("bounds must be west,south,east,north")
+	}
+	return bound.NewBound(values[0], values[1], values[2], values[3]), nil
+}
@@ -0,0 +1,163 @@
+// Command tileserver serves Mapbox vector tiles from PostGIS.
+//
+// Routes:
+//
+//	/{layer}/{z}/{x}/{y}.mvt   a tile with a single layer
+//	/{map}/{z}/{x}/{y}.pbf     a tile with the layers of a map
+//	/{layer or map}.json       the TileJSON document of a layer or a map
+//	POST /invalidate           purges the cached tiles intersecting a GeoJSON geometry or a bbox
+//
+// Rendered tiles are cached in memory, on disk or in a Redis server, as configured.
+//
+// The seed subcommand pre-generates tiles into a directory tree or a PMTiles archive.
+//
+// Usage:
+//
+//	tileserver -config tileserver.yaml
+//	tileserver seed -config tileserver.yaml -name base -zoom 0-14 -out base.pmtiles
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fredbi/geo/pkg/tilecache"
+	"github.com/fredbi/geo/pkg/tilecache/redis"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	"github.com/fredbi/geo/pkg/tileprovider/postgis"
+	"github.com/jackc/pgx"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		if err := seed(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	configPath := flag.String("config", "tileserver.yaml", "path to the YAML configuration file")
+	flag.Parse()
+
+	if err := run(*configPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(configPath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	handler, pool, err := connectServer(cfg)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	srv := &http.Server{
+		Addr:              cfg.Listen,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("serving tiles on %s", cfg.Listen)
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-stop:
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return srv.Shutdown(ctx)
+	}
+}
+
+// connectServer connects to the database and creates the server of its layers.
+func connectServer(cfg *Config) (*server, *pgx.ConnPool, error) {
+	pool, err := connect(cfg.PostGIS)
+	if err != nil {
+		return nil, nil, err
+	}
+	tiler := postgis.New(pool)
+	if err := registerLayers(tiler, cfg.Layers); err != nil {
+		pool.Close()
+		return nil, nil, err
+	}
+	backend, err := newCacheBackend(cfg.Cache)
+	if err != nil {
+		pool.Close()
+		return nil, nil, err
+	}
+	s, err := newServer(cfg, tiler, backend)
+	if err != nil {
+		pool.Close()
+		return nil, nil, err
+	}
+	return s, pool, nil
+}
+
+// newCacheBackend creates the backend of the cache of the tiles, or nil if tiles are not cached.
+func newCacheBackend(cfg CacheConfig) (tilecache.Backend, error) {
+	switch cfg.Backend {
+	case cacheMemory:
+		return tilecache.NewMemory(cfg.MaxBytes), nil
+	case cacheFilesystem:
+		return tilecache.NewFilesystem(cfg.Path)
+	case cacheRedis:
+		return redis.New(cfg.Address,
+			redis.WithPassword(cfg.Password),
+			redis.WithDB(cfg.DB),
+			redis.WithTTL(cfg.TTL),
+		), nil
+	default:
+		return nil, nil
+	}
+}
+
+func connect(cfg PostGISConfig) (*pgx.ConnPool, error) {
+	cc, err := pgx.ParseURI(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing postgis url: %v", err)
+	}
+	pool, err := pgx.NewConnPool(pgx.ConnPoolConfig{
+		ConnConfig:     cc,
+		MaxConnections: cfg.MaxConnections,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("connecting to postgis: %v", err)
+	}
+	return pool, nil
+}
+
+func registerLayers(tiler tileprovider.Tiler, layers []LayerConfig) error {
+	for _, l := range layers {
+		err := tiler.NewLayer(l.Name).
+			WithQuery(l.SQL).
+			WithGeomField(l.GeomField).
+			WithIDField(l.IDField).
+			WithGeomType(l.GeomType).
+			WithSRID(l.SRID).
+			Save()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
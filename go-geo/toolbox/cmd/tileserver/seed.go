@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileseed"
+	"github.com/fredbi/geo/pkg/tilestore"
+	"github.com/fredbi/geo/pkg/tilestore/directory"
+	"github.com/fredbi/geo/pkg/tilestore/pmtiles"
+)
+
+const seedUsage = `Usage: tileserver seed [flags] -name {layer or map} -out {directory or file.pmtiles}
+
+Pre-generates the tiles of a layer or a map of the configuration into a directory tree,
+with the layout of the routes of the server, or into a PMTiles archive.
+`
+
+// seedFlags are the flags of the seed subcommand.
+type seedFlags struct {
+	config     string
+	name       string
+	out        string
+	zoom       string
+	bounds     string
+	polygon    string
+	workers    int
+	checkpoint string
+}
+
+func parseSeedFlags(args []string) (seedFlags, error) {
+	var f seedFlags
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), seedUsage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&f.config, "config", "tileserver.yaml", "path to the YAML configuration file")
+	fs.StringVar(&f.name, "name", "", "the layer or map to seed")
+	fs.StringVar(&f.out, "out", "", "the output directory, or PMTiles archive with a .pmtiles extension")
+	fs.StringVar(&f.zoom, "zoom", "", "the zoom range, e.g. 0-14 (default the one of the layer or map)")
+	fs.StringVar(&f.bounds, "bounds", "", "the area to seed: west,south,east,north (default the bounds of the map)")
+	fs.StringVar(&f.polygon, "polygon", "", "a GeoJSON file with a polygon or a multi polygon to seed, instead of bounds")
+	fs.IntVar(&f.workers, "workers", runtime.NumCPU(), "the number of tiles rendered concurrently")
+	fs.StringVar(&f.checkpoint, "checkpoint", "", "a checkpoint file to resume interrupted runs (directory outputs only)")
+	if err := fs.Parse(args); err != nil {
+		return f, err
+	}
+	if f.name == "" || f.out == "" {
+		fs.Usage()
+		return f, errors.New("seed: -name and -out are required")
+	}
+	if f.bounds != "" && f.polygon != "" {
+		return f, errors.New("seed: -bounds and -polygon are exclusive")
+	}
+	if f.checkpoint != "" && isPMTiles(f.out) {
+		return f, errors.New("seed: PMTiles archives are written at once: runs cannot be resumed")
+	}
+	return f, nil
+}
+
+// seed runs the seed subcommand.
+func seed(args []string) error {
+	f, err := parseSeedFlags(args)
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig(f.config)
+	if err != nil {
+		return err
+	}
+
+	s, pool, err := connectServer(cfg)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	renderer, ext := s.layers[f.name], strings.TrimPrefix(layerTileExt, ".")
+	if renderer == nil {
+		renderer, ext = s.maps[f.name], strings.TrimPrefix(mapTileExt, ".")
+	}
+	doc, ok := s.tileJSON.build(f.name, "")
+	if renderer == nil || !ok {
+		return fmt.Errorf("seed: no layer or map %q", f.name)
+	}
+	minZoom, maxZoom, err := parseZoomRange(f.zoom, doc.MinZoom, doc.MaxZoom)
+	if err != nil {
+		return err
+	}
+	area, err := seedArea(f, doc.Bounds)
+	if err != nil {
+		return err
+	}
+
+	var (
+		store   tilestore.Writer
+		archive *os.File
+	)
+	if isPMTiles(f.out) {
+		if archive, err = os.Create(f.out); err != nil {
+			return err
+		}
+		defer archive.Close()
+		if store, err = pmtiles.NewWriter(archive); err != nil {
+			return err
+		}
+	} else if store, err = directory.New(f.out, ext); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	seeder := tileseed.New(renderer, store,
+		tileseed.WithWorkers(f.workers),
+		tileseed.WithCheckpoint(f.checkpoint),
+		tileseed.WithProgress(logProgress),
+	)
+	if _, err := seeder.Seed(ctx, area, minZoom, maxZoom); err != nil {
+		return err
+	}
+
+	m := seedMetadata(doc)
+	b := area.Bound()
+	m.Bounds = [4]float64{b.Min[0], b.Min[1], b.Max[0], b.Max[1]}
+	m.MinZoom, m.MaxZoom = minZoom, maxZoom
+	store.SetMetadata(m)
+	if err := store.Close(); err != nil {
+		return err
+	}
+	if archive != nil {
+		return archive.Close()
+	}
+	return nil
+}
+
+func isPMTiles(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".pmtiles")
+}
+
+// parseZoomRange parses a zoom range such as 0-14, or a single zoom level.
+func parseZoomRange(s string, minZoom, maxZoom maptile.Zoom) (maptile.Zoom, maptile.Zoom, error) {
+	if s == "" {
+		return minZoom, maxZoom, nil
+	}
+	parts := strings.SplitN(s, "-", 2)
+	var zooms [2]maptile.Zoom
+	for i, part := range parts {
+		z, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err != nil || z > maptile.MaxZ {
+			return 0, 0, fmt.Errorf("seed: invalid zoom range %q", s)
+		}
+		zooms[i] = maptile.Zoom(z)
+	}
+	if len(parts) == 1 {
+		zooms[1] = zooms[0]
+	}
+	if zooms[0] > zooms[1] {
+		return 0, 0, fmt.Errorf("seed: invalid zoom range %q", s)
+	}
+	return zooms[0], zooms[1], nil
+}
+
+// seedArea yields the area of the polygon file or the bounds of the flags, or else some default bounds.
+func seedArea(f seedFlags, defaultBounds []float64) (tileseed.Area, error) {
+	if f.polygon != "" {
+		b, err := ioutil.ReadFile(f.polygon)
+		if err != nil {
+			return nil, err
+		}
+		g, err := decodeGeometry(b)
+		if err != nil {
+			return nil, fmt.Errorf("seed: decoding %s: %v", f.polygon, err)
+		}
+		return tileseed.NewPolygonArea(g)
+	}
+
+	bounds := defaultBounds
+	if f.bounds != "" {
+		var err error
+		if bounds, err = parseFloats(f.bounds); err != nil {
+			return nil, fmt.Errorf("seed: invalid bounds %q", f.bounds)
+		}
+	}
+	b, err := newBound(bounds)
+	if err != nil {
+		return nil, fmt.Errorf("seed: %v", err)
+	}
+	return tileseed.NewBoundArea(b), nil
+}
+
+// seedMetadata yields the metadata of a store from a TileJSON document.
+func seedMetadata(doc *TileJSON) tilestore.Metadata {
+	m := tilestore.Metadata{
+		Name:        doc.Name,
+		Description: doc.Description,
+		Attribution: doc.Attribution,
+		Format:      tilestore.DefaultFormat,
+		MinZoom:     doc.MinZoom,
+		MaxZoom:     doc.MaxZoom,
+	}
+	copy(m.Bounds[:], doc.Bounds)
+	copy(m.Center[:], doc.Center)
+	for _, l := range doc.VectorLayers {
+		m.VectorLayers = append(m.VectorLayers, tilestore.VectorLayer{
+			ID:          l.ID,
+			Description: l.Description,
+			Fields:      l.Fields,
+			MinZoom:     l.MinZoom,
+			MaxZoom:     l.MaxZoom,
+		})
+	}
+	return m
+}
+
+func logProgress(p tileseed.Progress) {
+	var percent float64
+	if p.Total > 0 {
+		percent = 100 * float64(p.Done) / float64(p.Total)
+	}
+	log.Printf("seeded %d/%d tiles (%.1f%%), %d empty, %d resumed, in %s",
+		p.Done, p.Total, percent, p.Empty, p.Resumed, p.Elapsed.Round(time.Second))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeedFlags(t *testing.T) {
+	f, err := parseSeedFlags([]string{"-name", "base", "-out", "base.pmtiles", "-zoom", "2-4", "-workers", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, seedFlags{config: "tileserver.yaml", name: "base", out: "base.pmtiles", zoom: "2-4", workers: 3}, f)
+
+	for _, args := range [][]string{
+		{"-name", "base"},
+		{"-name", "base", "-out", "tiles", "-bounds", "0,0,1,1", "-polygon", "area.json"},
+		{"-name", "base", "-out", "base.PMTiles", "-checkpoint", "base.checkpoint"},
+	} {
+		_, err := parseSeedFlags(args)
+		assert.Error(t, err, args)
+	}
+}
+
+func TestParseZoomRange(t *testing.T) {
+	for s, expected := range map[string][2]maptile.Zoom{
+		"":     {1, 12},
+		"3":    {3, 3},
+		"0-14": {0, 14},
+	} {
+		minZoom, maxZoom, err := parseZoomRange(s, 1, 12)
+		require.NoError(t, err, s)
+		assert.Equal(t, expected, [2]maptile.Zoom{minZoom, maxZoom}, s)
+	}
+	for _, s := range []string{"a", "4-2", "0-23", "-1"} {
+		_, _, err := parseZoomRange(s, 1, 12)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSeedArea(t *testing.T) {
+	area, err := seedArea(seedFlags{}, tileprovider.WGS84Bounds[:])
+	require.NoError(t, err)
+	assert.Equal(t, -180.0, area.Bound().Min[0])
+
+	area, err = seedArea(seedFlags{bounds: "2.2, 48.8, 2.5, 48.9"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 48.9, area.Bound().Max[1])
+
+	_, err = seedArea(seedFlags{bounds: "2.5,48.8,2.2,48.9"}, nil)
+	assert.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "tileserver")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "area.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+		"type": "Feature",
+		"properties": {},
+		"geometry": {"type": "Polygon", "coordinates": [[[0, 0], [10, 0], [10, 5], [0, 0]]]}
+	}`), 0600))
+	area, err = seedArea(seedFlags{polygon: path}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, area.Bound().Max[1])
+	assert.False(t, area.Intersects(maptile.New(0, 0, 1)))
+	assert.True(t, area.Intersects(maptile.New(1, 0, 1)))
+}
@@ -0,0 +1,384 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/xxhash"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/tilecache"
+	"github.com/fredbi/geo/pkg/tilerenderer"
+	"github.com/fredbi/geo/pkg/tileseed"
+	"github.com/go-spatial/tegola/provider"
+)
+
+const (
+	layerTileExt = ".mvt"
+	mapTileExt   = ".pbf"
+	tileJSONExt  = ".json"
+
+	invalidatePath = "/invalidate"
+
+	// maxInvalidationBody is the maximum size of the geometries of invalidation requests.
+	maxInvalidationBody = 16 << 20
+)
+
+// server serves the tiles of the layers and maps of the configuration.
+type server struct {
+	cfg      *Config
+	layers   map[string]*tilerenderer.Renderer
+	maps     map[string]*tilerenderer.Renderer
+	tileJSON *tileJSONBuilder
+
+	// caches are the caches of the layers and maps, if any.
+	caches map[string]*tilecache.Cache
+}
+
+// newServer creates the server of the layers of a provider, caching tiles in a backend if not nil.
+func newServer(cfg *Config, tiler provider.Tiler, backend tilecache.Backend) (*server, error) {
+	tileJSON, err := newTileJSONBuilder(cfg, tiler)
+	if err != nil {
+		return nil, err
+	}
+	s := &server{
+		cfg:      cfg,
+		layers:   make(map[string]*tilerenderer.Renderer, len(cfg.Layers)),
+		maps:     make(map[string]*tilerenderer.Renderer, len(cfg.Maps)),
+		tileJSON: tileJSON,
+	}
+	for _, l := range cfg.Layers {
+		s.layers[l.Name] = tilerenderer.New(tiler, []tilerenderer.LayerConfig{rendererLayer(l)})
+	}
+	for _, m := range cfg.Maps {
+		layers := make([]tilerenderer.LayerConfig, 0, len(m.Layers))
+		for _, name := range m.Layers {
+			l, _ := cfg.layer(name)
+			layers = append(layers, rendererLayer(l))
+		}
+		s.maps[m.Name] = tilerenderer.New(tiler, layers)
+	}
+
+	if backend != nil {
+		s.caches = make(map[string]*tilecache.Cache, len(s.layers)+len(s.maps))
+		onError := tilecache.WithErrorHandler(func(err error) { log.Print(err) })
+		for _, l := range cfg.Layers {
+			s.caches[l.Name] = tilecache.New(l.Name, s.layers[l.Name], backend,
+				tilecache.WithZoomRange(l.MinZoom, l.MaxZoom), onError)
+		}
+		for _, m := range cfg.Maps {
+			minZoom, maxZoom := maptile.Zoom(maptile.MaxZ), maptile.Zoom(0)
+			for _, name := range m.Layers {
+				l, _ := cfg.layer(name)
+				if l.MinZoom < minZoom {
+					minZoom = l.MinZoom
+				}
+				if l.MaxZoom > maxZoom {
+					maxZoom = l.MaxZoom
+				}
+			}
+			s.caches[m.Name] = tilecache.New(m.Name, s.maps[m.Name], backend,
+				tilecache.WithZoomRange(minZoom, maxZoom), onError)
+		}
+	}
+	return s, nil
+}
+
+func rendererLayer(l LayerConfig) tilerenderer.LayerConfig {
+	cfg := tilerenderer.LayerConfig{Name: l.Name, MinZoom: l.MinZoom, MaxZoom: l.MaxZoom}
+	for _, r := range l.Generalization {
+		cfg.Generalization = append(cfg.Generalization, mvt.GeneralizationRule{
+			MinZoom: r.MinZoom,
+			MaxZoom: r.MaxZoom,
+			Keep:    r.Keep,
+			Drop:    r.Drop,
+			Round:   r.Round,
+			Rename:  r.Rename,
+			Merge:   r.Merge,
+		})
+	}
+	for _, r := range l.Collisions {
+		rule := mvt.CollisionRule{
+			MinZoom:    r.MinZoom,
+			MaxZoom:    r.MaxZoom,
+			Priority:   r.Priority,
+			Ascending:  r.Ascending,
+			MinSpacing: r.MinSpacing,
+		}
+		if r.Test == collideGeometries {
+			rule.Test = mvt.CollideGeometries
+		}
+		cfg.Collisions = append(cfg.Collisions, rule)
+	}
+	return cfg
+}
+
+// ServeHTTP routes /{layer}/{z}/{x}/{y}.mvt, /{map}/{z}/{x}/{y}.pbf, /{layer or map}.json
+// and /invalidate.
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == invalidatePath {
+		s.serveInvalidate(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if s.cfg.CORSOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", s.cfg.CORSOrigin)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 1 && strings.HasSuffix(parts[0], tileJSONExt):
+		s.serveTileJSON(w, r, strings.TrimSuffix(parts[0], tileJSONExt))
+	case len(parts) == 4 && strings.HasSuffix(parts[3], layerTileExt):
+		parts[3] = strings.TrimSuffix(parts[3], layerTileExt)
+		s.serveTile(w, r, s.renderer(parts[0], s.layers), parts[1:])
+	case len(parts) == 4 && strings.HasSuffix(parts[3], mapTileExt):
+		parts[3] = strings.TrimSuffix(parts[3], mapTileExt)
+		s.serveTile(w, r, s.renderer(parts[0], s.maps), parts[1:])
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// renderer yields the renderer of a layer or a map, through its cache if any, or nil.
+func (s *server) renderer(name string, renderers map[string]*tilerenderer.Renderer) tilecache.Renderer {
+	r, ok := renderers[name]
+	if !ok {
+		return nil
+	}
+	if c, ok := s.caches[name]; ok {
+		return c
+	}
+	return r
+}
+
+func (s *server) serveTile(w http.ResponseWriter, r *http.Request, renderer tilecache.Renderer, zxy []string) {
+	if renderer == nil {
+		http.NotFound(w, r)
+		return
+	}
+	tile, err := parseTile(zxy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := renderer.Render(r.Context(), tile)
+	if err != nil {
+		if r.Context().Err() != nil {
+			// the client went away
+			return
+		}
+		log.Printf("rendering tile %s %d/%d/%d: %v", r.URL.Path, tile.Z, tile.X, tile.Y, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h := w.Header()
+	h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.cfg.CacheMaxAge.Seconds())))
+	h.Set("Vary", "Accept-Encoding")
+	if len(data) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// the gzipped and plain representations are equivalent: a weak ETag covers both
+	etag := fmt.Sprintf(`W/"%016x"`, xxhash.Sum64(data))
+	h.Set("ETag", etag)
+	if matchETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.Set("Content-Type", mvt.MimeType)
+	if !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		if data, err = gunzip(data); err != nil {
+			log.Printf("decompressing tile %s: %v", r.URL.Path, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	} else {
+		h.Set("Content-Encoding", "gzip")
+	}
+	h.Set("Content-Length", strconv.Itoa(len(data)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// serveInvalidate purges the cached tiles intersecting the GeoJSON geometry or feature of the
+// body, or else the bbox parameter: west,south,east,north.
+//
+// The layers parameter, such as layers=roads,poi, restricts the purge to some layers and the
+// maps featuring them. Requests are authenticated by the bearer token of the configuration.
+func (s *server) serveInvalidate(w http.ResponseWriter, r *http.Request) {
+	token := s.cfg.Cache.InvalidationToken
+	if s.caches == nil || token == "" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") ||
+		subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(token)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	caches, err := s.invalidatedCaches(r.URL.Query().Get("layers"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxInvalidationBody)
+	area, err := invalidationArea(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, c := range caches {
+		if err := c.Invalidate(r.Context(), area); err != nil {
+			log.Printf("invalidating %s: %v", c.Name(), err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// invalidatedCaches yields the caches of some comma separated layers and of the maps featuring
+// them, or else all the caches.
+func (s *server) invalidatedCaches(layers string) ([]*tilecache.Cache, error) {
+	if layers == "" {
+		caches := make([]*tilecache.Cache, 0, len(s.caches))
+		for _, c := range s.caches {
+			caches = append(caches, c)
+		}
+		return caches, nil
+	}
+
+	names := make(map[string]bool)
+	for _, name := range strings.Split(layers, ",") {
+		if _, ok := s.layers[name]; !ok {
+			return nil, fmt.Errorf("unknown layer %q", name)
+		}
+		names[name] = true
+	}
+	var caches []*tilecache.Cache
+	for _, m := range s.cfg.Maps {
+		for _, name := range m.Layers {
+			if names[name] {
+				caches = append(caches, s.caches[m.Name])
+				break
+			}
+		}
+	}
+	for name := range names {
+		caches = append(caches, s.caches[name])
+	}
+	return caches, nil
+}
+
+// invalidationArea yields the area of the bbox parameter of a request, or else of its body.
+func invalidationArea(r *http.Request) (tileseed.Area, error) {
+	if bbox := r.URL.Query().Get("bbox"); bbox != "" {
+		values, err := parseFloats(bbox)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bbox %q", bbox)
+		}
+		b, err := newBound(values)
+		if err != nil {
+			return nil, err
+		}
+		return tileseed.NewBoundArea(b), nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading geometry: %v", err)
+	}
+	g, err := decodeGeometry(body)
+	if err != nil {
+		return nil, fmt.Errorf("decoding geometry: %v", err)
+	}
+	return tileseed.NewGeometryArea(g)
+}
+
+// parseTile parses and validates z, x and y.
+func parseTile(zxy []string) (maptile.Tile, error) {
+	var values [3]uint64
+	for i, s := range zxy {
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return maptile.Tile{}, fmt.Errorf("invalid tile coordinate %q", s)
+		}
+		values[i] = v
+	}
+	z, x, y := values[0], values[1], values[2]
+	if z > maptile.MaxZ {
+		return maptile.Tile{}, fmt.Errorf("zoom %d is greater than %d", z, maptile.MaxZ)
+	}
+	if n := uint64(1) << z; x >= n || y >= n {
+		return maptile.Tile{}, fmt.Errorf("tile %d/%d/%d does not exist", z, x, y)
+	}
+	return maptile.New(uint32(x), uint32(y), maptile.Zoom(z)), nil
+}
+
+// matchETag tells if an If-None-Match header matches an ETag, with the weak comparison.
+func matchETag(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip tells if an Accept-Encoding header accepts gzip.
+func acceptsGzip(acceptEncoding string) bool {
+	for _, coding := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(coding, ";")
+		name := strings.TrimSpace(params[0])
+		if name != "gzip" && name != "*" {
+			continue
+		}
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); strings.HasPrefix(param, "q=") && err == nil && q == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return ioutil.ReadAll(zr)
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/tilecache"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	gsgeom "github.com/go-spatial/geom"
+	"github.com/go-spatial/tegola/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLayerInfo struct {
+	name     string
+	geomType gsgeom.Geometry
+}
+
+func (l fakeLayerInfo) Name() string              { return l.name }
+func (l fakeLayerInfo) GeomType() gsgeom.Geometry { return l.geomType }
+func (l fakeLayerInfo) SRID() uint64              { return tileprovider.WGS84 }
+
+// fakeTiler serves the same features for every tile.
+type fakeTiler struct {
+	features map[string][]provider.Feature
+}
+
+func (f *fakeTiler) Layers() ([]provider.LayerInfo, error) {
+	return []provider.LayerInfo{
+		fakeLayerInfo{name: "roads", geomType: gsgeom.LineString{}},
+		fakeLayerInfo{name: "poi", geomType: gsgeom.Point{}},
+	}, nil
+}
+
+func (f *fakeTiler) TileFeatures(ctx context.Context, layer string, t provider.Tile, fn func(*provider.Feature) error) error {
+	for i := range f.features[layer] {
+		if err := fn(&f.features[layer][i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testServer(t *testing.T, backend tilecache.Backend) *server {
+	cfg := defaultConfig()
+	cfg.Layers = []LayerConfig{
+		{Name: "roads", Description: "the roads", MaxZoom: 14, Fields: map[string]string{"class": "String"}},
+		{Name: "poi", MinZoom: 2, MaxZoom: 18},
+	}
+	cfg.Maps = []MapConfig{{Name: "base", Attribution: "someone", Layers: []string{"roads", "poi"}}}
+	cfg.Cache.InvalidationToken = "secret"
+	require.NoError(t, cfg.validate())
+
+	s, err := newServer(cfg, &fakeTiler{features: map[string][]provider.Feature{
+		"roads": {{ID: 1, Geometry: gsgeom.LineString{{-10, -10}, {10, 10}}, SRID: tileprovider.WGS84}},
+		"poi":   {{ID: 2, Geometry: gsgeom.Point{1, 1}, SRID: tileprovider.WGS84}},
+	}}, backend)
+	require.NoError(t, err)
+	return s
+}
+
+func serve(s *server, method, path string, header http.Header) *httptest.ResponseRecorder {
+	return serveBody(s, method, path, header, "")
+}
+
+func serveBody(s *server, method, path string, header http.Header, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func TestServeTile(t *testing.T) {
+	s := testServer(t, nil)
+	gzipped := http.Header{"Accept-Encoding": {"gzip, deflate"}}
+
+	w := serve(s, http.MethodGet, "/roads/0/0/0.mvt", gzipped)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, mvt.MimeType, w.Header().Get("Content-Type"))
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	layers, err := mvt.UnmarshalGzipped(w.Body.Bytes())
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	assert.Equal(t, "roads", layers[0].Name)
+
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	w = serve(s, http.MethodGet, "/roads/0/0/0.mvt", http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+
+	// without gzip
+	w = serve(s, http.MethodGet, "/roads/0/0/0.mvt", http.Header{"Accept-Encoding": {"gzip;q=0"}})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	plain := w.Body.Bytes()
+	w = serve(s, http.MethodGet, "/roads/0/0/0.mvt", gzipped)
+	unzipped, err := gunzip(w.Body.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, unzipped, plain)
+
+	// a map, where poi is out of its zoom range
+	w = serve(s, http.MethodGet, "/base/0/0/0.pbf", gzipped)
+	require.Equal(t, http.StatusOK, w.Code)
+	layers, err = mvt.UnmarshalGzipped(w.Body.Bytes())
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+
+	w = serve(s, http.MethodHead, "/base/0/0/0.pbf", gzipped)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+
+	// empty tile
+	w = serve(s, http.MethodGet, "/roads/15/0/0.mvt", gzipped)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	for path, code := range map[string]int{
+		"/unknown/0/0/0.mvt": http.StatusNotFound,
+		"/base/0/0/0.mvt":    http.StatusNotFound,
+		"/roads/0/0/0.pbf":   http.StatusNotFound,
+		"/roads/0/0/0.png":   http.StatusNotFound,
+		"/roads/0/0.mvt":     http.StatusNotFound,
+		"/roads/1/2/0.mvt":   http.StatusBadRequest,
+		"/roads/a/0/0.mvt":   http.StatusBadRequest,
+		"/roads/23/0/0.mvt":  http.StatusBadRequest,
+	} {
+		assert.Equal(t, code, serve(s, http.MethodGet, path, nil).Code, path)
+	}
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(s, http.MethodPost, "/roads/0/0/0.mvt", nil).Code)
+}
+
+func TestServeInvalidate(t *testing.T) {
+	backend := tilecache.NewMemory(1 << 20)
+	s := testServer(t, backend)
+	auth := http.Header{"Authorization": {"Bearer secret"}}
+	render := func() {
+		for _, path := range []string{"/roads/2/2/1.mvt", "/poi/2/2/1.mvt", "/base/2/2/1.pbf", "/base/2/0/3.pbf"} {
+			require.Contains(t, []int{http.StatusOK, http.StatusNoContent}, serve(s, http.MethodGet, path, nil).Code, path)
+		}
+		require.Equal(t, 4, backend.Len())
+	}
+
+	render()
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(s, http.MethodGet, "/invalidate", auth).Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(s, http.MethodPost, "/invalidate?bbox=0,0,1,1", nil).Code)
+	assert.Equal(t, http.StatusUnauthorized,
+		serve(s, http.MethodPost, "/invalidate?bbox=0,0,1,1", http.Header{"Authorization": {"Bearer wrong"}}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(s, http.MethodPost, "/invalidate?bbox=1,0,0,1", auth).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(s, http.MethodPost, "/invalidate?bbox=0,0,1,1&layers=rails", auth).Code)
+	assert.Equal(t, http.StatusBadRequest, serveBody(s, http.MethodPost, "/invalidate", auth, "{").Code)
+	assert.Equal(t, 4, backend.Len())
+
+	// the tiles of poi and of the maps featuring it, in the north east quarter of the world
+	w := serve(s, http.MethodPost, "/invalidate?bbox=100,10,101,11&layers=poi", auth)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, 2, backend.Len())
+	_, ok, err := backend.Get(context.Background(), tilecache.Key{Name: "roads", Z: 2, X: 2, Y: 1})
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	render()
+	w = serveBody(s, http.MethodPost, "/invalidate", auth, `{
+		"type": "Feature",
+		"properties": {},
+		"geometry": {"type": "LineString", "coordinates": [[-170, -80], [-160, -70]]}
+	}`)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, 3, backend.Len())
+	_, ok, err = backend.Get(context.Background(), tilecache.Key{Name: "base", Z: 2, X: 0, Y: 3})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, http.StatusNotFound, serve(testServer(t, nil), http.MethodPost, "/invalidate?bbox=0,0,1,1", auth).Code)
+}
+
+func TestServeTileJSON(t *testing.T) {
+	s := testServer(t, nil)
+
+	w := serve(s, http.MethodGet, "/base.json", http.Header{"X-Forwarded-Proto": {"https"}})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var doc TileJSON
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, TileJSONVersion, doc.TileJSON)
+	assert.Equal(t, []string{"https://example.com/base/{z}/{x}/{y}.pbf"}, doc.Tiles)
+	assert.Equal(t, "someone", doc.Attribution)
+	assert.EqualValues(t, 0, doc.MinZoom)
+	assert.EqualValues(t, 18, doc.MaxZoom)
+	assert.Equal(t, tileprovider.WGS84Bounds[:], doc.Bounds)
+	require.Len(t, doc.VectorLayers, 2)
+	assert.Equal(t, "linestring", doc.VectorLayers[0].GeometryType)
+	assert.Equal(t, map[string]string{}, doc.VectorLayers[1].Fields)
+
+	s.cfg.BaseURL = "https://tiles.example.com/"
+	w = serve(s, http.MethodGet, "/roads.json", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, []string{"https://tiles.example.com/roads/{z}/{x}/{y}.mvt"}, doc.Tiles)
+	assert.Equal(t, "the roads", doc.Description)
+	assert.EqualValues(t, 14, doc.MaxZoom)
+
+	assert.Equal(t, http.StatusNotFound, serve(s, http.MethodGet, "/unknown.json", nil).Code)
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tileserver")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+cacheMaxAge: 10m
+postgis:
+  url: postgres://localhost/gis
+layers:
+  - name: roads
+    sql: SELECT id, geom FROM roads WHERE geom && !BBOX!
+    srid: 3857
+    minZoom: 4
+    generalization:
+      - maxZoom: 8
+        keep: [class, width]
+        round:
+          width: 1
+        rename:
+          class: kind
+        merge: true
+    collisions:
+      - maxZoom: 10
+        priority: rank
+        ascending: true
+        minSpacing: 8
+        test: geometries
+maps:
+  - name: base
+    layers: [roads]
+    center: [0, 0, 2]
+`), 0600))
+
+	cfg, err := loadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.Listen)
+	assert.Equal(t, 10*time.Minute, cfg.CacheMaxAge)
+	assert.Equal(t, 10, cfg.PostGIS.MaxConnections)
+	require.Len(t, cfg.Layers, 1)
+	assert.Equal(t, LayerConfig{
+		Name:      "roads",
+		SQL:       "SELECT id, geom FROM roads WHERE geom && !BBOX!",
+		GeomField: "geom",
+		IDField:   "id",
+		SRID:      tileprovider.WebMercator,
+		MinZoom:   4,
+		MaxZoom:   22,
+		Generalization: []GeneralizationConfig{
+			{MaxZoom: 8, Keep: []string{"class", "width"}, Round: map[string]int{"width": 1}, Rename: map[string]string{"class": "kind"}, Merge: true},
+		},
+		Collisions: []CollisionConfig{
+			{MaxZoom: 10, Priority: "rank", Ascending: true, MinSpacing: 8, Test: collideGeometries},
+		},
+	}, cfg.Layers[0])
+	assert.Equal(t, mvt.CollisionRules{
+		{MaxZoom: 10, Priority: "rank", Ascending: true, MinSpacing: 8, Test: mvt.CollideGeometries},
+	}, rendererLayer(cfg.Layers[0]).Collisions)
+	assert.Equal(t, mvt.GeneralizationRules{
+		{MaxZoom: 8, Keep: []string{"class", "width"}, Round: map[string]int{"width": 1}, Rename: map[string]string{"class": "kind"}, Merge: true},
+	}, rendererLayer(cfg.Layers[0]).Generalization)
+	assert.Equal(t, []float64{0, 0, 2}, cfg.Maps[0].Center)
+
+	for name, cfg := range map[string]*Config{
+		"no layer":              {},
+		"no name":               {Layers: []LayerConfig{{}}},
+		"duplicate":             {Layers: []LayerConfig{{Name: "a"}, {Name: "a"}}},
+		"zoom range":            {Layers: []LayerConfig{{Name: "a", MinZoom: 3, MaxZoom: 2}}},
+		"unknown layer":         {Layers: []LayerConfig{{Name: "a"}}, Maps: []MapConfig{{Name: "m", Layers: []string{"b"}}}},
+		"map name":              {Layers: []LayerConfig{{Name: "a"}}, Maps: []MapConfig{{Name: "a", Layers: []string{"a"}}}},
+		"invalid bounds":        {Layers: []LayerConfig{{Name: "a"}}, Maps: []MapConfig{{Name: "m", Layers: []string{"a"}, Bounds: []float64{1}}}},
+		"cache backend":         {Layers: []LayerConfig{{Name: "a"}}, Cache: CacheConfig{Backend: "memcached"}},
+		"cache path":            {Layers: []LayerConfig{{Name: "a"}}, Cache: CacheConfig{Backend: cacheFilesystem}},
+		"generalization zoom":   {Layers: []LayerConfig{{Name: "a", Generalization: []GeneralizationConfig{{MinZoom: 3, MaxZoom: 2}}}}},
+		"generalization rename": {Layers: []LayerConfig{{Name: "a", Generalization: []GeneralizationConfig{{Rename: map[string]string{"a": "c", "b": "c"}}}}}},
+		"collision zoom":        {Layers: []LayerConfig{{Name: "a", Collisions: []CollisionConfig{{MinZoom: 3, MaxZoom: 2}}}}},
+		"collision test":        {Layers: []LayerConfig{{Name: "a", Collisions: []CollisionConfig{{Test: "labels"}}}}},
+	} {
+		assert.Error(t, cfg.validate(), name)
+	}
+}
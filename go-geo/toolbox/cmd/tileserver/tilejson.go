@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	gsgeom "github.com/go-spatial/geom"
+	"github.com/go-spatial/tegola/provider"
+)
+
+// TileJSONVersion is the version of the TileJSON specification of the documents served.
+const TileJSONVersion = "3.0.0"
+
+// TileJSON is a TileJSON document, see https://github.com/mapbox/tilejson-spec/tree/master/3.0.0.
+type TileJSON struct {
+	TileJSON     string        `json:"tilejson"`
+	Name         string        `json:"name,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	Attribution  string        `json:"attribution,omitempty"`
+	Scheme       string        `json:"scheme"`
+	Tiles        []string      `json:"tiles"`
+	MinZoom      maptile.Zoom  `json:"minzoom"`
+	MaxZoom      maptile.Zoom  `json:"maxzoom"`
+	Bounds       []float64     `json:"bounds"`
+	Center       []float64     `json:"center,omitempty"`
+	VectorLayers []VectorLayer `json:"vector_layers"`
+}
+
+// VectorLayer describes a layer of vector tiles.
+//
+// GeometryType is an extension of the specification, with the type of the geometries of the layer.
+type VectorLayer struct {
+	ID           string            `json:"id"`
+	Description  string            `json:"description,omitempty"`
+	Fields       map[string]string `json:"fields"`
+	MinZoom      maptile.Zoom      `json:"minzoom"`
+	MaxZoom      maptile.Zoom      `json:"maxzoom"`
+	GeometryType string            `json:"geometry_type,omitempty"`
+}
+
+// tileJSONBuilder builds the TileJSON documents of the layers and maps, for some base URL.
+type tileJSONBuilder struct {
+	cfg    *Config
+	layers map[string]VectorLayer
+}
+
+func newTileJSONBuilder(cfg *Config, tiler provider.Tiler) (*tileJSONBuilder, error) {
+	infos, err := tiler.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("listing provider layers: %v", err)
+	}
+	geomTypes := make(map[string]string, len(infos))
+	for _, info := range infos {
+		geomTypes[info.Name()] = geomTypeName(info.GeomType())
+	}
+
+	b := &tileJSONBuilder{cfg: cfg, layers: make(map[string]VectorLayer, len(cfg.Layers))}
+	for _, l := range cfg.Layers {
+		fields := l.Fields
+		if fields == nil {
+			fields = map[string]string{}
+		}
+		b.layers[l.Name] = VectorLayer{
+			ID:           l.Name,
+			Description:  l.Description,
+			Fields:       fields,
+			MinZoom:      l.MinZoom,
+			MaxZoom:      l.MaxZoom,
+			GeometryType: geomTypes[l.Name],
+		}
+	}
+	return b, nil
+}
+
+// build yields the TileJSON of a map or a layer, or false if there is none with this name.
+func (b *tileJSONBuilder) build(name, baseURL string) (*TileJSON, bool) {
+	doc := &TileJSON{
+		TileJSON: TileJSONVersion,
+		Name:     name,
+		Scheme:   "xyz",
+		Bounds:   tileprovider.WGS84Bounds[:],
+	}
+
+	if layer, ok := b.layers[name]; ok {
+		doc.Description = layer.Description
+		doc.Tiles = []string{fmt.Sprintf("%s/%s/{z}/{x}/{y}%s", baseURL, name, layerTileExt)}
+		doc.MinZoom, doc.MaxZoom = layer.MinZoom, layer.MaxZoom
+		doc.VectorLayers = []VectorLayer{layer}
+		return doc, true
+	}
+
+	for _, m := range b.cfg.Maps {
+		if m.Name != name {
+			continue
+		}
+		doc.Description = m.Description
+		doc.Attribution = m.Attribution
+		doc.Tiles = []string{fmt.Sprintf("%s/%s/{z}/{x}/{y}%s", baseURL, name, mapTileExt)}
+		if m.Bounds != nil {
+			doc.Bounds = m.Bounds
+		}
+		doc.Center = m.Center
+		for i, layerName := range m.Layers {
+			layer := b.layers[layerName]
+			if i == 0 || layer.MinZoom < doc.MinZoom {
+				doc.MinZoom = layer.MinZoom
+			}
+			if layer.MaxZoom > doc.MaxZoom {
+				doc.MaxZoom = layer.MaxZoom
+			}
+			doc.VectorLayers = append(doc.VectorLayers, layer)
+		}
+		return doc, true
+	}
+	return nil, false
+}
+
+func (s *server) serveTileJSON(w http.ResponseWriter, r *http.Request, name string) {
+	doc, ok := s.tileJSON.build(name, s.baseURL(r))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.cfg.CacheMaxAge.Seconds())))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+// baseURL yields the configured base URL, or the base URL of the request.
+func (s *server) baseURL(r *http.Request) string {
+	if s.cfg.BaseURL != "" {
+		return strings.TrimSuffix(s.cfg.BaseURL, "/")
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host
+}
+
+// geomTypeName yields the name of the type of the geometries of a provider layer.
+func geomTypeName(g gsgeom.Geometry) string {
+	switch g.(type) {
+	case gsgeom.Point:
+		return "point"
+	case gsgeom.MultiPoint:
+		return "multipoint"
+	case gsgeom.LineString:
+		return "linestring"
+	case gsgeom.MultiLineString:
+		return "multilinestring"
+	case gsgeom.Polygon:
+		return "polygon"
+	case gsgeom.MultiPolygon:
+		return "multipolygon"
+	case gsgeom.Collection:
+		return "geometrycollection"
+	default:
+		return ""
+	}
+}
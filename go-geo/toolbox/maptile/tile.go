@@ -114,3 +114,35 @@ func (t Tile) ZEpislon() float64 {
 	e := epi / denom
 	return e
 }
+
+// Parent returns the tile of the previous zoom level containing the tile.
+// The parent of a tile at zoom 0 is the tile itself.
+func (t Tile) Parent() Tile {
+	if t.Z == 0 {
+		return t
+	}
+	p := t
+	p.X, p.Y, p.Z = t.X>>1, t.Y>>1, t.Z-1
+	return p
+}
+
+// Children returns the four tiles of the next zoom level covering the tile,
+// in the order north-west, north-east, south-west and south-east.
+func (t Tile) Children() Tiles {
+	children := make(Tiles, 4)
+	for i := range children {
+		c := t
+		c.X, c.Y, c.Z = t.X<<1+uint32(i&1), t.Y<<1+uint32(i>>1), t.Z+1
+		children[i] = c
+	}
+	return children
+}
+
+// Contains tells if a tile covers another tile, of the same or a greater zoom level.
+func (t Tile) Contains(other Tile) bool {
+	if other.Z < t.Z {
+		return false
+	}
+	n := uint32(other.Z - t.Z)
+	return other.X>>n == t.X && other.Y>>n == t.Y
+}
@@ -111,3 +111,29 @@ func TestTileBound(t *testing.T) {
 		}
 	}
 }
+
+func TestTilePyramid(t *testing.T) {
+	tile := maptile.New(3, 5, 4)
+	children := tile.Children()
+	if len(children) != 4 {
+		t.Fatalf("Expected 4 children, got %d", len(children))
+	}
+	expected := []maptile.Tile{maptile.New(6, 10, 5), maptile.New(7, 10, 5), maptile.New(6, 11, 5), maptile.New(7, 11, 5)}
+	for i, c := range children {
+		if c != expected[i] {
+			t.Errorf("Expected child %d to be %v, got %v", i, expected[i], c)
+		}
+		if c.Parent() != tile {
+			t.Errorf("Expected the parent of %v to be %v, got %v", c, tile, c.Parent())
+		}
+		if !tile.Contains(c) || c.Contains(tile) {
+			t.Errorf("Expected %v to contain %v only", tile, c)
+		}
+	}
+	if !tile.Contains(tile) || !tile.Contains(maptile.New(3<<4+15, 5<<4, 8)) || tile.Contains(maptile.New(3<<4+16, 5<<4, 8)) {
+		t.Errorf("Unexpected descendants of %v", tile)
+	}
+	if root := maptile.New(0, 0, 0); root.Parent() != root {
+		t.Errorf("Expected the root tile to be its own parent")
+	}
+}
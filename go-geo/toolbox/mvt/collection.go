@@ -0,0 +1,82 @@
+package mvt
+
+import (
+	"github.com/twpayne/go-geom"
+)
+
+// CollectionPolicy is the way Marshal encodes the features of geometry collections,
+// which MVT has no geometry type for.
+type CollectionPolicy int
+
+const (
+	// SplitCollections encodes a geometry collection as several features sharing its ID
+	// and properties: one of its points, one of its lines and one of its polygons, in this order.
+	SplitCollections CollectionPolicy = iota
+
+	// DropCollections leaves out the features of geometry collections.
+	DropCollections
+
+	// RejectCollections fails the encoding of layers with geometry collections.
+	RejectCollections
+)
+
+// splitCollection splits a geometry collection, nested collections included, into geometries
+// of homogeneous MVT types: the points, then the lines and then the polygons, merged into
+// multi geometries. Elements without coordinates are left out.
+func splitCollection(c *geom.GeometryCollection) []geom.T {
+	var points, lines, polygons []geom.T
+	var walk func(g geom.T)
+	walk = func(g geom.T) {
+		if empty(g) {
+			return
+		}
+		switch g := g.(type) {
+		case *geom.GeometryCollection:
+			for _, e := range g.Geoms() {
+				walk(e)
+			}
+		case *geom.Point, *geom.MultiPoint:
+			points = mergeInto(points, g)
+		case *geom.LineString, *geom.MultiLineString:
+			lines = mergeInto(lines, g)
+		case *geom.LinearRing:
+			polygons = mergeInto(polygons, geom.NewPolygonFlat(g.Layout(), g.FlatCoords(), []int{len(g.FlatCoords())}))
+		case *geom.Polygon, *geom.MultiPolygon:
+			polygons = mergeInto(polygons, g)
+		}
+	}
+	walk(c)
+
+	result := make([]geom.T, 0, len(points)+len(lines)+len(polygons))
+	result = append(result, points...)
+	result = append(result, lines...)
+	return append(result, polygons...)
+}
+
+// mergeInto merges a geometry into the last geometry of a group, of the same MVT type.
+// Geometries of another layout start a new geometry of the group.
+func mergeInto(group []geom.T, g geom.T) []geom.T {
+	if n := len(group); n > 0 {
+		if merged, ok := merge(group[n-1], g); ok {
+			group[n-1] = merged
+			return group
+		}
+	}
+	return append(group, g)
+}
+
+// empty tells if a geometry has no coordinates.
+func empty(g geom.T) bool {
+	switch g := g.(type) {
+	case nil:
+		return true
+	case *geom.GeometryCollection:
+		for _, e := range g.Geoms() {
+			if !empty(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return len(g.FlatCoords()) == 0
+}
@@ -0,0 +1,80 @@
+package mvt
+
+import (
+	"testing"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestMarshalCollections(t *testing.T) {
+	layers := func() Layers {
+		site := geojson.NewFeature(geom.NewGeometryCollection().MustPush(
+			geom.NewPolygonFlat(geom.XY, []float64{0, 0, 100, 0, 100, 100, 0, 0}, []int{8}),
+			geom.NewPointFlat(geom.XY, []float64{10, 10}),
+			geom.NewGeometryCollection().MustPush(
+				geom.NewPointFlat(geom.XY, []float64{20, 20}),
+				geom.NewLineStringFlat(geom.XY, []float64{0, 0, 50, 50}),
+				geom.NewGeometryCollection(),
+			),
+		))
+		site.ID = uint64(7)
+		site.Properties = geojson.Properties{"kind": "site"}
+		empty := geojson.NewFeature(geom.NewGeometryCollection())
+		empty.Properties = geojson.Properties{"kind": "empty"}
+		return Layers{NewLayer("sites", geojson.NewFeatureCollection().
+			Append(site).
+			Append(empty).
+			Append(pointFeature(30, 30, geojson.Properties{"kind": "point"})))}
+	}
+
+	data, err := Marshal(layers())
+	require.NoError(t, err)
+	decoded, err := Unmarshal(data)
+	require.NoError(t, err)
+	features := decoded[0].Features
+	require.Len(t, features, 4)
+	assert.Equal(t, []float64{10, 10, 20, 20}, features[0].Geometry.FlatCoords())
+	assert.Equal(t, []float64{0, 0, 50, 50}, features[1].Geometry.FlatCoords())
+	_, ok := features[2].Geometry.(*geom.Polygon)
+	assert.True(t, ok)
+	for _, f := range features[:3] {
+		assert.Equal(t, 7.0, f.ID)
+		assert.Equal(t, "site", f.Properties["kind"])
+	}
+	assert.Equal(t, "point", features[3].Properties["kind"])
+
+	data, err = Marshal(layers(), WithCollectionPolicy(DropCollections))
+	require.NoError(t, err)
+	decoded, err = Unmarshal(data)
+	require.NoError(t, err)
+	require.Len(t, decoded[0].Features, 1)
+	assert.Equal(t, "point", decoded[0].Features[0].Properties["kind"])
+	violations, err := Validate(data)
+	require.NoError(t, err)
+	assert.Empty(t, violations, "no key or value of dropped features is encoded")
+
+	_, err = Marshal(layers(), WithCollectionPolicy(RejectCollections))
+	assert.Error(t, err)
+}
+
+func TestClipCollections(t *testing.T) {
+	l := NewLayer("sites", geojson.NewFeatureCollection().
+		Append(geojson.NewFeature(geom.NewGeometryCollection().MustPush(
+			geom.NewPointFlat(geom.XY, []float64{10, 10}),
+			geom.NewLineStringFlat(geom.XY, []float64{0, 0, 5000, 0}),
+			geom.NewLineStringFlat(geom.XY, []float64{0, 10, 10, 10}),
+		))).
+		Append(geojson.NewFeature(geom.NewGeometryCollection().MustPush(
+			geom.NewLineStringFlat(geom.XY, []float64{5000, 0, 6000, 0}),
+		))))
+	l.Clip(bound.NewBound(0, 0, 4096, 4096))
+	require.Len(t, l.Features, 1)
+	c, ok := l.Features[0].Geometry.(*geom.GeometryCollection)
+	require.True(t, ok)
+	assert.Equal(t, 3, c.NumGeoms())
+	assert.Equal(t, []float64{0, 0, 4096, 0}, c.Geom(1).FlatCoords())
+}
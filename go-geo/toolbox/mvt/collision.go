@@ -0,0 +1,175 @@
+package mvt
+
+import (
+	"math"
+	"sort"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/twpayne/go-geom"
+)
+
+// TileSize is the size of tiles on screen, in pixels, which collision spacings refer to.
+const TileSize = 256
+
+// CollisionTest is the way features are tested for collisions.
+type CollisionTest int
+
+const (
+	// CollideBounds tests the bounds of features, which suits symbols and labels.
+	CollideBounds CollisionTest = iota
+
+	// CollideGeometries tests the geometries of features, e.g. the polygons of labels.
+	CollideGeometries
+)
+
+// CollisionRule configures the removal of colliding features of a layer at some zoom levels.
+//
+// Features are placed by priority: a feature colliding with a feature already placed is removed.
+type CollisionRule struct {
+	// MinZoom and MaxZoom bound the zoom levels of the rule. A zero MaxZoom means no maximum.
+	MinZoom, MaxZoom maptile.Zoom
+
+	// Priority is the numeric property ordering features, the highest values first.
+	// Features without this property come last, as well as all the features when Priority
+	// is empty. Features of equal priorities are placed in the order of the layer.
+	Priority string
+
+	// Ascending places the lowest values of the priority property first, such as ranks.
+	Ascending bool
+
+	// MinSpacing is the minimum distance between features, in pixels of tiles of TileSize.
+	// Features closer than this distance collide.
+	MinSpacing float64
+
+	// Test is the way features are tested for collisions. The default is CollideBounds.
+	Test CollisionTest
+}
+
+// InZoomRange tells if the rule applies at some zoom level.
+func (r CollisionRule) InZoomRange(z maptile.Zoom) bool {
+	return z >= r.MinZoom && (r.MaxZoom == 0 || z <= r.MaxZoom)
+}
+
+// CollisionRules are the rules of a layer at different zoom levels.
+type CollisionRules []CollisionRule
+
+// At yields the first rule applying at some zoom level.
+func (rs CollisionRules) At(z maptile.Zoom) (CollisionRule, bool) {
+	for _, r := range rs {
+		if r.InZoomRange(z) {
+			return r, true
+		}
+	}
+	return CollisionRule{}, false
+}
+
+// RemoveCollisions removes the colliding features of the layers, with the rules of the layers
+// by name at the zoom level of the tile. Layers without rules are left untouched.
+func (ls Layers) RemoveCollisions(z maptile.Zoom, rules map[string]CollisionRules) {
+	for _, l := range ls {
+		if rule, ok := rules[l.Name].At(z); ok {
+			l.RemoveCollisions(rule)
+		}
+	}
+}
+
+// RemoveCollisions removes the features colliding with features of higher priority.
+// Geometries are expected in tile coordinates, e.g. after ProjectToTile.
+//
+// The remaining features keep their order. Features without coordinates are removed.
+func (l *Layer) RemoveCollisions(rule CollisionRule) {
+	extent := float64(l.Extent)
+	if extent == 0 {
+		extent = DefaultExtent
+	}
+	spacing := rule.MinSpacing * extent / TileSize
+
+	order := make([]int, len(l.Features))
+	priorities := make([]float64, len(l.Features))
+	for i, f := range l.Features {
+		order[i] = i
+		priorities[i] = priority(f, rule)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return priorities[order[i]] > priorities[order[j]]
+	})
+
+	index := newGridIndex(extent)
+	placed := make([]bool, len(l.Features))
+	for _, i := range order {
+		g := l.Features[i].Geometry
+		if g == nil || g.Bounds().IsEmpty() {
+			continue
+		}
+		b := g.Bounds()
+		search := geom.NewBounds(geom.XY).Set(
+			b.Min(0)-spacing, b.Min(1)-spacing,
+			b.Max(0)+spacing, b.Max(1)+spacing,
+		)
+		collides := false
+		index.search(search, func(j int) bool {
+			collides = rule.Test != CollideGeometries ||
+				geometryDistance(g, l.Features[j].Geometry) <= spacing
+			return !collides
+		})
+		if !collides {
+			index.insert(i, b)
+			placed[i] = true
+		}
+	}
+
+	kept := l.Features[:0]
+	for i, f := range l.Features {
+		if placed[i] {
+			kept = append(kept, f)
+		}
+	}
+	l.Features = kept
+}
+
+// priority yields the priority of a feature, the greatest first.
+func priority(f *geojson.Feature, rule CollisionRule) float64 {
+	if rule.Priority == "" {
+		return math.Inf(-1)
+	}
+	v, ok := number(f.Properties[rule.Priority])
+	if !ok || math.IsNaN(v) {
+		return math.Inf(-1)
+	}
+	if rule.Ascending {
+		return -v
+	}
+	return v
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,121 @@
+package mvt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/twpayne/go-geom"
+)
+
+func pointFeature(x, y float64, properties geojson.Properties) *geojson.Feature {
+	f := geojson.NewFeature(geom.NewPointFlat(geom.XY, []float64{x, y}))
+	f.Properties = properties
+	return f
+}
+
+func polygonFeature(flat ...float64) *geojson.Feature {
+	return geojson.NewFeature(geom.NewPolygonFlat(geom.XY, flat, []int{len(flat)}))
+}
+
+func TestRemoveCollisions(t *testing.T) {
+	points := func() *Layer {
+		return NewLayer("poi", geojson.NewFeatureCollection().
+			Append(pointFeature(100, 100, geojson.Properties{"rank": 1})).
+			Append(pointFeature(110, 100, geojson.Properties{"rank": 5.0})).
+			Append(pointFeature(400, 400, nil)).
+			Append(pointFeature(105, 300, geojson.Properties{"rank": int64(3)})))
+	}
+
+	// 2 pixels are 32 units of the extent
+	l := points()
+	l.RemoveCollisions(CollisionRule{Priority: "rank", MinSpacing: 2})
+	assert.Len(t, l.Features, 3)
+	assert.Equal(t, 5.0, l.Features[0].Properties["rank"], "the feature of highest priority is kept")
+
+	l = points()
+	l.RemoveCollisions(CollisionRule{Priority: "rank", Ascending: true, MinSpacing: 2})
+	assert.Len(t, l.Features, 3)
+	assert.Equal(t, 1, l.Features[0].Properties["rank"])
+
+	l = points()
+	l.RemoveCollisions(CollisionRule{MinSpacing: 2})
+	assert.Len(t, l.Features, 3)
+	assert.Equal(t, 1, l.Features[0].Properties["rank"], "the order of the layer breaks ties")
+
+	l = points()
+	l.RemoveCollisions(CollisionRule{Priority: "rank"})
+	assert.Len(t, l.Features, 4, "points do not overlap")
+
+	l = points()
+	l.RemoveCollisions(CollisionRule{Priority: "rank", MinSpacing: 15})
+	assert.Len(t, l.Features, 2)
+}
+
+func TestRemoveCollisionsGeometries(t *testing.T) {
+	// triangles along parallel hypotenuses, 70.7 units away: their bounds overlap
+	triangles := func() *Layer {
+		return NewLayer("labels", geojson.NewFeatureCollection().
+			Append(polygonFeature(0, 0, 1000, 0, 0, 1000, 0, 0)).
+			Append(polygonFeature(1000, 1000, 1000, 100, 100, 1000, 1000, 1000)).
+			Append(polygonFeature(200, 200, 300, 200, 300, 300, 200, 300, 200, 200)))
+	}
+
+	l := triangles()
+	l.RemoveCollisions(CollisionRule{})
+	assert.Len(t, l.Features, 1)
+
+	l = triangles()
+	l.RemoveCollisions(CollisionRule{Test: CollideGeometries})
+	assert.Len(t, l.Features, 2, "the square inside the first triangle collides")
+
+	l = triangles()
+	l.RemoveCollisions(CollisionRule{Test: CollideGeometries, MinSpacing: 5})
+	assert.Len(t, l.Features, 1)
+}
+
+func TestLayersRemoveCollisions(t *testing.T) {
+	rules := map[string]CollisionRules{
+		"poi": {
+			{MinZoom: 10, MaxZoom: 14, MinSpacing: 100},
+			{MinZoom: 15, MinSpacing: 1},
+		},
+	}
+	layers := func() Layers {
+		return Layers{
+			NewLayer("poi", geojson.NewFeatureCollection().
+				Append(pointFeature(100, 100, nil)).
+				Append(pointFeature(200, 100, nil)).
+				Append(pointFeature(2000, 100, nil))),
+			NewLayer("roads", geojson.NewFeatureCollection().
+				Append(pointFeature(100, 100, nil)).
+				Append(pointFeature(100, 100, nil))),
+		}
+	}
+
+	for z, expected := range map[maptile.Zoom][2]int{5: {3, 2}, 12: {2, 2}, 16: {3, 2}} {
+		ls := layers()
+		ls.RemoveCollisions(z, rules)
+		assert.Equal(t, expected, [2]int{len(ls[0].Features), len(ls[1].Features)}, z)
+	}
+}
+
+func TestGeometryDistance(t *testing.T) {
+	point := geom.NewPointFlat(geom.XY, []float64{0, 3})
+	line := geom.NewLineStringFlat(geom.XY, []float64{-5, 0, 5, 0})
+	square := geom.NewPolygonFlat(geom.XY, []float64{-1, -1, 1, -1, 1, 1, -1, 1, -1, -1}, []int{10})
+	holed := geom.NewPolygonFlat(geom.XY,
+		[]float64{-10, -10, 10, -10, 10, 10, -10, 10, -10, -10, -5, -5, 5, -5, 5, 5, -5, 5, -5, -5},
+		[]int{10, 20},
+	)
+
+	assert.Equal(t, 3.0, geometryDistance(point, line))
+	assert.Equal(t, 0.0, geometryDistance(line, square))
+	assert.Equal(t, 2.0, geometryDistance(point, square))
+	assert.Equal(t, 4.0, geometryDistance(square, holed), "the square is in the hole")
+	assert.Equal(t, 0.0, geometryDistance(holed, geom.NewPointFlat(geom.XY, []float64{7, 7})))
+	assert.Equal(t, 0.0, geometryDistance(line, geom.NewLineStringFlat(geom.XY, []float64{5, 0, 6, 1})))
+	assert.InDelta(t, math.Sqrt2, geometryDistance(square, geom.NewPointFlat(geom.XY, []float64{2, 2})), 1e-9)
+}
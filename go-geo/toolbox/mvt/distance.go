@@ -0,0 +1,173 @@
+package mvt
+
+import (
+	"math"
+
+	"github.com/twpayne/go-geom"
+)
+
+// segment is a segment of a geometry, or a point when both ends are equal.
+type segment [2][2]float64
+
+// shape is a geometry decomposed for distance computations.
+type shape struct {
+	segments []segment
+	// vertices are the first vertex of every component, to test containment.
+	vertices [][2]float64
+	polygons []*geom.Polygon
+}
+
+// geometryDistance yields the distance between two geometries, zero when they intersect.
+func geometryDistance(a, b geom.T) float64 {
+	sa, sb := newShape(a), newShape(b)
+	if sa.contains(sb) || sb.contains(sa) {
+		return 0
+	}
+	d := math.Inf(1)
+	for _, s := range sa.segments {
+		for _, t := range sb.segments {
+			if d = math.Min(d, segmentDistance(s, t)); d == 0 {
+				return 0
+			}
+		}
+	}
+	return d
+}
+
+func newShape(g geom.T) *shape {
+	s := &shape{}
+	s.add(g)
+	return s
+}
+
+func (s *shape) add(g geom.T) {
+	switch g := g.(type) {
+	case *geom.Point:
+		s.addPath(g.FlatCoords(), g.Stride(), false)
+	case *geom.MultiPoint:
+		for i := 0; i < g.NumPoints(); i++ {
+			s.add(g.Point(i))
+		}
+	case *geom.LineString:
+		s.addPath(g.FlatCoords(), g.Stride(), false)
+	case *geom.MultiLineString:
+		for i := 0; i < g.NumLineStrings(); i++ {
+			s.add(g.LineString(i))
+		}
+	case *geom.Polygon:
+		s.polygons = append(s.polygons, g)
+		for i := 0; i < g.NumLinearRings(); i++ {
+			r := g.LinearRing(i)
+			s.addPath(r.FlatCoords(), r.Stride(), true)
+		}
+	case *geom.MultiPolygon:
+		for i := 0; i < g.NumPolygons(); i++ {
+			s.add(g.Polygon(i))
+		}
+	case *geom.GeometryCollection:
+		for i := 0; i < g.NumGeoms(); i++ {
+			s.add(g.Geom(i))
+		}
+	}
+}
+
+// addPath adds the segments of a path, closed for rings.
+func (s *shape) addPath(flat []float64, stride int, ring bool) {
+	n := len(flat) / stride
+	if n == 0 {
+		return
+	}
+	point := func(i int) [2]float64 {
+		return [2]float64{flat[i*stride], flat[i*stride+1]}
+	}
+	s.vertices = append(s.vertices, point(0))
+	if n == 1 {
+		s.segments = append(s.segments, segment{point(0), point(0)})
+		return
+	}
+	for i := 1; i < n; i++ {
+		s.segments = append(s.segments, segment{point(i - 1), point(i)})
+	}
+	if ring && point(0) != point(n-1) {
+		s.segments = append(s.segments, segment{point(n - 1), point(0)})
+	}
+}
+
+// contains tells if the polygons of the shape contain a component of another shape.
+// Components which cross the polygons are found by segment intersections.
+func (s *shape) contains(other *shape) bool {
+	for _, p := range s.polygons {
+		for _, v := range other.vertices {
+			if polygonContains(p, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// polygonContains tells if a point is inside a polygon and out of its holes.
+func polygonContains(p *geom.Polygon, v [2]float64) bool {
+	if p.NumLinearRings() == 0 || !ringContains(p.LinearRing(0), v) {
+		return false
+	}
+	for i := 1; i < p.NumLinearRings(); i++ {
+		if ringContains(p.LinearRing(i), v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringContains tells if a point is inside a ring, by the even-odd rule.
+func ringContains(r *geom.LinearRing, v [2]float64) bool {
+	flat, stride := r.FlatCoords(), r.Stride()
+	n := len(flat) / stride
+	inside := false
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		xi, yi := flat[i*stride], flat[i*stride+1]
+		xj, yj := flat[j*stride], flat[j*stride+1]
+		if (yi > v[1]) != (yj > v[1]) && v[0] < (xj-xi)*(v[1]-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// segmentDistance yields the distance between two segments, zero when they intersect.
+func segmentDistance(s, t segment) float64 {
+	if segmentsIntersect(s, t) {
+		return 0
+	}
+	return math.Min(
+		math.Min(pointSegmentDistance(s[0], t), pointSegmentDistance(s[1], t)),
+		math.Min(pointSegmentDistance(t[0], s), pointSegmentDistance(t[1], s)),
+	)
+}
+
+func segmentsIntersect(s, t segment) bool {
+	d1, d2 := cross(t[0], t[1], s[0]), cross(t[0], t[1], s[1])
+	d3, d4 := cross(s[0], s[1], t[0]), cross(s[0], s[1], t[1])
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	// improper intersections, such as touching or collinear segments, have an end at a zero
+	// distance from the other segment
+	return false
+}
+
+// cross yields the cross product of (b - a) and (c - a).
+func cross(a, b, c [2]float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func pointSegmentDistance(p [2]float64, s segment) float64 {
+	dx, dy := s[1][0]-s[0][0], s[1][1]-s[0][1]
+	l := dx*dx + dy*dy
+	if l == 0 {
+		return math.Hypot(p[0]-s[0][0], p[1]-s[0][1])
+	}
+	u := ((p[0]-s[0][0])*dx + (p[1]-s[0][1])*dy) / l
+	u = math.Max(0, math.Min(1, u))
+	return math.Hypot(p[0]-(s[0][0]+u*dx), p[1]-(s[0][1]+u*dy))
+}
@@ -0,0 +1,441 @@
+package mvt
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/twpayne/go-geom"
+)
+
+// GeneralizationRule configures the generalization of the features of a layer at some zoom levels:
+// the properties they keep and the merge of features of equal properties.
+//
+// Properties are kept, dropped and rounded by their names in the layer, then renamed.
+type GeneralizationRule struct {
+	// MinZoom and MaxZoom bound the zoom levels of the rule. A zero MaxZoom means no maximum.
+	MinZoom, MaxZoom maptile.Zoom
+
+	// Keep are the properties to keep, all of them when empty.
+	Keep []string
+
+	// Drop are the properties to drop.
+	Drop []string
+
+	// Round are the numbers of decimals of numeric properties. Properties rounded to
+	// no decimal, or to tens, hundreds, etc. with negative numbers, become integers.
+	Round map[string]int
+
+	// Rename are the new names of properties.
+	Rename map[string]string
+
+	// Merge merges the features of equal properties and of the same geometry type, once their
+	// properties are generalized: lines are joined where exactly two of them meet and polygons
+	// are dissolved along the edges they share. Other features are left as they are.
+	//
+	// Merged features have no ID, and take the place of the first of them in the layer.
+	Merge bool
+}
+
+// InZoomRange tells if the rule applies at some zoom level.
+func (r GeneralizationRule) InZoomRange(z maptile.Zoom) bool {
+	return z >= r.MinZoom && (r.MaxZoom == 0 || z <= r.MaxZoom)
+}
+
+// GeneralizationRules are the rules of a layer at different zoom levels.
+type GeneralizationRules []GeneralizationRule
+
+// At yields the first rule applying at some zoom level.
+func (rs GeneralizationRules) At(z maptile.Zoom) (GeneralizationRule, bool) {
+	for _, r := range rs {
+		if r.InZoomRange(z) {
+			return r, true
+		}
+	}
+	return GeneralizationRule{}, false
+}
+
+// Generalize generalizes the features of the layers, with the rules of the layers by name
+// at the zoom level of the tile. Layers without rules are left untouched.
+func (ls Layers) Generalize(z maptile.Zoom, rules map[string]GeneralizationRules) {
+	for _, l := range ls {
+		if rule, ok := rules[l.Name].At(z); ok {
+			l.Generalize(rule)
+		}
+	}
+}
+
+// Generalize generalizes the properties of the features, then merges them as the rule requires.
+// Polygons are dissolved when they share their common edges vertex by vertex, as the polygons of
+// a coverage do, and are expected not to overlap.
+//
+// Features get new properties, their former properties being left untouched.
+func (l *Layer) Generalize(rule GeneralizationRule) {
+	for _, f := range l.Features {
+		f.Properties = rule.properties(f.Properties)
+	}
+	if rule.Merge {
+		l.mergeFeatures()
+	}
+}
+
+// properties yields the generalized properties of a feature.
+func (r GeneralizationRule) properties(p geojson.Properties) geojson.Properties {
+	result := make(geojson.Properties, len(p))
+	if len(r.Keep) == 0 {
+		for k, v := range p {
+			result[k] = v
+		}
+	}
+	for _, k := range r.Keep {
+		if v, ok := p[k]; ok {
+			result[k] = v
+		}
+	}
+	for _, k := range r.Drop {
+		delete(result, k)
+	}
+	for k, decimals := range r.Round {
+		if v, ok := result[k]; ok {
+			result[k] = round(v, decimals)
+		}
+	}
+
+	// properties may swap their names: remove all the renamed properties before adding them back
+	renamed := make(map[string]interface{}, len(r.Rename))
+	for from, to := range r.Rename {
+		if v, ok := result[from]; ok {
+			renamed[to] = v
+			delete(result, from)
+		}
+	}
+	for k, v := range renamed {
+		result[k] = v
+	}
+	return result
+}
+
+// round rounds a numeric value to some number of decimals. Values rounded to no decimals are int64,
+// other values left unchanged keep their type.
+func round(v interface{}, decimals int) interface{} {
+	f, ok := number(v)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return v
+	}
+	p := math.Pow10(decimals)
+	r := math.Round(f*p) / p
+	switch {
+	case decimals <= 0:
+		return int64(r)
+	case r == f:
+		return v
+	}
+	if _, ok := v.(float32); ok {
+		return float32(r)
+	}
+	return r
+}
+
+// Kinds of merged geometries.
+const (
+	mergeLines = iota + 1
+	mergePolygons
+)
+
+// mergeFeatures merges the lines and the polygons of features of equal properties.
+func (l *Layer) mergeFeatures() {
+	type group struct {
+		index    int
+		kind     int
+		features []*geojson.Feature
+	}
+	groups := make(map[string]*group)
+	var ordered []*group
+	result := make([]*geojson.Feature, 0, len(l.Features))
+	for _, f := range l.Features {
+		var kind int
+		switch f.Geometry.(type) {
+		case *geom.LineString, *geom.MultiLineString:
+			kind = mergeLines
+		case *geom.Polygon, *geom.MultiPolygon:
+			kind = mergePolygons
+		default:
+			result = append(result, f)
+			continue
+		}
+		key := fmt.Sprintf("%d %d %s", kind, f.Geometry.Layout(), propertiesKey(f.Properties))
+		g, ok := groups[key]
+		if !ok {
+			g = &group{index: len(result), kind: kind}
+			groups[key] = g
+			ordered = append(ordered, g)
+			result = append(result, f)
+		}
+		g.features = append(g.features, f)
+	}
+
+	for _, g := range ordered {
+		if len(g.features) < 2 {
+			continue
+		}
+		var merged geom.T
+		switch g.kind {
+		case mergeLines:
+			merged = joinLines(g.features)
+		case mergePolygons:
+			merged = dissolvePolygons(g.features)
+		}
+		if merged == nil {
+			// nothing to merge, e.g. degenerate lines: the features are left as they are
+			result = append(result, g.features[1:]...)
+			continue
+		}
+		result[g.index] = &geojson.Feature{
+			Geometry:   merged,
+			Properties: g.features[0].Properties,
+		}
+	}
+	l.Features = result
+}
+
+// propertiesKey yields a key of equal properties, values of different types being different.
+func propertiesKey(p geojson.Properties) string {
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%q=%T:%v;", k, p[k], p[k])
+	}
+	return b.String()
+}
+
+// pointOf yields the position of a coordinate, whatever its layout.
+func pointOf(c geom.Coord) [2]float64 {
+	return [2]float64{c[0], c[1]}
+}
+
+// joinLines joins the lines of features where exactly two lines end, like the line merge of
+// PostGIS. It yields a line string, a multi line string, or nil without lines.
+func joinLines(features []*geojson.Feature) geom.T {
+	var lines [][]geom.Coord
+	for _, f := range features {
+		switch g := f.Geometry.(type) {
+		case *geom.LineString:
+			lines = append(lines, g.Coords())
+		case *geom.MultiLineString:
+			lines = append(lines, g.Coords()...)
+		}
+	}
+	ends := make(map[[2]float64][]int, 2*len(lines))
+	kept := lines[:0]
+	for _, line := range lines {
+		if len(line) < 2 {
+			continue
+		}
+		i := len(kept)
+		kept = append(kept, line)
+		ends[pointOf(line[0])] = append(ends[pointOf(line[0])], i)
+		ends[pointOf(line[len(line)-1])] = append(ends[pointOf(line[len(line)-1])], i)
+	}
+	lines = kept
+	if len(lines) == 0 {
+		return nil
+	}
+
+	used := make([]bool, len(lines))
+	// extend appends to a line the lines following it, as long as its end meets exactly one other line
+	extend := func(line []geom.Coord) []geom.Coord {
+		for {
+			end := pointOf(line[len(line)-1])
+			next := ends[end]
+			if len(next) != 2 {
+				return line
+			}
+			j := next[0]
+			if used[j] {
+				j = next[1]
+			}
+			if used[j] {
+				return line
+			}
+			used[j] = true
+			other := lines[j]
+			if pointOf(other[0]) == end {
+				line = append(line, other[1:]...)
+			} else {
+				for k := len(other) - 2; k >= 0; k-- {
+					line = append(line, other[k])
+				}
+			}
+		}
+	}
+
+	layout := features[0].Geometry.Layout()
+	mls := geom.NewMultiLineString(layout)
+	for i := range lines {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		line := extend(append([]geom.Coord(nil), lines[i]...))
+		reverseCoords(line)
+		line = extend(line)
+		reverseCoords(line)
+		if ls, err := geom.NewLineString(layout).SetCoords(line); err == nil {
+			_ = mls.Push(ls)
+		}
+	}
+	if mls.NumLineStrings() == 1 {
+		return mls.LineString(0)
+	}
+	return mls
+}
+
+// edge is a directed edge of a polygon ring.
+type edge struct {
+	from, to geom.Coord
+}
+
+// dissolvePolygons dissolves the polygons of features into the polygons of their union, by removing
+// the edges they share in opposite directions. It yields a polygon, a multi polygon, or nil without
+// polygons of some area.
+func dissolvePolygons(features []*geojson.Feature) geom.T {
+	var polygons [][][]geom.Coord
+	for _, f := range features {
+		switch g := f.Geometry.(type) {
+		case *geom.Polygon:
+			polygons = append(polygons, g.Coords())
+		case *geom.MultiPolygon:
+			polygons = append(polygons, g.Coords()...)
+		}
+	}
+
+	// with exterior rings counterclockwise and interior rings clockwise, the edges shared by
+	// adjacent polygons run in opposite directions
+	var edges []edge
+	removed := make(map[int]bool)
+	index := make(map[[2][2]float64][]int)
+	for _, p := range polygons {
+		windRings(p)
+		for _, r := range p {
+			for i := range r {
+				from, to := r[i], r[(i+1)%len(r)]
+				if pointOf(from) == pointOf(to) {
+					continue
+				}
+				reverse := [2][2]float64{pointOf(to), pointOf(from)}
+				if shared := index[reverse]; len(shared) > 0 {
+					removed[shared[len(shared)-1]] = true
+					index[reverse] = shared[:len(shared)-1]
+					continue
+				}
+				key := [2][2]float64{pointOf(from), pointOf(to)}
+				index[key] = append(index[key], len(edges))
+				edges = append(edges, edge{from, to})
+			}
+		}
+	}
+
+	outgoing := make(map[[2]float64][]int)
+	for i, e := range edges {
+		if !removed[i] {
+			outgoing[pointOf(e.from)] = append(outgoing[pointOf(e.from)], i)
+		}
+	}
+
+	// link the remaining edges into rings
+	var exteriors, interiors [][]geom.Coord
+	for i, e := range edges {
+		if removed[i] {
+			continue
+		}
+		start := pointOf(e.from)
+		ring := []geom.Coord{e.from}
+		for j := i; j >= 0; {
+			removed[j] = true
+			ring = append(ring, edges[j].to)
+			end := pointOf(edges[j].to)
+			if end == start {
+				break
+			}
+			j = -1
+			for _, k := range outgoing[end] {
+				if !removed[k] {
+					j = k
+					break
+				}
+			}
+		}
+		if pointOf(ring[len(ring)-1]) != start {
+			ring = append(ring, ring[0])
+		}
+		if len(ring) < 4 {
+			continue
+		}
+		switch orientation(ring) {
+		case CCW:
+			exteriors = append(exteriors, ring)
+		case CW:
+			interiors = append(interiors, ring)
+		}
+	}
+	if len(exteriors) == 0 {
+		return nil
+	}
+
+	layout := features[0].Geometry.Layout()
+	shells := make([]*geom.LinearRing, len(exteriors))
+	areas := make([]float64, len(exteriors))
+	rings := make([][][]geom.Coord, len(exteriors))
+	for i, r := range exteriors {
+		shells[i], _ = geom.NewLinearRing(layout).SetCoords(r)
+		areas[i] = shells[i].Area()
+		rings[i] = [][]geom.Coord{r}
+	}
+	// holes go to the smallest exterior ring around them
+	for _, hole := range interiors {
+		owner := -1
+		for i, shell := range shells {
+			if (owner < 0 || areas[i] < areas[owner]) && ringAround(shell, hole) {
+				owner = i
+			}
+		}
+		if owner >= 0 {
+			rings[owner] = append(rings[owner], hole)
+		}
+	}
+
+	mp := geom.NewMultiPolygon(layout)
+	for _, r := range rings {
+		if p, err := geom.NewPolygon(layout).SetCoords(r); err == nil {
+			_ = mp.Push(p)
+		}
+	}
+	if mp.NumPolygons() == 1 {
+		return mp.Polygon(0)
+	}
+	return mp
+}
+
+// ringAround tells if a ring is around some vertex of another ring.
+func ringAround(r *geom.LinearRing, other []geom.Coord) bool {
+	for _, c := range other {
+		if ringContains(r, pointOf(c)) {
+			return true
+		}
+	}
+	return false
+}
+
+func reverseCoords(coords []geom.Coord) {
+	for i, j := 0, len(coords)-1; i < j; i, j = i+1, j-1 {
+		coords[i], coords[j] = coords[j], coords[i]
+	}
+}
@@ -0,0 +1,144 @@
+package mvt
+
+import (
+	"testing"
+
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestGeneralizeProperties(t *testing.T) {
+	properties := geojson.Properties{
+		"name": "somewhere", "class": "city", "rank": 2, "population": 123456,
+		"area": 12.345, "height": float32(2.5), "code": "x",
+	}
+	f := geojson.NewFeature(geom.NewPointFlat(geom.XY, []float64{1, 1}))
+	f.Properties = properties
+	l := NewLayer("places", geojson.NewFeatureCollection().Append(f))
+
+	rules := map[string]GeneralizationRules{
+		"places": {
+			{MinZoom: 10, Drop: []string{"code"}},
+			{
+				MaxZoom: 9,
+				Keep:    []string{"class", "rank", "population", "area", "height", "code"},
+				Drop:    []string{"code"},
+				Round:   map[string]int{"population": -3, "area": 1, "height": 0, "rank": 0, "class": 1},
+				Rename:  map[string]string{"class": "rank", "rank": "class"},
+			},
+		},
+	}
+	Layers{l}.Generalize(12, rules)
+	assert.Len(t, l.Features[0].Properties, 6)
+	assert.NotContains(t, l.Features[0].Properties, "code")
+
+	l.Features[0].Properties = properties
+	Layers{l}.Generalize(4, rules)
+	assert.Equal(t, geojson.Properties{
+		"rank":       "city",
+		"class":      int64(2),
+		"population": int64(123000),
+		"area":       12.3,
+		"height":     int64(3),
+	}, l.Features[0].Properties)
+	assert.Len(t, properties, 7, "the former properties are left untouched")
+}
+
+func TestGeneralizeMerge(t *testing.T) {
+	line := func(properties geojson.Properties, flat ...float64) *geojson.Feature {
+		f := geojson.NewFeature(geom.NewLineStringFlat(geom.XY, flat))
+		f.ID = uint64(len(flat))
+		f.Properties = properties
+		return f
+	}
+	square := func(x, y float64, clockwise bool) *geojson.Feature {
+		flat := []float64{x, y, x + 10, y, x + 10, y + 10, x, y + 10, x, y}
+		if clockwise {
+			flat = []float64{x, y, x, y + 10, x + 10, y + 10, x + 10, y, x, y}
+		}
+		f := geojson.NewFeature(geom.NewPolygonFlat(geom.XY, flat, []int{len(flat)}))
+		f.Properties = geojson.Properties{"landuse": "forest", "id": x*100 + y}
+		return f
+	}
+	primary := func() geojson.Properties {
+		return geojson.Properties{"class": "primary", "name": "a"}
+	}
+
+	fc := geojson.NewFeatureCollection().
+		Append(pointFeature(1, 1, primary())).
+		Append(line(primary(), 0, 0, 10, 0)).
+		Append(line(primary(), 20, 0, 10, 0)).
+		Append(line(geojson.Properties{"class": "secondary"}, 10, 0, 10, 10)).
+		Append(line(primary(), 20, 0, 30, 0, 30, 5)).
+		Append(line(primary(), 100, 100, 110, 110))
+	// a 3 by 3 grid of squares without its center square, and a distant square
+	for _, xy := range [][2]float64{{0, 0}, {10, 0}, {20, 0}, {0, 10}, {20, 10}, {0, 20}, {10, 20}, {20, 20}, {100, 100}} {
+		fc.Append(square(xy[0], xy[1], xy[0] == 10))
+	}
+	l := NewLayer("landuse", fc)
+	l.Generalize(GeneralizationRule{Drop: []string{"name", "id"}, Merge: true})
+
+	require.Len(t, l.Features, 4)
+	_, ok := l.Features[0].Geometry.(*geom.Point)
+	assert.True(t, ok, "points are left as they are")
+
+	lines, ok := l.Features[1].Geometry.(*geom.MultiLineString)
+	require.True(t, ok)
+	assert.Nil(t, l.Features[1].ID)
+	assert.Equal(t, geojson.Properties{"class": "primary"}, l.Features[1].Properties)
+	require.Equal(t, 2, lines.NumLineStrings())
+	assert.Equal(t, []float64{0, 0, 10, 0, 20, 0, 30, 0, 30, 5}, lines.LineString(0).FlatCoords())
+	assert.Equal(t, []float64{100, 100, 110, 110}, lines.LineString(1).FlatCoords())
+
+	assert.Equal(t, uint64(4), l.Features[2].ID, "the line of other properties is left as it is")
+
+	polygons, ok := l.Features[3].Geometry.(*geom.MultiPolygon)
+	require.True(t, ok)
+	assert.Equal(t, geojson.Properties{"landuse": "forest"}, l.Features[3].Properties)
+	require.Equal(t, 2, polygons.NumPolygons())
+	grid := polygons.Polygon(0)
+	require.Equal(t, 2, grid.NumLinearRings(), "the grid has a hole")
+	assert.Equal(t, 900.0, grid.LinearRing(0).Area())
+	assert.Equal(t, -100.0, grid.LinearRing(1).Area(), "the hole is wound clockwise")
+	assert.Equal(t, []float64{0, 0, 30, 30}, []float64{grid.Bounds().Min(0), grid.Bounds().Min(1), grid.Bounds().Max(0), grid.Bounds().Max(1)})
+	assert.Equal(t, []float64{10, 10, 20, 20}, []float64{
+		grid.LinearRing(1).Bounds().Min(0), grid.LinearRing(1).Bounds().Min(1),
+		grid.LinearRing(1).Bounds().Max(0), grid.LinearRing(1).Bounds().Max(1),
+	})
+	assert.Equal(t, 100.0, polygons.Polygon(1).Area())
+
+	data, err := Marshal(Layers{l}, WithWindingFix(true))
+	require.NoError(t, err)
+	violations, err := Validate(data)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestGeneralizeMergeUnmergeable(t *testing.T) {
+	// degenerate lines of equal properties, which can't be joined
+	fc := geojson.NewFeatureCollection()
+	for i := 0; i < 3; i++ {
+		f := geojson.NewFeature(geom.NewLineStringFlat(geom.XY, []float64{float64(i), 0}))
+		f.ID = uint64(i)
+		f.Properties = geojson.Properties{"class": "primary"}
+		fc.Append(f)
+	}
+	l := NewLayer("roads", fc)
+	l.Generalize(GeneralizationRule{Merge: true})
+
+	require.Len(t, l.Features, 3, "no feature is lost")
+	for i, f := range l.Features {
+		assert.Equal(t, uint64(i), f.ID)
+	}
+}
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, int64(2), round(2, 0))
+	assert.Equal(t, int64(2), round(2.0, 0))
+	assert.Equal(t, int64(100), round(123, -2))
+	assert.Equal(t, 2.5, round(2.5, 1))
+	assert.Equal(t, float32(2.5), round(float32(2.54), 1))
+	assert.Equal(t, "x", round("x", 0))
+}
@@ -566,3 +566,61 @@ func orientation(r []geom.Coord) Orientation {
 	// degenerate case, no area
 	return 0
 }
+
+// fixWinding yields a polygon geometry with its rings reversed as needed, so that exterior
+// rings have a positive area and interior rings a negative area. Rings of no area are left
+// as is. The geometry is copied only when some ring is reversed.
+func fixWinding(g geom.T) geom.T {
+	switch g := g.(type) {
+	case *geom.LinearRing:
+		coords := g.Coords()
+		if windRings([][]geom.Coord{coords}) {
+			if r, err := geom.NewLinearRing(g.Layout()).SetCoords(coords); err == nil {
+				return r
+			}
+		}
+	case *geom.Polygon:
+		coords := g.Coords()
+		if windRings(coords) {
+			if p, err := geom.NewPolygon(g.Layout()).SetCoords(coords); err == nil {
+				return p
+			}
+		}
+	case *geom.MultiPolygon:
+		coords := g.Coords()
+		reversed := false
+		for _, p := range coords {
+			if windRings(p) {
+				reversed = true
+			}
+		}
+		if reversed {
+			if mp, err := geom.NewMultiPolygon(g.Layout()).SetCoords(coords); err == nil {
+				return mp
+			}
+		}
+	}
+	return g
+}
+
+// windRings reverses the exterior ring of a polygon unless CCW, and its interior rings unless CW.
+// It tells if some ring was reversed.
+func windRings(rings [][]geom.Coord) bool {
+	reversed := false
+	for i, r := range rings {
+		if len(r) < 3 {
+			continue
+		}
+		expected := CW
+		if i == 0 {
+			expected = CCW
+		}
+		if o := orientation(r); o != 0 && o != expected {
+			for j, k := 0, len(r)-1; j < k; j, k = j+1, k-1 {
+				r[j], r[k] = r[k], r[j]
+			}
+			reversed = true
+		}
+	}
+	return reversed
+}
@@ -0,0 +1,84 @@
+package mvt
+
+import (
+	"math"
+
+	"github.com/twpayne/go-geom"
+)
+
+// gridCells is the number of cells of grid indexes along each axis of the tile extent.
+const gridCells = 16
+
+// gridIndex is a spatial index of bounds, for tiles: a uniform grid of cells over the extent.
+//
+// Bounds beyond the extent and its buffer fall in the border cells.
+type gridIndex struct {
+	cellSize float64
+	minCell  int
+	maxCell  int
+	cells    map[[2]int][]int
+	items    []gridItem
+	query    int
+}
+
+type gridItem struct {
+	id     int
+	bounds *geom.Bounds
+	query  int
+}
+
+func newGridIndex(extent float64) *gridIndex {
+	return &gridIndex{
+		cellSize: extent / gridCells,
+		minCell:  -gridCells,
+		maxCell:  2*gridCells - 1,
+		cells:    make(map[[2]int][]int),
+	}
+}
+
+// insert indexes the bounds of some item.
+func (g *gridIndex) insert(id int, b *geom.Bounds) {
+	g.items = append(g.items, gridItem{id: id, bounds: b})
+	k := len(g.items) - 1
+	minX, minY, maxX, maxY := g.cellRange(b)
+	for x := minX; x <= maxX; x++ {
+		for y := minY; y <= maxY; y++ {
+			g.cells[[2]int{x, y}] = append(g.cells[[2]int{x, y}], k)
+		}
+	}
+}
+
+// search calls fn with the items whose bounds intersect some bounds, while fn returns true.
+func (g *gridIndex) search(b *geom.Bounds, fn func(id int) bool) {
+	g.query++
+	minX, minY, maxX, maxY := g.cellRange(b)
+	for x := minX; x <= maxX; x++ {
+		for y := minY; y <= maxY; y++ {
+			for _, k := range g.cells[[2]int{x, y}] {
+				item := &g.items[k]
+				if item.query == g.query {
+					continue
+				}
+				item.query = g.query
+				if item.bounds.Overlaps(geom.XY, b) && !fn(item.id) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *gridIndex) cellRange(b *geom.Bounds) (minX, minY, maxX, maxY int) {
+	return g.cell(b.Min(0)), g.cell(b.Min(1)), g.cell(b.Max(0)), g.cell(b.Max(1))
+}
+
+func (g *gridIndex) cell(v float64) int {
+	c := math.Floor(v / g.cellSize)
+	if c < float64(g.minCell) {
+		return g.minCell
+	}
+	if c > float64(g.maxCell) {
+		return g.maxCell
+	}
+	return int(c)
+}
@@ -2,26 +2,17 @@ package mvt
 
 //.*NO TEST
 import (
-	"log"
-	"time"
-
-	"github.com/fredbi/geo/internal/triangle"
 	"github.com/fredbi/geo/pkg/bound"
 	"github.com/fredbi/geo/pkg/clip"
 	"github.com/fredbi/geo/pkg/maptile"
 	"github.com/fredbi/geo/pkg/project"
-
-	"fmt"
-
 	"github.com/fredbi/geo/utils/geojson"
-	"github.com/twpayne/go-geom"
 )
 
 const (
 	// DefaultExtent for mapbox vector tiles. (https://www.mapbox.com/vector-tiles/specification/)
-	DefaultExtent       = 4096
-	MimeType            = "application/vnd.mapbox-vector-tile"
-	TriangleOverlapZoom = 14
+	DefaultExtent = 4096
+	MimeType      = "application/vnd.mapbox-vector-tile"
 )
 
 // Layer is intermediate MVT layer to be encoded/decoded or projected.
@@ -141,7 +132,7 @@ func (l *Layer) Clip(bound bound.Bound) {
 	newFt := l.Features[:0]
 	for i, f := range l.Features {
 		clipped := clip.Geometry(bound, f.Geometry)
-		if clipped != nil && len(clipped.FlatCoords()) > 0 {
+		if !empty(clipped) {
 			l.Features[i].Geometry = clipped
 			newFt = append(newFt, l.Features[i])
 		}
@@ -158,60 +149,3 @@ func (ls Layers) Empty() bool {
 	}
 	return true
 }
-
-func (ls Layers) RemoveOverlapTriangle(mapName string, zoom int) {
-	if zoom < TriangleOverlapZoom {
-		return
-	}
-	for _, l := range ls {
-		l.RemoveOverlapTriangle(mapName)
-	}
-}
-
-func (l *Layer) RemoveOverlapTriangle(mapName string) {
-	if l.Name != mapName {
-		return
-	}
-
-	newFt := l.Features[:0]
-	//fmt.Println("here start RemoveOverlapTriangle, total len", len(l.Features))
-	start := time.Now()
-	for i, f := range l.Features {
-		if i == 0 {
-			newFt = append(newFt, l.Features[i])
-		} else {
-			overlap := false
-			for _, newf := range newFt {
-				t1, err := triangleFromGeom(newf.Geometry)
-				if err != nil {
-					continue
-				}
-				t2, err := triangleFromGeom(f.Geometry)
-				if err != nil {
-					continue
-				}
-				res := triangle.TriTri2D(t1, t2, 0.0, true, false)
-				overlap = res || overlap
-				if overlap {
-					log.Println("found overlap", t1, t2)
-					break
-				}
-			}
-			if !overlap {
-				newFt = append(newFt, l.Features[i])
-			}
-		}
-	}
-	elapsed := time.Since(start)
-	log.Printf("RemoveOverlapTriangle took %s, before %d, after %d", elapsed, len(l.Features), len(newFt))
-	l.Features = newFt
-}
-
-func triangleFromGeom(g geom.T) (*triangle.Triangle, error) {
-	coords := g.FlatCoords()
-	if len(coords) < 6 {
-		return nil, fmt.Errorf("coords length too short %v", coords)
-	}
-	t := triangle.NewTriangle(coords[0], coords[1], coords[2], coords[3], coords[4], coords[5])
-	return t, nil
-}
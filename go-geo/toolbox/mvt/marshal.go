@@ -14,6 +14,7 @@ import (
 	vectortile "github.com/fredbi/geo/pkg/mvt/vtile"
 	"github.com/fredbi/geo/utils/geojson"
 	"github.com/pkg/errors"
+	"github.com/twpayne/go-geom"
 	"github.com/valyala/bytebufferpool"
 )
 
@@ -31,8 +32,8 @@ func init() {
 // MarshalGzipped will marshal the layers into Mapbox Vector Tile format
 // and gzip the result. A lot of times MVT data is gzipped at rest,
 // e.g. in a mbtiles file.
-func MarshalGzipped(layers Layers) ([]byte, error) {
-	data, err := Marshal(layers)
+func MarshalGzipped(layers Layers, opts ...MarshalOption) ([]byte, error) {
+	data, err := Marshal(layers, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +62,8 @@ func MarshalGzipped(layers Layers) ([]byte, error) {
 	if err := gzw.Close(); err != nil {
 		return nil, fmt.Errorf("closing gzip of tile: %v", err)
 	}
-	return buf.Bytes(), nil
+	// the buffer goes back to the pool: copy its content
+	return append([]byte(nil), buf.Bytes()...), nil
 
 	// buf := bytes.NewBuffer(nil)
 	// gzwriter := gzip.NewWriter(buf)
@@ -80,7 +82,14 @@ func MarshalGzipped(layers Layers) ([]byte, error) {
 }
 
 // Marshal will take a set of layers and encode them into a Mapbox Vector Tile format.
-func Marshal(layers Layers) ([]byte, error) {
+// Features of geometry collections are split into features of every MVT geometry type
+// by default, see WithCollectionPolicy.
+func Marshal(layers Layers, opts ...MarshalOption) ([]byte, error) {
+	var o marshalOptions
+	for _, apply := range opts {
+		apply(&o)
+	}
+
 	vt := &vectortile.Tile{
 		Layers: make([]*vectortile.Tile_Layer, 0, len(layers)),
 	}
@@ -94,21 +103,39 @@ func Marshal(layers Layers) ([]byte, error) {
 
 		kve := newKeyValueEncoder()
 		for i, f := range l.Features {
-			t, g, err := encodeGeometry(f.Geometry)
-			if err != nil {
-				return nil, errors.WithMessage(err, fmt.Sprintf("layer %s: feature %d: error encoding geometry", l.Name, i))
+			geometries := []geom.T{f.Geometry}
+			if c, ok := f.Geometry.(*geom.GeometryCollection); ok {
+				switch o.collections {
+				case SplitCollections:
+					geometries = splitCollection(c)
+				case DropCollections:
+					geometries = nil
+				}
 			}
+			if len(geometries) == 0 {
+				continue
+			}
+
 			tags, err := encodeProperties(kve, f.Properties)
 			if err != nil {
 				return nil, errors.WithMessage(err, fmt.Sprintf("layer %s: feature %d: error encoding properties", l.Name, i))
 			}
+			for _, g := range geometries {
+				if o.fixWinding {
+					g = fixWinding(g)
+				}
+				t, encoded, err := encodeGeometry(g)
+				if err != nil {
+					return nil, errors.WithMessage(err, fmt.Sprintf("layer %s: feature %d: error encoding geometry", l.Name, i))
+				}
 
-			layer.Features = append(layer.Features, &vectortile.Tile_Feature{
-				Id:       convertID(f.ID),
-				Tags:     tags,
-				Type:     &t,
-				Geometry: g,
-			})
+				layer.Features = append(layer.Features, &vectortile.Tile_Feature{
+					Id:       convertID(f.ID),
+					Tags:     tags,
+					Type:     &t,
+					Geometry: encoded,
+				})
+			}
 		}
 
 		layer.Keys = kve.Keys
@@ -123,6 +150,15 @@ func Marshal(layers Layers) ([]byte, error) {
 // UnmarshalGzipped takes gzipped Mapbox Vector Tile (MVT) data and unzips it
 // before decoding it into a set of layers, It does not project the coordinates.
 func UnmarshalGzipped(data []byte) (Layers, error) {
+	decoded, err := gunzip(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return Unmarshal(decoded)
+}
+
+func gunzip(data []byte) ([]byte, error) {
 	gzreader, err := gzip.NewReader(bytes.NewBuffer(data))
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create gzreader")
@@ -133,7 +169,7 @@ func UnmarshalGzipped(data []byte) (Layers, error) {
 		return nil, errors.WithMessage(err, "failed to unzip")
 	}
 
-	return Unmarshal(decoded)
+	return decoded, nil
 }
 
 // Unmarshal takes Mapbox Vector Tile (MVT) data and converts into a
@@ -0,0 +1,55 @@
+package mvt
+
+// DefaultValidationBuffer is the buffer around tiles, in extent units, where Validate
+// accepts coordinates by default.
+const DefaultValidationBuffer = 64
+
+type marshalOptions struct {
+	fixWinding  bool
+	collections CollectionPolicy
+}
+
+// A MarshalOption is a possible parameter to Marshal.
+type MarshalOption func(*marshalOptions)
+
+// WithWindingFix enables or disables the fix of the winding of polygon rings.
+//
+// When enabled, rings are reversed as needed so that the exterior rings of polygons
+// have a positive area in tile coordinates and their interior rings a negative area,
+// as required by the specification. It is disabled by default.
+func WithWindingFix(yes bool) MarshalOption {
+	return func(o *marshalOptions) {
+		o.fixWinding = yes
+	}
+}
+
+// WithCollectionPolicy sets the way features of geometry collections are encoded.
+// The default is SplitCollections.
+func WithCollectionPolicy(p CollectionPolicy) MarshalOption {
+	return func(o *marshalOptions) {
+		o.collections = p
+	}
+}
+
+type validateOptions struct {
+	buffer float64
+}
+
+func defaultValidateOptions() validateOptions {
+	return validateOptions{
+		buffer: DefaultValidationBuffer,
+	}
+}
+
+// A ValidateOption is a possible parameter to Validate.
+type ValidateOption func(*validateOptions)
+
+// WithBuffer sets the buffer around tiles, in extent units, where coordinates are valid.
+// The default is DefaultValidationBuffer.
+func WithBuffer(buffer float64) ValidateOption {
+	return func(o *validateOptions) {
+		if buffer >= 0 {
+			o.buffer = buffer
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package mvt
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/clip"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/project"
+	"github.com/fredbi/geo/pkg/simplify"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/twpayne/go-geom"
+)
+
+// OverzoomGzipped decodes a gzipped tile, derives the layers of a descendant tile with Overzoom,
+// and encodes them as gzipped MVT. Descendant tiles without features yield nil.
+func OverzoomGzipped(data []byte, tile, descendant maptile.Tile, buffer float64) ([]byte, error) {
+	layers, err := UnmarshalGzipped(data)
+	if err != nil {
+		return nil, err
+	}
+	layers, err = layers.Overzoom(tile, descendant, buffer)
+	if err != nil || len(layers) == 0 {
+		return nil, err
+	}
+	return MarshalGzipped(layers)
+}
+
+// Overzoom derives the layers of a descendant tile, of a greater zoom level, from the layers
+// of a tile in tile coordinates, e.g. as decoded by Unmarshal.
+//
+// Coordinates are rescaled to the descendant tile, then clipped to its extent grown by
+// buffer units on every side. Layers without features in the descendant tile are omitted.
+// The layers of the tile are left untouched, so that they can serve several descendants.
+func (ls Layers) Overzoom(tile, descendant maptile.Tile, buffer float64) (Layers, error) {
+	if !tile.Contains(descendant) {
+		return nil, fmt.Errorf("tile %d/%d/%d is not within tile %d/%d/%d",
+			descendant.Z, descendant.X, descendant.Y, tile.Z, tile.X, tile.Y)
+	}
+	result := make(Layers, 0, len(ls))
+	for _, l := range ls {
+		if overzoomed := l.overzoom(tile, descendant, buffer); len(overzoomed.Features) > 0 {
+			result = append(result, overzoomed)
+		}
+	}
+	return result, nil
+}
+
+// Overzoom derives the layer of a descendant tile from the layer of a tile.
+// See Layers.Overzoom.
+func (l *Layer) Overzoom(tile, descendant maptile.Tile, buffer float64) (*Layer, error) {
+	if !tile.Contains(descendant) {
+		return nil, fmt.Errorf("tile %d/%d/%d is not within tile %d/%d/%d",
+			descendant.Z, descendant.X, descendant.Y, tile.Z, tile.X, tile.Y)
+	}
+	return l.overzoom(tile, descendant, buffer), nil
+}
+
+func (l *Layer) overzoom(tile, descendant maptile.Tile, buffer float64) *Layer {
+	extent := layerExtent(l)
+	n := uint32(descendant.Z - tile.Z)
+	scale := float64(uint64(1) << n)
+	offsetX := float64(descendant.X-tile.X<<n) * extent
+	offsetY := float64(descendant.Y-tile.Y<<n) * extent
+	rescale := func(c geom.Coord) geom.Coord {
+		return geom.Coord{c[0]*scale - offsetX, c[1]*scale - offsetY}
+	}
+	b := bound.NewBound(-buffer, -buffer, extent+buffer, extent+buffer)
+
+	result := &Layer{Name: l.Name, Version: l.Version, Extent: l.Extent}
+	for _, f := range l.Features {
+		if f.Geometry == nil || f.Geometry.Bounds().IsEmpty() {
+			continue
+		}
+		// skip the features out of the descendant tile before copying them
+		fb := f.Geometry.Bounds()
+		if fb.Max(0)*scale-offsetX < b.Min[0] || fb.Min(0)*scale-offsetX > b.Max[0] ||
+			fb.Max(1)*scale-offsetY < b.Min[1] || fb.Min(1)*scale-offsetY > b.Max[1] {
+			continue
+		}
+		g := transformGeometry(f.Geometry, rescale)
+		if g = clipGeometry(b, g); g != nil {
+			result.Features = append(result.Features, copyFeature(f, g))
+		}
+	}
+	return result
+}
+
+// Aggregate builds the layers of a tile from the layers of its four children, in tile
+// coordinates and in the order of maptile.Tile.Children. Missing children are nil.
+//
+// The features of every child are clipped to the child tile, leaving out its buffer,
+// scaled down to the quarter of the tile it covers, then simplified by s unless nil.
+// Features of the same ID in several children, e.g. a road crossing the children, are
+// merged into a single feature of multi geometry. The layers of the children are left untouched.
+//
+// Layers come in the order they appear in the children, with the extent of their first appearance.
+func Aggregate(children [4]Layers, s simplify.Simplifier) Layers {
+	var result Layers
+	layers := make(map[string]*Layer)
+	ids := make(map[*Layer]map[uint64]int)
+	for i, child := range children {
+		for _, l := range child {
+			parent, ok := layers[l.Name]
+			if !ok {
+				parent = &Layer{Name: l.Name, Version: l.Version, Extent: l.Extent}
+				layers[l.Name] = parent
+				ids[parent] = make(map[uint64]int)
+				result = append(result, parent)
+			}
+			aggregate(parent, l, i, ids[parent])
+		}
+	}
+
+	kept := result[:0]
+	for _, l := range result {
+		if s != nil {
+			l.Simplify(s)
+		}
+		if len(l.Features) > 0 {
+			kept = append(kept, l)
+		}
+	}
+	return kept
+}
+
+// aggregate adds the features of the layer of the i-th child of a tile to the layer of the tile.
+// ids holds the positions of the features of the tile by ID.
+func aggregate(parent, child *Layer, i int, ids map[uint64]int) {
+	half := layerExtent(parent) / 2
+	scale := half / layerExtent(child)
+	minX, minY := float64(i&1)*half, float64(i>>1)*half
+	rescale := func(c geom.Coord) geom.Coord {
+		return geom.Coord{c[0]*scale + minX, c[1]*scale + minY}
+	}
+	lines := bound.NewBound(minX, minY, minX+half, minY+half)
+	// points on the east and south edges belong to the next children
+	points := bound.NewBound(minX, minY, math.Nextafter(minX+half, minX), math.Nextafter(minY+half, minY))
+
+	for _, f := range child.Features {
+		if f.Geometry == nil || f.Geometry.Bounds().IsEmpty() {
+			continue
+		}
+		b := lines
+		switch f.Geometry.(type) {
+		case *geom.Point, *geom.MultiPoint:
+			b = points
+		}
+		g := clipGeometry(b, transformGeometry(f.Geometry, rescale))
+		if g == nil {
+			continue
+		}
+
+		id := convertID(f.ID)
+		if id != nil {
+			j, ok := ids[*id]
+			if ok {
+				if merged, ok := merge(parent.Features[j].Geometry, g); ok {
+					parent.Features[j].Geometry = merged
+					continue
+				}
+			} else {
+				ids[*id] = len(parent.Features)
+			}
+		}
+		parent.Features = append(parent.Features, copyFeature(f, g))
+	}
+}
+
+// layerExtent yields the extent of a layer, or the default extent when it is not set.
+func layerExtent(l *Layer) float64 {
+	if l.Extent == 0 {
+		return DefaultExtent
+	}
+	return float64(l.Extent)
+}
+
+// copyFeature copies a feature with another geometry.
+func copyFeature(f *geojson.Feature, g geom.T) *geojson.Feature {
+	var properties geojson.Properties
+	if f.Properties != nil {
+		properties = make(geojson.Properties, len(f.Properties))
+		for k, v := range f.Properties {
+			properties[k] = v
+		}
+	}
+	return &geojson.Feature{ID: f.ID, Geometry: g, Properties: properties}
+}
+
+// transformGeometry yields a copy of a geometry with transformed coordinates.
+func transformGeometry(g geom.T, p project.Projection) geom.T {
+	var clone geom.T
+	switch g := g.(type) {
+	case *geom.Point:
+		clone = g.Clone()
+	case *geom.MultiPoint:
+		clone = g.Clone()
+	case *geom.LineString:
+		clone = g.Clone()
+	case *geom.MultiLineString:
+		clone = g.Clone()
+	case *geom.LinearRing:
+		clone = g.Clone()
+	case *geom.Polygon:
+		clone = g.Clone()
+	case *geom.MultiPolygon:
+		clone = g.Clone()
+	case *geom.GeometryCollection:
+		c := geom.NewGeometryCollection()
+		for _, e := range g.Geoms() {
+			if e = transformGeometry(e, p); e != nil {
+				c.MustPush(e)
+			}
+		}
+		return c
+	default:
+		return nil
+	}
+	clone, _ = project.Geometry(clone, p)
+	return clone
+}
+
+// clipGeometry clips a geometry to a bound, dropping points out of the bound.
+// Geometries without coordinates left yield nil.
+func clipGeometry(b bound.Bound, g geom.T) geom.T {
+	if g == nil {
+		return nil
+	}
+	if p, ok := g.(*geom.Point); ok {
+		if !b.Contains(p.Coords()) {
+			return nil
+		}
+		return p
+	}
+	clipped := clip.Geometry(b, g)
+	if empty(clipped) {
+		return nil
+	}
+	return clipped
+}
+
+// merge merges two geometries of the same dimension and layout into a new multi geometry.
+func merge(a, b geom.T) (geom.T, bool) {
+	if a.Layout() != b.Layout() {
+		return nil, false
+	}
+	switch m := multi(a).(type) {
+	case *geom.MultiPoint:
+		other, ok := multi(b).(*geom.MultiPoint)
+		if !ok {
+			return nil, false
+		}
+		for i := 0; i < other.NumPoints(); i++ {
+			_ = m.Push(other.Point(i))
+		}
+		return m, true
+	case *geom.MultiLineString:
+		other, ok := multi(b).(*geom.MultiLineString)
+		if !ok {
+			return nil, false
+		}
+		for i := 0; i < other.NumLineStrings(); i++ {
+			_ = m.Push(other.LineString(i))
+		}
+		return m, true
+	case *geom.MultiPolygon:
+		other, ok := multi(b).(*geom.MultiPolygon)
+		if !ok {
+			return nil, false
+		}
+		for i := 0; i < other.NumPolygons(); i++ {
+			_ = m.Push(other.Polygon(i))
+		}
+		return m, true
+	}
+	return nil, false
+}
+
+// multi yields a copy of a geometry as a multi geometry.
+func multi(g geom.T) geom.T {
+	switch g := g.(type) {
+	case *geom.MultiPoint:
+		return g.Clone()
+	case *geom.MultiLineString:
+		return g.Clone()
+	case *geom.MultiPolygon:
+		return g.Clone()
+	case *geom.Point:
+		m := geom.NewMultiPoint(g.Layout())
+		_ = m.Push(g)
+		return m
+	case *geom.LineString:
+		m := geom.NewMultiLineString(g.Layout())
+		_ = m.Push(g)
+		return m
+	case *geom.Polygon:
+		m := geom.NewMultiPolygon(g.Layout())
+		_ = m.Push(g)
+		return m
+	}
+	return g
+}
@@ -0,0 +1,131 @@
+package mvt
+
+import (
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/simplify"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func TestOverzoom(t *testing.T) {
+	road := geojson.NewFeature(geom.NewLineStringFlat(geom.XY, []float64{0, 2048, 4096, 2048}))
+	road.ID = uint64(1)
+	road.Properties = geojson.Properties{"class": "primary"}
+	layers := Layers{
+		NewLayer("roads", geojson.NewFeatureCollection().Append(road)),
+		NewLayer("poi", geojson.NewFeatureCollection().Append(pointFeature(1000, 1000, nil))),
+	}
+	tile := maptile.New(4, 6, 4)
+
+	nw, err := layers.Overzoom(tile, maptile.New(8, 12, 5), 64)
+	require.NoError(t, err)
+	require.Len(t, nw, 2)
+	assert.Equal(t, []float64{0, 4096, 4160, 4096}, nw[0].Features[0].Geometry.FlatCoords())
+	assert.Equal(t, uint64(1), nw[0].Features[0].ID)
+	assert.Equal(t, "primary", nw[0].Features[0].Properties["class"])
+	assert.Equal(t, []float64{2000, 2000}, nw[1].Features[0].Geometry.FlatCoords())
+
+	ne, err := layers.Overzoom(tile, maptile.New(9, 12, 5), 64)
+	require.NoError(t, err)
+	require.Len(t, ne, 1, "the point is out of the tile")
+	assert.Equal(t, "roads", ne[0].Name)
+	assert.Equal(t, []float64{-64, 4096, 4096, 4096}, ne[0].Features[0].Geometry.FlatCoords())
+
+	// two zoom levels down, in the south-east corner: the road is out of the buffer
+	se, err := layers.Overzoom(tile, maptile.New(19, 27, 6), 64)
+	require.NoError(t, err)
+	assert.Empty(t, se)
+	se, err = layers.Overzoom(tile, maptile.New(19, 26, 6), 64)
+	require.NoError(t, err)
+	require.Len(t, se, 1)
+	assert.Equal(t, []float64{-64, 0, 4096, 0}, se[0].Features[0].Geometry.FlatCoords())
+
+	assert.Equal(t, []float64{0, 2048, 4096, 2048}, road.Geometry.FlatCoords(), "the tile is left untouched")
+	_, err = layers.Overzoom(tile, maptile.New(7, 12, 5), 64)
+	assert.Error(t, err)
+	_, err = layers.Overzoom(tile, maptile.New(2, 3, 3), 64)
+	assert.Error(t, err)
+
+	data, err := MarshalGzipped(layers)
+	require.NoError(t, err)
+	data, err = OverzoomGzipped(data, tile, maptile.New(9, 12, 5), 64)
+	require.NoError(t, err)
+	decoded, err := UnmarshalGzipped(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, []float64{-64, 4096, 4096, 4096}, decoded[0].Features[0].Geometry.FlatCoords())
+}
+
+func TestAggregate(t *testing.T) {
+	var children [4]Layers
+	for i := range children {
+		// a lake covering the children and their buffers
+		lake := polygonFeature(-64, -64, 4160, -64, 4160, 4160, -64, 4160, -64, -64)
+		lake.ID = uint64(7)
+		lake.Properties = geojson.Properties{"name": "lake"}
+		children[i] = Layers{NewLayer("water", geojson.NewFeatureCollection().Append(lake))}
+	}
+	// a point on the edge of the first two children
+	children[0] = append(children[0], NewLayer("poi", geojson.NewFeatureCollection().Append(pointFeature(4096, 100, nil))))
+	children[1] = append(children[1], NewLayer("poi", geojson.NewFeatureCollection().Append(pointFeature(0, 100, nil))))
+	children[3] = nil
+
+	layers := Aggregate(children, simplify.DouglasPeucker(1))
+	require.Len(t, layers, 2)
+	assert.Equal(t, "water", layers[0].Name)
+	require.Len(t, layers[0].Features, 1, "the lake is merged")
+	lake, ok := layers[0].Features[0].Geometry.(*geom.MultiPolygon)
+	require.True(t, ok)
+	require.Equal(t, 3, lake.NumPolygons())
+	assert.Equal(t, geom.NewBounds(geom.XY).Set(0, 0, 2048, 2048), lake.Polygon(0).Bounds())
+	assert.Equal(t, geom.NewBounds(geom.XY).Set(0, 2048, 2048, 4096), lake.Polygon(2).Bounds())
+	assert.Equal(t, "lake", layers[0].Features[0].Properties["name"])
+
+	assert.Equal(t, "poi", layers[1].Name)
+	require.Len(t, layers[1].Features, 1, "points on edges belong to a single child")
+	assert.Equal(t, []float64{2048, 50}, layers[1].Features[0].Geometry.FlatCoords())
+	assert.Equal(t, 4160.0, children[0][0].Features[0].Geometry.Bounds().Max(0), "the children are left untouched")
+}
+
+func TestAggregateLeavesChildrenUntouched(t *testing.T) {
+	// islands in every child, with the same ID
+	islands := func() *geom.MultiPolygon {
+		return geom.NewMultiPolygonFlat(geom.XY, []float64{
+			100, 100, 200, 100, 200, 200, 100, 100,
+			300, 300, 400, 300, 400, 400, 300, 300,
+		}, [][]int{{8}, {16}})
+	}
+	var (
+		children [4]Layers
+		original [4]*geom.MultiPolygon
+	)
+	for i := range children {
+		f := geojson.NewFeature(islands())
+		f.ID = uint64(7)
+		children[i] = Layers{NewLayer("islands", geojson.NewFeatureCollection().Append(f))}
+		original[i] = islands()
+	}
+
+	layers := Aggregate(children, nil)
+	require.Len(t, layers, 1)
+	require.Len(t, layers[0].Features, 1, "the islands are merged")
+	merged, ok := layers[0].Features[0].Geometry.(*geom.MultiPolygon)
+	require.True(t, ok)
+	assert.Equal(t, 8, merged.NumPolygons())
+
+	for i, child := range children {
+		assert.Equal(t, original[i], child[0].Features[0].Geometry, "child %d is left untouched", i)
+	}
+
+	// neither are the merged geometries
+	a, b := islands(), islands()
+	m, ok := merge(a, b)
+	require.True(t, ok)
+	assert.Equal(t, 4, m.(*geom.MultiPolygon).NumPolygons())
+	assert.Equal(t, islands(), a)
+	assert.Equal(t, islands(), b)
+}
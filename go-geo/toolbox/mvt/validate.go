@@ -0,0 +1,328 @@
+package mvt
+
+import (
+	"fmt"
+
+	vectortile "github.com/fredbi/geo/pkg/mvt/vtile"
+)
+
+// ViolationKind is a kind of violation of the MVT specification.
+type ViolationKind string
+
+// Kinds of violations.
+const (
+	// InvalidGeometry is a geometry of unknown type, or of malformed commands.
+	InvalidGeometry ViolationKind = "invalid geometry"
+
+	// RingWinding is a polygon whose first ring, its exterior ring, has a negative area.
+	RingWinding ViolationKind = "ring winding"
+
+	// ZeroAreaRing is a polygon ring of no area.
+	ZeroAreaRing ViolationKind = "zero area ring"
+
+	// UnclosedPath is a polygon ring without ClosePath command.
+	UnclosedPath ViolationKind = "unclosed path"
+
+	// DuplicateKey is a key found twice in the keys of a layer.
+	DuplicateKey ViolationKind = "duplicate key"
+
+	// DuplicateValue is a value found twice in the values of a layer.
+	DuplicateValue ViolationKind = "duplicate value"
+
+	// InvalidValue is a value of no type, or of several types.
+	InvalidValue ViolationKind = "invalid value"
+
+	// TagOutOfRange is a tag referring to a key or a value the layer does not have,
+	// or a tag without value.
+	TagOutOfRange ViolationKind = "tag out of range"
+
+	// DuplicateLayerName is a layer of the name of a previous layer of the tile.
+	DuplicateLayerName ViolationKind = "duplicate layer name"
+
+	// InvalidVersion is a layer of another version than 2.
+	InvalidVersion ViolationKind = "invalid version"
+
+	// OutOfBounds is a coordinate outside of the extent of the tile and its buffer.
+	OutOfBounds ViolationKind = "out of bounds"
+)
+
+// Violation is a violation of the MVT specification, version 2, by a layer or a feature.
+type Violation struct {
+	Kind  ViolationKind
+	Layer string
+
+	// Feature is the index of the feature in the layer, or -1 for violations of the layer.
+	Feature int
+
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Feature < 0 {
+		return fmt.Sprintf("layer %s: %s: %s", v.Layer, v.Kind, v.Message)
+	}
+	return fmt.Sprintf("layer %s: feature %d: %s: %s", v.Layer, v.Feature, v.Kind, v.Message)
+}
+
+// ValidateGzipped unzips gzipped Mapbox Vector Tile (MVT) data before validating it.
+func ValidateGzipped(data []byte, opts ...ValidateOption) ([]Violation, error) {
+	decoded, err := gunzip(data)
+	if err != nil {
+		return nil, err
+	}
+	return Validate(decoded, opts...)
+}
+
+// Validate reports the violations of the MVT specification, version 2, by Mapbox Vector Tile
+// (MVT) data, in the order of the layers and of their features.
+//
+// An error is returned only when the data cannot be decoded at all.
+func Validate(data []byte, opts ...ValidateOption) ([]Violation, error) {
+	o := defaultValidateOptions()
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	vt := &vectortile.Tile{}
+	if err := vt.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	names := make(map[string]bool, len(vt.Layers))
+	for _, l := range vt.Layers {
+		v := &layerValidator{layer: l, buffer: o.buffer}
+		if names[l.GetName()] {
+			v.report(-1, DuplicateLayerName, "a previous layer has this name")
+		}
+		names[l.GetName()] = true
+		v.validate()
+		violations = append(violations, v.violations...)
+	}
+	return violations, nil
+}
+
+// layerValidator collects the violations of a layer.
+type layerValidator struct {
+	layer      *vectortile.Tile_Layer
+	buffer     float64
+	violations []Violation
+}
+
+func (v *layerValidator) report(feature int, kind ViolationKind, format string, args ...interface{}) {
+	v.violations = append(v.violations, Violation{
+		Kind:    kind,
+		Layer:   v.layer.GetName(),
+		Feature: feature,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *layerValidator) validate() {
+	l := v.layer
+	if l.GetVersion() != 2 {
+		v.report(-1, InvalidVersion, "version %d instead of 2", l.GetVersion())
+	}
+
+	keys := make(map[string]int, len(l.Keys))
+	for i, k := range l.Keys {
+		if j, ok := keys[k]; ok {
+			v.report(-1, DuplicateKey, "key %d %q is key %d", i, k, j)
+			continue
+		}
+		keys[k] = i
+	}
+
+	values := make(map[interface{}]int, len(l.Values))
+	for i, value := range l.Values {
+		key, ok := valueKey(value)
+		if !ok {
+			v.report(-1, InvalidValue, "value %d has not exactly one type", i)
+			continue
+		}
+		if j, ok := values[key]; ok {
+			v.report(-1, DuplicateValue, "value %d %v is value %d", i, decodeValue(value), j)
+			continue
+		}
+		values[key] = i
+	}
+
+	for i, f := range l.Features {
+		v.validateTags(i, f.Tags)
+		v.validateGeometry(i, f.GetType(), f.Geometry)
+	}
+}
+
+func (v *layerValidator) validateTags(feature int, tags []uint32) {
+	if len(tags)%2 != 0 {
+		v.report(feature, TagOutOfRange, "the last key has no value")
+	}
+	for i := 0; i+1 < len(tags); i += 2 {
+		if int(tags[i]) >= len(v.layer.Keys) {
+			v.report(feature, TagOutOfRange, "key %d of %d keys", tags[i], len(v.layer.Keys))
+		}
+		if int(tags[i+1]) >= len(v.layer.Values) {
+			v.report(feature, TagOutOfRange, "value %d of %d values", tags[i+1], len(v.layer.Values))
+		}
+	}
+}
+
+// command is a command of an encoded geometry, with the absolute coordinates of its parameters.
+type command struct {
+	id     uint32
+	points [][2]float64
+}
+
+func (v *layerValidator) validateGeometry(feature int, t vectortile.Tile_GeomType, data []uint32) {
+	commands, err := decodeCommands(data)
+	if err != nil {
+		v.report(feature, InvalidGeometry, "%v", err)
+		return
+	}
+	if len(commands) == 0 {
+		v.report(feature, InvalidGeometry, "the geometry has no command")
+		return
+	}
+	v.validateBounds(feature, commands)
+
+	switch t {
+	case vectortile.Tile_POINT:
+		if len(commands) != 1 || commands[0].id != moveTo || len(commands[0].points) == 0 {
+			v.report(feature, InvalidGeometry, "a point is a single MoveTo command")
+		}
+	case vectortile.Tile_LINESTRING:
+		for i := 0; i < len(commands); i += 2 {
+			if !isPathStart(commands, i) {
+				v.report(feature, InvalidGeometry, "line %d is not a MoveTo command of one point and a LineTo command", i/2)
+				return
+			}
+		}
+	case vectortile.Tile_POLYGON:
+		v.validateRings(feature, commands)
+	default:
+		v.report(feature, InvalidGeometry, "unknown geometry type %v", t)
+	}
+}
+
+// validateRings validates the rings of a polygon, of a MoveTo command of one point,
+// a LineTo command of at least 2 points and a ClosePath command.
+func (v *layerValidator) validateRings(feature int, commands []command) {
+	for ring, i := 0, 0; i < len(commands); ring++ {
+		if !isPathStart(commands, i) {
+			v.report(feature, InvalidGeometry, "ring %d is not a MoveTo command of one point and a LineTo command", ring)
+			return
+		}
+		points := append(commands[i].points, commands[i+1].points...)
+		i += 2
+		if i < len(commands) && commands[i].id == closePath {
+			i++
+		} else {
+			v.report(feature, UnclosedPath, "ring %d has no ClosePath command", ring)
+		}
+
+		switch area := ringArea(points); {
+		case len(points) < 3 || area == 0:
+			v.report(feature, ZeroAreaRing, "ring %d has no area", ring)
+		case ring == 0 && area < 0:
+			v.report(feature, RingWinding, "the exterior ring has a negative area")
+		}
+	}
+}
+
+// validateBounds reports the first coordinate out of the extent and the buffer.
+func (v *layerValidator) validateBounds(feature int, commands []command) {
+	min, max := -v.buffer, float64(v.layer.GetExtent())+v.buffer
+	for _, c := range commands {
+		for _, p := range c.points {
+			if p[0] < min || p[0] > max || p[1] < min || p[1] > max {
+				v.report(feature, OutOfBounds, "(%g, %g) is out of [%g, %g]", p[0], p[1], min, max)
+				return
+			}
+		}
+	}
+}
+
+// isPathStart tells if the commands at i are a MoveTo command of one point and a LineTo command.
+func isPathStart(commands []command, i int) bool {
+	return i+1 < len(commands) &&
+		commands[i].id == moveTo && len(commands[i].points) == 1 &&
+		commands[i+1].id == lineTo && len(commands[i+1].points) > 0
+}
+
+// decodeCommands decodes the commands of a geometry, failing on unknown or truncated commands.
+func decodeCommands(data []uint32) ([]command, error) {
+	var (
+		commands []command
+		x, y     float64
+	)
+	for i := 0; i < len(data); {
+		id, count := data[i]&0x07, data[i]>>3
+		i++
+		switch id {
+		case moveTo, lineTo:
+			if len(data) < i+2*int(count) {
+				return nil, fmt.Errorf("command %d is cut short", len(commands))
+			}
+			c := command{id: id, points: make([][2]float64, count)}
+			for j := range c.points {
+				x += unzigzag(data[i])
+				y += unzigzag(data[i+1])
+				c.points[j] = [2]float64{x, y}
+				i += 2
+			}
+			commands = append(commands, c)
+		case closePath:
+			if count != 1 {
+				return nil, fmt.Errorf("command %d is a ClosePath of count %d", len(commands), count)
+			}
+			commands = append(commands, command{id: id})
+		default:
+			return nil, fmt.Errorf("command %d has unknown id %d", len(commands), id)
+		}
+	}
+	return commands, nil
+}
+
+// ringArea yields twice the signed area of a ring, by the surveyor's formula.
+func ringArea(points [][2]float64) float64 {
+	area := 0.0
+	for i := range points {
+		j := (i + 1) % len(points)
+		area += points[i][0]*points[j][1] - points[j][0]*points[i][1]
+	}
+	return area
+}
+
+// valueKey yields a comparable key of a value, and whether it has exactly one type.
+func valueKey(v *vectortile.Tile_Value) (interface{}, bool) {
+	type key struct {
+		field int
+		value interface{}
+	}
+	var (
+		k     key
+		types int
+	)
+	if v.StringValue != nil {
+		k, types = key{1, *v.StringValue}, types+1
+	}
+	if v.FloatValue != nil {
+		k, types = key{2, *v.FloatValue}, types+1
+	}
+	if v.DoubleValue != nil {
+		k, types = key{3, *v.DoubleValue}, types+1
+	}
+	if v.IntValue != nil {
+		k, types = key{4, *v.IntValue}, types+1
+	}
+	if v.UintValue != nil {
+		k, types = key{5, *v.UintValue}, types+1
+	}
+	if v.SintValue != nil {
+		k, types = key{6, *v.SintValue}, types+1
+	}
+	if v.BoolValue != nil {
+		k, types = key{7, *v.BoolValue}, types+1
+	}
+	return k, types == 1
+}
@@ -0,0 +1,140 @@
+package mvt
+
+import (
+	"fmt"
+	"testing"
+
+	vectortile "github.com/fredbi/geo/pkg/mvt/vtile"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+func kinds(violations []Violation) []string {
+	result := make([]string, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, fmt.Sprintf("%s %d %s", v.Layer, v.Feature, v.Kind))
+	}
+	return result
+}
+
+func TestValidate(t *testing.T) {
+	geometry := func(build func(e *geomEncoder)) []uint32 {
+		e := newGeomEncoder(0)
+		build(e)
+		return e.Data
+	}
+	feature := func(t vectortile.Tile_GeomType, tags []uint32, g []uint32) *vectortile.Tile_Feature {
+		return &vectortile.Tile_Feature{Type: &t, Tags: tags, Geometry: g}
+	}
+	point := geometry(func(e *geomEncoder) { e.MoveTo([]geom.Coord{{10, 10}}) })
+	v1, v2, x := uint32(1), uint32(2), "x"
+	roads, water := "roads", "water"
+
+	vt := &vectortile.Tile{Layers: []*vectortile.Tile_Layer{
+		{
+			Name:    &roads,
+			Version: &v1,
+			Keys:    []string{"a", "a"},
+			Values:  []*vectortile.Tile_Value{{StringValue: &x}, {StringValue: &x}, {}},
+			Features: []*vectortile.Tile_Feature{
+				feature(vectortile.Tile_POINT, []uint32{0, 5, 1}, point),
+				// a flat ring, not closed
+				feature(vectortile.Tile_POLYGON, nil, geometry(func(e *geomEncoder) {
+					e.MoveTo([]geom.Coord{{0, 0}})
+					e.LineTo([]geom.Coord{{10, 0}, {20, 0}})
+				})),
+				feature(vectortile.Tile_POINT, nil, geometry(func(e *geomEncoder) { e.MoveTo([]geom.Coord{{5000, 0}}) })),
+				feature(vectortile.Tile_UNKNOWN, nil, point),
+				feature(vectortile.Tile_LINESTRING, nil, point[:2]),
+				feature(vectortile.Tile_LINESTRING, nil, point),
+			},
+		},
+		{
+			Name:    &water,
+			Version: &v2,
+			Features: []*vectortile.Tile_Feature{
+				// an exterior ring of negative area, then a hole of positive area
+				feature(vectortile.Tile_POLYGON, nil, geometry(func(e *geomEncoder) {
+					e.MoveTo([]geom.Coord{{0, 0}})
+					e.LineTo([]geom.Coord{{0, 100}, {100, 100}, {100, 0}})
+					e.ClosePath()
+					e.MoveTo([]geom.Coord{{10, 10}})
+					e.LineTo([]geom.Coord{{20, 10}, {20, 20}})
+					e.ClosePath()
+				})),
+			},
+		},
+		{Name: &roads, Version: &v2},
+	}}
+	data, err := proto.Marshal(vt)
+	require.NoError(t, err)
+
+	violations, err := Validate(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"roads -1 invalid version",
+		"roads -1 duplicate key",
+		"roads -1 duplicate value",
+		"roads -1 invalid value",
+		"roads 0 tag out of range",
+		"roads 0 tag out of range",
+		"roads 1 unclosed path",
+		"roads 1 zero area ring",
+		"roads 2 out of bounds",
+		"roads 3 invalid geometry",
+		"roads 4 invalid geometry",
+		"roads 5 invalid geometry",
+		"water 0 ring winding",
+		"roads -1 duplicate layer name",
+	}, kinds(violations))
+	assert.Equal(t, "layer roads: feature 2: out of bounds: (5000, 0) is out of [-64, 4160]", violations[8].String())
+
+	violations, err = Validate(data, WithBuffer(1000))
+	require.NoError(t, err)
+	assert.NotContains(t, kinds(violations), "roads 2 out of bounds")
+
+	_, err = Validate([]byte("not a tile"))
+	assert.Error(t, err)
+}
+
+func TestMarshalWindingFix(t *testing.T) {
+	// a polygon of negative area with a hole of positive area, and a valid polygon
+	polygon := geom.NewPolygonFlat(geom.XY, []float64{
+		0, 0, 0, 100, 100, 100, 100, 0, 0, 0,
+		10, 10, 20, 10, 20, 20, 10, 10,
+	}, []int{10, 18})
+	valid := geom.NewPolygonFlat(geom.XY, []float64{0, 0, 100, 0, 100, 100, 0, 0}, []int{8})
+	layers := func() Layers {
+		fc := geojson.NewFeatureCollection().
+			Append(geojson.NewFeature(polygon)).
+			Append(geojson.NewFeature(valid))
+		for _, f := range fc.Features {
+			f.Properties = geojson.Properties{"kind": "lake"}
+		}
+		return Layers{NewLayer("water", fc)}
+	}
+
+	data, err := Marshal(layers())
+	require.NoError(t, err)
+	violations, err := Validate(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"water 0 ring winding"}, kinds(violations))
+
+	data, err = MarshalGzipped(layers(), WithWindingFix(true))
+	require.NoError(t, err)
+	violations, err = ValidateGzipped(data)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+	assert.Equal(t, []float64{0, 0, 0, 100, 100, 100, 100, 0, 0, 0}, polygon.FlatCoords()[:10], "the layers are left untouched")
+
+	decoded, err := UnmarshalGzipped(data)
+	require.NoError(t, err)
+	p, ok := decoded[0].Features[0].Geometry.(*geom.Polygon)
+	require.True(t, ok, "the hole is decoded as a hole")
+	assert.Equal(t, 2, p.NumLinearRings())
+	assert.Equal(t, CCW, orientation(p.LinearRing(0).Coords()))
+	assert.Equal(t, CW, orientation(p.LinearRing(1).Coords()))
+}
@@ -169,11 +169,11 @@ func MultiPolygon(mp *geom.MultiPolygon, proj Projection) (*geom.MultiPolygon, e
 	return mp, nil
 }
 
-// Collection is a helper to project a rectangle.
+// Collection is a helper to project an entire geometry collection.
 func Collection(c *geom.GeometryCollection, proj Projection) (*geom.GeometryCollection, error) {
 	gc := geom.NewGeometryCollection()
 	for i := 0; i < c.NumGeoms(); i++ {
-		g, err := Geometry(gc.Geom(i), proj)
+		g, err := Geometry(c.Geom(i), proj)
 		if err != nil {
 			return nil, err
 		}
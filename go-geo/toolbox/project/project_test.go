@@ -147,7 +147,12 @@ var (
 		},
 	)
 
-	geoms = []geom.T{p, multip, ls, mls, plg, ring, mplg}
+	coll = geom.NewGeometryCollection().MustPush(
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2}),
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{geom.Coord{1, 2}, geom.Coord{3, 4}}),
+	)
+
+	geoms = []geom.T{p, multip, ls, mls, plg, ring, mplg, coll}
 )
 
 func FakeProjection(g geom.Coord) geom.Coord {
@@ -0,0 +1,266 @@
+// Package tilecache caches rendered tiles in memory, on disk or in a Redis server.
+//
+// A Cache wraps the renderer of some layer or map. Cached tiles are invalidated by area:
+// editing a feature purges the tiles featuring it at every zoom level, without flushing
+// the whole cache.
+package tilecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileseed"
+	"github.com/twpayne/go-geom"
+)
+
+// Key identifies a cached tile of some layer or map.
+type Key struct {
+	Name string
+	Z    maptile.Zoom
+	X, Y uint32
+}
+
+// NewKey yields the key of a tile of some layer or map.
+func NewKey(name string, tile maptile.Tile) Key {
+	return Key{Name: name, Z: tile.Z, X: tile.X, Y: tile.Y}
+}
+
+// String yields the key as {name}/{z}/{x}/{y}.
+func (k Key) String() string {
+	return k.Name + "/" +
+		strconv.FormatUint(uint64(k.Z), 10) + "/" +
+		strconv.FormatUint(uint64(k.X), 10) + "/" +
+		strconv.FormatUint(uint64(k.Y), 10)
+}
+
+// Backend stores cached tiles.
+//
+// Empty tiles are cached too, as empty data. The data yielded by Get must not be modified.
+//
+// A Backend must be safe for concurrent use.
+type Backend interface {
+	// Get yields the data of a tile, and whether the tile is cached.
+	Get(ctx context.Context, key Key) ([]byte, bool, error)
+
+	// Set caches the data of a tile.
+	Set(ctx context.Context, key Key, data []byte) error
+
+	// Delete removes some tiles. Tiles which are not cached are ignored.
+	Delete(ctx context.Context, keys ...Key) error
+
+	// DeleteZoom removes all the tiles of a zoom level of some layer or map.
+	DeleteZoom(ctx context.Context, name string, z maptile.Zoom) error
+}
+
+// Renderer renders tiles, such as a tilerenderer.Renderer.
+type Renderer interface {
+	Render(ctx context.Context, tile maptile.Tile) ([]byte, error)
+}
+
+var _ Renderer = &Cache{}
+
+// Cache caches the tiles of the renderer of some layer or map.
+//
+// Concurrent requests of a tile which is not cached share a single rendering.
+//
+// A Cache is safe for concurrent use.
+type Cache struct {
+	name     string
+	renderer Renderer
+	backend  Backend
+	options
+
+	mu    sync.Mutex
+	calls map[Key]*call
+
+	// generation counts invalidations: tiles rendered before an invalidation are not cached.
+	// Tiles are cached with a read lock, so that no tile is cached while the generation changes.
+	generationMu sync.RWMutex
+	generation   uint64
+}
+
+// call is the rendering of a tile, shared by concurrent requests.
+type call struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// New creates the cache of the tiles of a renderer, stored in a backend under some name.
+//
+// Caches of different layers or maps may share a backend, with different names.
+func New(name string, r Renderer, backend Backend, opts ...Option) *Cache {
+	c := &Cache{
+		name:     name,
+		renderer: r,
+		backend:  backend,
+		options:  defaultOptions(),
+		calls:    make(map[Key]*call),
+	}
+	for _, apply := range opts {
+		apply(&c.options)
+	}
+	return c
+}
+
+// Name yields the name of the tiles of the cache in its backend.
+func (c *Cache) Name() string {
+	return c.name
+}
+
+// Render yields a cached tile, or else renders and caches it.
+//
+// Failures of the backend are reported to the error handler of the cache: the tile is
+// rendered as if it were not cached.
+func (c *Cache) Render(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	key := NewKey(c.name, tile)
+	data, ok, err := c.backend.Get(ctx, key)
+	if err != nil {
+		c.errorHandler(fmt.Errorf("tilecache: getting %s: %v", key, err))
+	} else if ok {
+		return data, nil
+	}
+
+	for {
+		data, shared, err := c.render(ctx, key, tile)
+		// a shared rendering canceled by the request which started it is retried
+		if shared && err != nil && ctx.Err() == nil &&
+			(errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+			continue
+		}
+		return data, err
+	}
+}
+
+// render renders and caches a tile, or waits for the rendering started by another request.
+func (c *Cache) render(ctx context.Context, key Key, tile maptile.Tile) ([]byte, bool, error) {
+	c.mu.Lock()
+	if cl, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-cl.done:
+			return cl.data, true, cl.err
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	cl := &call{done: make(chan struct{})}
+	c.calls[key] = cl
+	c.mu.Unlock()
+
+	c.generationMu.RLock()
+	generation := c.generation
+	c.generationMu.RUnlock()
+
+	cl.data, cl.err = c.renderer.Render(ctx, tile)
+	if cl.err == nil {
+		c.set(ctx, key, cl.data, generation)
+	}
+
+	c.mu.Lock()
+	delete(c.calls, key)
+	c.mu.Unlock()
+	close(cl.done)
+	return cl.data, false, cl.err
+}
+
+// set caches a tile, unless the cache was invalidated since the tile was rendered.
+func (c *Cache) set(ctx context.Context, key Key, data []byte, generation uint64) {
+	c.generationMu.RLock()
+	defer c.generationMu.RUnlock()
+	if c.generation != generation {
+		return
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	if err := c.backend.Set(ctx, key, data); err != nil {
+		c.errorHandler(fmt.Errorf("tilecache: setting %s: %v", key, err))
+	}
+}
+
+// Invalidate purges the cached tiles intersecting an area, at every zoom level of the cache.
+//
+// The neighbors of these tiles are purged too, as their buffer may overlap the area.
+// Zoom levels where the area spans more tiles than the limit set by WithMaxInvalidatedTiles
+// are purged entirely.
+func (c *Cache) Invalidate(ctx context.Context, area tileseed.Area) error {
+	c.generationMu.Lock()
+	c.generation++
+	c.generationMu.Unlock()
+
+	for z := c.minZoom; z <= c.maxZoom; z++ {
+		minX, minY, maxX, maxY := tileseed.TileRange(area.Bound(), z)
+		if n := int64(maxX-minX+3) * int64(maxY-minY+3); n > int64(c.maxInvalidatedTiles) {
+			if err := c.backend.DeleteZoom(ctx, c.name, z); err != nil {
+				return fmt.Errorf("tilecache: purging %s/%d: %v", c.name, z, err)
+			}
+			continue
+		}
+
+		keys, err := c.invalidatedKeys(area, z)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if err := c.backend.Delete(ctx, keys...); err != nil {
+			return fmt.Errorf("tilecache: purging %s/%d: %v", c.name, z, err)
+		}
+	}
+	return nil
+}
+
+// InvalidateBound purges the cached tiles intersecting a bound. See Invalidate.
+func (c *Cache) InvalidateBound(ctx context.Context, b bound.Bound) error {
+	return c.Invalidate(ctx, tileseed.NewBoundArea(b))
+}
+
+// InvalidateGeometry purges the cached tiles intersecting a geometry, in WGS84 coordinates.
+// See Invalidate.
+func (c *Cache) InvalidateGeometry(ctx context.Context, g geom.T) error {
+	area, err := tileseed.NewGeometryArea(g)
+	if err != nil {
+		return err
+	}
+	return c.Invalidate(ctx, area)
+}
+
+// invalidatedKeys yields the keys of the tiles of a zoom level intersecting an area and
+// of their neighbors, ordered by x then y.
+func (c *Cache) invalidatedKeys(area tileseed.Area, z maptile.Zoom) ([]Key, error) {
+	last := int64(1)<<z - 1
+	tiles := make(map[[2]uint32]bool)
+	err := tileseed.Tiles(area, z, z, func(tile maptile.Tile) error {
+		for x := int64(tile.X) - 1; x <= int64(tile.X)+1; x++ {
+			for y := int64(tile.Y) - 1; y <= int64(tile.Y)+1; y++ {
+				if x >= 0 && y >= 0 && x <= last && y <= last {
+					tiles[[2]uint32{uint32(x), uint32(y)}] = true
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]Key, 0, len(tiles))
+	for xy := range tiles {
+		keys = append(keys, Key{Name: c.name, Z: z, X: xy[0], Y: xy[1]})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].X != keys[j].X {
+			return keys[i].X < keys[j].X
+		}
+		return keys[i].Y < keys[j].Y
+	})
+	return keys, nil
+}
@@ -0,0 +1,229 @@
+package tilecache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileseed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+// fakeRenderer renders tiles as their coordinates, and the tiles of odd columns as empty.
+// It signals its renderings on started and blocks on its gate, if any.
+type fakeRenderer struct {
+	started  chan struct{}
+	gate     chan struct{}
+	mu       sync.Mutex
+	rendered int
+}
+
+func (f *fakeRenderer) Render(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	if f.started != nil {
+		f.started <- struct{}{}
+	}
+	if f.gate != nil {
+		<-f.gate
+	}
+	f.mu.Lock()
+	f.rendered++
+	f.mu.Unlock()
+	if tile.X%2 == 1 {
+		return nil, nil
+	}
+	return []byte(fmt.Sprintf("%d/%d/%d", tile.Z, tile.X, tile.Y)), nil
+}
+
+func (f *fakeRenderer) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rendered
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	r := &fakeRenderer{}
+	backend := NewMemory(1 << 20)
+	c := New("roads", r, backend)
+
+	for i := 0; i < 2; i++ {
+		data, err := c.Render(ctx, maptile.New(2, 1, 3))
+		require.NoError(t, err)
+		assert.Equal(t, "3/2/1", string(data))
+		data, err = c.Render(ctx, maptile.New(3, 1, 3))
+		require.NoError(t, err)
+		assert.Empty(t, data)
+	}
+	assert.Equal(t, 2, r.count(), "tiles are rendered once, even empty ones")
+	_, ok, err := backend.Get(ctx, Key{Name: "roads", Z: 3, X: 3, Y: 1})
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// concurrent requests share a rendering
+	r = &fakeRenderer{gate: make(chan struct{})}
+	c = New("roads", r, backend)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := c.Render(ctx, maptile.New(0, 0, 1))
+			assert.NoError(t, err)
+			assert.Equal(t, "1/0/0", string(data))
+		}()
+	}
+	close(r.gate)
+	wg.Wait()
+	assert.True(t, r.count() < 4)
+}
+
+func TestInvalidate(t *testing.T) {
+	ctx := context.Background()
+	backend := NewMemory(1 << 20)
+	c := New("roads", &fakeRenderer{}, backend, WithZoomRange(0, 8), WithMaxInvalidatedTiles(64))
+	// all the tiles up to zoom 4, then the tiles around Paris
+	paris := geom.NewPointFlat(geom.XY, []float64{2.3, 48.9})
+	for z := maptile.Zoom(0); z <= 8; z++ {
+		minX, minY, maxX, maxY := uint32(0), uint32(0), uint32(1)<<z-1, uint32(1)<<z-1
+		if z > 4 {
+			x, y, _, _ := tileseed.TileRange(bound.NewBound(2.3, 48.9, 2.3, 48.9), z)
+			minX, minY, maxX, maxY = x-3, y-3, x+3, y+3
+		}
+		for x := minX; x <= maxX; x++ {
+			for y := minY; y <= maxY; y++ {
+				_, err := c.Render(ctx, maptile.New(x, y, z))
+				require.NoError(t, err)
+			}
+		}
+	}
+	other := New("rails", &fakeRenderer{}, backend)
+	_, err := other.Render(ctx, maptile.New(0, 0, 0))
+	require.NoError(t, err)
+	cached := func(x, y uint32, z maptile.Zoom) bool {
+		_, ok, err := backend.Get(ctx, Key{Name: "roads", Z: z, X: x, Y: y})
+		require.NoError(t, err)
+		return ok
+	}
+
+	// Paris is in the tiles 1/1/0, 2/2/1 and 8/129/88
+	require.NoError(t, c.InvalidateGeometry(ctx, paris))
+	assert.False(t, cached(0, 0, 0))
+	assert.False(t, cached(1, 0, 1))
+	assert.False(t, cached(0, 0, 1), "neighbors are purged")
+	assert.False(t, cached(3, 2, 2))
+	assert.True(t, cached(0, 0, 2))
+	assert.True(t, cached(0, 3, 2))
+	assert.False(t, cached(129, 88, 8))
+	assert.False(t, cached(128, 87, 8))
+	assert.True(t, cached(126, 88, 8))
+	_, ok, err := backend.Get(ctx, Key{Name: "rails", Z: 0, X: 0, Y: 0})
+	require.NoError(t, err)
+	assert.True(t, ok, "other layers or maps are kept")
+
+	// zoom levels where the bound spans too many tiles are purged entirely
+	require.NoError(t, c.InvalidateBound(ctx, bound.NewBound(-10, 40, 10, 50)))
+	assert.True(t, cached(0, 3, 3))
+	assert.False(t, cached(3, 2, 3))
+	assert.True(t, cached(0, 0, 4))
+	assert.False(t, cached(30, 21, 6))
+	assert.True(t, cached(35, 19, 6))
+	assert.False(t, cached(64, 44, 7), "zoom levels 7 and 8 are purged entirely")
+	assert.False(t, cached(126, 88, 8))
+}
+
+func TestInvalidateWhileRendering(t *testing.T) {
+	ctx := context.Background()
+	r := &fakeRenderer{started: make(chan struct{}), gate: make(chan struct{})}
+	backend := NewMemory(1 << 20)
+	c := New("roads", r, backend)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := c.Render(ctx, maptile.New(0, 0, 0))
+		assert.NoError(t, err)
+	}()
+	// the rendering of the tile is pending while its data changes
+	<-r.started
+	require.NoError(t, c.InvalidateBound(ctx, bound.NewBound(0, 0, 1, 1)))
+	close(r.gate)
+	<-done
+	assert.Equal(t, 0, backend.Len(), "tiles rendered before an invalidation are not cached")
+}
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	data := make([]byte, 1000-memoryEntryOverhead-1)
+	m := NewMemory(3000)
+	for x := uint32(0); x < 3; x++ {
+		require.NoError(t, m.Set(ctx, Key{Name: "a", X: x}, data))
+	}
+	assert.Equal(t, int64(3000), m.Size())
+	_, ok, _ := m.Get(ctx, Key{Name: "a", X: 0})
+	assert.True(t, ok)
+
+	// the least recently used tile is evicted
+	require.NoError(t, m.Set(ctx, Key{Name: "a", X: 3}, data))
+	assert.Equal(t, 3, m.Len())
+	_, ok, _ = m.Get(ctx, Key{Name: "a", X: 1})
+	assert.False(t, ok)
+	_, ok, _ = m.Get(ctx, Key{Name: "a", X: 0})
+	assert.True(t, ok)
+
+	require.NoError(t, m.Set(ctx, Key{Name: "a", X: 4}, make([]byte, 4000)))
+	_, ok, _ = m.Get(ctx, Key{Name: "a", X: 4})
+	assert.False(t, ok, "tiles over budget are not cached")
+	assert.Equal(t, 3, m.Len())
+
+	require.NoError(t, m.Delete(ctx, Key{Name: "a", X: 0}, Key{Name: "a", X: 9}))
+	require.NoError(t, m.DeleteZoom(ctx, "a", 0))
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, int64(0), m.Size())
+}
+
+func TestFilesystem(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "tilecache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	f, err := NewFilesystem(dir)
+	require.NoError(t, err)
+	key := Key{Name: "../base map", Z: 4, X: 3, Y: 5}
+	assert.Equal(t, filepath.Join(dir, "%2E.%2Fbase%20map", "4", "3", "5"), f.Path(key))
+
+	_, ok, err := f.Get(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, f.Set(ctx, key, []byte{}))
+	data, ok, err := f.Get(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, data)
+
+	require.NoError(t, f.Set(ctx, key, []byte("data")))
+	require.NoError(t, f.Set(ctx, Key{Name: key.Name, Z: 4, X: 3, Y: 6}, []byte("data")))
+	data, _, err = f.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	require.NoError(t, f.Delete(ctx, key, Key{Name: key.Name, Z: 5}))
+	_, ok, _ = f.Get(ctx, key)
+	assert.False(t, ok)
+	require.NoError(t, f.DeleteZoom(ctx, key.Name, 4))
+	require.NoError(t, f.DeleteZoom(ctx, key.Name, 4))
+	_, ok, _ = f.Get(ctx, Key{Name: key.Name, Z: 4, X: 3, Y: 6})
+	assert.False(t, ok)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "no temporary file is left")
+}
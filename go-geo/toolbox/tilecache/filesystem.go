@@ -0,0 +1,115 @@
+package tilecache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/fredbi/geo/pkg/maptile"
+)
+
+var _ Backend = &Filesystem{}
+
+// Filesystem caches tiles as files of a directory tree: {name}/{z}/{x}/{y}.
+//
+// Tiles are written atomically and zoom levels are purged at once, so that a cache shared
+// by several servers never yields partial tiles.
+//
+// A Filesystem is safe for concurrent use.
+type Filesystem struct {
+	root string
+}
+
+// NewFilesystem creates a cache of tiles in a directory, created if needed.
+func NewFilesystem(root string) (*Filesystem, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("tilecache: creating cache directory: %v", err)
+	}
+	return &Filesystem{root: root}, nil
+}
+
+// Path yields the path of the file of a tile.
+func (f *Filesystem) Path(key Key) string {
+	return filepath.Join(
+		f.zoomDir(key.Name, key.Z),
+		strconv.FormatUint(uint64(key.X), 10),
+		strconv.FormatUint(uint64(key.Y), 10),
+	)
+}
+
+// zoomDir yields the directory of a zoom level. Names are escaped so that they are
+// single path elements, which do not start with a dot.
+func (f *Filesystem) zoomDir(name string, z maptile.Zoom) string {
+	name = url.PathEscape(name)
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+	return filepath.Join(f.root, name, strconv.FormatUint(uint64(z), 10))
+}
+
+// Get yields the data of a tile, and whether the tile is cached.
+func (f *Filesystem) Get(_ context.Context, key Key) ([]byte, bool, error) {
+	data, err := ioutil.ReadFile(f.Path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// Set caches the data of a tile.
+func (f *Filesystem) Set(_ context.Context, key Key, data []byte) error {
+	path := f.Path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Delete removes some tiles.
+func (f *Filesystem) Delete(_ context.Context, keys ...Key) error {
+	for _, key := range keys {
+		if err := os.Remove(f.Path(key)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteZoom removes all the tiles of a zoom level of some layer or map.
+//
+// The directory of the zoom level is moved aside before being removed.
+func (f *Filesystem) DeleteZoom(_ context.Context, name string, z maptile.Zoom) error {
+	dir := f.zoomDir(name, z)
+	trash, err := ioutil.TempDir(f.root, ".deleted.")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(trash)
+	if err := os.Rename(dir, filepath.Join(trash, "tiles")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
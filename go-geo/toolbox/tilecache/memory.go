@@ -0,0 +1,122 @@
+package tilecache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/maptile"
+)
+
+// memoryEntryOverhead approximates the memory used by an entry besides its data and name:
+// the list element, the entry and its slot in the map.
+const memoryEntryOverhead = 128
+
+var _ Backend = &Memory{}
+
+// Memory caches tiles in memory, within a budget of bytes.
+// The least recently used tiles are evicted first.
+//
+// A Memory is safe for concurrent use.
+type Memory struct {
+	maxBytes int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[Key]*list.Element
+}
+
+type memoryEntry struct {
+	key  Key
+	data []byte
+}
+
+func (e *memoryEntry) size() int64 {
+	return int64(len(e.data)+len(e.key.Name)) + memoryEntryOverhead
+}
+
+// NewMemory creates an in-memory cache of at most some number of bytes.
+func NewMemory(maxBytes int64) *Memory {
+	return &Memory{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[Key]*list.Element),
+	}
+}
+
+// Size yields the number of bytes used by the cached tiles.
+func (m *Memory) Size() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.size
+}
+
+// Len yields the number of cached tiles.
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+// Get yields the data of a tile, and whether the tile is cached.
+func (m *Memory) Get(_ context.Context, key Key) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	m.lru.MoveToFront(elem)
+	return elem.Value.(*memoryEntry).data, true, nil
+}
+
+// Set caches the data of a tile, evicting the least recently used tiles beyond the budget.
+// Tiles larger than the budget are not cached.
+func (m *Memory) Set(_ context.Context, key Key, data []byte) error {
+	e := &memoryEntry{key: key, data: data}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+	if e.size() > m.maxBytes {
+		return nil
+	}
+	m.entries[key] = m.lru.PushFront(e)
+	m.size += e.size()
+	for m.size > m.maxBytes {
+		m.remove(m.lru.Back())
+	}
+	return nil
+}
+
+// Delete removes some tiles.
+func (m *Memory) Delete(_ context.Context, keys ...Key) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		if elem, ok := m.entries[key]; ok {
+			m.remove(elem)
+		}
+	}
+	return nil
+}
+
+// DeleteZoom removes all the tiles of a zoom level of some layer or map.
+func (m *Memory) DeleteZoom(_ context.Context, name string, z maptile.Zoom) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, elem := range m.entries {
+		if key.Name == name && key.Z == z {
+			m.remove(elem)
+		}
+	}
+	return nil
+}
+
+func (m *Memory) remove(elem *list.Element) {
+	e := m.lru.Remove(elem).(*memoryEntry)
+	delete(m.entries, e.key)
+	m.size -= e.size()
+}
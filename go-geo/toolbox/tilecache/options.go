@@ -0,0 +1,55 @@
+package tilecache
+
+import "github.com/fredbi/geo/pkg/maptile"
+
+// DefaultMaxInvalidatedTiles is the default number of tiles above which invalidations purge
+// entire zoom levels.
+const DefaultMaxInvalidatedTiles = 1 << 14
+
+type options struct {
+	minZoom, maxZoom    maptile.Zoom
+	maxInvalidatedTiles int
+	errorHandler        func(error)
+}
+
+func defaultOptions() options {
+	return options{
+		maxZoom:             maptile.MaxZ,
+		maxInvalidatedTiles: DefaultMaxInvalidatedTiles,
+		errorHandler:        func(error) {},
+	}
+}
+
+// An Option is a possible parameter to the cache.
+type Option func(*options)
+
+// WithZoomRange sets the zoom levels of the cached tiles, which are purged by invalidations.
+// The default is all zoom levels.
+func WithZoomRange(minZoom, maxZoom maptile.Zoom) Option {
+	return func(o *options) {
+		if minZoom <= maxZoom && maxZoom <= maptile.MaxZ {
+			o.minZoom, o.maxZoom = minZoom, maxZoom
+		}
+	}
+}
+
+// WithMaxInvalidatedTiles sets the number of tiles of a zoom level above which invalidations
+// purge the entire zoom level, rather than enumerating its tiles.
+// The default is DefaultMaxInvalidatedTiles.
+func WithMaxInvalidatedTiles(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.maxInvalidatedTiles = n
+		}
+	}
+}
+
+// WithErrorHandler sets a function called with the failures of the backend while rendering
+// tiles, which are otherwise ignored.
+func WithErrorHandler(fn func(error)) Option {
+	return func(o *options) {
+		if fn != nil {
+			o.errorHandler = fn
+		}
+	}
+}
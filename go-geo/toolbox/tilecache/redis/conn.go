@@ -0,0 +1,135 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Error is an error reply of the server.
+type Error string
+
+func (e Error) Error() string {
+	return "redis: " + string(e)
+}
+
+// conn is a connection to the server, exchanging commands and replies of the RESP protocol.
+type conn struct {
+	c       net.Conn
+	r       *bufio.Reader
+	w       *bufio.Writer
+	timeout time.Duration
+}
+
+func dial(ctx context.Context, addr string, timeout time.Duration) (*conn, error) {
+	d := net.Dialer{Timeout: timeout}
+	c, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{
+		c:       c,
+		r:       bufio.NewReader(c),
+		w:       bufio.NewWriter(c),
+		timeout: timeout,
+	}, nil
+}
+
+func (c *conn) Close() error {
+	return c.c.Close()
+}
+
+// do sends a command and reads its reply: a string, an int64, a []byte, an []interface{}
+// of replies, which may be of type Error, or nil.
+//
+// An error reply of the server is returned as an Error, which leaves the connection usable.
+func (c *conn) do(ctx context.Context, args ...[]byte) (interface{}, error) {
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	c.w.WriteString("*")
+	c.w.WriteString(strconv.Itoa(len(args)))
+	c.w.WriteString("\r\n")
+	for _, arg := range args {
+		c.w.WriteString("$")
+		c.w.WriteString(strconv.Itoa(len(arg)))
+		c.w.WriteString("\r\n")
+		c.w.Write(arg)
+		c.w.WriteString("\r\n")
+	}
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+	return c.readReply()
+}
+
+func (c *conn) readReply() (interface{}, error) {
+	line, err := c.r.ReadSlice('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.New("redis: invalid reply")
+	}
+	kind, s := line[0], string(line[1:len(line)-2])
+
+	switch kind {
+	case '+':
+		return s, nil
+	case '-':
+		return nil, Error(s)
+	case ':':
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid integer reply %q", s)
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid bulk string length %q", s)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, b); err != nil {
+			return nil, err
+		}
+		if b[n] != '\r' || b[n+1] != '\n' {
+			return nil, errors.New("redis: invalid bulk string")
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid array length %q", s)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		replies := make([]interface{}, n)
+		for i := range replies {
+			replies[i], err = c.readReply()
+			if e, ok := err.(Error); ok {
+				// the elements of arrays may be errors
+				replies[i] = e
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		return replies, nil
+	default:
+		return nil, fmt.Errorf("redis: invalid reply type %q", kind)
+	}
+}
@@ -0,0 +1,80 @@
+package redis
+
+import "time"
+
+const (
+	// DefaultPrefix is the default prefix of the keys of the tiles.
+	DefaultPrefix = "tiles:"
+
+	// DefaultPoolSize is the default maximum number of connections.
+	DefaultPoolSize = 10
+
+	// DefaultTimeout is the default timeout of connections and commands.
+	DefaultTimeout = 5 * time.Second
+)
+
+type options struct {
+	password string
+	db       int
+	prefix   string
+	ttl      time.Duration
+	poolSize int
+	timeout  time.Duration
+}
+
+func defaultOptions() options {
+	return options{
+		prefix:   DefaultPrefix,
+		poolSize: DefaultPoolSize,
+		timeout:  DefaultTimeout,
+	}
+}
+
+// An Option is a possible parameter to the backend.
+type Option func(*options)
+
+// WithPassword authenticates connections with a password.
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.password = password
+	}
+}
+
+// WithDB selects a database of the server. The default is 0.
+func WithDB(db int) Option {
+	return func(o *options) {
+		o.db = db
+	}
+}
+
+// WithPrefix sets the prefix of the keys of the tiles. The default is DefaultPrefix.
+func WithPrefix(prefix string) Option {
+	return func(o *options) {
+		o.prefix = prefix
+	}
+}
+
+// WithTTL makes cached tiles expire after some duration. By default, tiles do not expire.
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithPoolSize sets the maximum number of connections. The default is DefaultPoolSize.
+func WithPoolSize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.poolSize = n
+		}
+	}
+}
+
+// WithTimeout sets the timeout of connections and commands. The default is DefaultTimeout.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.timeout = d
+		}
+	}
+}
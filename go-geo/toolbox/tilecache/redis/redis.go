@@ -0,0 +1,235 @@
+// Package redis caches tiles in a server of the Redis protocol, such as Redis, KeyDB or Valkey.
+//
+// Tiles are stored as strings with keys {prefix}{name}/{z}/{x}/{y}.
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilecache"
+)
+
+// deleteBatchSize is the number of keys removed per DEL command.
+const deleteBatchSize = 512
+
+var _ tilecache.Backend = &Backend{}
+
+// Backend caches tiles in a server of the Redis protocol.
+//
+// Connections are opened on demand, and reused.
+//
+// A Backend is safe for concurrent use.
+type Backend struct {
+	addr string
+	options
+
+	// slots bounds the number of connections; idle holds the open connections not in use.
+	slots chan struct{}
+	idle  chan *conn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// New creates a backend storing tiles in the server at some address, such as "localhost:6379".
+func New(addr string, opts ...Option) *Backend {
+	o := defaultOptions()
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return &Backend{
+		addr:    addr,
+		options: o,
+		slots:   make(chan struct{}, o.poolSize),
+		idle:    make(chan *conn, o.poolSize),
+	}
+}
+
+// Get yields the data of a tile, and whether the tile is cached.
+func (b *Backend) Get(ctx context.Context, key tilecache.Key) ([]byte, bool, error) {
+	reply, err := b.do(ctx, []byte("GET"), b.key(key))
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	data, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected reply %T to GET", reply)
+	}
+	return data, true, nil
+}
+
+// Set caches the data of a tile, expiring after the TTL of the backend if any.
+func (b *Backend) Set(ctx context.Context, key tilecache.Key, data []byte) error {
+	args := [][]byte{[]byte("SET"), b.key(key), data}
+	if b.ttl > 0 {
+		args = append(args, []byte("PX"), []byte(strconv.FormatInt(b.ttl.Milliseconds(), 10)))
+	}
+	_, err := b.do(ctx, args...)
+	return err
+}
+
+// Delete removes some tiles.
+func (b *Backend) Delete(ctx context.Context, keys ...tilecache.Key) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > deleteBatchSize {
+			n = deleteBatchSize
+		}
+		args := make([][]byte, 0, n+1)
+		args = append(args, []byte("DEL"))
+		for _, key := range keys[:n] {
+			args = append(args, b.key(key))
+		}
+		if _, err := b.do(ctx, args...); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// DeleteZoom removes all the tiles of a zoom level of some layer or map, scanning the keys
+// of the server.
+func (b *Backend) DeleteZoom(ctx context.Context, name string, z maptile.Zoom) error {
+	pattern := b.prefix + escapePattern(name) + "/" + strconv.FormatUint(uint64(z), 10) + "/*"
+	cursor := []byte("0")
+	for {
+		reply, err := b.do(ctx, []byte("SCAN"), cursor,
+			[]byte("MATCH"), []byte(pattern),
+			[]byte("COUNT"), []byte(strconv.Itoa(deleteBatchSize)),
+		)
+		if err != nil {
+			return err
+		}
+		page, ok := reply.([]interface{})
+		if !ok || len(page) != 2 {
+			return errors.New("redis: unexpected reply to SCAN")
+		}
+		if cursor, ok = page[0].([]byte); !ok {
+			return errors.New("redis: unexpected reply to SCAN")
+		}
+		keys, _ := page[1].([]interface{})
+		if len(keys) > 0 {
+			args := make([][]byte, 0, len(keys)+1)
+			args = append(args, []byte("DEL"))
+			for _, key := range keys {
+				if key, ok := key.([]byte); ok {
+					args = append(args, key)
+				}
+			}
+			if _, err := b.do(ctx, args...); err != nil {
+				return err
+			}
+		}
+		if string(cursor) == "0" {
+			return nil
+		}
+	}
+}
+
+// Close closes the idle connections. Connections in use are closed once released.
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for {
+		select {
+		case c := <-b.idle:
+			_ = c.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+func (b *Backend) key(key tilecache.Key) []byte {
+	return []byte(b.prefix + key.String())
+}
+
+// do sends a command through a connection of the pool.
+func (b *Backend) do(ctx context.Context, args ...[]byte) (interface{}, error) {
+	c, err := b.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.do(ctx, args...)
+	b.put(c, err)
+	return reply, err
+}
+
+// get yields an idle connection, or else opens one, waiting while the pool is full.
+func (b *Backend) get(ctx context.Context) (*conn, error) {
+	select {
+	case b.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case c := <-b.idle:
+		return c, nil
+	default:
+	}
+
+	c, err := dial(ctx, b.addr, b.timeout)
+	if err == nil {
+		err = b.init(ctx, c)
+	}
+	if err != nil {
+		<-b.slots
+		return nil, fmt.Errorf("redis: connecting to %s: %v", b.addr, err)
+	}
+	return c, nil
+}
+
+// init authenticates a new connection and selects the database.
+func (b *Backend) init(ctx context.Context, c *conn) error {
+	var err error
+	if b.password != "" {
+		_, err = c.do(ctx, []byte("AUTH"), []byte(b.password))
+	}
+	if err == nil && b.db != 0 {
+		_, err = c.do(ctx, []byte("SELECT"), []byte(strconv.Itoa(b.db)))
+	}
+	if err != nil {
+		_ = c.Close()
+	}
+	return err
+}
+
+// put releases a connection, closed unless the last command left it usable.
+func (b *Backend) put(c *conn, err error) {
+	if _, ok := err.(Error); err != nil && !ok {
+		_ = c.Close()
+	} else {
+		b.mu.Lock()
+		if b.closed {
+			_ = c.Close()
+		} else {
+			b.idle <- c
+		}
+		b.mu.Unlock()
+	}
+	<-b.slots
+}
+
+// escapePattern escapes the special characters of the patterns of SCAN.
+func escapePattern(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,179 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/tilecache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer serves GET, SET, DEL, SCAN and AUTH commands from memory.
+// SCAN yields one key per page, and only matches patterns of prefixes such as tiles:roads/5/*.
+type fakeServer struct {
+	l        net.Listener
+	password string
+	mu       sync.Mutex
+	values   map[string]string
+	commands []string
+}
+
+func newFakeServer(t *testing.T, password string) *fakeServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{l: l, password: password, values: make(map[string]string)}
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(c)
+		}
+	}()
+	return s
+}
+
+var unescape = strings.NewReplacer(`\\`, `\`, `\*`, "*", `\?`, "?", `\[`, "[", `\]`, "]")
+
+func (s *fakeServer) serve(c net.Conn) {
+	defer c.Close()
+	r := &conn{r: bufio.NewReader(c)}
+	authenticated := s.password == ""
+	for {
+		reply, err := r.readReply()
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, arg := range reply.([]interface{}) {
+			args = append(args, string(arg.([]byte)))
+		}
+
+		s.mu.Lock()
+		s.commands = append(s.commands, args[0])
+		var out string
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[1] == s.password
+			out = "+OK\r\n"
+			if !authenticated {
+				out = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			out = "-NOAUTH Authentication required\r\n"
+		case args[0] == "GET":
+			if v, ok := s.values[args[1]]; ok {
+				out = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+			} else {
+				out = "$-1\r\n"
+			}
+		case args[0] == "SET":
+			s.values[args[1]] = args[2]
+			out = "+OK\r\n"
+		case args[0] == "DEL":
+			for _, key := range args[1:] {
+				delete(s.values, key)
+			}
+			out = fmt.Sprintf(":%d\r\n", len(args)-1)
+		case args[0] == "SCAN":
+			var keys []string
+			prefix := unescape.Replace(strings.TrimSuffix(args[3], "*"))
+			for key := range s.values {
+				if strings.HasPrefix(key, prefix) && key > args[1] {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			if len(keys) == 0 {
+				out = "*2\r\n$1\r\n0\r\n*0\r\n"
+			} else {
+				out = fmt.Sprintf("*2\r\n$%d\r\n%s\r\n*1\r\n$%d\r\n%s\r\n", len(keys[0]), keys[0], len(keys[0]), keys[0])
+			}
+		default:
+			out = "-ERR unknown command\r\n"
+		}
+		s.mu.Unlock()
+		if _, err := c.Write([]byte(out)); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeServer) snapshot() (map[string]string, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	values := make(map[string]string, len(s.values))
+	for k, v := range s.values {
+		values[k] = v
+	}
+	return values, append([]string(nil), s.commands...)
+}
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer(t, "secret")
+	defer s.l.Close()
+	b := New(s.l.Addr().String(), WithPassword("secret"), WithPoolSize(2))
+	defer b.Close()
+
+	key := tilecache.Key{Name: "roads", Z: 4, X: 3, Y: 5}
+	_, ok, err := b.Get(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, b.Set(ctx, key, []byte{}))
+	data, ok, err := b.Get(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, data)
+	values, _ := s.snapshot()
+	assert.Equal(t, map[string]string{"tiles:roads/4/3/5": ""}, values)
+
+	var wg sync.WaitGroup
+	for y := uint32(0); y < 8; y++ {
+		wg.Add(1)
+		go func(y uint32) {
+			defer wg.Done()
+			assert.NoError(t, b.Set(ctx, tilecache.Key{Name: "roads", Z: 5, X: 1, Y: y}, []byte("data")))
+		}(y)
+	}
+	wg.Wait()
+	require.NoError(t, b.Set(ctx, tilecache.Key{Name: "roads*", Z: 5, X: 1, Y: 1}, []byte("data")))
+	data, ok, err = b.Get(ctx, tilecache.Key{Name: "roads", Z: 5, X: 1, Y: 7})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "data", string(data))
+
+	require.NoError(t, b.Delete(ctx, key, tilecache.Key{Name: "roads", Z: 5, X: 1, Y: 0}))
+	require.NoError(t, b.DeleteZoom(ctx, "roads", 5))
+	values, commands := s.snapshot()
+	assert.Equal(t, map[string]string{"tiles:roads*/5/1/1": "data"}, values)
+
+	auths := strings.Count(strings.Join(commands, " "), "AUTH")
+	assert.True(t, auths >= 1 && auths <= 2, "connections are reused")
+}
+
+func TestBackendErrors(t *testing.T) {
+	ctx := context.Background()
+	s := newFakeServer(t, "secret")
+	defer s.l.Close()
+
+	b := New(s.l.Addr().String(), WithPassword("wrong"))
+	_, _, err := b.Get(ctx, tilecache.Key{Name: "roads"})
+	assert.Error(t, err)
+
+	b = New(s.l.Addr().String())
+	_, _, err = b.Get(ctx, tilecache.Key{Name: "roads"})
+	assert.Equal(t, Error("NOAUTH Authentication required"), err)
+	_, _, err = b.Get(ctx, tilecache.Key{Name: "roads"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, len(b.idle), "connections are reused after error replies")
+}
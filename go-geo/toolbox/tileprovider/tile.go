@@ -10,6 +10,11 @@ func NewTile(z, x, y uint) *Tile {
 	return newTile(z, x, y, float64(TileBuffer), WebMercator)
 }
 
+// NewTileWithBuffer creates a tile with a custom buffer, in MVT extent units.
+func NewTileWithBuffer(z, x, y uint, buffer float64) *Tile {
+	return newTile(z, x, y, buffer, WebMercator)
+}
+
 func newTile(z, x, y uint, buffer float64, srid uint64) *Tile {
 	return &Tile{z: z, x: x, y: y, buffer: buffer, srid: srid}
 }
@@ -0,0 +1,78 @@
+package tilerenderer
+
+import (
+	"fmt"
+
+	gsgeom "github.com/go-spatial/geom"
+	"github.com/twpayne/go-geom"
+)
+
+// convertGeometry converts a go-spatial geometry, as decoded by tile providers, to a go-geom geometry.
+//
+// go-spatial rings don't repeat their first point: rings are closed by the conversion.
+func convertGeometry(g gsgeom.Geometry) (geom.T, error) {
+	switch g := g.(type) {
+	case gsgeom.Pointer:
+		xy := g.XY()
+		return geom.NewPointFlat(geom.XY, xy[:]), nil
+	case gsgeom.MultiPointer:
+		return geom.NewMultiPointFlat(geom.XY, flatCoords(nil, g.Points())), nil
+	case gsgeom.LineStringer:
+		return geom.NewLineStringFlat(geom.XY, flatCoords(nil, g.Vertices())), nil
+	case gsgeom.MultiLineStringer:
+		var flat []float64
+		lines := g.LineStrings()
+		ends := make([]int, 0, len(lines))
+		for _, line := range lines {
+			flat = flatCoords(flat, line)
+			ends = append(ends, len(flat))
+		}
+		return geom.NewMultiLineStringFlat(geom.XY, flat, ends), nil
+	case gsgeom.Polygoner:
+		flat, ends := polygonFlatCoords(nil, g.LinearRings())
+		return geom.NewPolygonFlat(geom.XY, flat, ends), nil
+	case gsgeom.MultiPolygoner:
+		var flat []float64
+		polygons := g.Polygons()
+		endss := make([][]int, 0, len(polygons))
+		for _, rings := range polygons {
+			var ends []int
+			flat, ends = polygonFlatCoords(flat, rings)
+			endss = append(endss, ends)
+		}
+		return geom.NewMultiPolygonFlat(geom.XY, flat, endss), nil
+	case gsgeom.Collectioner:
+		collection := geom.NewGeometryCollection()
+		for _, member := range g.Geometries() {
+			converted, err := convertGeometry(member)
+			if err != nil {
+				return nil, err
+			}
+			if err := collection.Push(converted); err != nil {
+				return nil, err
+			}
+		}
+		return collection, nil
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %T", g)
+	}
+}
+
+func flatCoords(flat []float64, points [][2]float64) []float64 {
+	for _, p := range points {
+		flat = append(flat, p[0], p[1])
+	}
+	return flat
+}
+
+func polygonFlatCoords(flat []float64, rings [][][2]float64) ([]float64, []int) {
+	ends := make([]int, 0, len(rings))
+	for _, ring := range rings {
+		flat = flatCoords(flat, ring)
+		if n := len(ring); n > 0 && ring[0] != ring[n-1] {
+			flat = append(flat, ring[0][0], ring[0][1])
+		}
+		ends = append(ends, len(flat))
+	}
+	return flat, ends
+}
@@ -0,0 +1,37 @@
+package tilerenderer
+
+import (
+	"github.com/fredbi/geo/pkg/tileprovider"
+)
+
+type options struct {
+	buffer   float64
+	simplify bool
+}
+
+func defaultOptions() options {
+	return options{
+		buffer:   float64(tileprovider.TileBuffer),
+		simplify: true,
+	}
+}
+
+// An Option is a possible parameter to the renderer.
+type Option func(*options)
+
+// WithBuffer sets the buffer around tiles, in MVT extent units.
+// Features are fetched and clipped with this buffer. The default is tileprovider.TileBuffer.
+func WithBuffer(buffer float64) Option {
+	return func(o *options) {
+		if buffer >= 0 {
+			o.buffer = buffer
+		}
+	}
+}
+
+// WithSimplify enables or disables the simplification of geometries. It is enabled by default.
+func WithSimplify(yes bool) Option {
+	return func(o *options) {
+		o.simplify = yes
+	}
+}
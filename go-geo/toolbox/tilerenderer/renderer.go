@@ -0,0 +1,192 @@
+// Package tilerenderer renders Mapbox vector tiles from the features of a tile provider.
+//
+// For every configured layer, the features of a tile are fetched from the provider,
+// converted to go-geom geometries, projected to tile coordinates, clipped to the buffered
+// tile bound, generalized, simplified and finally encoded as gzipped MVT. Features of geometry collections
+// are encoded as several features, one per MVT geometry type, sharing their ID and properties.
+package tilerenderer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/project"
+	"github.com/fredbi/geo/pkg/simplify"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/go-spatial/tegola/provider"
+)
+
+// LayerConfig configures a layer of the rendered tiles.
+type LayerConfig struct {
+	// Name is the name of the MVT layer.
+	Name string
+
+	// ProviderLayer is the name of the layer of the provider. It defaults to Name.
+	ProviderLayer string
+
+	// MinZoom and MaxZoom bound the zoom levels of the tiles featuring the layer.
+	// A zero MaxZoom means no maximum.
+	MinZoom, MaxZoom maptile.Zoom
+
+	// Generalization are the rules generalizing the properties of features and merging features,
+	// by zoom level. Features are generalized before they are simplified and before their collisions
+	// are removed: collision priorities refer to generalized properties.
+	Generalization mvt.GeneralizationRules
+
+	// Collisions are the rules removing colliding features, by zoom level.
+	Collisions mvt.CollisionRules
+}
+
+// InZoomRange tells if tiles at some zoom level feature the layer.
+func (c LayerConfig) InZoomRange(z maptile.Zoom) bool {
+	return z >= c.MinZoom && (c.MaxZoom == 0 || z <= c.MaxZoom)
+}
+
+func (c LayerConfig) providerLayer() string {
+	if c.ProviderLayer != "" {
+		return c.ProviderLayer
+	}
+	return c.Name
+}
+
+// Renderer renders tiles from a provider.
+//
+// A Renderer is safe for concurrent use.
+type Renderer struct {
+	provider provider.Tiler
+	layers   []LayerConfig
+	options
+}
+
+// New creates a renderer of some layers of a provider.
+func New(p provider.Tiler, layers []LayerConfig, opts ...Option) *Renderer {
+	r := &Renderer{
+		provider: p,
+		layers:   layers,
+		options:  defaultOptions(),
+	}
+	for _, apply := range opts {
+		apply(&r.options)
+	}
+	return r
+}
+
+// Render renders a tile as gzipped MVT, with the rings of polygons wound as the specification requires.
+//
+// It returns nil data when no layer has any feature in the tile.
+func (r *Renderer) Render(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	layers, err := r.Layers(ctx, tile)
+	if err != nil {
+		return nil, err
+	}
+	if layers.Empty() {
+		return nil, nil
+	}
+	return mvt.MarshalGzipped(layers, mvt.WithWindingFix(true))
+}
+
+// Layers fetches the layers of a tile, projected to tile coordinates, clipped and simplified.
+//
+// Layers are fetched concurrently. Layers out of their zoom range, or without features, are omitted.
+func (r *Renderer) Layers(ctx context.Context, tile maptile.Tile) (mvt.Layers, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	layers := make(mvt.Layers, len(r.layers))
+	errs := make([]error, len(r.layers))
+	var wg sync.WaitGroup
+	for i, cfg := range r.layers {
+		if !cfg.InZoomRange(tile.Z) {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, cfg LayerConfig) {
+			defer wg.Done()
+			layers[i], errs[i] = r.layer(ctx, tile, cfg)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, cfg)
+	}
+	wg.Wait()
+
+	// a layer in error cancels the others: report its error rather than the cancellation
+	var firstErr error
+	for _, err := range errs {
+		if err != nil && (firstErr == nil || firstErr == context.Canceled) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	result := layers[:0]
+	for _, l := range layers {
+		if l != nil && len(l.Features) > 0 {
+			result = append(result, l)
+		}
+	}
+	return result, nil
+}
+
+func (r *Renderer) layer(ctx context.Context, tile maptile.Tile, cfg LayerConfig) (*mvt.Layer, error) {
+	fc := &geojson.FeatureCollection{}
+	ptile := tileprovider.NewTileWithBuffer(uint(tile.Z), uint(tile.X), uint(tile.Y), r.buffer)
+	err := r.provider.TileFeatures(ctx, cfg.providerLayer(), ptile, func(f *provider.Feature) error {
+		g, err := convertGeometry(f.Geometry)
+		if err != nil {
+			return fmt.Errorf("layer %s: feature %d: %v", cfg.Name, f.ID, err)
+		}
+		if f.SRID == tileprovider.WebMercator {
+			if g, err = project.Geometry(g, project.Mercator.ToWGS84); err != nil {
+				return fmt.Errorf("layer %s: feature %d: %v", cfg.Name, f.ID, err)
+			}
+		}
+		fc.Features = append(fc.Features, &geojson.Feature{
+			ID:         f.ID,
+			Geometry:   g,
+			Properties: geojson.Properties(f.Tags),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	layer := mvt.NewLayer(cfg.Name, fc)
+	layer.ProjectToTile(tile)
+	extent := float64(layer.Extent)
+	layer.Clip(bound.NewBound(-r.buffer, -r.buffer, extent+r.buffer, extent+r.buffer))
+	if rule, ok := cfg.Generalization.At(tile.Z); ok {
+		layer.Generalize(rule)
+	}
+	if r.simplify {
+		if epsilon := tileEpsilon(tile, extent); epsilon > 0 {
+			layer.Simplify(simplify.DouglasPeucker(epsilon))
+		}
+	}
+	if rule, ok := cfg.Collisions.At(tile.Z); ok {
+		layer.RemoveCollisions(rule)
+	}
+	return layer, nil
+}
+
+// tileEpsilon yields the threshold of the simplification of geometries projected to tile coordinates,
+// i.e. the tolerance of the tile scaled to the extent of the layer.
+//
+// Geometries are not simplified at the maximum zoom level.
+func tileEpsilon(tile maptile.Tile, extent float64) float64 {
+	if tile.Z == maptile.MaxZ || tile.Tolerance <= 0 {
+		return 0
+	}
+	if tile.Extent <= 0 {
+		return tile.Tolerance
+	}
+	return tile.Tolerance * extent / tile.Extent
+}
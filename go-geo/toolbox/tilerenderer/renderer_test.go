@@ -0,0 +1,229 @@
+package tilerenderer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	gsgeom "github.com/go-spatial/geom"
+	"github.com/go-spatial/tegola/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+// fakeTiler serves the same features for every tile.
+type fakeTiler struct {
+	features map[string][]provider.Feature
+	err      error
+}
+
+func (f *fakeTiler) Layers() ([]provider.LayerInfo, error) {
+	return nil, nil
+}
+
+func (f *fakeTiler) TileFeatures(ctx context.Context, layer string, t provider.Tile, fn func(*provider.Feature) error) error {
+	if f.err != nil {
+		return f.err
+	}
+	for i := range f.features[layer] {
+		if err := fn(&f.features[layer][i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRenderCollections(t *testing.T) {
+	tiler := &fakeTiler{features: map[string][]provider.Feature{
+		"sites": {
+			{
+				ID: 3,
+				Geometry: gsgeom.Collection{
+					gsgeom.Point{1, 1},
+					gsgeom.LineString{{-10, -10}, {10, 10}},
+					gsgeom.Collection{gsgeom.Point{2, 2}},
+				},
+				SRID: tileprovider.WGS84,
+				Tags: map[string]interface{}{"kind": "site"},
+			},
+		},
+	}}
+	r := New(tiler, []LayerConfig{{Name: "sites"}})
+
+	data, err := r.Render(context.Background(), maptile.New(0, 0, 0))
+	require.NoError(t, err)
+	decoded, err := mvt.UnmarshalGzipped(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	require.Len(t, decoded[0].Features, 2, "the collection is split into points and lines")
+	_, ok := decoded[0].Features[0].Geometry.(*geom.MultiPoint)
+	assert.True(t, ok)
+	_, ok = decoded[0].Features[1].Geometry.(*geom.LineString)
+	assert.True(t, ok)
+	for _, f := range decoded[0].Features {
+		assert.Equal(t, 3.0, f.ID)
+		assert.Equal(t, "site", f.Properties["kind"])
+	}
+}
+
+func TestRender(t *testing.T) {
+	tiler := &fakeTiler{features: map[string][]provider.Feature{
+		"roads": {
+			{
+				ID:       1,
+				Geometry: gsgeom.LineString{{-10, -10}, {10, 10}},
+				SRID:     tileprovider.WGS84,
+				Tags:     map[string]interface{}{"class": "primary"},
+			},
+		},
+		"poi": {
+			{
+				ID:       2,
+				Geometry: gsgeom.Point{111319.49, 111325.14}, // (1, 1) in web mercator
+				SRID:     tileprovider.WebMercator,
+				Tags:     map[string]interface{}{"name": "somewhere"},
+			},
+		},
+	}}
+	r := New(tiler, []LayerConfig{
+		{Name: "roads"},
+		{Name: "places", ProviderLayer: "poi"},
+		{Name: "empty"},
+	})
+
+	tile := maptile.New(0, 0, 0)
+	layers, err := r.Layers(context.Background(), tile)
+	require.NoError(t, err)
+	require.Len(t, layers, 2)
+
+	assert.Equal(t, "roads", layers[0].Name)
+	require.Len(t, layers[0].Features, 1)
+	assert.Equal(t, uint64(1), layers[0].Features[0].ID)
+	assert.Equal(t, "primary", layers[0].Features[0].Properties["class"])
+	for _, v := range layers[0].Features[0].Geometry.FlatCoords() {
+		assert.InDelta(t, 2048, v, 200)
+	}
+
+	assert.Equal(t, "places", layers[1].Name)
+	require.Len(t, layers[1].Features, 1)
+	p, ok := layers[1].Features[0].Geometry.(*geom.Point)
+	require.True(t, ok)
+	assert.InDelta(t, 2059, p.X(), 1)
+	assert.InDelta(t, 2036, p.Y(), 1)
+
+	data, err := r.Render(context.Background(), tile)
+	require.NoError(t, err)
+	decoded, err := mvt.UnmarshalGzipped(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	assert.Equal(t, "roads", decoded[0].Name)
+
+	// out of the zoom range of the layers
+	r = New(tiler, []LayerConfig{{Name: "roads", MinZoom: 1}, {Name: "poi", MaxZoom: 1}})
+	layers, err = r.Layers(context.Background(), tile)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	assert.Equal(t, "poi", layers[0].Name)
+	layers, err = r.Layers(context.Background(), maptile.New(0, 0, 2))
+	require.NoError(t, err)
+	assert.Empty(t, layers)
+
+	// nothing in this tile
+	data, err = r.Render(context.Background(), maptile.New(0, 0, 4))
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
+
+func TestRenderSimplify(t *testing.T) {
+	// a road along the equator, wiggling by less than a tile unit at zoom 0
+	road := make(gsgeom.LineString, 0, 41)
+	for i := 0; i <= 40; i++ {
+		road = append(road, [2]float64{-10 + float64(i)/2, 0.01 * float64(i%2)})
+	}
+	tiler := &fakeTiler{features: map[string][]provider.Feature{
+		"roads": {{ID: 1, Geometry: road, SRID: tileprovider.WGS84}},
+	}}
+	tile := maptile.New(0, 0, 0)
+
+	layers, err := New(tiler, []LayerConfig{{Name: "roads"}}, WithSimplify(false)).Layers(context.Background(), tile)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	require.Len(t, layers[0].Features, 1)
+	assert.Len(t, layers[0].Features[0].Geometry.FlatCoords(), 2*len(road))
+
+	layers, err = New(tiler, []LayerConfig{{Name: "roads"}}).Layers(context.Background(), tile)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	require.Len(t, layers[0].Features, 1)
+	assert.Len(t, layers[0].Features[0].Geometry.FlatCoords(), 4, "only the ends of the road are kept")
+
+	assert.Equal(t, 10.0, tileEpsilon(tile, 4096))
+	assert.Equal(t, 5.0, tileEpsilon(tile, 2048))
+	assert.Zero(t, tileEpsilon(maptile.New(0, 0, maptile.MaxZ), 4096))
+}
+
+func TestRenderError(t *testing.T) {
+	failure := errors.New("failure")
+	r := New(&fakeTiler{err: failure}, []LayerConfig{{Name: "a"}, {Name: "b"}})
+
+	_, err := r.Render(context.Background(), maptile.New(0, 0, 0))
+	assert.Equal(t, failure, err)
+}
+
+func TestConvertGeometry(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		g        gsgeom.Geometry
+		expected geom.T
+	}{
+		{
+			name:     "point",
+			g:        gsgeom.Point{1, 2},
+			expected: geom.NewPointFlat(geom.XY, []float64{1, 2}),
+		},
+		{
+			name:     "multi point",
+			g:        gsgeom.MultiPoint{{1, 2}, {3, 4}},
+			expected: geom.NewMultiPointFlat(geom.XY, []float64{1, 2, 3, 4}),
+		},
+		{
+			name:     "line string",
+			g:        gsgeom.LineString{{1, 2}, {3, 4}},
+			expected: geom.NewLineStringFlat(geom.XY, []float64{1, 2, 3, 4}),
+		},
+		{
+			name:     "multi line string",
+			g:        gsgeom.MultiLineString{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}},
+			expected: geom.NewMultiLineStringFlat(geom.XY, []float64{1, 2, 3, 4, 5, 6, 7, 8}, []int{4, 8}),
+		},
+		{
+			name:     "polygon",
+			g:        gsgeom.Polygon{{{0, 0}, {1, 0}, {1, 1}}},
+			expected: geom.NewPolygonFlat(geom.XY, []float64{0, 0, 1, 0, 1, 1, 0, 0}, []int{8}),
+		},
+		{
+			name:     "multi polygon",
+			g:        gsgeom.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, {{{2, 2}, {3, 2}, {3, 3}}}},
+			expected: geom.NewMultiPolygonFlat(geom.XY, []float64{0, 0, 1, 0, 1, 1, 0, 0, 2, 2, 3, 2, 3, 3, 2, 2}, [][]int{{8}, {16}}),
+		},
+		{
+			name:     "collection",
+			g:        gsgeom.Collection{gsgeom.Point{1, 2}},
+			expected: geom.NewGeometryCollection().MustPush(geom.NewPointFlat(geom.XY, []float64{1, 2})),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := convertGeometry(tc.g)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, g)
+		})
+	}
+
+	_, err := convertGeometry(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,210 @@
+package tileseed
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/clip"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileprovider"
+	"github.com/twpayne/go-geom"
+)
+
+// Area is an area to cover with tiles, in WGS84 coordinates.
+type Area interface {
+	// Bound yields the bounding box of the area.
+	Bound() bound.Bound
+
+	// Intersects tells if the area intersects a tile.
+	Intersects(tile maptile.Tile) bool
+}
+
+type boundArea bound.Bound
+
+// NewBoundArea creates the area of a bounding box.
+func NewBoundArea(b bound.Bound) Area {
+	return boundArea(b)
+}
+
+func (a boundArea) Bound() bound.Bound {
+	return bound.Bound(a)
+}
+
+func (a boundArea) Intersects(tile maptile.Tile) bool {
+	tb := tile.Bound()
+	return tb.Min[0] <= a.Max[0] && a.Min[0] <= tb.Max[0] && tb.Min[1] <= a.Max[1] && a.Min[1] <= tb.Max[1]
+}
+
+type polygonArea struct {
+	polygons *geom.MultiPolygon
+	bound    bound.Bound
+}
+
+// NewPolygonArea creates the area of a polygon or multi polygon.
+func NewPolygonArea(g geom.T) (Area, error) {
+	var mp *geom.MultiPolygon
+	switch g := g.(type) {
+	case *geom.Polygon:
+		mp = geom.NewMultiPolygon(g.Layout())
+		if err := mp.Push(g); err != nil {
+			return nil, err
+		}
+	case *geom.MultiPolygon:
+		mp = g
+	default:
+		return nil, fmt.Errorf("tileseed: unsupported area geometry %T", g)
+	}
+	if mp.NumPolygons() == 0 {
+		return nil, errors.New("tileseed: empty area")
+	}
+
+	ext := mp.Bounds()
+	return &polygonArea{
+		polygons: mp,
+		bound:    bound.NewBound(ext.Min(0), ext.Min(1), ext.Max(0), ext.Max(1)),
+	}, nil
+}
+
+func (a *polygonArea) Bound() bound.Bound {
+	return a.bound
+}
+
+func (a *polygonArea) Intersects(tile maptile.Tile) bool {
+	if !boundArea(a.bound).Intersects(tile) {
+		return false
+	}
+	return intersects(tile.Bound(), a.polygons)
+}
+
+type geometryArea struct {
+	g     geom.T
+	bound bound.Bound
+}
+
+// NewGeometryArea creates the area covered by any geometry: tiles intersect the area when
+// they intersect the geometry, e.g. when some line crosses them.
+func NewGeometryArea(g geom.T) (Area, error) {
+	switch g := g.(type) {
+	case *geom.Polygon, *geom.MultiPolygon:
+		return NewPolygonArea(g)
+	case *geom.Point, *geom.MultiPoint, *geom.LineString, *geom.MultiLineString, *geom.GeometryCollection:
+		ext := g.Bounds()
+		if ext.IsEmpty() {
+			return nil, errors.New("tileseed: empty area")
+		}
+		return &geometryArea{
+			g:     g,
+			bound: bound.NewBound(ext.Min(0), ext.Min(1), ext.Max(0), ext.Max(1)),
+		}, nil
+	default:
+		return nil, fmt.Errorf("tileseed: unsupported area geometry %T", g)
+	}
+}
+
+func (a *geometryArea) Bound() bound.Bound {
+	return a.bound
+}
+
+func (a *geometryArea) Intersects(tile maptile.Tile) bool {
+	if !boundArea(a.bound).Intersects(tile) {
+		return false
+	}
+	return intersects(tile.Bound(), a.g)
+}
+
+// intersects tells if a geometry intersects a bound.
+//
+// Clipping works in place: copies of lines and polygons are clipped. A polygon clipped outside
+// of the bound degenerates to its edges along the bound, with no area. The area is signed by
+// the winding order.
+func intersects(b bound.Bound, g geom.T) bool {
+	switch g := g.(type) {
+	case *geom.Point:
+		return b.Contains(g.Coords())
+	case *geom.MultiPoint:
+		for i := 0; i < g.NumPoints(); i++ {
+			if b.Contains(g.Point(i).Coords()) {
+				return true
+			}
+		}
+		return false
+	case *geom.LineString:
+		clipped := clip.LineString(b, g.Clone())
+		return clipped != nil && clipped.NumLineStrings() > 0
+	case *geom.MultiLineString:
+		clipped := clip.MultiLineString(b, g.Clone())
+		return clipped != nil && clipped.NumLineStrings() > 0
+	case *geom.Polygon:
+		clipped := clip.Polygon(b, g.Clone())
+		return clipped != nil && math.Abs(clipped.Area()) > 0
+	case *geom.MultiPolygon:
+		clipped := clip.MultiPolygon(b, g.Clone())
+		return clipped != nil && math.Abs(clipped.Area()) > 0
+	case *geom.GeometryCollection:
+		for i := 0; i < g.NumGeoms(); i++ {
+			if intersects(b, g.Geom(i)) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Count yields the number of tiles of a zoom range intersecting an area.
+func Count(area Area, minZoom, maxZoom maptile.Zoom) int64 {
+	var n int64
+	_ = Tiles(area, minZoom, maxZoom, func(maptile.Tile) error {
+		n++
+		return nil
+	})
+	return n
+}
+
+// Tiles calls a function for all the tiles of a zoom range intersecting an area,
+// by increasing zoom levels, then columns and rows.
+//
+// Iteration stops on the first error of the function, which Tiles returns.
+func Tiles(area Area, minZoom, maxZoom maptile.Zoom, fn func(maptile.Tile) error) error {
+	b := area.Bound()
+	for z := minZoom; z <= maxZoom; z++ {
+		minX, minY, maxX, maxY := TileRange(b, z)
+		for x := minX; x <= maxX; x++ {
+			for y := minY; y <= maxY; y++ {
+				tile := maptile.New(x, y, z)
+				if !area.Intersects(tile) {
+					continue
+				}
+				if err := fn(tile); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// TileRange yields the range of the columns and rows of the tiles of a zoom level covering a bound.
+func TileRange(b bound.Bound, z maptile.Zoom) (minX, minY, maxX, maxY uint32) {
+	minX, minY = tileAt(b.Min[0], b.Max[1], z)
+	maxX, maxY = tileAt(b.Max[0], b.Min[1], z)
+	return minX, minY, maxX, maxY
+}
+
+// tileAt yields the column and row of the tile at some coordinates.
+func tileAt(lon, lat float64, z maptile.Zoom) (uint32, uint32) {
+	maxLat := tileprovider.WGS84Bounds[3]
+	lat = math.Max(-maxLat, math.Min(maxLat, lat))
+	n := math.Exp2(float64(z))
+	x := (lon + 180) / 360 * n
+	rad := lat * math.Pi / 180
+	y := (1 - math.Log(math.Tan(rad)+1/math.Cos(rad))/math.Pi) / 2 * n
+	return clamp(x, n), clamp(y, n)
+}
+
+func clamp(v, n float64) uint32 {
+	return uint32(math.Max(0, math.Min(n-1, math.Floor(v))))
+}
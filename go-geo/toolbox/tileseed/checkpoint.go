@@ -0,0 +1,68 @@
+package tileseed
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/fredbi/geo/pkg/maptile"
+)
+
+// checkpoint is the progress of a run, saved to resume it.
+//
+// Tiles are enumerated in a stable order: Done is the number of the first tiles of the run
+// which are all rendered and stored.
+type checkpoint struct {
+	Bound   [4]float64   `json:"bound"`
+	MinZoom maptile.Zoom `json:"minZoom"`
+	MaxZoom maptile.Zoom `json:"maxZoom"`
+	Total   int64        `json:"total"`
+	Done    int64        `json:"done"`
+}
+
+// sameRun tells if two checkpoints are of the same run.
+func (c checkpoint) sameRun(other checkpoint) bool {
+	return c.Bound == other.Bound && c.MinZoom == other.MinZoom && c.MaxZoom == other.MaxZoom && c.Total == other.Total
+}
+
+// loadCheckpoint loads a checkpoint, or yields false if there is none.
+func loadCheckpoint(path string) (checkpoint, bool, error) {
+	var c checkpoint
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, false, nil
+	}
+	if err != nil {
+		return c, false, fmt.Errorf("tileseed: reading checkpoint: %v", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, false, fmt.Errorf("tileseed: decoding checkpoint %s: %v", path, err)
+	}
+	return c, true, nil
+}
+
+// save writes a checkpoint, atomically replacing the former one.
+func (c checkpoint) save(path string) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("tileseed: writing checkpoint: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("tileseed: writing checkpoint: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("tileseed: writing checkpoint: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("tileseed: writing checkpoint: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package tileseed
+
+import (
+	"runtime"
+	"time"
+)
+
+// DefaultProgressInterval is the default interval between progress reports and checkpoints.
+const DefaultProgressInterval = 10 * time.Second
+
+type options struct {
+	workers          int
+	progress         func(Progress)
+	progressInterval time.Duration
+	checkpoint       string
+}
+
+func defaultOptions() options {
+	return options{
+		workers:          runtime.NumCPU(),
+		progressInterval: DefaultProgressInterval,
+	}
+}
+
+// An Option is a possible parameter to the seeder.
+type Option func(*options)
+
+// WithWorkers sets the number of tiles rendered concurrently. The default is the number of CPUs.
+func WithWorkers(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.workers = n
+		}
+	}
+}
+
+// WithProgress sets a function called with the progress of runs, at regular intervals and when runs end.
+func WithProgress(fn func(Progress)) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithProgressInterval sets the interval between progress reports and checkpoints.
+// The default is DefaultProgressInterval.
+func WithProgressInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.progressInterval = d
+		}
+	}
+}
+
+// WithCheckpoint makes runs resumable, saving their progress to a checkpoint file.
+//
+// A run starting with an existing checkpoint file skips the tiles done by the former run.
+// The checkpoint file is removed once a run completes.
+func WithCheckpoint(path string) Option {
+	return func(o *options) {
+		o.checkpoint = path
+	}
+}
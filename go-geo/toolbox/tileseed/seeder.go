@@ -0,0 +1,254 @@
+// Package tileseed pre-generates the tiles of an area for a zoom range, into a tile store.
+//
+// Tiles are rendered concurrently by a bounded pool of workers. Empty tiles are not stored.
+// With a checkpoint file, an interrupted run resumes where it stopped.
+package tileseed
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+// Renderer renders the layers of tiles, e.g. a tilerenderer.Renderer.
+type Renderer interface {
+	Layers(ctx context.Context, tile maptile.Tile) (mvt.Layers, error)
+}
+
+// Progress is the progress of a run.
+type Progress struct {
+	// Total is the number of tiles of the run.
+	Total int64
+
+	// Done is the number of tiles rendered so far, including the ones of a resumed run.
+	Done int64
+
+	// Resumed is the number of tiles done by a former run.
+	Resumed int64
+
+	// Empty is the number of empty tiles, which are not stored.
+	Empty int64
+
+	// Elapsed is the duration of the run so far.
+	Elapsed time.Duration
+}
+
+// Seeder renders tiles into a store.
+type Seeder struct {
+	renderer Renderer
+	store    tilestore.Writer
+	options
+}
+
+// New creates a seeder of tiles rendered by some renderer into some store.
+func New(r Renderer, store tilestore.Writer, opts ...Option) *Seeder {
+	s := &Seeder{
+		renderer: r,
+		store:    store,
+		options:  defaultOptions(),
+	}
+	for _, apply := range opts {
+		apply(&s.options)
+	}
+	return s
+}
+
+type job struct {
+	index int64
+	tile  maptile.Tile
+}
+
+// run is the state of a run of the seeder.
+type run struct {
+	checkpoint
+	start time.Time
+	empty int64 // atomic
+	done  int64 // atomic, may exceed checkpoint.Done
+
+	mu        sync.Mutex
+	completed map[int64]bool // done tiles after checkpoint.Done
+}
+
+// complete records a done tile, advancing the checkpoint past the tiles all done.
+func (r *run) complete(index int64) {
+	atomic.AddInt64(&r.done, 1)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.completed[index] = true
+	for r.completed[r.checkpoint.Done] {
+		delete(r.completed, r.checkpoint.Done)
+		r.checkpoint.Done++
+	}
+}
+
+func (r *run) progress(resumed int64) Progress {
+	return Progress{
+		Total:   r.Total,
+		Done:    atomic.LoadInt64(&r.done),
+		Resumed: resumed,
+		Empty:   atomic.LoadInt64(&r.empty),
+		Elapsed: time.Since(r.start),
+	}
+}
+
+// Seed renders and stores the tiles of a zoom range intersecting an area.
+//
+// The store is not closed: the caller sets its metadata and closes it.
+func (s *Seeder) Seed(ctx context.Context, area Area, minZoom, maxZoom maptile.Zoom) (Progress, error) {
+	b := area.Bound()
+	r := &run{
+		checkpoint: checkpoint{
+			Bound:   [4]float64{b.Min[0], b.Min[1], b.Max[0], b.Max[1]},
+			MinZoom: minZoom,
+			MaxZoom: maxZoom,
+			Total:   Count(area, minZoom, maxZoom),
+		},
+		start:     time.Now(),
+		completed: make(map[int64]bool),
+	}
+	if s.checkpoint != "" {
+		former, ok, err := loadCheckpoint(s.checkpoint)
+		if err != nil {
+			return Progress{}, err
+		}
+		if ok && !former.sameRun(r.checkpoint) {
+			return Progress{}, fmt.Errorf("tileseed: checkpoint %s is the one of another run", s.checkpoint)
+		}
+		r.checkpoint.Done = former.Done
+	}
+	resumed := r.checkpoint.Done
+	r.done = resumed
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan job, s.workers)
+	go func() {
+		defer close(jobs)
+		var index int64
+		_ = Tiles(area, minZoom, maxZoom, func(tile maptile.Tile) error {
+			defer func() { index++ }()
+			if index < resumed {
+				return nil
+			}
+			select {
+			case jobs <- job{index: index, tile: tile}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if err := s.seed(ctx, r, j.tile); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				r.complete(j.index)
+			}
+		}()
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+	ticker := time.NewTicker(s.progressInterval)
+	defer ticker.Stop()
+	for running := true; running; {
+		select {
+		case <-ticker.C:
+			if err := s.save(r); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+			s.report(r.progress(resumed))
+		case <-finished:
+			running = false
+		}
+	}
+
+	err := firstErr
+	if err == nil {
+		// workers stop on the cancellation of the parent context without error
+		err = ctx.Err()
+	}
+	if err != nil {
+		if saveErr := s.save(r); saveErr != nil {
+			err = fmt.Errorf("%v (%v)", err, saveErr)
+		}
+	} else if s.checkpoint != "" {
+		if rmErr := os.Remove(s.checkpoint); rmErr != nil && !os.IsNotExist(rmErr) {
+			err = fmt.Errorf("tileseed: removing checkpoint: %v", rmErr)
+		}
+	}
+	p := r.progress(resumed)
+	s.report(p)
+	return p, err
+}
+
+// seed renders and stores a tile.
+func (s *Seeder) seed(ctx context.Context, r *run, tile maptile.Tile) error {
+	layers, err := s.renderer.Layers(ctx, tile)
+	if err != nil {
+		return fmt.Errorf("tileseed: rendering tile %d/%d/%d: %v", tile.Z, tile.X, tile.Y, err)
+	}
+	if layers.Empty() {
+		atomic.AddInt64(&r.empty, 1)
+		return nil
+	}
+	data, err := mvt.MarshalGzipped(layers, mvt.WithWindingFix(true))
+	if err != nil {
+		return fmt.Errorf("tileseed: encoding tile %d/%d/%d: %v", tile.Z, tile.X, tile.Y, err)
+	}
+	if err := s.store.WriteTile(ctx, tile, data); err != nil {
+		return fmt.Errorf("tileseed: storing tile %d/%d/%d: %v", tile.Z, tile.X, tile.Y, err)
+	}
+	return nil
+}
+
+// save persists the tiles stored so far and saves the checkpoint of a run.
+func (s *Seeder) save(r *run) error {
+	if s.checkpoint == "" {
+		return nil
+	}
+	// the checkpoint must not get ahead of the store
+	r.mu.Lock()
+	c := r.checkpoint
+	r.mu.Unlock()
+	if f, ok := s.store.(tilestore.Flusher); ok {
+		if err := f.Flush(); err != nil {
+			return fmt.Errorf("tileseed: flushing store: %v", err)
+		}
+	}
+	return c.save(s.checkpoint)
+}
+
+func (s *Seeder) report(p Progress) {
+	if s.progress != nil {
+		s.progress(p)
+	}
+}
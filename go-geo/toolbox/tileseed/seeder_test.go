@@ -0,0 +1,136 @@
+package tileseed
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/bound"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/pkg/tilestore/directory"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+// fakeRenderer renders a point in the tiles of even columns, and fails on some tile.
+type fakeRenderer struct {
+	fail     *maptile.Tile
+	mu       sync.Mutex
+	rendered []maptile.Tile
+}
+
+func (f *fakeRenderer) Layers(ctx context.Context, tile maptile.Tile) (mvt.Layers, error) {
+	if f.fail != nil && *f.fail == tile {
+		return nil, errors.New("failure")
+	}
+	f.mu.Lock()
+	f.rendered = append(f.rendered, tile)
+	f.mu.Unlock()
+	if tile.X%2 == 1 {
+		return mvt.Layers{mvt.NewLayer("points", geojson.NewFeatureCollection())}, nil
+	}
+	fc := geojson.NewFeatureCollection().Append(geojson.NewFeature(geom.NewPointFlat(geom.XY, []float64{10, 10})))
+	return mvt.Layers{mvt.NewLayer("points", fc)}, nil
+}
+
+func TestTiles(t *testing.T) {
+	world := NewBoundArea(bound.NewBound(-180, -85, 180, 85))
+	assert.Equal(t, int64(1+4+16), Count(world, 0, 2))
+
+	// around Paris
+	paris := NewBoundArea(bound.NewBound(2.2, 48.8, 2.5, 48.9))
+	var tiles []maptile.Tile
+	require.NoError(t, Tiles(paris, 10, 11, func(tile maptile.Tile) error {
+		tiles = append(tiles, tile)
+		return nil
+	}))
+	assert.Equal(t, []maptile.Tile{
+		maptile.New(518, 352, 10),
+		maptile.New(519, 352, 10),
+		maptile.New(1036, 704, 11),
+		maptile.New(1036, 705, 11),
+		maptile.New(1037, 704, 11),
+		maptile.New(1037, 705, 11),
+		maptile.New(1038, 704, 11),
+		maptile.New(1038, 705, 11),
+	}, tiles)
+
+	// a triangle over the north west and south east quarters of the world misses the north east one
+	triangle := geom.NewPolygonFlat(geom.XY, []float64{-170, 80, 170, -80, -170, -80, -170, 80}, []int{8})
+	area, err := NewPolygonArea(triangle)
+	require.NoError(t, err)
+	tiles = nil
+	require.NoError(t, Tiles(area, 1, 1, func(tile maptile.Tile) error {
+		tiles = append(tiles, tile)
+		return nil
+	}))
+	assert.Equal(t, []maptile.Tile{maptile.New(0, 0, 1), maptile.New(0, 1, 1), maptile.New(1, 1, 1)}, tiles)
+
+	_, err = NewPolygonArea(geom.NewPointFlat(geom.XY, []float64{1, 2}))
+	assert.Error(t, err)
+}
+
+func TestSeed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tileseed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store, err := directory.New(filepath.Join(dir, "tiles"), "pbf")
+	require.NoError(t, err)
+	checkpointPath := filepath.Join(dir, "checkpoint.json")
+	world := NewBoundArea(bound.NewBound(-180, -85, 180, 85))
+
+	// fail in the middle of zoom 2
+	failing := maptile.New(2, 3, 2)
+	r := &fakeRenderer{fail: &failing}
+	var reports []Progress
+	s := New(r, store, WithWorkers(1), WithCheckpoint(checkpointPath), WithProgress(func(p Progress) {
+		reports = append(reports, p)
+	}))
+	p, err := s.Seed(context.Background(), world, 0, 2)
+	require.Error(t, err)
+	assert.Equal(t, int64(21), p.Total)
+	assert.Equal(t, int64(1+4+11), p.Done)
+	require.NotEmpty(t, reports)
+	assert.Equal(t, p, reports[len(reports)-1])
+
+	data, err := store.ReadTile(context.Background(), maptile.New(2, 2, 2))
+	require.NoError(t, err)
+	layers, err := mvt.UnmarshalGzipped(data)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	assert.Equal(t, "points", layers[0].Name)
+
+	data, err = store.ReadTile(context.Background(), maptile.New(1, 0, 1))
+	require.NoError(t, err)
+	assert.Nil(t, data, "empty tiles are not stored")
+
+	// resume
+	r = &fakeRenderer{}
+	s = New(r, store, WithWorkers(3), WithCheckpoint(checkpointPath))
+	p, err = s.Seed(context.Background(), world, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, Progress{Total: 21, Done: 21, Resumed: 16, Empty: 4, Elapsed: p.Elapsed}, p)
+	assert.Len(t, r.rendered, 5)
+	for _, tile := range r.rendered {
+		assert.Equal(t, maptile.Zoom(2), tile.Z)
+		assert.True(t, tile.X >= 2)
+	}
+	_, err = os.Stat(checkpointPath)
+	assert.True(t, os.IsNotExist(err), "the checkpoint is removed once done")
+
+	data, err = store.ReadTile(context.Background(), maptile.New(2, 3, 2))
+	require.NoError(t, err)
+	assert.NotNil(t, data)
+
+	// a checkpoint of another run
+	require.NoError(t, checkpoint{Total: 21, Done: 3}.save(checkpointPath))
+	_, err = s.Seed(context.Background(), world, 0, 2)
+	assert.Error(t, err)
+}
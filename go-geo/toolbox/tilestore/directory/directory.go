@@ -0,0 +1,145 @@
+// Package directory stores tiles as files of a directory tree: {z}/{x}/{y}.{ext},
+// as served by static file servers and object storage.
+//
+// The metadata is stored as metadata.json at the root of the tree.
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+// MetadataFile is the name of the file with the metadata, at the root of the tree.
+const MetadataFile = "metadata.json"
+
+var (
+	_ tilestore.Reader = &Store{}
+	_ tilestore.Writer = &Store{}
+)
+
+// Store is a directory tree of tiles.
+//
+// Tiles are written atomically: an interrupted write leaves no partial tile.
+//
+// A Store is safe for concurrent use.
+type Store struct {
+	root     string
+	ext      string
+	metadata *tilestore.Metadata
+	mu       sync.Mutex
+}
+
+// New creates a store of tiles in a directory, created if needed.
+// The files of the tiles have some extension, e.g. "pbf".
+func New(root, ext string) (*Store, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("directory: creating store: %v", err)
+	}
+	return &Store{root: root, ext: ext}, nil
+}
+
+// Path yields the path of the file of a tile.
+func (s *Store) Path(tile maptile.Tile) string {
+	return filepath.Join(
+		s.root,
+		strconv.FormatUint(uint64(tile.Z), 10),
+		strconv.FormatUint(uint64(tile.X), 10),
+		strconv.FormatUint(uint64(tile.Y), 10)+"."+s.ext,
+	)
+}
+
+// ReadTile yields the data of a tile, or nil if the store has no such tile.
+func (s *Store) ReadTile(_ context.Context, tile maptile.Tile) ([]byte, error) {
+	data, err := ioutil.ReadFile(s.Path(tile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("directory: reading tile: %v", err)
+	}
+	return data, nil
+}
+
+// Metadata yields the metadata of the store.
+func (s *Store) Metadata(_ context.Context) (tilestore.Metadata, error) {
+	var m tilestore.Metadata
+	b, err := ioutil.ReadFile(filepath.Join(s.root, MetadataFile))
+	if err != nil {
+		return m, fmt.Errorf("directory: reading metadata: %v", err)
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("directory: decoding metadata: %v", err)
+	}
+	return m, nil
+}
+
+// WriteTile writes the data of a tile. Empty data removes the tile.
+func (s *Store) WriteTile(_ context.Context, tile maptile.Tile, data []byte) error {
+	path := s.Path(tile)
+	if len(data) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("directory: removing tile: %v", err)
+		}
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("directory: writing tile: %v", err)
+	}
+	if err := writeFile(path, data); err != nil {
+		return fmt.Errorf("directory: writing tile: %v", err)
+	}
+	return nil
+}
+
+// SetMetadata sets the metadata of the store, written on Close.
+func (s *Store) SetMetadata(m tilestore.Metadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metadata = &m
+}
+
+// Close writes the metadata, if set.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.metadata == nil {
+		return nil
+	}
+	b, err := json.MarshalIndent(s.metadata.WithDefaults(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("directory: encoding metadata: %v", err)
+	}
+	if err := writeFile(filepath.Join(s.root, MetadataFile), b); err != nil {
+		return fmt.Errorf("directory: writing metadata: %v", err)
+	}
+	return nil
+}
+
+// writeFile writes a file through a temporary file, renamed once complete.
+func writeFile(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
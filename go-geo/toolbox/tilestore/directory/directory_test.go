@@ -0,0 +1,49 @@
+package directory
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "tilestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := New(dir, "pbf")
+	require.NoError(t, err)
+	tile := maptile.New(3, 5, 4)
+	assert.Equal(t, filepath.Join(dir, "4", "3", "5.pbf"), s.Path(tile))
+
+	require.NoError(t, s.WriteTile(ctx, tile, []byte("data")))
+	data, err := s.ReadTile(ctx, tile)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	require.NoError(t, s.WriteTile(ctx, tile, nil))
+	data, err = s.ReadTile(ctx, tile)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+	require.NoError(t, s.WriteTile(ctx, tile, nil))
+
+	_, err = s.Metadata(ctx)
+	assert.Error(t, err)
+	s.SetMetadata(tilestore.Metadata{Name: "test", MaxZoom: 4})
+	require.NoError(t, s.Close())
+	m, err := s.Metadata(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tilestore.Metadata{Name: "test", MaxZoom: 4}.WithDefaults(), m)
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "4", "3"))
+	require.NoError(t, err)
+	assert.Empty(t, files, "no temporary file is left")
+}
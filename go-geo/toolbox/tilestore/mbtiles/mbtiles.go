@@ -0,0 +1,65 @@
+// Package mbtiles reads and writes MBTiles archives,
+// see https://github.com/mapbox/mbtiles-spec/blob/master/1.3/spec.md.
+//
+// MBTiles archives are SQLite databases. The package works with any database/sql driver of SQLite,
+// opened by the caller, e.g.:
+//
+//	db, err := sql.Open("sqlite3", "basemap.mbtiles")
+//
+// Writers store identical tile data once: tiles are a view joining a map of tile coordinates
+// with the images, keyed by the hash of their data.
+//
+// As in the specification, rows are numbered from the south (TMS), whereas the Y of maptile.Tile
+// is numbered from the north (XYZ).
+package mbtiles
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fredbi/geo/pkg/maptile"
+)
+
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS metadata (name TEXT PRIMARY KEY, value TEXT)`,
+	`CREATE TABLE IF NOT EXISTS map (
+		zoom_level INTEGER NOT NULL,
+		tile_column INTEGER NOT NULL,
+		tile_row INTEGER NOT NULL,
+		tile_id TEXT NOT NULL,
+		PRIMARY KEY (zoom_level, tile_column, tile_row)
+	)`,
+	`CREATE TABLE IF NOT EXISTS images (tile_id TEXT PRIMARY KEY, tile_data BLOB NOT NULL)`,
+	`CREATE VIEW IF NOT EXISTS tiles AS
+		SELECT map.zoom_level AS zoom_level, map.tile_column AS tile_column, map.tile_row AS tile_row, images.tile_data AS tile_data
+		FROM map JOIN images ON images.tile_id = map.tile_id`,
+}
+
+// row yields the TMS row of a tile.
+func row(tile maptile.Tile) uint32 {
+	return uint32(1)<<tile.Z - 1 - tile.Y
+}
+
+func formatFloats(values []float64) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strings.Join(s, ",")
+}
+
+func parseFloats(s string, values []float64) error {
+	parts := strings.Split(s, ",")
+	if len(parts) != len(values) {
+		return fmt.Errorf("expected %d values, got %q", len(values), s)
+	}
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+	return nil
+}
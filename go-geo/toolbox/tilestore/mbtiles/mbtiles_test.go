@@ -0,0 +1,163 @@
+package mbtiles
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openDB opens an empty SQLite database, removed when the test ends.
+func openDB(t *testing.T) *sql.DB {
+	dir, err := ioutil.TempDir("", "mbtiles")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "test.mbtiles"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Skipf("sqlite3 is not available: %v", err)
+	}
+	return db
+}
+
+func count(t *testing.T, db *sql.DB, query string, args ...interface{}) int {
+	var n int
+	require.NoError(t, db.QueryRow(query, args...).Scan(&n))
+	return n
+}
+
+func TestRow(t *testing.T) {
+	assert.Equal(t, uint32(0), row(maptile.New(0, 0, 0)))
+	assert.Equal(t, uint32(1), row(maptile.New(0, 0, 1)))
+	assert.Equal(t, uint32(0), row(maptile.New(0, 1, 1)))
+	assert.Equal(t, uint32(1020), row(maptile.New(3, 3, 10)))
+}
+
+func TestMetadata(t *testing.T) {
+	m := tilestore.Metadata{
+		Name:        "basemap",
+		Attribution: "© contributors",
+		Format:      "pbf",
+		Bounds:      [4]float64{-5.5, 41.25, 10, 51.5},
+		Center:      [3]float64{2.35, 48.85, 5},
+		MinZoom:     2,
+		MaxZoom:     14,
+		VectorLayers: []tilestore.VectorLayer{
+			{ID: "roads", Fields: map[string]string{"class": "String"}, MinZoom: 6, MaxZoom: 14},
+		},
+	}
+	rows, err := encodeMetadata(m)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"name":        "basemap",
+		"attribution": "© contributors",
+		"format":      "pbf",
+		"bounds":      "-5.5,41.25,10,51.5",
+		"center":      "2.35,48.85,5",
+		"minzoom":     "2",
+		"maxzoom":     "14",
+		"json":        `{"vector_layers":[{"id":"roads","fields":{"class":"String"},"minzoom":6,"maxzoom":14}]}`,
+	}, rows)
+
+	decoded, err := decodeMetadata(rows)
+	require.NoError(t, err)
+	assert.Equal(t, m, decoded)
+
+	for _, rows := range []map[string]string{
+		{"bounds": "1,2,3"},
+		{"center": "a,b,c"},
+		{"minzoom": "-1"},
+		{"json": "{"},
+	} {
+		_, err := decodeMetadata(rows)
+		assert.Error(t, err, rows)
+	}
+}
+
+func TestWriterReader(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	w, err := NewWriter(ctx, db, WithBatchSize(2))
+	require.NoError(t, err)
+	r := NewReader(db)
+
+	tiles := map[maptile.Tile]string{
+		maptile.New(0, 0, 0):  "world",
+		maptile.New(0, 0, 1):  "ocean",
+		maptile.New(1, 1, 1):  "ocean",
+		maptile.New(3, 3, 10): "land",
+	}
+	for tile, data := range tiles {
+		require.NoError(t, w.WriteTile(ctx, tile, []byte(data)))
+	}
+	require.NoError(t, w.Flush())
+
+	for tile, data := range tiles {
+		got, err := r.ReadTile(ctx, tile)
+		require.NoError(t, err)
+		assert.Equal(t, data, string(got), tile)
+	}
+	got, err := r.ReadTile(ctx, maptile.New(1, 0, 1))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	// identical data is stored once
+	assert.Equal(t, 4, count(t, db, `SELECT COUNT(*) FROM map`))
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM images`))
+
+	// rows are numbered from the south in the database
+	assert.Equal(t, 1, count(t, db, `SELECT COUNT(*) FROM map WHERE zoom_level = 1 AND tile_column = 0 AND tile_row = 1`))
+	assert.Equal(t, 1, count(t, db, `SELECT COUNT(*) FROM tiles WHERE zoom_level = 10 AND tile_column = 3 AND tile_row = 1020`))
+
+	m := tilestore.Metadata{Name: "basemap", VectorLayers: []tilestore.VectorLayer{{ID: "roads"}}}
+	w.SetMetadata(m)
+	require.NoError(t, w.Close())
+	assert.Equal(t, errClosed, w.WriteTile(ctx, maptile.New(0, 0, 0), []byte("world")))
+	assert.Equal(t, errClosed, w.Close())
+
+	decoded, err := r.Metadata(ctx)
+	require.NoError(t, err)
+	m.MaxZoom = 10 // the zoom range of the written tiles
+	assert.Equal(t, m.WithDefaults(), decoded)
+}
+
+func TestWriterCollectsUnusedImages(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	w, err := NewWriter(ctx, db)
+	require.NoError(t, err)
+
+	a, b := maptile.New(0, 0, 1), maptile.New(1, 0, 1)
+	require.NoError(t, w.WriteTile(ctx, a, []byte("first")))
+	require.NoError(t, w.WriteTile(ctx, b, []byte("second")))
+	require.NoError(t, w.Flush())
+
+	require.NoError(t, w.WriteTile(ctx, a, []byte("replaced")))
+	require.NoError(t, w.WriteTile(ctx, b, nil))
+	require.NoError(t, w.Flush())
+	assert.Equal(t, 3, count(t, db, `SELECT COUNT(*) FROM images`), "images are kept until the writer is closed")
+
+	require.NoError(t, w.Close())
+	assert.Equal(t, 1, count(t, db, `SELECT COUNT(*) FROM images`))
+
+	r := NewReader(db)
+	data, err := r.ReadTile(ctx, a)
+	require.NoError(t, err)
+	assert.Equal(t, "replaced", string(data))
+	data, err = r.ReadTile(ctx, b)
+	require.NoError(t, err)
+	assert.Nil(t, data)
+
+	// without SetMetadata, the metadata are left unchanged
+	assert.Equal(t, 0, count(t, db, `SELECT COUNT(*) FROM metadata`))
+}
@@ -0,0 +1,84 @@
+package mbtiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+// jsonMetadata is the value of the json key of the metadata of vector tiles.
+type jsonMetadata struct {
+	VectorLayers []tilestore.VectorLayer `json:"vector_layers"`
+}
+
+// encodeMetadata yields the rows of the metadata table.
+func encodeMetadata(m tilestore.Metadata) (map[string]string, error) {
+	rows := map[string]string{
+		"name":    m.Name,
+		"format":  m.Format,
+		"bounds":  formatFloats(m.Bounds[:]),
+		"center":  formatFloats(m.Center[:]),
+		"minzoom": strconv.Itoa(int(m.MinZoom)),
+		"maxzoom": strconv.Itoa(int(m.MaxZoom)),
+	}
+	for key, value := range map[string]string{
+		"description": m.Description,
+		"attribution": m.Attribution,
+		"version":     m.Version,
+	} {
+		if value != "" {
+			rows[key] = value
+		}
+	}
+	if m.VectorLayers != nil {
+		b, err := json.Marshal(jsonMetadata{VectorLayers: m.VectorLayers})
+		if err != nil {
+			return nil, err
+		}
+		rows["json"] = string(b)
+	}
+	return rows, nil
+}
+
+// decodeMetadata decodes the rows of the metadata table. Unknown keys are ignored.
+func decodeMetadata(rows map[string]string) (tilestore.Metadata, error) {
+	m := tilestore.Metadata{
+		Name:        rows["name"],
+		Description: rows["description"],
+		Attribution: rows["attribution"],
+		Version:     rows["version"],
+		Format:      rows["format"],
+	}
+	if v, ok := rows["bounds"]; ok {
+		if err := parseFloats(v, m.Bounds[:]); err != nil {
+			return m, fmt.Errorf("mbtiles: invalid bounds: %v", err)
+		}
+	}
+	if v, ok := rows["center"]; ok {
+		if err := parseFloats(v, m.Center[:]); err != nil {
+			return m, fmt.Errorf("mbtiles: invalid center: %v", err)
+		}
+	}
+	for key, z := range map[string]*maptile.Zoom{"minzoom": &m.MinZoom, "maxzoom": &m.MaxZoom} {
+		v, ok := rows[key]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return m, fmt.Errorf("mbtiles: invalid %s: %v", key, err)
+		}
+		*z = maptile.Zoom(n)
+	}
+	if v, ok := rows["json"]; ok {
+		var doc jsonMetadata
+		if err := json.Unmarshal([]byte(v), &doc); err != nil {
+			return m, fmt.Errorf("mbtiles: invalid json metadata: %v", err)
+		}
+		m.VectorLayers = doc.VectorLayers
+	}
+	return m, nil
+}
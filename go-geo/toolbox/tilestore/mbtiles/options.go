@@ -0,0 +1,29 @@
+package mbtiles
+
+// DefaultBatchSize is the default number of tiles written per transaction.
+const DefaultBatchSize = 1000
+
+type options struct {
+	batchSize int
+}
+
+func defaultOptions() options {
+	return options{
+		batchSize: DefaultBatchSize,
+	}
+}
+
+// An Option is a possible parameter to the writer.
+type Option func(*options)
+
+// WithBatchSize sets the number of tiles written per transaction. The default is DefaultBatchSize.
+//
+// The tiles of a committed transaction persist even if the writer is not closed,
+// e.g. when a long run is interrupted.
+func WithBatchSize(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
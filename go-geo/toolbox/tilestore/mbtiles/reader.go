@@ -0,0 +1,60 @@
+package mbtiles
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+var _ tilestore.Reader = &Reader{}
+
+// Reader reads the tiles of an archive, whether or not its tiles are deduplicated.
+type Reader struct {
+	db *sql.DB
+}
+
+// NewReader creates a reader of the tiles of a database.
+func NewReader(db *sql.DB) *Reader {
+	return &Reader{db: db}
+}
+
+// ReadTile yields the data of a tile, or nil if the archive has no such tile.
+func (r *Reader) ReadTile(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	var data []byte
+	err := r.db.QueryRowContext(ctx,
+		`SELECT tile_data FROM tiles WHERE zoom_level = ? AND tile_column = ? AND tile_row = ?`,
+		int64(tile.Z), int64(tile.X), int64(row(tile)),
+	).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("mbtiles: reading tile: %v", err)
+	}
+	return data, nil
+}
+
+// Metadata yields the metadata of the archive.
+func (r *Reader) Metadata(ctx context.Context) (tilestore.Metadata, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT name, value FROM metadata`)
+	if err != nil {
+		return tilestore.Metadata{}, fmt.Errorf("mbtiles: reading metadata: %v", err)
+	}
+	defer rows.Close()
+
+	values := make(map[string]string)
+	for rows.Next() {
+		var name, value sql.NullString
+		if err := rows.Scan(&name, &value); err != nil {
+			return tilestore.Metadata{}, fmt.Errorf("mbtiles: reading metadata: %v", err)
+		}
+		values[name.String] = value.String
+	}
+	if err := rows.Err(); err != nil {
+		return tilestore.Metadata{}, fmt.Errorf("mbtiles: reading metadata: %v", err)
+	}
+	return decodeMetadata(values)
+}
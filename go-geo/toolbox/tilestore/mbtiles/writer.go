@@ -0,0 +1,204 @@
+package mbtiles
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cespare/xxhash"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+var errClosed = errors.New("mbtiles: writer is closed")
+
+var (
+	_ tilestore.Writer  = &Writer{}
+	_ tilestore.Flusher = &Writer{}
+)
+
+// Writer writes tiles to an archive, creating its tables if needed.
+//
+// Tiles are written in batches of transactions. If a write fails, the tiles
+// of the current batch are discarded.
+//
+// A Writer is safe for concurrent use.
+type Writer struct {
+	db       *sql.DB
+	tx       *sql.Tx
+	stmts    statements
+	pending  int
+	metadata *tilestore.Metadata
+	closed   bool
+	mu       sync.Mutex
+	options
+}
+
+type statements struct {
+	insertImage, insertMap, deleteMap *sql.Stmt
+}
+
+// NewWriter creates a writer of tiles to a database.
+func NewWriter(ctx context.Context, db *sql.DB, opts ...Option) (*Writer, error) {
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("mbtiles: creating schema: %v", err)
+		}
+	}
+	w := &Writer{db: db, options: defaultOptions()}
+	for _, apply := range opts {
+		apply(&w.options)
+	}
+	return w, nil
+}
+
+// WriteTile writes the data of a tile. Empty data removes the tile.
+func (w *Writer) WriteTile(ctx context.Context, tile maptile.Tile, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errClosed
+	}
+	if err := w.begin(ctx); err != nil {
+		return err
+	}
+
+	z, x, y := int64(tile.Z), int64(tile.X), int64(row(tile))
+	if len(data) == 0 {
+		if _, err := w.stmts.deleteMap.ExecContext(ctx, z, x, y); err != nil {
+			return w.rollback(fmt.Errorf("mbtiles: removing tile: %v", err))
+		}
+	} else {
+		id := fmt.Sprintf("%016x", xxhash.Sum64(data))
+		if _, err := w.stmts.insertImage.ExecContext(ctx, id, data); err != nil {
+			return w.rollback(fmt.Errorf("mbtiles: writing tile: %v", err))
+		}
+		if _, err := w.stmts.insertMap.ExecContext(ctx, z, x, y, id); err != nil {
+			return w.rollback(fmt.Errorf("mbtiles: writing tile: %v", err))
+		}
+	}
+
+	w.pending++
+	if w.pending >= w.batchSize {
+		return w.commit()
+	}
+	return nil
+}
+
+// Flush commits the pending tiles.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errClosed
+	}
+	if w.tx == nil {
+		return nil
+	}
+	return w.commit()
+}
+
+// SetMetadata sets the metadata of the archive, written on Close.
+//
+// When the metadata is not set, Close leaves the metadata of the archive unchanged.
+func (w *Writer) SetMetadata(m tilestore.Metadata) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.metadata = &m
+}
+
+// Close writes the metadata, removes the images no tile refers to any longer
+// and commits the pending tiles. It does not close the database.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errClosed
+	}
+	w.closed = true
+
+	ctx := context.Background()
+	if err := w.begin(ctx); err != nil {
+		return err
+	}
+	if w.metadata != nil {
+		if err := w.writeMetadata(ctx, *w.metadata); err != nil {
+			return w.rollback(err)
+		}
+	}
+	if _, err := w.tx.ExecContext(ctx, `DELETE FROM images WHERE tile_id NOT IN (SELECT tile_id FROM map)`); err != nil {
+		return w.rollback(fmt.Errorf("mbtiles: removing unused images: %v", err))
+	}
+	return w.commit()
+}
+
+func (w *Writer) writeMetadata(ctx context.Context, m tilestore.Metadata) error {
+	m = m.WithDefaults()
+	if m.MinZoom == 0 && m.MaxZoom == 0 {
+		var minZoom, maxZoom sql.NullInt64
+		err := w.tx.QueryRowContext(ctx, `SELECT MIN(zoom_level), MAX(zoom_level) FROM map`).Scan(&minZoom, &maxZoom)
+		if err != nil {
+			return fmt.Errorf("mbtiles: reading zoom range: %v", err)
+		}
+		m.MinZoom, m.MaxZoom = maptile.Zoom(minZoom.Int64), maptile.Zoom(maxZoom.Int64)
+	}
+
+	rows, err := encodeMetadata(m)
+	if err != nil {
+		return fmt.Errorf("mbtiles: encoding metadata: %v", err)
+	}
+	if _, err := w.tx.ExecContext(ctx, `DELETE FROM metadata`); err != nil {
+		return fmt.Errorf("mbtiles: writing metadata: %v", err)
+	}
+	for name, value := range rows {
+		if _, err := w.tx.ExecContext(ctx, `INSERT INTO metadata (name, value) VALUES (?, ?)`, name, value); err != nil {
+			return fmt.Errorf("mbtiles: writing metadata: %v", err)
+		}
+	}
+	return nil
+}
+
+// begin starts a transaction, unless one is pending.
+func (w *Writer) begin(ctx context.Context) error {
+	if w.tx != nil {
+		return nil
+	}
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("mbtiles: starting transaction: %v", err)
+	}
+	for _, s := range []struct {
+		stmt  **sql.Stmt
+		query string
+	}{
+		{&w.stmts.insertImage, `INSERT OR IGNORE INTO images (tile_id, tile_data) VALUES (?, ?)`},
+		{&w.stmts.insertMap, `INSERT OR REPLACE INTO map (zoom_level, tile_column, tile_row, tile_id) VALUES (?, ?, ?, ?)`},
+		{&w.stmts.deleteMap, `DELETE FROM map WHERE zoom_level = ? AND tile_column = ? AND tile_row = ?`},
+	} {
+		if *s.stmt, err = tx.PrepareContext(ctx, s.query); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("mbtiles: preparing statement: %v", err)
+		}
+	}
+	w.tx = tx
+	return nil
+}
+
+// commit commits the pending transaction, which also closes its statements.
+func (w *Writer) commit() error {
+	tx := w.tx
+	w.tx, w.pending = nil, 0
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("mbtiles: committing tiles: %v", err)
+	}
+	return nil
+}
+
+// rollback discards the pending transaction after some error.
+func (w *Writer) rollback(err error) error {
+	_ = w.tx.Rollback()
+	w.tx, w.pending = nil, 0
+	return err
+}
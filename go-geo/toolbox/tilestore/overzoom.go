@@ -0,0 +1,60 @@
+package tilestore
+
+import (
+	"context"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+)
+
+// Overzoom serves the tiles of a store of vector tiles beyond its maximum zoom level:
+// the tiles of greater zoom levels are derived from their ancestor at maxZoom with
+// mvt.OverzoomGzipped, clipped with a buffer in units of the tile extent.
+//
+// High zoom levels can thus be served without storing them.
+func Overzoom(r Reader, maxZoom maptile.Zoom, buffer float64) Reader {
+	return &overzoomReader{Reader: r, maxZoom: maxZoom, buffer: buffer}
+}
+
+type overzoomReader struct {
+	Reader
+	maxZoom maptile.Zoom
+	buffer  float64
+}
+
+// ReadTile reads the tiles up to the maximum zoom level from the store,
+// and derives the tiles of greater zoom levels.
+func (o *overzoomReader) ReadTile(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	if tile.Z <= o.maxZoom {
+		return o.Reader.ReadTile(ctx, tile)
+	}
+	n := uint32(tile.Z - o.maxZoom)
+	ancestor := maptile.New(tile.X>>n, tile.Y>>n, o.maxZoom)
+	data, err := o.Reader.ReadTile(ctx, ancestor)
+	if err != nil || len(data) == 0 {
+		return data, err
+	}
+	return mvt.OverzoomGzipped(data, ancestor, tile, o.buffer)
+}
+
+// Metadata yields the metadata of the store, with zoom ranges extended to maptile.MaxZ.
+func (o *overzoomReader) Metadata(ctx context.Context) (Metadata, error) {
+	m, err := o.Reader.Metadata(ctx)
+	if err != nil {
+		return m, err
+	}
+	if m.MaxZoom == o.maxZoom {
+		m.MaxZoom = maptile.MaxZ
+	}
+	if len(m.VectorLayers) > 0 {
+		layers := make([]VectorLayer, len(m.VectorLayers))
+		for i, l := range m.VectorLayers {
+			if l.MaxZoom == o.maxZoom {
+				l.MaxZoom = maptile.MaxZ
+			}
+			layers[i] = l
+		}
+		m.VectorLayers = layers
+	}
+	return m, nil
+}
@@ -0,0 +1,57 @@
+package tilestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/mvt"
+	"github.com/fredbi/geo/utils/geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+)
+
+// memoryReader reads tiles from a map.
+type memoryReader map[maptile.Tile][]byte
+
+func (m memoryReader) ReadTile(_ context.Context, tile maptile.Tile) ([]byte, error) {
+	return m[tile], nil
+}
+
+func (m memoryReader) Metadata(context.Context) (Metadata, error) {
+	return Metadata{MaxZoom: 10, VectorLayers: []VectorLayer{{ID: "poi", MaxZoom: 10}, {ID: "roads", MaxZoom: 8}}}, nil
+}
+
+func TestOverzoom(t *testing.T) {
+	ctx := context.Background()
+	poi := geojson.NewFeature(geom.NewPointFlat(geom.XY, []float64{100, 3000}))
+	poi.Properties = geojson.Properties{"name": "station"}
+	data, err := mvt.MarshalGzipped(mvt.Layers{mvt.NewLayer("poi", geojson.NewFeatureCollection().Append(poi))})
+	require.NoError(t, err)
+	r := Overzoom(memoryReader{maptile.New(5, 7, 10): data}, 10, 64)
+
+	stored, err := r.ReadTile(ctx, maptile.New(5, 7, 10))
+	require.NoError(t, err)
+	assert.Equal(t, data, stored)
+
+	overzoomed, err := r.ReadTile(ctx, maptile.New(20, 30, 12))
+	require.NoError(t, err)
+	layers, err := mvt.UnmarshalGzipped(overzoomed)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	assert.Equal(t, []float64{400, 3808}, layers[0].Features[0].Geometry.FlatCoords())
+
+	overzoomed, err = r.ReadTile(ctx, maptile.New(21, 29, 12))
+	require.NoError(t, err)
+	assert.Nil(t, overzoomed)
+	overzoomed, err = r.ReadTile(ctx, maptile.New(0, 0, 12))
+	require.NoError(t, err)
+	assert.Nil(t, overzoomed)
+
+	m, err := r.Metadata(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, maptile.Zoom(maptile.MaxZ), m.MaxZoom)
+	assert.Equal(t, maptile.Zoom(maptile.MaxZ), m.VectorLayers[0].MaxZoom)
+	assert.Equal(t, maptile.Zoom(8), m.VectorLayers[1].MaxZoom)
+}
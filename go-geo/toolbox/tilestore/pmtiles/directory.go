@@ -0,0 +1,191 @@
+package pmtiles
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"sort"
+)
+
+// entry is an entry of a directory: either a run of tiles with the same data,
+// or a leaf directory when runLength is zero.
+type entry struct {
+	tileID    uint64
+	offset    uint64
+	length    uint32
+	runLength uint32
+}
+
+// find yields the entry of a directory covering a tile ID: a run including the tile,
+// or the leaf directory which may include it.
+func find(entries []entry, tileID uint64) (entry, bool) {
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].tileID > tileID }) - 1
+	if i < 0 {
+		return entry{}, false
+	}
+	e := entries[i]
+	if e.runLength == 0 || tileID < e.tileID+uint64(e.runLength) {
+		return e, true
+	}
+	return entry{}, false
+}
+
+// marshalDirectory encodes and gzips a directory.
+//
+// The columns of the entries are written one after the other as varints:
+// deltas of tile IDs, run lengths, lengths and offsets, where a zero offset
+// means the data follows the one of the former entry.
+func marshalDirectory(entries []entry) ([]byte, error) {
+	raw := make([]byte, 0, 4*binary.MaxVarintLen64*len(entries)+binary.MaxVarintLen64)
+	raw = appendUvarint(raw, uint64(len(entries)))
+	var lastID uint64
+	for _, e := range entries {
+		raw = appendUvarint(raw, e.tileID-lastID)
+		lastID = e.tileID
+	}
+	for _, e := range entries {
+		raw = appendUvarint(raw, uint64(e.runLength))
+	}
+	for _, e := range entries {
+		raw = appendUvarint(raw, uint64(e.length))
+	}
+	for i, e := range entries {
+		if i > 0 && e.offset == entries[i-1].offset+uint64(entries[i-1].length) {
+			raw = appendUvarint(raw, 0)
+			continue
+		}
+		raw = appendUvarint(raw, e.offset+1)
+	}
+	return gzipped(raw)
+}
+
+// unmarshalDirectory decodes a directory compressed with some compression.
+func unmarshalDirectory(data []byte, compression Compression) ([]entry, error) {
+	data, err := decompress(data, compression)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(data)
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > uint64(len(data)) {
+		return nil, ErrInvalidArchive
+	}
+	entries := make([]entry, n)
+	var lastID uint64
+	for i := range entries {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, ErrInvalidArchive
+		}
+		lastID += v
+		entries[i].tileID = lastID
+	}
+	for i := range entries {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, ErrInvalidArchive
+		}
+		entries[i].runLength = uint32(v)
+	}
+	for i := range entries {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, ErrInvalidArchive
+		}
+		entries[i].length = uint32(v)
+	}
+	for i := range entries {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, ErrInvalidArchive
+		}
+		if v == 0 {
+			if i == 0 {
+				return nil, ErrInvalidArchive
+			}
+			entries[i].offset = entries[i-1].offset + uint64(entries[i-1].length)
+			continue
+		}
+		entries[i].offset = v - 1
+	}
+	return entries, nil
+}
+
+// buildDirectories yields a root directory small enough for the first 16 KiB of the archive,
+// spilling entries to leaf directories when needed.
+func buildDirectories(entries []entry) (root, leaves []byte, err error) {
+	root, err = marshalDirectory(entries)
+	if err != nil || len(root) <= rootSizeLimit-headerSize {
+		return root, nil, err
+	}
+
+	for leafSize := 4096; ; leafSize *= 2 {
+		var (
+			rootEntries []entry
+			buf         bytes.Buffer
+		)
+		for i := 0; i < len(entries); i += leafSize {
+			end := i + leafSize
+			if end > len(entries) {
+				end = len(entries)
+			}
+			leaf, err := marshalDirectory(entries[i:end])
+			if err != nil {
+				return nil, nil, err
+			}
+			rootEntries = append(rootEntries, entry{
+				tileID: entries[i].tileID,
+				offset: uint64(buf.Len()),
+				length: uint32(len(leaf)),
+			})
+			buf.Write(leaf)
+		}
+		if root, err = marshalDirectory(rootEntries); err != nil {
+			return nil, nil, err
+		}
+		if len(root) <= rootSizeLimit-headerSize {
+			return root, buf.Bytes(), nil
+		}
+	}
+}
+
+func gzipped(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decompress decompresses directories or metadata.
+func decompress(data []byte, compression Compression) ([]byte, error) {
+	switch compression {
+	case NoCompression:
+		return data, nil
+	case Gzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("pmtiles: decompressing: %v", err)
+		}
+		data, err = ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("pmtiles: decompressing: %v", err)
+		}
+		return data, nil
+	default:
+		return nil, ErrUnsupportedCompression(compression)
+	}
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(b, tmp[:n]...)
+}
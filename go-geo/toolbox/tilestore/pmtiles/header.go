@@ -0,0 +1,104 @@
+package pmtiles
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+const (
+	magic         = "PMTiles"
+	specVersion   = 3
+	headerSize    = 127
+	rootSizeLimit = 16384 // the header and the root directory fit in the first 16 KiB
+)
+
+// header is the fixed size header at the start of the archives.
+type header struct {
+	rootOffset, rootLength         uint64
+	metadataOffset, metadataLength uint64
+	leavesOffset, leavesLength     uint64
+	dataOffset, dataLength         uint64
+
+	addressedTiles, tileEntries, tileContents uint64
+
+	clustered           bool
+	internalCompression Compression
+	tileCompression     Compression
+	tileType            TileType
+
+	minZoom, maxZoom               uint8
+	minLon, minLat, maxLon, maxLat float64
+	centerZoom                     uint8
+	centerLon, centerLat           float64
+}
+
+func (h *header) marshal() []byte {
+	b := make([]byte, headerSize)
+	copy(b, magic)
+	b[7] = specVersion
+	le := binary.LittleEndian
+	for i, v := range []uint64{
+		h.rootOffset, h.rootLength,
+		h.metadataOffset, h.metadataLength,
+		h.leavesOffset, h.leavesLength,
+		h.dataOffset, h.dataLength,
+		h.addressedTiles, h.tileEntries, h.tileContents,
+	} {
+		le.PutUint64(b[8+8*i:], v)
+	}
+	if h.clustered {
+		b[96] = 1
+	}
+	b[97] = byte(h.internalCompression)
+	b[98] = byte(h.tileCompression)
+	b[99] = byte(h.tileType)
+	b[100] = h.minZoom
+	b[101] = h.maxZoom
+	le.PutUint32(b[102:], uint32(e7(h.minLon)))
+	le.PutUint32(b[106:], uint32(e7(h.minLat)))
+	le.PutUint32(b[110:], uint32(e7(h.maxLon)))
+	le.PutUint32(b[114:], uint32(e7(h.maxLat)))
+	b[118] = h.centerZoom
+	le.PutUint32(b[119:], uint32(e7(h.centerLon)))
+	le.PutUint32(b[123:], uint32(e7(h.centerLat)))
+	return b
+}
+
+func (h *header) unmarshal(b []byte) error {
+	if len(b) < headerSize || string(b[:7]) != magic || b[7] != specVersion {
+		return ErrInvalidArchive
+	}
+	le := binary.LittleEndian
+	for i, v := range []*uint64{
+		&h.rootOffset, &h.rootLength,
+		&h.metadataOffset, &h.metadataLength,
+		&h.leavesOffset, &h.leavesLength,
+		&h.dataOffset, &h.dataLength,
+		&h.addressedTiles, &h.tileEntries, &h.tileContents,
+	} {
+		*v = le.Uint64(b[8+8*i:])
+	}
+	h.clustered = b[96] == 1
+	h.internalCompression = Compression(b[97])
+	h.tileCompression = Compression(b[98])
+	h.tileType = TileType(b[99])
+	h.minZoom = b[100]
+	h.maxZoom = b[101]
+	h.minLon = fromE7(le.Uint32(b[102:]))
+	h.minLat = fromE7(le.Uint32(b[106:]))
+	h.maxLon = fromE7(le.Uint32(b[110:]))
+	h.maxLat = fromE7(le.Uint32(b[114:]))
+	h.centerZoom = b[118]
+	h.centerLon = fromE7(le.Uint32(b[119:]))
+	h.centerLat = fromE7(le.Uint32(b[123:]))
+	return nil
+}
+
+// e7 yields a coordinate as an integer of 10^-7 degrees.
+func e7(v float64) int32 {
+	return int32(math.Round(v * 1e7))
+}
+
+func fromE7(v uint32) float64 {
+	return float64(int32(v)) / 1e7
+}
@@ -0,0 +1,83 @@
+package pmtiles
+
+import (
+	"strings"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+// metadataDocument is the JSON metadata of an archive. Bounds, center and zoom range are in the header.
+type metadataDocument struct {
+	Name         string                  `json:"name,omitempty"`
+	Description  string                  `json:"description,omitempty"`
+	Attribution  string                  `json:"attribution,omitempty"`
+	Version      string                  `json:"version,omitempty"`
+	Format       string                  `json:"format,omitempty"`
+	VectorLayers []tilestore.VectorLayer `json:"vector_layers,omitempty"`
+}
+
+func newMetadataDocument(m tilestore.Metadata) metadataDocument {
+	return metadataDocument{
+		Name:         m.Name,
+		Description:  m.Description,
+		Attribution:  m.Attribution,
+		Version:      m.Version,
+		Format:       m.Format,
+		VectorLayers: m.VectorLayers,
+	}
+}
+
+func (d metadataDocument) metadata(h *header) tilestore.Metadata {
+	format := d.Format
+	if format == "" {
+		format = formatOf(h.tileType)
+	}
+	return tilestore.Metadata{
+		Name:         d.Name,
+		Description:  d.Description,
+		Attribution:  d.Attribution,
+		Version:      d.Version,
+		Format:       format,
+		Bounds:       [4]float64{h.minLon, h.minLat, h.maxLon, h.maxLat},
+		Center:       [3]float64{h.centerLon, h.centerLat, float64(h.centerZoom)},
+		MinZoom:      maptile.Zoom(h.minZoom),
+		MaxZoom:      maptile.Zoom(h.maxZoom),
+		VectorLayers: d.VectorLayers,
+	}
+}
+
+// tileTypeOf yields the tile type and compression of the tiles of some format.
+func tileTypeOf(format string) (TileType, Compression) {
+	switch strings.ToLower(format) {
+	case "pbf", "mvt":
+		return MVT, Gzip
+	case "png":
+		return PNG, NoCompression
+	case "jpg", "jpeg":
+		return JPEG, NoCompression
+	case "webp":
+		return WebP, NoCompression
+	case "avif":
+		return AVIF, NoCompression
+	default:
+		return UnknownTileType, UnknownCompression
+	}
+}
+
+func formatOf(t TileType) string {
+	switch t {
+	case MVT:
+		return "pbf"
+	case PNG:
+		return "png"
+	case JPEG:
+		return "jpg"
+	case WebP:
+		return "webp"
+	case AVIF:
+		return "avif"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,95 @@
+// Package pmtiles reads and writes PMTiles v3 archives,
+// see https://github.com/protomaps/PMTiles/blob/main/spec/v3/spec.md.
+//
+// A PMTiles archive is a single file, with the tiles sorted along a Hilbert curve and
+// compressed directories of tile offsets. A tile is read with a handful of range reads,
+// so archives may be served straight from object storage through an io.ReaderAt.
+package pmtiles
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fredbi/geo/pkg/maptile"
+)
+
+// Compression is the compression of the tiles or directories of an archive.
+type Compression uint8
+
+// Compressions of the specification.
+const (
+	UnknownCompression Compression = iota
+	NoCompression
+	Gzip
+	Brotli
+	Zstd
+)
+
+// TileType is the type of the tiles of an archive.
+type TileType uint8
+
+// Tile types of the specification.
+const (
+	UnknownTileType TileType = iota
+	MVT
+	PNG
+	JPEG
+	WebP
+	AVIF
+)
+
+// ErrInvalidArchive is returned when some data is not a PMTiles v3 archive.
+var ErrInvalidArchive = errors.New("pmtiles: invalid archive")
+
+// ErrUnsupportedCompression is returned for archives with directories compressed
+// with something else than gzip.
+type ErrUnsupportedCompression Compression
+
+func (e ErrUnsupportedCompression) Error() string {
+	return fmt.Sprintf("pmtiles: unsupported compression %d", e)
+}
+
+// TileID yields the position of a tile on the Hilbert curve of the archives.
+func TileID(tile maptile.Tile) uint64 {
+	z := uint64(tile.Z)
+	id := (uint64(1)<<(2*z) - 1) / 3 // tiles of the lower zoom levels
+	x, y := uint64(tile.X), uint64(tile.Y)
+	for s := uint64(1) << z >> 1; s > 0; s >>= 1 {
+		rx, ry := x&s, y&s
+		id += ((3 * rx) ^ ry) * s
+		x, y = rotate(s, x, y, rx != 0, ry != 0)
+	}
+	return id
+}
+
+// TileFromID yields the tile at some position on the Hilbert curve.
+func TileFromID(id uint64) maptile.Tile {
+	var z uint64
+	for ; ; z++ {
+		n := uint64(1) << (2 * z)
+		if id < n {
+			break
+		}
+		id -= n
+	}
+	var x, y uint64
+	for s := uint64(1); s < uint64(1)<<z; s <<= 1 {
+		rx := 1 & (id / 2)
+		ry := 1 & (id ^ rx)
+		x, y = rotate(s, x, y, rx > 0, ry > 0)
+		x += s * rx
+		y += s * ry
+		id /= 4
+	}
+	return maptile.New(uint32(x), uint32(y), maptile.Zoom(z))
+}
+
+func rotate(n, x, y uint64, rx, ry bool) (uint64, uint64) {
+	if ry {
+		return x, y
+	}
+	if rx {
+		x, y = n-1-x, n-1-y
+	}
+	return y, x
+}
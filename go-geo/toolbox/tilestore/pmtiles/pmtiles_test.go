@@ -0,0 +1,166 @@
+package pmtiles
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTileID(t *testing.T) {
+	for _, tc := range []struct {
+		tile maptile.Tile
+		id   uint64
+	}{
+		{tile: maptile.New(0, 0, 0), id: 0},
+		{tile: maptile.New(0, 0, 1), id: 1},
+		{tile: maptile.New(0, 1, 1), id: 2},
+		{tile: maptile.New(1, 1, 1), id: 3},
+		{tile: maptile.New(1, 0, 1), id: 4},
+		{tile: maptile.New(0, 0, 2), id: 5},
+		{tile: maptile.New(0, 0, 20), id: 366503875925},
+	} {
+		assert.Equal(t, tc.id, TileID(tc.tile))
+		assert.Equal(t, tc.tile, TileFromID(tc.id))
+	}
+
+	for z := maptile.Zoom(0); z < 6; z++ {
+		for x := uint32(0); x < 1<<z; x++ {
+			for y := uint32(0); y < 1<<z; y++ {
+				tile := maptile.New(x, y, z)
+				require.Equal(t, tile, TileFromID(TileID(tile)))
+			}
+		}
+	}
+}
+
+func TestDirectory(t *testing.T) {
+	entries := []entry{
+		{tileID: 0, offset: 0, length: 10, runLength: 1},
+		{tileID: 1, offset: 10, length: 20, runLength: 3},
+		{tileID: 5, offset: 0, length: 10, runLength: 1},
+		{tileID: 9, offset: 0, length: 100},
+	}
+	b, err := marshalDirectory(entries)
+	require.NoError(t, err)
+	decoded, err := unmarshalDirectory(b, Gzip)
+	require.NoError(t, err)
+	assert.Equal(t, entries, decoded)
+
+	for id, expected := range map[uint64]int{0: 0, 1: 1, 3: 1, 4: -1, 5: 2, 6: -1, 9: 3, 1000: 3} {
+		e, ok := find(entries, id)
+		if expected < 0 {
+			assert.False(t, ok, id)
+			continue
+		}
+		require.True(t, ok, id)
+		assert.Equal(t, entries[expected], e, id)
+	}
+
+	_, err = unmarshalDirectory(b, Brotli)
+	assert.Equal(t, ErrUnsupportedCompression(Brotli), err)
+	_, err = unmarshalDirectory([]byte{3, 1}, NoCompression)
+	assert.Equal(t, ErrInvalidArchive, err)
+}
+
+func TestWriteRead(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.NoError(t, err)
+
+	ocean := []byte("ocean")
+	require.NoError(t, w.WriteTile(ctx, maptile.New(1, 1, 1), []byte("land")))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(0, 0, 0), []byte("world")))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(0, 0, 1), ocean))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(0, 1, 1), ocean))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(1, 0, 1), ocean))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(0, 0, 2), ocean))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(2, 2, 2), []byte("removed")))
+	require.NoError(t, w.WriteTile(ctx, maptile.New(2, 2, 2), nil))
+	w.SetMetadata(tilestore.Metadata{
+		Name:         "test",
+		Attribution:  "someone",
+		Center:       [3]float64{2.35, 48.85, 1},
+		VectorLayers: []tilestore.VectorLayer{{ID: "roads", Fields: map[string]string{"class": "String"}, MaxZoom: 2}},
+	})
+	require.NoError(t, w.Close())
+	assert.Equal(t, errClosed, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, MVT, r.TileType())
+	assert.Equal(t, Gzip, r.TileCompression())
+	assert.Equal(t, uint64(6), r.header.addressedTiles)
+	assert.Equal(t, uint64(4), r.header.tileEntries)
+	assert.Equal(t, uint64(3), r.header.tileContents)
+	assert.Equal(t, uint64(len("worldoceanland")), r.header.dataLength)
+
+	for tile, expected := range map[maptile.Tile]string{
+		maptile.New(0, 0, 0): "world",
+		maptile.New(0, 0, 1): "ocean",
+		maptile.New(0, 1, 1): "ocean",
+		maptile.New(1, 1, 1): "land",
+		maptile.New(1, 0, 1): "ocean",
+		maptile.New(0, 0, 2): "ocean",
+		maptile.New(2, 2, 2): "",
+		maptile.New(3, 3, 2): "",
+		maptile.New(0, 0, 3): "",
+	} {
+		data, err := r.ReadTile(ctx, tile)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data), "%d/%d/%d", tile.Z, tile.X, tile.Y)
+	}
+
+	m, err := r.Metadata(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test", m.Name)
+	assert.Equal(t, "someone", m.Attribution)
+	assert.Equal(t, tilestore.DefaultFormat, m.Format)
+	assert.Equal(t, maptile.Zoom(0), m.MinZoom)
+	assert.Equal(t, maptile.Zoom(2), m.MaxZoom)
+	assert.Equal(t, [3]float64{2.35, 48.85, 1}, m.Center)
+	for i, v := range m.Bounds {
+		assert.InDelta(t, tilestore.Metadata{}.WithDefaults().Bounds[i], v, 1e-7)
+	}
+	assert.Equal(t, "roads", m.VectorLayers[0].ID)
+
+	_, err = NewReader(bytes.NewReader([]byte("not an archive")))
+	assert.Error(t, err)
+}
+
+func TestLeafDirectories(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	require.NoError(t, err)
+
+	// random lengths defeat the compression of the directory
+	const z = 8
+	rnd := rand.New(rand.NewSource(1))
+	for x := uint32(0); x < 1<<z; x++ {
+		for y := uint32(0); y < 1<<z; y++ {
+			data := make([]byte, 4+rnd.Intn(64))
+			binary.LittleEndian.PutUint32(data, x<<16|y)
+			require.NoError(t, w.WriteTile(ctx, maptile.New(x, y, z), data))
+		}
+	}
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.NotZero(t, r.header.leavesLength)
+	assert.LessOrEqual(t, int(headerSize+r.header.rootLength), rootSizeLimit)
+	for _, tile := range []maptile.Tile{maptile.New(0, 0, z), maptile.New(17, 200, z), maptile.New(255, 255, z)} {
+		tile := tile
+		data, err := r.ReadTile(ctx, tile)
+		require.NoError(t, err)
+		assert.Equal(t, tile.X<<16|tile.Y, binary.LittleEndian.Uint32(data))
+	}
+}
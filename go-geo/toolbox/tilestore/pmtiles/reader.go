@@ -0,0 +1,148 @@
+package pmtiles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+const (
+	// maxDepth bounds the number of directories visited to find a tile.
+	maxDepth = 4
+
+	// maxCachedLeaves bounds the number of leaf directories cached by readers.
+	maxCachedLeaves = 64
+)
+
+var _ tilestore.Reader = &Reader{}
+
+// Reader reads an archive.
+//
+// The root directory is read once. Leaf directories are cached.
+//
+// A Reader is safe for concurrent use if its io.ReaderAt is, e.g. an *os.File.
+type Reader struct {
+	r      io.ReaderAt
+	header header
+	root   []entry
+	leaves map[uint64][]entry // by offset
+	mu     sync.Mutex
+}
+
+// NewReader creates a reader of an archive, reading its header and root directory.
+func NewReader(r io.ReaderAt) (*Reader, error) {
+	rd := &Reader{r: r, leaves: make(map[uint64][]entry)}
+	b, err := rd.read(0, headerSize)
+	if err != nil {
+		return nil, fmt.Errorf("pmtiles: reading header: %v", err)
+	}
+	if err := rd.header.unmarshal(b); err != nil {
+		return nil, err
+	}
+	if rd.root, err = rd.directory(rd.header.rootOffset, rd.header.rootLength); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+// TileCompression yields the compression of the tiles of the archive.
+func (r *Reader) TileCompression() Compression {
+	return r.header.tileCompression
+}
+
+// TileType yields the type of the tiles of the archive.
+func (r *Reader) TileType() TileType {
+	return r.header.tileType
+}
+
+// ReadTile yields the data of a tile, as stored, or nil if the archive has no such tile.
+func (r *Reader) ReadTile(ctx context.Context, tile maptile.Tile) ([]byte, error) {
+	if tile.Z < maptile.Zoom(r.header.minZoom) || tile.Z > maptile.Zoom(r.header.maxZoom) {
+		return nil, nil
+	}
+
+	id := TileID(tile)
+	dir := r.root
+	for depth := 0; depth < maxDepth; depth++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		e, ok := find(dir, id)
+		if !ok {
+			return nil, nil
+		}
+		if e.runLength > 0 {
+			data, err := r.read(r.header.dataOffset+e.offset, uint64(e.length))
+			if err != nil {
+				return nil, fmt.Errorf("pmtiles: reading tile: %v", err)
+			}
+			return data, nil
+		}
+		leaf, err := r.leaf(e)
+		if err != nil {
+			return nil, err
+		}
+		dir = leaf
+	}
+	return nil, ErrInvalidArchive
+}
+
+// Metadata yields the metadata of the archive.
+func (r *Reader) Metadata(_ context.Context) (tilestore.Metadata, error) {
+	b, err := r.read(r.header.metadataOffset, r.header.metadataLength)
+	if err != nil {
+		return tilestore.Metadata{}, fmt.Errorf("pmtiles: reading metadata: %v", err)
+	}
+	if b, err = decompress(b, r.header.internalCompression); err != nil {
+		return tilestore.Metadata{}, err
+	}
+	var doc metadataDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return tilestore.Metadata{}, fmt.Errorf("pmtiles: decoding metadata: %v", err)
+	}
+	return doc.metadata(&r.header), nil
+}
+
+func (r *Reader) leaf(e entry) ([]entry, error) {
+	offset := r.header.leavesOffset + e.offset
+	r.mu.Lock()
+	leaf, ok := r.leaves[offset]
+	r.mu.Unlock()
+	if ok {
+		return leaf, nil
+	}
+
+	leaf, err := r.directory(offset, uint64(e.length))
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	if len(r.leaves) >= maxCachedLeaves {
+		r.leaves = make(map[uint64][]entry)
+	}
+	r.leaves[offset] = leaf
+	r.mu.Unlock()
+	return leaf, nil
+}
+
+func (r *Reader) directory(offset, length uint64) ([]entry, error) {
+	b, err := r.read(offset, length)
+	if err != nil {
+		return nil, fmt.Errorf("pmtiles: reading directory: %v", err)
+	}
+	return unmarshalDirectory(b, r.header.internalCompression)
+}
+
+func (r *Reader) read(offset, length uint64) ([]byte, error) {
+	b := make([]byte, length)
+	n, err := r.r.ReadAt(b, int64(offset))
+	if err == io.EOF && n == len(b) {
+		err = nil
+	}
+	return b, err
+}
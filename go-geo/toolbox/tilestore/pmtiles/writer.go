@@ -0,0 +1,209 @@
+package pmtiles
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/cespare/xxhash"
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tilestore"
+)
+
+var errClosed = errors.New("pmtiles: writer is closed")
+
+var _ tilestore.Writer = &Writer{}
+
+// spooled locates some tile data in the spool file.
+type spooled struct {
+	offset uint64
+	length uint32
+	hash   uint64
+}
+
+// Writer writes an archive.
+//
+// Tiles may be written in any order: their data is spooled to a temporary file, and the archive
+// is assembled on Close, with the tile data sorted by tile ID. Identical tile data is detected
+// by its 64-bit hash and stored once.
+//
+// A Writer is safe for concurrent use.
+type Writer struct {
+	out      io.Writer
+	spool    *os.File
+	size     uint64
+	tiles    map[uint64]spooled // by tile ID
+	contents map[uint64]spooled // by hash
+	zooms    tilestore.ZoomRange
+	metadata tilestore.Metadata
+	closed   bool
+	mu       sync.Mutex
+}
+
+// NewWriter creates a writer of an archive to w, written on Close.
+func NewWriter(w io.Writer) (*Writer, error) {
+	spool, err := ioutil.TempFile("", "pmtiles-")
+	if err != nil {
+		return nil, fmt.Errorf("pmtiles: creating spool file: %v", err)
+	}
+	return &Writer{
+		out:      w,
+		spool:    spool,
+		tiles:    make(map[uint64]spooled),
+		contents: make(map[uint64]spooled),
+	}, nil
+}
+
+// WriteTile writes the data of a tile. Empty data removes the tile.
+func (w *Writer) WriteTile(_ context.Context, tile maptile.Tile, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errClosed
+	}
+
+	id := TileID(tile)
+	if len(data) == 0 {
+		delete(w.tiles, id)
+		return nil
+	}
+
+	hash := xxhash.Sum64(data)
+	s, ok := w.contents[hash]
+	if !ok {
+		if _, err := w.spool.Write(data); err != nil {
+			return fmt.Errorf("pmtiles: spooling tile: %v", err)
+		}
+		s = spooled{offset: w.size, length: uint32(len(data)), hash: hash}
+		w.size += uint64(len(data))
+		w.contents[hash] = s
+	}
+	w.tiles[id] = s
+	w.zooms.Add(tile.Z)
+	return nil
+}
+
+// SetMetadata sets the metadata of the archive, written on Close.
+func (w *Writer) SetMetadata(m tilestore.Metadata) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.metadata = m
+}
+
+// Close writes the archive and removes the spool file. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errClosed
+	}
+	w.closed = true
+	defer func() {
+		_ = w.spool.Close()
+		_ = os.Remove(w.spool.Name())
+	}()
+
+	ids := make([]uint64, 0, len(w.tiles))
+	for id := range w.tiles {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	// lay out the tile data in the order of the tile IDs, with runs of identical tiles
+	var (
+		entries    []entry
+		contents   []spooled
+		dataLength uint64
+	)
+	offsets := make(map[uint64]uint64, len(w.contents)) // by hash
+	for _, id := range ids {
+		s := w.tiles[id]
+		offset, ok := offsets[s.hash]
+		if !ok {
+			offset = dataLength
+			offsets[s.hash] = offset
+			dataLength += uint64(s.length)
+			contents = append(contents, s)
+		}
+		if n := len(entries); n > 0 {
+			last := &entries[n-1]
+			if last.offset == offset && last.tileID+uint64(last.runLength) == id {
+				last.runLength++
+				continue
+			}
+		}
+		entries = append(entries, entry{tileID: id, offset: offset, length: s.length, runLength: 1})
+	}
+
+	root, leaves, err := buildDirectories(entries)
+	if err != nil {
+		return fmt.Errorf("pmtiles: encoding directories: %v", err)
+	}
+	m := w.metadata.WithDefaults()
+	metadata, err := json.Marshal(newMetadataDocument(m))
+	if err != nil {
+		return fmt.Errorf("pmtiles: encoding metadata: %v", err)
+	}
+	if metadata, err = gzipped(metadata); err != nil {
+		return fmt.Errorf("pmtiles: encoding metadata: %v", err)
+	}
+
+	h := w.header(m)
+	h.rootOffset, h.rootLength = headerSize, uint64(len(root))
+	h.metadataOffset, h.metadataLength = h.rootOffset+h.rootLength, uint64(len(metadata))
+	h.leavesOffset, h.leavesLength = h.metadataOffset+h.metadataLength, uint64(len(leaves))
+	h.dataOffset, h.dataLength = h.leavesOffset+h.leavesLength, dataLength
+	h.addressedTiles = uint64(len(ids))
+	h.tileEntries = uint64(len(entries))
+	h.tileContents = uint64(len(contents))
+
+	bw := bufio.NewWriter(w.out)
+	for _, b := range [][]byte{h.marshal(), root, metadata, leaves} {
+		if _, err := bw.Write(b); err != nil {
+			return fmt.Errorf("pmtiles: writing archive: %v", err)
+		}
+	}
+	for _, s := range contents {
+		if _, err := io.Copy(bw, io.NewSectionReader(w.spool, int64(s.offset), int64(s.length))); err != nil {
+			return fmt.Errorf("pmtiles: writing archive: %v", err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("pmtiles: writing archive: %v", err)
+	}
+	return nil
+}
+
+// header yields the header of the archive, without the sections.
+func (w *Writer) header(m tilestore.Metadata) *header {
+	h := &header{
+		clustered:           true,
+		internalCompression: Gzip,
+		minZoom:             uint8(m.MinZoom),
+		maxZoom:             uint8(m.MaxZoom),
+		minLon:              m.Bounds[0],
+		minLat:              m.Bounds[1],
+		maxLon:              m.Bounds[2],
+		maxLat:              m.Bounds[3],
+		centerLon:           m.Center[0],
+		centerLat:           m.Center[1],
+		centerZoom:          uint8(m.Center[2]),
+	}
+	h.tileType, h.tileCompression = tileTypeOf(m.Format)
+	if m.MinZoom == 0 && m.MaxZoom == 0 && !w.zooms.Empty() {
+		h.minZoom, h.maxZoom = uint8(w.zooms.Min), uint8(w.zooms.Max)
+	}
+	if m.Center == [3]float64{} {
+		h.centerLon = (h.minLon + h.maxLon) / 2
+		h.centerLat = (h.minLat + h.maxLat) / 2
+		h.centerZoom = h.minZoom
+	}
+	return h
+}
@@ -0,0 +1,112 @@
+// Package tilestore defines stores of pre-generated tiles, such as MBTiles or PMTiles archives.
+//
+// Stores are keyed by maptile.Tile and hold the tile data as is: for vector tiles,
+// this is gzipped MVT data, as produced by mvt.MarshalGzipped.
+package tilestore
+
+import (
+	"context"
+
+	"github.com/fredbi/geo/pkg/maptile"
+	"github.com/fredbi/geo/pkg/tileprovider"
+)
+
+// DefaultFormat is the format of the tiles of a store, unless the metadata tells otherwise.
+const DefaultFormat = "pbf"
+
+// Reader reads the tiles of a store.
+type Reader interface {
+	// ReadTile yields the data of a tile, or nil if the store has no such tile.
+	ReadTile(ctx context.Context, tile maptile.Tile) ([]byte, error)
+
+	// Metadata yields the metadata of the store.
+	Metadata(ctx context.Context) (Metadata, error)
+}
+
+// Writer writes tiles to a store.
+//
+// Identical tile data, e.g. of empty ocean tiles, is stored once.
+type Writer interface {
+	// WriteTile writes the data of a tile, replacing any former data of this tile.
+	WriteTile(ctx context.Context, tile maptile.Tile, data []byte) error
+
+	// SetMetadata sets the metadata of the store, written on Close.
+	SetMetadata(m Metadata)
+
+	// Close writes the metadata and finalizes the store.
+	Close() error
+}
+
+// Flusher is implemented by writers buffering tiles.
+type Flusher interface {
+	// Flush persists the tiles written so far.
+	Flush() error
+}
+
+// Metadata describes the tiles of a store.
+type Metadata struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Attribution string `json:"attribution,omitempty"`
+	Version     string `json:"version,omitempty"`
+
+	// Format is the format of the tiles, i.e. pbf, png, jpg or webp. The default is DefaultFormat.
+	Format string `json:"format,omitempty"`
+
+	// Bounds is the extent of the tiles: west, south, east, north in WGS84.
+	// A zero value means the whole world.
+	Bounds [4]float64 `json:"bounds"`
+
+	// Center is the default view of the tiles: longitude, latitude and zoom.
+	Center [3]float64 `json:"center"`
+
+	// MinZoom and MaxZoom are the zoom range of the tiles.
+	// When both are zero, writers use the zoom range of the written tiles.
+	MinZoom maptile.Zoom `json:"minzoom"`
+	MaxZoom maptile.Zoom `json:"maxzoom"`
+
+	VectorLayers []VectorLayer `json:"vector_layers,omitempty"`
+}
+
+// VectorLayer describes a layer of vector tiles, as in TileJSON.
+type VectorLayer struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description,omitempty"`
+	Fields      map[string]string `json:"fields"`
+	MinZoom     maptile.Zoom      `json:"minzoom"`
+	MaxZoom     maptile.Zoom      `json:"maxzoom"`
+}
+
+// WithDefaults yields the metadata with the default format and bounds when they are not set.
+func (m Metadata) WithDefaults() Metadata {
+	if m.Format == "" {
+		m.Format = DefaultFormat
+	}
+	if m.Bounds == [4]float64{} {
+		m.Bounds = tileprovider.WGS84Bounds
+	}
+	return m
+}
+
+// ZoomRange tracks the zoom range of some tiles.
+// The zero value is an empty range.
+type ZoomRange struct {
+	Min, Max maptile.Zoom
+	nonEmpty bool
+}
+
+// Add extends the range with some zoom level.
+func (r *ZoomRange) Add(z maptile.Zoom) {
+	if !r.nonEmpty || z < r.Min {
+		r.Min = z
+	}
+	if !r.nonEmpty || z > r.Max {
+		r.Max = z
+	}
+	r.nonEmpty = true
+}
+
+// Empty tells if no zoom level was added to the range.
+func (r ZoomRange) Empty() bool {
+	return !r.nonEmpty
+}